@@ -0,0 +1,69 @@
+package aztft
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/magodo/armid"
+	"github.com/magodo/aztft/internal/resmap"
+	"github.com/magodo/aztft/internal/resolve"
+)
+
+// Ambiguity is the error returned when the Azure API can't tell which one of the multiple matched TF resource types the resource is,
+// e.g. the resolver fails to retrieve the resource, or none of its candidates matches the resource.
+// In this case, the query still returns all the candidate TF resource types (as non-exact), so that the caller can choose one manually.
+type Ambiguity struct {
+	AzureId armid.ResourceId
+
+	// Candidates are the matched TF resource types, together with the resolution detail of each.
+	Candidates []Candidate
+
+	// Err is the underlying resolution error.
+	Err error
+}
+
+// Candidate is one of the candidate TF resource types of an ambiguous resource.
+type Candidate struct {
+	TFType string `json:"tf_type"`
+
+	// Evidence is the resource properties that the resolver has checked, e.g. "kind=functionapp,linux", "properties.sku=FlexConsumption".
+	// It is empty if the resolver doesn't explain its resolution, or fails to retrieve the resource.
+	Evidence []string `json:"evidence,omitempty"`
+
+	// Matched indicates whether the candidate is ruled in by the evidence.
+	Matched bool `json:"matched"`
+
+	// Reason tells why the candidate is ruled in or out.
+	Reason string `json:"reason"`
+}
+
+func (e *Ambiguity) Error() string {
+	var l []string
+	for _, c := range e.Candidates {
+		l = append(l, c.TFType)
+	}
+	return fmt.Sprintf("%s is ambiguous among %s: %v", e.AzureId, strings.Join(l, ", "), e.Err)
+}
+
+func (e *Ambiguity) Unwrap() error { return e.Err }
+
+// newAmbiguity returns the ambiguity of the mapping items, explained by the resolver candidates (if any).
+func newAmbiguity(id armid.ResourceId, items []resmap.ARMId2TFMapItem, candidates []resolve.Candidate, err error) *Ambiguity {
+	amb := &Ambiguity{AzureId: id, Err: err}
+	for _, item := range items {
+		c := Candidate{
+			TFType: item.ResourceType,
+			Reason: "no evidence from the resolver",
+		}
+		for _, rc := range candidates {
+			if rc.ResourceType == item.ResourceType {
+				c.Evidence = rc.Evidence
+				c.Matched = rc.Matched
+				c.Reason = rc.Reason
+				break
+			}
+		}
+		amb.Candidates = append(amb.Candidates, c)
+	}
+	return amb
+}
@@ -0,0 +1,126 @@
+package aztft
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/magodo/armid"
+	"github.com/magodo/aztft/internal/client"
+	"github.com/magodo/aztft/internal/errs"
+)
+
+// AzapiResourceType is the TF resource type of the azapi provider, which is returned for the ARM resources that are not covered by the azurerm provider,
+// if the WithAzapiFallback option is specified.
+const AzapiResourceType = "azapi_resource"
+
+const providersAPIVersion = "2021-04-01"
+
+// WithAzapiFallback makes the query return an "azapi_resource" for the ARM resource ID that doesn't match any azurerm resource type,
+// with the Type.AzapiType set to "<provider>/<types>@<api-version>". Its TF resource ID is the ARM resource ID with the "api-version" query parameter.
+//
+// The apiVersions maps the ARM resource type (e.g. "Microsoft.Foo/foos", case insensitively) to its API version. For the resource types not in it,
+// the latest (non-preview, if any) API version is discovered by the Azure providers API, which requires the API option.
+func WithAzapiFallback(apiVersions map[string]string) QueryOption {
+	return func(opts *queryOptions) {
+		opts.azapiFallback = true
+		opts.azapiAPIVersions = map[string]string{}
+		for k, v := range apiVersions {
+			opts.azapiAPIVersions[strings.ToUpper(k)] = v
+		}
+	}
+}
+
+// azapiTypes returns the azapi_resource type for the id, if the WithAzapiFallback option is specified.
+func azapiTypes(ctx context.Context, id armid.ResourceId, apiOpt *APIOption, opts queryOptions) ([]Type, error) {
+	if !opts.azapiFallback || id.Provider() == "" {
+		return nil, nil
+	}
+	azapiType, err := buildAzapiType(ctx, id, apiOpt, opts)
+	if err != nil {
+		return nil, err
+	}
+	return []Type{
+		{
+			AzureId:   id,
+			TFType:    AzapiResourceType,
+			AzapiType: azapiType,
+		},
+	}, nil
+}
+
+func buildAzapiType(ctx context.Context, id armid.ResourceId, apiOpt *APIOption, opts queryOptions) (string, error) {
+	rt := id.Provider() + "/" + strings.Join(id.Types(), "/")
+	if v, ok := opts.azapiAPIVersions[strings.ToUpper(rt)]; ok {
+		return rt + "@" + v, nil
+	}
+	if apiOpt == nil {
+		return "", errs.Mark(ErrNeedsAPI, "no API version specified for %s, which needs call Azure API to discover", rt)
+	}
+	v, err := discoverAPIVersion(ctx, id, apiOpt)
+	if err != nil {
+		return "", fmt.Errorf("discovering API version for %s: %w", rt, err)
+	}
+	return rt + "@" + v, nil
+}
+
+// discoverAPIVersion returns the latest API version of the resource type of the id by the Azure providers API, where the non-preview ones are preferred.
+func discoverAPIVersion(ctx context.Context, id armid.ResourceId, apiOpt *APIOption) (string, error) {
+	providerPath := "/providers/" + id.Provider()
+	switch scope := id.RootScope().(type) {
+	case *armid.SubscriptionId:
+		providerPath = scope.String() + providerPath
+	case *armid.ResourceGroup:
+		providerPath = "/subscriptions/" + scope.SubscriptionId + providerPath
+	}
+
+	b := &client.ClientBuilder{Cred: apiOpt.Cred, ClientOpt: apiOpt.ClientOption}
+	c, err := b.NewRawClient()
+	if err != nil {
+		return "", err
+	}
+	resp, err := c.Get(ctx, providerPath, providersAPIVersion)
+	if err != nil {
+		return "", fmt.Errorf("retrieving %s: %w", providerPath, err)
+	}
+	provider, ok := resp.(map[string]interface{})
+	if !ok {
+		return "", fmt.Errorf("unexpected response of %s", providerPath)
+	}
+	resourceTypes, _ := provider["resourceTypes"].([]interface{})
+	types := strings.Join(id.Types(), "/")
+	for _, v := range resourceTypes {
+		rt, ok := v.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		if name, _ := rt["resourceType"].(string); !strings.EqualFold(name, types) {
+			continue
+		}
+		apiVersions, _ := rt["apiVersions"].([]interface{})
+		var latest string
+		for _, v := range apiVersions {
+			apiVersion, ok := v.(string)
+			if !ok {
+				continue
+			}
+			if !strings.Contains(strings.ToLower(apiVersion), "preview") {
+				return apiVersion, nil
+			}
+			if latest == "" {
+				latest = apiVersion
+			}
+		}
+		if latest == "" {
+			return "", fmt.Errorf("no API version found for resource type %s", types)
+		}
+		return latest, nil
+	}
+	return "", fmt.Errorf("resource type %s not found in %s", types, id.Provider())
+}
+
+// azapiImportId returns the TF resource ID of the azapi_resource, which is the ARM resource ID with the "api-version" query parameter.
+func azapiImportId(id armid.ResourceId, azapiType string) string {
+	_, apiVersion, _ := strings.Cut(azapiType, "@")
+	return id.String() + "?api-version=" + apiVersion
+}
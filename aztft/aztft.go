@@ -1,12 +1,15 @@
 package aztft
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"sort"
 	"strings"
 
 	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
 	"github.com/Azure/azure-sdk-for-go/sdk/azcore/arm"
+	"github.com/magodo/aztft/internal/errs"
 	"github.com/magodo/aztft/internal/populate"
 	"github.com/magodo/aztft/internal/resmap"
 	"github.com/magodo/aztft/internal/resolve"
@@ -18,93 +21,300 @@ import (
 type Type struct {
 	AzureId armid.ResourceId
 	TFType  string
+
+	// Deprecation is only set for the removed/deprecated TF resource type, which is only returned when querying with the IncludeDeprecated option.
+	Deprecation *Deprecation
+
+	// AzapiType is the "type" of the azapi_resource, i.e. "<provider>/<types>@<api-version>", which is only set when the TFType is AzapiResourceType.
+	AzapiType string
+}
+
+// Deprecation describes why a TF resource type is removed/deprecated.
+type Deprecation struct {
+	Reason string
+
+	// Successors are the TF resource types that supersede the deprecated one, if any.
+	Successors []string
+}
+
+// QueryOption customizes the behavior of QueryType and QueryTypeAndId.
+type QueryOption func(*queryOptions)
+
+type queryOptions struct {
+	includeDeprecated bool
+
+	providerVersion string
+	// providerSnapshot is the specified snapshot that matches the provider version, which is nil if none matches (see WithProviderVersion).
+	providerSnapshot    *resmap.Snapshot
+	providerSnapshotErr error
+
+	mapping *Mapping
+
+	azapiFallback    bool
+	azapiAPIVersions map[string]string
+
+	trace func(TraceEvent)
+}
+
+// IncludeDeprecated makes the query additionally return the removed/deprecated TF resource types that match the ARM resource ID, with the Type.Deprecation set.
+// The deprecated types don't affect the "exact" result, and are not resolved even if the API option is specified.
+// This is useful to migrate from the deprecated TF resource types (e.g. "azurerm_app_service") to their successors.
+func IncludeDeprecated() QueryOption {
+	return func(opts *queryOptions) {
+		opts.includeDeprecated = true
+	}
+}
+
+// WithMapping makes the query use the mapping, instead of the embedded one.
+func WithMapping(m *Mapping) QueryOption {
+	return func(opts *queryOptions) {
+		opts.mapping = m
+	}
+}
+
+// resmapMapping returns the mapping specified by the WithMapping option, or the embedded one.
+func (opts queryOptions) resmapMapping() (*resmap.Mapping, error) {
+	if opts.mapping != nil {
+		return opts.mapping.m, nil
+	}
+	return resmap.Embedded()
+}
+
+func newQueryOptions(opts []QueryOption) queryOptions {
+	var o queryOptions
+	for _, opt := range opts {
+		opt(&o)
+	}
+	return o
 }
 
 type APIOption struct {
 	Cred         azcore.TokenCredential
 	ClientOption arm.ClientOptions
+
+	// BodyProvider, if specified, provides the ARM resource JSON bodies to resolve the resources offline, instead of calling the Azure API.
+	// In this case, the Cred is not used and can be left nil.
+	BodyProvider ResourceBodyProvider
+
+	// prefetched is the resource bodies retrieved in bulk from the Azure Resource Graph, see PrefetchResourceGraph.
+	prefetched resolve.Prefetched
 }
 
 // QueryType queries a given ARM resource ID and returns a list of potential matched Terraform resource type.
 // It firstly statically search the known resource mappings. If there are multiple matches and the "apiOpt" is not nil,
 // it will further call Azure API to retrieve additionl information about this resource and return the exact match.
 // Additionally, if "apiOpt" is specified and this resource maps to multiple TF resources, then multiple Types will be returned.
-func QueryType(idStr string, apiOpt *APIOption) (types []Type, exact bool, err error) {
-	return queryType(idStr, apiOpt)
+// If the Azure API can't tell which one of the multiple matches the resource is, all of them are returned (as non-exact) together with an *Ambiguity error,
+// which explains each of the candidates.
+func QueryType(idStr string, apiOpt *APIOption, opts ...QueryOption) (types []Type, exact bool, err error) {
+	return QueryTypeWithContext(context.Background(), idStr, apiOpt, opts...)
+}
+
+// QueryTypeWithContext is similar to QueryType, except the context is used for any Azure API call, which allows the caller to cancel or set a deadline on them.
+func QueryTypeWithContext(ctx context.Context, idStr string, apiOpt *APIOption, opts ...QueryOption) (types []Type, exact bool, err error) {
+	types, exact, err = queryType(ctx, idStr, apiOpt.normalize(), newQueryOptions(opts))
+	return types, exact, apiError(err)
 }
 
 // QueryId queries a given ARM resource ID and its resource type, returns the matched Terraform resource ID.
-func QueryId(idStr string, rt string, apiOpt *APIOption) (string, error) {
+// Only the WithMapping, WithTrace and the WithAzapiFallback (for the AzapiResourceType) options take effect.
+func QueryId(idStr string, rt string, apiOpt *APIOption, opts ...QueryOption) (string, error) {
+	return QueryIdWithContext(context.Background(), idStr, rt, apiOpt, opts...)
+}
+
+// QueryIdWithContext is similar to QueryId, except the context is used for any Azure API call.
+func QueryIdWithContext(ctx context.Context, idStr string, rt string, apiOpt *APIOption, opts ...QueryOption) (string, error) {
 	id, err := armid.ParseResourceId(idStr)
 	if err != nil {
-		return "", fmt.Errorf("parsing id: %v", err)
+		return "", errs.Mark(ErrInvalidId, "parsing id: %w", err)
+	}
+	qopts := newQueryOptions(opts)
+	m, err := qopts.resmapMapping()
+	if err != nil {
+		return "", err
 	}
 
-	return queryId(id, rt, apiOpt)
+	apiOpt = apiOpt.normalize()
+	if rt == AzapiResourceType {
+		azapiType, err := buildAzapiType(ctx, id, apiOpt, qopts)
+		if err != nil {
+			return "", apiError(err)
+		}
+		return azapiImportId(id, azapiType), nil
+	}
+	spec, err := queryId(ctx, m, id, rt, apiOpt, qopts)
+	return spec, apiError(err)
 }
 
 // QueryTypeAndId is similar to QueryType, except it also returns the Terraform resource ID (having same length as the types).
-func QueryTypeAndId(idStr string, apiOpt *APIOption) (types []Type, ids []string, exact bool, err error) {
-	types, exact, err = queryType(idStr, apiOpt)
-	if err != nil {
+// In case of the *Ambiguity error, the Terraform resource IDs of all the candidates are returned as well, where the ID of a candidate that fails to build is empty.
+func QueryTypeAndId(idStr string, apiOpt *APIOption, opts ...QueryOption) (types []Type, ids []string, exact bool, err error) {
+	return QueryTypeAndIdWithContext(context.Background(), idStr, apiOpt, opts...)
+}
+
+// QueryTypeAndIdWithContext is similar to QueryTypeAndId, except the context is used for any Azure API call.
+func QueryTypeAndIdWithContext(ctx context.Context, idStr string, apiOpt *APIOption, opts ...QueryOption) (types []Type, ids []string, exact bool, err error) {
+	types, ids, idErrs, exact, err := queryTypeAndIds(ctx, idStr, apiOpt.normalize(), newQueryOptions(opts))
+	var amb *Ambiguity
+	if err != nil && !errors.As(err, &amb) {
 		return nil, nil, false, err
 	}
+	if amb != nil {
+		// Keep the ambiguity, the candidate whose id can't be built is left with an empty id.
+		return types, ids, false, amb
+	}
+	for _, err := range idErrs {
+		if err != nil {
+			return nil, nil, false, err
+		}
+	}
+	return types, ids, exact, nil
+}
+
+// queryTypeAndIds queries the types of the id, and builds the TF resource id of each of them (including the candidates of the *Ambiguity error).
+// The ids and idErrs have the same length as the types, where the id is empty if it fails to build, with the error recorded in idErrs.
+// All the returned errors are converted by apiError.
+func queryTypeAndIds(ctx context.Context, idStr string, apiOpt *APIOption, qopts queryOptions) (types []Type, ids []string, idErrs []error, exact bool, err error) {
+	m, err := qopts.resmapMapping()
+	if err != nil {
+		return nil, nil, nil, false, err
+	}
+	types, exact, err = queryType(ctx, idStr, apiOpt, qopts)
+	var amb *Ambiguity
+	if err != nil && !errors.As(err, &amb) {
+		return nil, nil, nil, false, apiError(err)
+	}
 	for _, t := range types {
-		tfid, err := queryId(t.AzureId, t.TFType, apiOpt)
+		if t.TFType == AzapiResourceType {
+			ids = append(ids, azapiImportId(t.AzureId, t.AzapiType))
+			idErrs = append(idErrs, nil)
+			continue
+		}
+		tfid, err := queryId(ctx, m, t.AzureId, t.TFType, apiOpt, qopts)
 		if err != nil {
-			return nil, nil, false, fmt.Errorf("querying id %q as %q: %v", t.AzureId, t.TFType, err)
+			ids = append(ids, "")
+			idErrs = append(idErrs, apiError(fmt.Errorf("querying id %q as %q: %w", t.AzureId, t.TFType, err)))
+			continue
 		}
 		ids = append(ids, tfid)
+		idErrs = append(idErrs, nil)
 	}
-	return types, ids, exact, nil
+	if amb != nil {
+		return types, ids, idErrs, false, apiError(amb)
+	}
+	return types, ids, idErrs, exact, nil
+}
+
+// QueryAzureId is the reverse of QueryId, which queries a given Terraform resource type and its resource ID, and returns the Azure resource ID.
+// For property-like resources, the returned Azure resource ID is the pesudo resource ID.
+// Note that not all the Terraform resource IDs can be queried back, e.g. the data plane URLs, or the synthetic IDs that lose information of the Azure resource ID.
+// The Azure resource ID of an azapi_resource is its Terraform resource ID without the "api-version" query parameter.
+// Only the WithMapping option takes effect.
+func QueryAzureId(tfType string, tfId string, opts ...QueryOption) (string, error) {
+	if tfType == AzapiResourceType {
+		idStr, _, _ := strings.Cut(tfId, "?")
+		id, err := armid.ParseResourceId(idStr)
+		if err != nil {
+			return "", errs.Mark(ErrInvalidId, "failed to parse id for %s: %w", tfType, err)
+		}
+		return id.String(), nil
+	}
+	m, err := newQueryOptions(opts).resmapMapping()
+	if err != nil {
+		return "", err
+	}
+	id, err := tfid.StaticParse(m, tfId, tfType)
+	if err != nil {
+		return "", fmt.Errorf("failed to parse id for %s: %w", tfType, err)
+	}
+	return id.String(), nil
 }
 
-func queryId(id armid.ResourceId, rt string, apiOpt *APIOption) (string, error) {
+func queryId(ctx context.Context, m *resmap.Mapping, id armid.ResourceId, rt string, apiOpt *APIOption, opts queryOptions) (string, error) {
 	var (
 		spec string
 		err  error
 	)
 	if tfid.NeedsAPI(rt) {
 		if apiOpt == nil {
-			return "", fmt.Errorf("%s needs call Azure API to build the import spec", rt)
+			return "", errs.Mark(ErrNeedsAPI, "%s needs call Azure API to build the import spec", rt)
 		}
-		spec, err = tfid.DynamicBuild(id, rt, apiOpt.Cred, apiOpt.ClientOption)
+		spec, err = tfid.DynamicBuildWithTrace(ctx, m, id, rt, apiOpt.Cred, apiOpt.ClientOption, opts.tfidTracer(id))
 	} else {
-		spec, err = tfid.StaticBuild(id, rt)
+		spec, err = tfid.StaticBuildWithTrace(m, id, rt, opts.tfidTracer(id))
 	}
 	if err != nil {
-		return "", fmt.Errorf("failed to build id for %s: %v", rt, err)
+		return "", fmt.Errorf("failed to build id for %s: %w", rt, err)
 	}
 	return spec, nil
 }
 
-func getARMId2TFMapItems(id armid.ResourceId) []resmap.ARMId2TFMapItem {
-	resmap.Init()
-	k1 := strings.ToUpper(id.RouteScopeString())
-	b, ok := resmap.ARMId2TFMap[k1]
-	if !ok {
-		return nil
-	}
+func getARMId2TFMapItems(m *resmap.Mapping, id armid.ResourceId) []resmap.ARMId2TFMapItem {
+	return lookupARMId2TFMap(m.ARMId2TFMap, "ARMId2TFMap", id).items
+}
+
+func getRemovedARMId2TFMapItems(m *resmap.Mapping, id armid.ResourceId) []resmap.ARMId2TFMapItem {
+	return lookupARMId2TFMap(m.RemovedARMId2TFMap, "RemovedARMId2TFMap", id).items
+}
+
+// armId2TFMapLookup records how an id is looked up in an ARMId2TFMapType, which is also used to trace the lookup.
+type armId2TFMapLookup struct {
+	// table is the name of the looked up map, e.g. "ARMId2TFMap".
+	table string
+
+	routeKey       string
+	parentScopeKey string
 
-	var k2 string
+	routeFound bool
+	scopeFound bool
+
+	// anyScope indicates the parent scope key is not found, and the "any" scope is fallen back to.
+	anyScope bool
+
+	items []resmap.ARMId2TFMapItem
+}
+
+func lookupARMId2TFMap(m resmap.ARMId2TFMapType, table string, id armid.ResourceId) armId2TFMapLookup {
+	lookup := armId2TFMapLookup{
+		table:    table,
+		routeKey: strings.ToUpper(id.RouteScopeString()),
+	}
 	if id.ParentScope() != nil {
-		k2 = strings.ToUpper(id.ParentScope().ScopeString())
+		lookup.parentScopeKey = strings.ToUpper(id.ParentScope().ScopeString())
 	}
 
-	l, ok := b[k2]
+	b, ok := m[lookup.routeKey]
 	if !ok {
-		l, ok = b[strings.ToUpper(resmap.ScopeAny)]
-		if !ok {
-			return nil
-		}
+		return lookup
 	}
-	return l
+	lookup.routeFound = true
+
+	if l, ok := b[lookup.parentScopeKey]; ok {
+		lookup.scopeFound = true
+		lookup.items = l
+		return lookup
+	}
+	if l, ok := b[strings.ToUpper(resmap.ScopeAny)]; ok {
+		lookup.scopeFound = true
+		lookup.anyScope = true
+		lookup.items = l
+	}
+	return lookup
 }
 
-func queryType(idStr string, apiOpt *APIOption) ([]Type, bool, error) {
+func queryType(ctx context.Context, idStr string, apiOpt *APIOption, opts queryOptions) ([]Type, bool, error) {
 	id, err := armid.ParseResourceId(idStr)
 	if err != nil {
-		return nil, false, fmt.Errorf("invalid resource id: %v", err)
+		return nil, false, errs.Mark(ErrInvalidId, "invalid resource id: %w", err)
+	}
+
+	m, err := opts.resmapMapping()
+	if err != nil {
+		return nil, false, err
+	}
+	snapshot, err := opts.snapshot(m)
+	if err != nil {
+		return nil, false, err
 	}
 
 	var (
@@ -113,9 +323,10 @@ func queryType(idStr string, apiOpt *APIOption) ([]Type, bool, error) {
 	)
 
 	if apiOpt == nil {
-		l := getARMId2TFMapItems(id)
+		l, lookups := getSnapshotARMId2TFMapItems(m, id, snapshot)
+		opts.traceLookup(id, lookups, snapshot, l)
 		if len(l) == 0 {
-			return nil, false, nil
+			return noMatchTypes(ctx, m, id, apiOpt, opts, snapshot)
 		}
 
 		exact = len(l) == 1
@@ -126,12 +337,16 @@ func queryType(idStr string, apiOpt *APIOption) ([]Type, bool, error) {
 			})
 		}
 	} else {
-		entry, err := mapEntryById(id, *apiOpt)
+		entry, err := mapEntryById(ctx, m, id, *apiOpt, snapshot, opts)
 		if err != nil {
-			return nil, false, fmt.Errorf("mapping entry by id %s: %v", id, err)
+			var amb *Ambiguity
+			if errors.As(err, &amb) {
+				return ambiguousTypes(m, id, opts, snapshot, amb), false, amb
+			}
+			return nil, false, fmt.Errorf("mapping entry by id %s: %w", id, err)
 		}
 		if entry == nil {
-			return nil, false, nil
+			return noMatchTypes(ctx, m, id, apiOpt, opts, snapshot)
 		}
 
 		// There must be only one resource type, try to populate any property like resources for it.
@@ -144,15 +359,15 @@ func queryType(idStr string, apiOpt *APIOption) ([]Type, bool, error) {
 		}
 
 		rt := entry.ResourceType
-		propLikeResIds, err := populate.Populate(id, rt, apiOpt.Cred, apiOpt.ClientOption)
+		propLikeResIds, err := populate.Populate(ctx, id, rt, apiOpt.Cred, apiOpt.ClientOption)
 		if err != nil {
-			return nil, false, fmt.Errorf("populating property-like resources for %s: %v", rt, err)
+			return nil, false, fmt.Errorf("populating property-like resources for %s: %w", rt, err)
 		}
 
 		for _, propLikeResId := range propLikeResIds {
-			entry, err := mapEntryById(propLikeResId, *apiOpt)
+			entry, err := mapEntryById(ctx, m, propLikeResId, *apiOpt, snapshot, opts)
 			if err != nil {
-				return nil, false, fmt.Errorf("mapping entry by id %s: %v", id, err)
+				return nil, false, fmt.Errorf("mapping entry by id %s: %w", id, err)
 			}
 			if entry == nil {
 				continue
@@ -164,26 +379,102 @@ func queryType(idStr string, apiOpt *APIOption) ([]Type, bool, error) {
 		}
 	}
 
-	sort.Slice(result, func(i, j int) bool {
-		if result[i].AzureId.String() != result[j].AzureId.String() {
-			return result[i].AzureId.String() < result[j].AzureId.String()
+	result = append(result, deprecatedTypes(m, id, opts, snapshot)...)
+	sortTypes(result)
+	return result, exact, nil
+}
+
+// ambiguousTypes returns the candidate types of the ambiguous resource, together with the deprecated types, as the static query does.
+func ambiguousTypes(m *resmap.Mapping, id armid.ResourceId, opts queryOptions, snapshot *resmap.Snapshot, amb *Ambiguity) []Type {
+	var result []Type
+	for _, c := range amb.Candidates {
+		result = append(result, Type{
+			AzureId: id,
+			TFType:  c.TFType,
+		})
+	}
+	result = append(result, deprecatedTypes(m, id, opts, snapshot)...)
+	sortTypes(result)
+	return result
+}
+
+func sortTypes(types []Type) {
+	sort.Slice(types, func(i, j int) bool {
+		if types[i].AzureId.String() != types[j].AzureId.String() {
+			return types[i].AzureId.String() < types[j].AzureId.String()
 		}
-		return result[i].TFType < result[j].TFType
+		return types[i].TFType < types[j].TFType
 	})
+}
 
-	return result, exact, nil
+// noMatchTypes returns the types for the id that doesn't match any azurerm resource type, i.e. the deprecated types and the azapi_resource type,
+// depending on the query options. The result is exact if there is the azapi_resource type.
+func noMatchTypes(ctx context.Context, m *resmap.Mapping, id armid.ResourceId, apiOpt *APIOption, opts queryOptions, snapshot *resmap.Snapshot) ([]Type, bool, error) {
+	types, err := azapiTypes(ctx, id, apiOpt, opts)
+	if err != nil {
+		return nil, false, err
+	}
+	exact := len(types) != 0
+	return append(types, deprecatedTypes(m, id, opts, snapshot)...), exact, nil
+}
+
+// getSnapshotARMId2TFMapItems returns the TF items of the id that exist in the provider snapshot, or the not removed ones if the snapshot is nil,
+// together with the lookups made.
+func getSnapshotARMId2TFMapItems(m *resmap.Mapping, id armid.ResourceId, snapshot *resmap.Snapshot) ([]resmap.ARMId2TFMapItem, []armId2TFMapLookup) {
+	lookup := lookupARMId2TFMap(m.ARMId2TFMap, "ARMId2TFMap", id)
+	if snapshot == nil {
+		return lookup.items, []armId2TFMapLookup{lookup}
+	}
+	removedLookup := lookupARMId2TFMap(m.RemovedARMId2TFMap, "RemovedARMId2TFMap", id)
+	var l []resmap.ARMId2TFMapItem
+	for _, items := range [][]resmap.ARMId2TFMapItem{lookup.items, removedLookup.items} {
+		for _, item := range items {
+			if snapshot.Has(item.ResourceType) {
+				l = append(l, item)
+			}
+		}
+	}
+	return l, []armId2TFMapLookup{lookup, removedLookup}
+}
+
+// deprecatedTypes returns the removed/deprecated TF resource types that match the id, if the IncludeDeprecated option is specified.
+// If the provider snapshot is specified, the removed TF resource types that exist in it are not regarded as deprecated.
+func deprecatedTypes(m *resmap.Mapping, id armid.ResourceId, opts queryOptions, snapshot *resmap.Snapshot) []Type {
+	if !opts.includeDeprecated {
+		return nil
+	}
+	var result []Type
+	for _, item := range getRemovedARMId2TFMapItems(m, id) {
+		if snapshot != nil && snapshot.Has(item.ResourceType) {
+			continue
+		}
+		t := Type{
+			AzureId: id,
+			TFType:  item.ResourceType,
+		}
+		if d := m.TF2ARMIdMap[item.ResourceType].Deprecation; d != nil {
+			t.Deprecation = &Deprecation{
+				Reason:     d.Reason,
+				Successors: d.Successors,
+			}
+		}
+		result = append(result, t)
+	}
+	return result
 }
 
-func mapEntryById(id armid.ResourceId, apiOpt APIOption) (*resmap.ARMId2TFMapItem, error) {
-	l := getARMId2TFMapItems(id)
+func mapEntryById(ctx context.Context, m *resmap.Mapping, id armid.ResourceId, apiOpt APIOption, snapshot *resmap.Snapshot, opts queryOptions) (*resmap.ARMId2TFMapItem, error) {
+	l, lookups := getSnapshotARMId2TFMapItems(m, id, snapshot)
+	opts.traceLookup(id, lookups, snapshot, l)
 	if len(l) == 0 {
 		return nil, nil
 	}
 	// Resolve ambiguous resources
 	if len(l) > 1 {
-		rt, err := resolve.Resolve(id, apiOpt.Cred, apiOpt.ClientOption)
+		rt, candidates, err := resolve.ResolvePrefetched(ctx, id, apiOpt.prefetched, apiOpt.Cred, apiOpt.ClientOption)
+		opts.traceResolve(id, candidates, rt, err)
 		if err != nil {
-			return nil, err
+			return nil, newAmbiguity(id, l, candidates, err)
 		}
 		for _, item := range l {
 			if item.ResourceType == rt {
@@ -192,7 +483,7 @@ func mapEntryById(id armid.ResourceId, apiOpt APIOption) (*resmap.ARMId2TFMapIte
 			}
 		}
 		if len(l) > 1 {
-			return nil, fmt.Errorf("the ambiguity list doesn't have an item with resource type %q", rt)
+			return nil, newAmbiguity(id, l, candidates, fmt.Errorf("the ambiguity list doesn't have an item with resource type %q", rt))
 		}
 	}
 	return &l[0], nil
@@ -1,9 +1,23 @@
 package aztft
 
 import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
 	"testing"
+	"time"
 
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/arm"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/cloud"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/policy"
 	"github.com/magodo/armid"
+	"github.com/magodo/aztft/internal/tfid"
 	"github.com/stretchr/testify/require"
 )
 
@@ -185,6 +199,12 @@ func TestQueryId(t *testing.T) {
 			rt:     "azurerm_management_group",
 			expect: "/providers/Microsoft.Management/managementGroups/GROUP1",
 		},
+		{
+			name:   "blueprint assignment (management group level)",
+			input:  "/PROVIDERS/MICROSOFT.MANAGEMENT/MANAGEMENTGROUPS/GROUP1/PROVIDERS/MICROSOFT.BLUEPRINT/BLUEPRINTASSIGNMENTS/ASSIGN1",
+			rt:     "azurerm_blueprint_assignment",
+			expect: "/providers/Microsoft.Management/managementGroups/GROUP1/providers/Microsoft.Blueprint/blueprintAssignments/ASSIGN1",
+		},
 		{
 			name:   "poliy definition (subscription level)",
 			input:  "/subscriptions/sub1/providers/Microsoft.Authorization/policyDefinitions/policy1",
@@ -215,6 +235,30 @@ func TestQueryId(t *testing.T) {
 			rt:     "azurerm_backup_protected_vm",
 			expect: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.RecoveryServices/vaults/example-recovery-vault/backupFabrics/Azure/protectionContainers/iaasvmcontainer;iaasvmcontainerv2;group1;vm1/protectedItems/vm;iaasvmcontainerv2;group1;vm1",
 		},
+		{
+			name:   "storage container (constant name)",
+			input:  "/subscriptions/sub1/resourceGroups/rg1/providers/Microsoft.Storage/storageAccounts/sa1/blobServices/Default/containers/c1",
+			rt:     "azurerm_storage_container",
+			expect: "/subscriptions/sub1/resourceGroups/rg1/providers/Microsoft.Storage/storageAccounts/sa1/blobServices/default/containers/c1",
+		},
+		{
+			name:   "iothub endpoint (type rename)",
+			input:  "/subscriptions/sub1/resourceGroups/rg1/providers/Microsoft.Devices/iotHubs/hub1/endpointsEventhub/ep1",
+			rt:     "azurerm_iothub_endpoint_eventhub",
+			expect: "/subscriptions/sub1/resourceGroups/rg1/providers/Microsoft.Devices/iotHubs/hub1/endpoints/ep1",
+		},
+		{
+			name:   "managed redis geo replication (grand parent projection)",
+			input:  "/subscriptions/sub1/resourceGroups/rg1/providers/Microsoft.Cache/redisEnterprise/redis1/databases/default/replications/default",
+			rt:     "azurerm_managed_redis_geo_replication",
+			expect: "/subscriptions/sub1/resourceGroups/rg1/providers/Microsoft.Cache/redisEnterprise/redis1",
+		},
+		{
+			name:   "eventgrid partner configuration (parent scope projection)",
+			input:  "/subscriptions/sub1/resourceGroups/rg1/providers/Microsoft.EventGrid/partnerConfigurations/default",
+			rt:     "azurerm_eventgrid_partner_configuration",
+			expect: "/subscriptions/sub1/resourceGroups/rg1",
+		},
 	}
 
 	for _, tt := range cases {
@@ -386,3 +430,1120 @@ func TestQueryTypeAndId(t *testing.T) {
 		})
 	}
 }
+
+func TestQueryBatch(t *testing.T) {
+	ids := []string{
+		"/subscriptions/sub1/resourceGroups/rg1",
+		"/subscriptions/sub1/resourceGroups/rg1/foos",
+		"/subscriptions/sub1/resourceGroups/rg1/providers/Microsoft.Web/sites/site1/slots/slot1/networkConfig/cfg1",
+	}
+	results := QueryBatch(context.Background(), ids, &BatchOption{Concurrency: 2})
+	require.Len(t, results, len(ids))
+	for i, id := range ids {
+		require.Equal(t, id, results[i].Id)
+	}
+
+	require.NoError(t, results[0].Err)
+	require.Equal(t, []string{"/subscriptions/sub1/resourceGroups/rg1"}, results[0].TFIds)
+	require.True(t, results[0].Exact)
+	require.False(t, results[0].API)
+
+	require.Error(t, results[1].Err)
+
+	require.NoError(t, results[2].Err)
+	require.Equal(t, []string{"/subscriptions/sub1/resourceGroups/rg1/providers/Microsoft.Web/sites/site1/slots/slot1/config/cfg1"}, results[2].TFIds)
+
+	// The types are kept if the id fails to build.
+	blobId := "/subscriptions/sub1/resourceGroups/rg1/providers/Microsoft.Storage/storageAccounts/sa1/blobServices/default/containers/c1/blobs/b1"
+	results = QueryBatch(context.Background(), []string{blobId}, nil)
+	require.ErrorIs(t, results[0].Err, ErrNeedsAPI)
+	require.True(t, results[0].Exact)
+	require.Len(t, results[0].Types, 1)
+	require.Equal(t, "azurerm_storage_blob", results[0].Types[0].TFType)
+	require.Equal(t, []string{""}, results[0].TFIds)
+	require.ErrorIs(t, results[0].TFIdErrs[0], ErrNeedsAPI)
+
+	results = QueryBatch(context.Background(), []string{blobId}, &BatchOption{TypesOnly: true})
+	require.NoError(t, results[0].Err)
+	require.True(t, results[0].Exact)
+	require.Nil(t, results[0].TFIds)
+}
+
+func TestQueryStream(t *testing.T) {
+	var ids []string
+	for i := 0; i < 20; i++ {
+		ids = append(ids, fmt.Sprintf("/subscriptions/sub1/resourceGroups/rg%d", i))
+	}
+
+	var emitted []string
+	err := QueryStream(context.Background(), ids, &BatchOption{Concurrency: 3}, func(result BatchResult) error {
+		require.NoError(t, result.Err)
+		emitted = append(emitted, result.Id)
+		return nil
+	})
+	require.NoError(t, err)
+	require.Equal(t, ids, emitted)
+
+	// The emit error stops the stream.
+	emitted = nil
+	stopErr := errors.New("stop")
+	err = QueryStream(context.Background(), ids, &BatchOption{Concurrency: 3}, func(result BatchResult) error {
+		emitted = append(emitted, result.Id)
+		if len(emitted) == 5 {
+			return stopErr
+		}
+		return nil
+	})
+	require.ErrorIs(t, err, stopErr)
+	require.Equal(t, ids[:5], emitted)
+
+	// All the ids get their results even if the context is done.
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	results := QueryBatch(ctx, ids, nil)
+	require.Len(t, results, len(ids))
+	for _, result := range results {
+		require.ErrorIs(t, result.Err, context.Canceled)
+	}
+}
+
+func TestQueryStreamConcurrency(t *testing.T) {
+	var (
+		mu       sync.Mutex
+		inflight int
+		peak     int
+	)
+	srv := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		inflight++
+		if inflight > peak {
+			peak = inflight
+		}
+		mu.Unlock()
+		time.Sleep(20 * time.Millisecond)
+		mu.Lock()
+		inflight--
+		mu.Unlock()
+		fmt.Fprint(w, `{"kind": "SocketIO", "properties": {}}`)
+	}))
+	defer srv.Close()
+
+	var ids []string
+	for i := 0; i < 20; i++ {
+		ids = append(ids, fmt.Sprintf("/subscriptions/sub1/resourceGroups/rg1/providers/Microsoft.SignalRService/webPubSub/ps%d", i))
+	}
+	opt := &BatchOption{
+		APIOption: &APIOption{
+			Cred: fakeCredential{},
+			ClientOption: arm.ClientOptions{
+				ClientOptions: policy.ClientOptions{
+					Cloud: cloud.Configuration{
+						Services: map[cloud.ServiceName]cloud.ServiceConfiguration{
+							cloud.ResourceManager: {Endpoint: srv.URL, Audience: "https://management.core.windows.net/"},
+						},
+					},
+					Transport: srv.Client(),
+					Retry:     policy.RetryOptions{MaxRetries: -1},
+				},
+			},
+		},
+		Concurrency: 3,
+	}
+	results := QueryBatch(context.Background(), ids, opt)
+	require.Len(t, results, len(ids))
+	for i, result := range results {
+		require.Equal(t, ids[i], result.Id)
+		require.NoError(t, result.Err)
+		require.True(t, result.API)
+	}
+	require.Equal(t, 3, peak)
+}
+
+type fakeCredential struct{}
+
+func (fakeCredential) GetToken(context.Context, policy.TokenRequestOptions) (azcore.AccessToken, error) {
+	return azcore.AccessToken{Token: "fake", ExpiresOn: time.Now().Add(time.Hour)}, nil
+}
+
+func TestListResources(t *testing.T) {
+	var srv *httptest.Server
+	srv = httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/subscriptions/sub1/resourceGroups/rg1/resources":
+			fmt.Fprintf(w, `{"value": [{"id": "/subscriptions/sub1/resourceGroups/rg1/providers/Microsoft.Network/virtualNetworks/vnet1"}], "nextLink": "%s/page2"}`, srv.URL)
+		case "/page2":
+			fmt.Fprint(w, `{"value": [{"id": "/subscriptions/sub1/resourceGroups/rg1/providers/Microsoft.Storage/storageAccounts/sa1"}]}`)
+		case "/subscriptions/sub1/resourceGroups":
+			fmt.Fprint(w, `{"value": [{"id": "/subscriptions/sub1/resourceGroups/rg1"}]}`)
+		case "/subscriptions/sub1/resources":
+			fmt.Fprint(w, `{"value": [{"id": "/subscriptions/sub1/resourceGroups/rg1/providers/Microsoft.Network/virtualNetworks/vnet1"}]}`)
+		case "/providers/Microsoft.Management/managementGroups/mg1/descendants":
+			fmt.Fprint(w, `{"value": [
+				{"id": "/providers/Microsoft.Management/managementGroups/mg2", "type": "Microsoft.Management/managementGroups", "name": "mg2"},
+				{"id": "/providers/Microsoft.Management/managementGroups/mg2/subscriptions/sub1", "type": "Microsoft.Management/managementGroups/subscriptions", "name": "sub1"}
+			]}`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer srv.Close()
+
+	opt := &APIOption{
+		Cred: fakeCredential{},
+		ClientOption: arm.ClientOptions{
+			ClientOptions: policy.ClientOptions{
+				Cloud: cloud.Configuration{
+					Services: map[cloud.ServiceName]cloud.ServiceConfiguration{
+						cloud.ResourceManager: {Endpoint: srv.URL, Audience: "https://management.core.windows.net/"},
+					},
+				},
+				Transport: srv.Client(),
+				Retry:     policy.RetryOptions{MaxRetries: -1},
+			},
+		},
+	}
+
+	ids, err := ListResources(context.Background(), "/subscriptions/sub1/resourceGroups/rg1", opt)
+	require.NoError(t, err)
+	require.Equal(t, []string{
+		"/subscriptions/sub1/resourceGroups/rg1",
+		"/subscriptions/sub1/resourceGroups/rg1/providers/Microsoft.Network/virtualNetworks/vnet1",
+		"/subscriptions/sub1/resourceGroups/rg1/providers/Microsoft.Storage/storageAccounts/sa1",
+	}, ids)
+
+	// The subscription itself is not listed.
+	ids, err = ListResources(context.Background(), "/subscriptions/sub1", opt)
+	require.NoError(t, err)
+	require.Equal(t, []string{
+		"/subscriptions/sub1/resourceGroups/rg1",
+		"/subscriptions/sub1/resourceGroups/rg1/providers/Microsoft.Network/virtualNetworks/vnet1",
+	}, ids)
+
+	ids, err = ListResources(context.Background(), "/providers/Microsoft.Management/managementGroups/mg1", opt)
+	require.NoError(t, err)
+	require.Equal(t, []string{
+		"/providers/Microsoft.Management/managementGroups/mg1",
+		"/providers/Microsoft.Management/managementGroups/mg2",
+		"/subscriptions/sub1/resourceGroups/rg1",
+		"/subscriptions/sub1/resourceGroups/rg1/providers/Microsoft.Network/virtualNetworks/vnet1",
+	}, ids)
+
+	_, err = ListResources(context.Background(), "/subscriptions/sub2/resourceGroups/rg1", opt)
+	require.Error(t, err)
+
+	_, err = ListResources(context.Background(), "/subscriptions/sub1/resourceGroups/rg1/providers/Microsoft.Network/virtualNetworks/vnet1", opt)
+	require.Error(t, err)
+}
+
+func TestQueryBatchResourceGraph(t *testing.T) {
+	var (
+		graphFails   atomic.Bool
+		graphQueries atomic.Int32
+		gets         atomic.Int32
+	)
+	srv := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodPost && r.URL.Path == "/providers/Microsoft.ResourceGraph/resources":
+			graphQueries.Add(1)
+			if graphFails.Load() {
+				w.WriteHeader(http.StatusForbidden)
+				fmt.Fprint(w, `{"error": {"code": "AuthorizationFailed"}}`)
+				return
+			}
+			fmt.Fprint(w, `{"count": 1, "data": [{"id": "/subscriptions/sub1/resourceGroups/rg1/providers/Microsoft.SignalRService/webPubSub/ps1", "kind": "SocketIO"}]}`)
+		case r.Method == http.MethodGet && r.URL.Path == "/subscriptions/sub1/resourceGroups/rg1/providers/Microsoft.SignalRService/webPubSub/ps1":
+			gets.Add(1)
+			fmt.Fprint(w, `{"name": "ps1", "kind": "SocketIO", "properties": {}}`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer srv.Close()
+
+	opt := &BatchOption{
+		APIOption: &APIOption{
+			Cred: fakeCredential{},
+			ClientOption: arm.ClientOptions{
+				ClientOptions: policy.ClientOptions{
+					Cloud: cloud.Configuration{
+						Services: map[cloud.ServiceName]cloud.ServiceConfiguration{
+							cloud.ResourceManager: {Endpoint: srv.URL, Audience: "https://management.core.windows.net/"},
+						},
+					},
+					Transport: srv.Client(),
+					Retry:     policy.RetryOptions{MaxRetries: -1},
+				},
+			},
+		},
+		ResourceGraph: true,
+	}
+	ids := []string{
+		"/subscriptions/sub1/resourceGroups/rg1/providers/Microsoft.SignalRService/webPubSub/ps1",
+		"/subscriptions/sub1/resourceGroups/rg1",
+	}
+
+	check := func() {
+		results := QueryBatch(context.Background(), ids, opt)
+		require.Len(t, results, 2)
+		require.NoError(t, results[0].Err)
+		require.Len(t, results[0].Types, 1)
+		require.Equal(t, "azurerm_web_pubsub_socketio", results[0].Types[0].TFType)
+		require.True(t, results[0].API)
+		require.NoError(t, results[1].Err)
+		require.False(t, results[1].API)
+	}
+
+	// Resolved from the Azure Resource Graph, without the GET.
+	check()
+	require.EqualValues(t, 1, graphQueries.Load())
+	require.EqualValues(t, 0, gets.Load())
+
+	// Fall back to the GET if the Azure Resource Graph query fails, which is reported to the trace hook.
+	var (
+		mu     sync.Mutex
+		events []TraceEvent
+	)
+	opt.QueryOptions = []QueryOption{WithTrace(func(ev TraceEvent) {
+		if ev.Stage != TracePrefetch {
+			return
+		}
+		mu.Lock()
+		defer mu.Unlock()
+		events = append(events, ev)
+	})}
+	graphFails.Store(true)
+	check()
+	require.EqualValues(t, 2, graphQueries.Load())
+	require.EqualValues(t, 1, gets.Load())
+	require.Len(t, events, 1)
+	require.Nil(t, events[0].AzureId)
+	require.Contains(t, events[0].Message, "AuthorizationFailed")
+}
+
+func TestQueryAmbiguity(t *testing.T) {
+	srv := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/subscriptions/sub1/resourceGroups/rg1/providers/Microsoft.Web/sites/site1":
+			fmt.Fprint(w, `{"name": "site1", "kind": "unknown", "properties": {}}`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			fmt.Fprint(w, `{"error": {"code": "ResourceNotFound"}}`)
+		}
+	}))
+	defer srv.Close()
+
+	opt := &APIOption{
+		Cred: fakeCredential{},
+		ClientOption: arm.ClientOptions{
+			ClientOptions: policy.ClientOptions{
+				Cloud: cloud.Configuration{
+					Services: map[cloud.ServiceName]cloud.ServiceConfiguration{
+						cloud.ResourceManager: {Endpoint: srv.URL, Audience: "https://management.core.windows.net/"},
+					},
+				},
+				Transport: srv.Client(),
+				Retry:     policy.RetryOptions{MaxRetries: -1},
+			},
+		},
+	}
+
+	// None of the candidates matches the resource.
+	id := "/subscriptions/sub1/resourceGroups/rg1/providers/Microsoft.Web/sites/site1"
+	types, tfids, exact, err := QueryTypeAndId(id, opt)
+	var amb *Ambiguity
+	require.ErrorAs(t, err, &amb)
+	require.False(t, exact)
+	require.Len(t, tfids, len(types))
+	var tfTypes []string
+	for _, c := range amb.Candidates {
+		tfTypes = append(tfTypes, c.TFType)
+		require.False(t, c.Matched)
+		require.NotEmpty(t, c.Reason)
+		require.Contains(t, c.Evidence, "kind=unknown")
+	}
+	require.Contains(t, tfTypes, "azurerm_linux_web_app")
+	require.Contains(t, tfTypes, "azurerm_windows_function_app")
+	for _, typ := range types {
+		require.Contains(t, tfTypes, typ.TFType)
+	}
+
+	// The candidate whose id fails to build doesn't lose the ambiguity.
+	m, err := DefaultMapping()
+	require.NoError(t, err)
+	om, err := m.Overlay([]byte(`{
+  "azurerm_linux_web_app": {
+    "management_plane": {
+      "scopes": ["/subscriptions/resourceGroups"],
+      "provider": "Microsoft.Web",
+      "types": ["sites"],
+      "import_specs": ["/subscriptions/resourceGroups/Microsoft.Web/sites/slots"]
+    }
+  }
+}`))
+	require.NoError(t, err)
+	types, tfids, _, err = QueryTypeAndId(id, opt, WithMapping(om))
+	require.ErrorAs(t, err, &amb)
+	require.Len(t, tfids, len(types))
+	for i, typ := range types {
+		if typ.TFType == "azurerm_linux_web_app" {
+			require.Empty(t, tfids[i])
+		} else {
+			require.Equal(t, id, tfids[i])
+		}
+	}
+
+	// The resolver fails to retrieve the resource.
+	types, _, err = QueryType("/subscriptions/sub1/resourceGroups/rg1/providers/Microsoft.Web/sites/site2", opt)
+	require.ErrorAs(t, err, &amb)
+	require.NotEmpty(t, types)
+	for _, c := range amb.Candidates {
+		require.Empty(t, c.Evidence)
+	}
+}
+
+func TestErrors(t *testing.T) {
+	srv := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/subscriptions/sub1/resourceGroups/rg1/providers/Microsoft.Web/sites/unknown":
+			fmt.Fprint(w, `{"name": "unknown", "kind": "unknown", "properties": {}}`)
+		case "/subscriptions/sub1/resourceGroups/rg1/providers/Microsoft.Web/sites/forbidden":
+			w.WriteHeader(http.StatusForbidden)
+			fmt.Fprint(w, `{"error": {"code": "AuthorizationFailed"}}`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			fmt.Fprint(w, `{"error": {"code": "ResourceNotFound"}}`)
+		}
+	}))
+	defer srv.Close()
+
+	opt := &APIOption{
+		Cred: fakeCredential{},
+		ClientOption: arm.ClientOptions{
+			ClientOptions: policy.ClientOptions{
+				Cloud: cloud.Configuration{
+					Services: map[cloud.ServiceName]cloud.ServiceConfiguration{
+						cloud.ResourceManager: {Endpoint: srv.URL, Audience: "https://management.core.windows.net/"},
+					},
+				},
+				Transport: srv.Client(),
+				Retry:     policy.RetryOptions{MaxRetries: -1},
+			},
+		},
+	}
+
+	cases := []struct {
+		name  string
+		query func() error
+		err   error
+	}{
+		{
+			name: "invalid resource id",
+			query: func() error {
+				_, _, err := QueryType("/foo", nil)
+				return err
+			},
+			err: ErrInvalidId,
+		},
+		{
+			name: "invalid TF resource id",
+			query: func() error {
+				_, err := QueryAzureId("azurerm_resource_group", "foo")
+				return err
+			},
+			err: ErrInvalidId,
+		},
+		{
+			name: "unknown TF resource type",
+			query: func() error {
+				_, err := QueryId("/subscriptions/sub1/resourceGroups/rg1", "azurerm_foo", nil)
+				return err
+			},
+			err: ErrNoMatch,
+		},
+		{
+			name: "id mismatches TF resource type",
+			query: func() error {
+				_, err := QueryAzureId("azurerm_resource_group", "/subscriptions/sub1/resourceGroups/rg1/providers/Microsoft.Compute/virtualMachines/vm1")
+				return err
+			},
+			err: ErrNoMatch,
+		},
+		{
+			name: "needs API",
+			query: func() error {
+				_, err := QueryId("/subscriptions/sub1/resourceGroups/rg1/providers/Microsoft.KeyVault/vaults/vault1/secrets/secret1", "azurerm_key_vault_secret", nil)
+				return err
+			},
+			err: ErrNeedsAPI,
+		},
+		{
+			name: "resource not found",
+			query: func() error {
+				_, _, err := QueryType("/subscriptions/sub1/resourceGroups/rg1/providers/Microsoft.Web/sites/notfound", opt)
+				return err
+			},
+			err: ErrResourceNotFound,
+		},
+		{
+			name: "unauthorized",
+			query: func() error {
+				_, _, _, err := QueryTypeAndId("/subscriptions/sub1/resourceGroups/rg1/providers/Microsoft.Web/sites/forbidden", opt)
+				return err
+			},
+			err: ErrUnauthorized,
+		},
+		{
+			name: "unknown kind",
+			query: func() error {
+				_, _, err := QueryType("/subscriptions/sub1/resourceGroups/rg1/providers/Microsoft.Web/sites/unknown", opt)
+				return err
+			},
+			err: ErrUnknownKind,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			require.ErrorIs(t, c.query(), c.err)
+		})
+	}
+
+	// The API errors are inspectable, wrapped in the ambiguity.
+	_, _, err := QueryType("/subscriptions/sub1/resourceGroups/rg1/providers/Microsoft.Web/sites/forbidden", opt)
+	var amb *Ambiguity
+	require.ErrorAs(t, err, &amb)
+	var rerr *ResolveError
+	require.ErrorAs(t, err, &rerr)
+	var aerr *APIError
+	require.ErrorAs(t, err, &aerr)
+	require.Equal(t, http.StatusForbidden, aerr.StatusCode)
+	require.Equal(t, "AuthorizationFailed", aerr.ErrorCode)
+	require.NotErrorIs(t, err, ErrResourceNotFound)
+}
+
+func TestQueryOffline(t *testing.T) {
+	provider, err := NewResourceBodyProvider([]byte(`[
+	{
+		"id": "/subscriptions/sub1/resourceGroups/rg1/providers/Microsoft.Compute/virtualMachines/vm1",
+		"name": "vm1",
+		"type": "Microsoft.Compute/virtualMachines",
+		"properties": {
+			"osProfile": {"computerName": "vm1"},
+			"storageProfile": {
+				"osDisk": {"osType": "Linux"},
+				"dataDisks": [{"lun": 0, "name": "disk1", "createOption": "Attach", "managedDisk": {"id": "/subscriptions/sub1/resourceGroups/rg1/providers/Microsoft.Compute/disks/disk1"}}]
+			}
+		}
+	},
+	{
+		"id": "/subscriptions/sub1/resourceGroups/rg1/providers/Microsoft.KeyVault/vaults/vault1/secrets/secret1",
+		"name": "secret1",
+		"properties": {"secretUriWithVersion": "https://vault1.vault.azure.net/secrets/secret1/v1"}
+	}
+]`))
+	require.NoError(t, err)
+	opt := &APIOption{BodyProvider: provider}
+
+	types, ids, exact, err := QueryTypeAndId("/subscriptions/sub1/resourceGroups/rg1/providers/Microsoft.Compute/virtualMachines/VM1", opt)
+	require.NoError(t, err)
+	require.True(t, exact)
+	require.Equal(t, []Type{
+		{
+			AzureId: MustParseId(t, "/subscriptions/sub1/resourceGroups/rg1/providers/Microsoft.Compute/virtualMachines/VM1"),
+			TFType:  "azurerm_linux_virtual_machine",
+		},
+		{
+			AzureId: MustParseId(t, "/subscriptions/sub1/resourceGroups/rg1/providers/Microsoft.Compute/virtualMachines/VM1/dataDisks/disk1"),
+			TFType:  "azurerm_virtual_machine_data_disk_attachment",
+		},
+	}, types)
+	require.Len(t, ids, 2)
+
+	id, err := QueryId("/subscriptions/sub1/resourceGroups/rg1/providers/Microsoft.KeyVault/vaults/vault1/secrets/secret1", "azurerm_key_vault_secret", opt)
+	require.NoError(t, err)
+	require.Equal(t, "https://vault1.vault.azure.net/secrets/secret1/v1", id)
+
+	_, err = QueryId("/subscriptions/sub1/resourceGroups/rg1/providers/Microsoft.KeyVault/vaults/vault1/secrets/secret2", "azurerm_key_vault_secret", opt)
+	require.ErrorContains(t, err, "ResourceNotFound")
+
+	// The Azure API isn't called.
+	results := QueryBatch(context.Background(), []string{"/subscriptions/sub1/resourceGroups/rg1/providers/Microsoft.Compute/virtualMachines/vm1"}, &BatchOption{APIOption: opt})
+	require.NoError(t, results[0].Err)
+	require.Len(t, results[0].Types, 2)
+	require.False(t, results[0].API)
+}
+
+func TestQueryTrace(t *testing.T) {
+	provider, err := NewResourceBodyProvider([]byte(`[{"id": "/subscriptions/sub1/resourceGroups/rg1/providers/Microsoft.Web/sites/site1", "name": "site1", "kind": "functionapp,linux", "properties": {}}]`))
+	require.NoError(t, err)
+
+	cases := []struct {
+		name   string
+		id     string
+		apiOpt *APIOption
+		opts   []QueryOption
+		expect map[TraceStage][]string
+	}{
+		{
+			name: "any scope fallback",
+			id:   "/subscriptions/sub1/resourceGroups/rg1/providers/Microsoft.Network/virtualNetworks/vnet1/providers/Microsoft.Insights/diagnosticSettings/ds1",
+			expect: map[TraceStage][]string{
+				TraceLookup:     {`ARMId2TFMap: routing key "/MICROSOFT.INSIGHTS/DIAGNOSTICSETTINGS", parent scope key "/SUBSCRIPTIONS/RESOURCEGROUPS/MICROSOFT.NETWORK/VIRTUALNETWORKS" is not found, fell back to the "any" scope`},
+				TraceCandidates: {"azurerm_monitor_diagnostic_setting"},
+				TraceImportSpec: {`"" (no import spec, the id is dynamically built)`},
+				TraceBuild:      {`combined the parent scope id /subscriptions/sub1/resourceGroups/rg1/providers/Microsoft.Network/virtualNetworks/vnet1 and the name "ds1"`},
+			},
+		},
+		{
+			name:   "resolved",
+			id:     "/subscriptions/sub1/resourceGroups/rg1/providers/Microsoft.Web/sites/site1",
+			apiOpt: &APIOption{BodyProvider: provider},
+			expect: map[TraceStage][]string{
+				TraceLookup:     {`ARMId2TFMap: routing key "/MICROSOFT.WEB/SITES", parent scope key "/SUBSCRIPTIONS/RESOURCEGROUPS" is found`},
+				TraceCandidates: {"azurerm_function_app_flex_consumption, azurerm_linux_function_app, azurerm_linux_web_app, azurerm_logic_app_standard, azurerm_windows_function_app, azurerm_windows_web_app"},
+				TraceImportSpec: {`"/subscriptions/resourceGroups/Microsoft.Web/sites" (the only import spec)`},
+				TraceBuild:      {"normalized the id with the import spec: /subscriptions/sub1/resourceGroups/rg1/providers/Microsoft.Web/sites/site1 -> /subscriptions/sub1/resourceGroups/rg1/providers/Microsoft.Web/sites/site1"},
+			},
+		},
+		{
+			name: "provider snapshot",
+			id:   "/subscriptions/sub1/resourceGroups/rg1/providers/Microsoft.Web/sites/site1",
+			opts: []QueryOption{WithProviderVersion("3.117.1", ProviderSnapshot{ProviderVersion: ">= 3.0.0, < 4.0.0", ResourceTypes: []string{"azurerm_app_service"}})},
+			expect: map[TraceStage][]string{
+				TraceLookup: {
+					`ARMId2TFMap: routing key "/MICROSOFT.WEB/SITES", parent scope key "/SUBSCRIPTIONS/RESOURCEGROUPS" is found`,
+					`RemovedARMId2TFMap: routing key "/MICROSOFT.WEB/SITES", parent scope key "/SUBSCRIPTIONS/RESOURCEGROUPS" is found`,
+				},
+				TraceCandidates: {"azurerm_app_service (in the provider snapshot)"},
+				TraceImportSpec: {`"/subscriptions/resourceGroups/Microsoft.Web/sites" (the only import spec)`},
+				TraceBuild:      {"normalized the id with the import spec: /subscriptions/sub1/resourceGroups/rg1/providers/Microsoft.Web/sites/site1 -> /subscriptions/sub1/resourceGroups/rg1/providers/Microsoft.Web/sites/site1"},
+			},
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			actual := map[TraceStage][]string{}
+			var resolves []string
+			_, _, _, err := QueryTypeAndId(c.id, c.apiOpt, append(c.opts, WithTrace(func(ev TraceEvent) {
+				if ev.Stage == TraceResolve {
+					resolves = append(resolves, ev.Message)
+					return
+				}
+				actual[ev.Stage] = append(actual[ev.Stage], ev.Message)
+			}))...)
+			require.NoError(t, err)
+			require.Equal(t, c.expect, actual)
+			if c.apiOpt == nil {
+				require.Empty(t, resolves)
+				return
+			}
+			require.Equal(t, "resolver: appServiceSitesResolver", resolves[0])
+			require.Contains(t, resolves, "azurerm_linux_function_app is matched: requires the kind to have \"functionapp\" and \"linux\", but not the \"FlexConsumption\" SKU (evidence: kind=functionapp,linux, properties.sku=<absent>)")
+			require.Equal(t, "resolved to azurerm_linux_function_app", resolves[len(resolves)-1])
+		})
+	}
+}
+
+func TestQueryAzureId(t *testing.T) {
+	b64 := func(s string) string { return base64.StdEncoding.EncodeToString([]byte(s)) }
+	cases := []struct {
+		name   string
+		tfType string
+		input  string
+		expect string
+		err    bool
+	}{
+		{
+			name:   "resource group",
+			tfType: "azurerm_resource_group",
+			input:  "/subscriptions/sub1/resourceGroups/rg1",
+			expect: "/subscriptions/sub1/resourceGroups/rg1",
+		},
+		{
+			name:   "diagnostic setting",
+			tfType: "azurerm_monitor_diagnostic_setting",
+			input:  "/subscriptions/sub1/resourceGroups/rg1/providers/Microsoft.KeyVault/vaults/vault1|setting1",
+			expect: "/subscriptions/sub1/resourceGroups/rg1/providers/Microsoft.KeyVault/vaults/vault1/providers/Microsoft.Insights/diagnosticSettings/setting1",
+		},
+		{
+			name:   "diagnostic setting (no separator)",
+			tfType: "azurerm_monitor_diagnostic_setting",
+			input:  "/subscriptions/sub1/resourceGroups/rg1/providers/Microsoft.KeyVault/vaults/vault1",
+			err:    true,
+		},
+		{
+			name:   "network manager deployment",
+			tfType: "azurerm_network_manager_deployment",
+			input:  "/subscriptions/sub1/resourceGroups/rg1/providers/Microsoft.Network/networkManagers/nm1/commit|eastus|SecurityAdmin",
+			expect: "/subscriptions/sub1/resourceGroups/rg1/providers/Microsoft.Network/networkManagers/nm1/locations/eastus/types/SecurityAdmin",
+		},
+		{
+			name:   "nat gateway public ip association",
+			tfType: "azurerm_nat_gateway_public_ip_association",
+			input:  "/subscriptions/sub1/resourceGroups/rg1/providers/Microsoft.Network/natGateways/gw1|/subscriptions/sub1/resourceGroups/rg1/providers/Microsoft.Network/publicIPAddresses/pip1",
+			expect: "/subscriptions/sub1/resourceGroups/rg1/providers/Microsoft.Network/natGateways/gw1/publicIPAddresses/" + b64("/subscriptions/sub1/resourceGroups/rg1/providers/Microsoft.Network/publicIPAddresses/pip1"),
+		},
+		{
+			name:   "network interface backend address pool association",
+			tfType: "azurerm_network_interface_backend_address_pool_association",
+			input:  "/subscriptions/sub1/resourceGroups/rg1/providers/Microsoft.Network/networkInterfaces/nic1/ipConfigurations/cfg1|/subscriptions/sub1/resourceGroups/rg1/providers/Microsoft.Network/loadBalancers/lb1/backendAddressPools/pool1",
+			expect: "/subscriptions/sub1/resourceGroups/rg1/providers/Microsoft.Network/networkInterfaces/nic1/ipConfigurations/cfg1/loadBalancerBackendAddressPools/" + b64("/subscriptions/sub1/resourceGroups/rg1/providers/Microsoft.Network/loadBalancers/lb1/backendAddressPools/pool1"),
+		},
+		{
+			name:   "iothub endpoint",
+			tfType: "azurerm_iothub_endpoint_eventhub",
+			input:  "/subscriptions/sub1/resourceGroups/rg1/providers/Microsoft.Devices/iotHubs/hub1/endpoints/ep1",
+			expect: "/subscriptions/sub1/resourceGroups/rg1/providers/Microsoft.Devices/iotHubs/hub1/endpointsEventhub/ep1",
+		},
+		{
+			name:   "app service slot virtual network swift connection",
+			tfType: "azurerm_app_service_slot_virtual_network_swift_connection",
+			input:  "/subscriptions/sub1/resourceGroups/rg1/providers/Microsoft.Web/sites/site1/slots/slot1/config/cfg1",
+			expect: "/subscriptions/sub1/resourceGroups/rg1/providers/Microsoft.Web/sites/site1/slots/slot1/networkConfig/cfg1",
+		},
+		{
+			name:   "storage account queue properties",
+			tfType: "azurerm_storage_account_queue_properties",
+			input:  "/subscriptions/sub1/resourceGroups/rg1/providers/Microsoft.Storage/storageAccounts/account1",
+			expect: "/subscriptions/sub1/resourceGroups/rg1/providers/Microsoft.Storage/storageAccounts/account1/queueServices/default",
+		},
+		{
+			name:   "eventgrid partner configuration",
+			tfType: "azurerm_eventgrid_partner_configuration",
+			input:  "/subscriptions/sub1/resourceGroups/rg1",
+			expect: "/subscriptions/sub1/resourceGroups/rg1/providers/Microsoft.EventGrid/partnerConfigurations/default",
+		},
+		{
+			name:   "api management api",
+			tfType: "azurerm_api_management_api",
+			input:  "/subscriptions/sub1/resourceGroups/rg1/providers/Microsoft.ApiManagement/service/svc1/apis/api1;rev=1",
+			expect: "/subscriptions/sub1/resourceGroups/rg1/providers/Microsoft.ApiManagement/service/svc1/apis/api1",
+		},
+		{
+			name:   "mismatched type",
+			tfType: "azurerm_resource_group",
+			input:  "/subscriptions/sub1/resourceGroups/rg1/providers/Microsoft.Network/natGateways/gw1",
+			err:    true,
+		},
+		{
+			name:   "subnet route table association",
+			tfType: "azurerm_subnet_route_table_association",
+			input:  "/subscriptions/sub1/resourceGroups/rg1/providers/Microsoft.Network/virtualNetworks/vnet1/subnets/subnet1",
+			err:    true,
+		},
+		{
+			name:   "storage blob",
+			tfType: "azurerm_storage_blob",
+			input:  "https://account1.blob.core.windows.net/container1/blob1",
+			err:    true,
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			actual, err := QueryAzureId(tt.tfType, tt.input)
+			if tt.err {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.expect, actual)
+
+			// Round trip
+			if !tfid.NeedsAPI(tt.tfType) {
+				tfId, err := QueryId(actual, tt.tfType, nil)
+				require.NoError(t, err)
+				require.Equal(t, tt.input, tfId)
+			}
+		})
+	}
+
+	// The data plane URLs can't be parsed back without calling Azure API.
+	_, err := QueryAzureId("azurerm_key_vault_secret", "https://vault1.vault.azure.net/secrets/secret1/0123456789abcdef")
+	require.ErrorIs(t, err, ErrNeedsAPI)
+}
+
+func TestCatalog(t *testing.T) {
+	m, err := DefaultMapping()
+	require.NoError(t, err)
+	entries := map[string]CatalogEntry{}
+	for _, entry := range m.Catalog() {
+		entries[entry.TFType] = entry
+	}
+	require.NotContains(t, entries, "fake_azurerm_application_gateway_backend_address_pool")
+
+	entry := entries["azurerm_nat_gateway_public_ip_association"]
+	require.True(t, entry.PropertyLike)
+	require.False(t, entry.DataPlaneOnly)
+	require.Equal(t, []string{"azurerm_nat_gateway"}, entry.PopulatedBy)
+	require.Equal(t, "/subscriptions/sub1/resourceGroups/rg1/providers/Microsoft.Network/natGateways/natGateways1/publicIPAddresses/<base64 id of azurerm_public_ip>", entry.PesudoId)
+
+	entry = entries["azurerm_storage_share_file"]
+	require.True(t, entry.DataPlaneOnly)
+	require.True(t, entry.IdNeedsAPI)
+	require.NotEmpty(t, entry.PesudoIdNote)
+	require.Equal(t, "/subscriptions/sub1/resourceGroups/rg1/providers/Microsoft.Storage/storageAccounts/storageAccounts1/fileServices/default/shares/shares1/files/files1", entry.PesudoId)
+
+	for _, entry := range entries {
+		require.NotContains(t, entry.PesudoId, "fake_", entry.TFType)
+	}
+
+	entry = entries["azurerm_linux_virtual_machine"]
+	require.True(t, entry.ResolveNeedsAPI)
+	require.False(t, entry.PropertyLike)
+	require.Empty(t, entry.PesudoId)
+}
+
+func TestQueryTypeIncludeDeprecated(t *testing.T) {
+	id := "/subscriptions/sub1/resourceGroups/rg1/providers/Microsoft.Web/sites/site1"
+
+	types, _, err := QueryType(id, nil)
+	require.NoError(t, err)
+	for _, typ := range types {
+		require.Nil(t, typ.Deprecation)
+		require.NotEqual(t, "azurerm_app_service", typ.TFType)
+	}
+
+	types, exact, err := QueryType(id, nil, IncludeDeprecated())
+	require.NoError(t, err)
+	require.False(t, exact)
+	deprecated := map[string]*Deprecation{}
+	for _, typ := range types {
+		if typ.Deprecation != nil {
+			deprecated[typ.TFType] = typ.Deprecation
+		}
+	}
+	require.Contains(t, deprecated, "azurerm_app_service")
+	require.Equal(t, []string{"azurerm_linux_web_app", "azurerm_windows_web_app"}, deprecated["azurerm_app_service"].Successors)
+	require.Contains(t, deprecated, "azurerm_function_app")
+	require.Equal(t, []string{"azurerm_linux_function_app", "azurerm_windows_function_app"}, deprecated["azurerm_function_app"].Successors)
+
+	// The deprecated types don't affect the exactness.
+	types, exact, err = QueryType("/subscriptions/sub1/resourceGroups/rg1/providers/Microsoft.Web/serverFarms/plan1", nil, IncludeDeprecated())
+	require.NoError(t, err)
+	require.True(t, exact)
+	require.Len(t, types, 2)
+	for _, typ := range types {
+		if typ.TFType == "azurerm_app_service_plan" {
+			require.Equal(t, []string{"azurerm_service_plan"}, typ.Deprecation.Successors)
+		} else {
+			require.Equal(t, "azurerm_service_plan", typ.TFType)
+			require.Nil(t, typ.Deprecation)
+		}
+	}
+}
+
+func TestQueryTypeProviderVersion(t *testing.T) {
+	id := "/subscriptions/sub1/resourceGroups/rg1/providers/Microsoft.Web/sites/site1"
+	snapshots := []ProviderSnapshot{
+		{
+			ProviderVersion: ">= 3.0.0, < 4.0.0",
+			ResourceTypes:   []string{"azurerm_app_service", "azurerm_function_app", "azurerm_linux_web_app"},
+		},
+	}
+	tfTypes := func(types []Type) []string {
+		var out []string
+		for _, typ := range types {
+			out = append(out, typ.TFType)
+		}
+		return out
+	}
+
+	types, exact, err := QueryType(id, nil, WithProviderVersion("3.117.1", snapshots...))
+	require.NoError(t, err)
+	require.False(t, exact)
+	require.Equal(t, []string{"azurerm_app_service", "azurerm_function_app", "azurerm_linux_web_app"}, tfTypes(types))
+	for _, typ := range types {
+		require.Nil(t, typ.Deprecation)
+	}
+
+	types, _, err = QueryType(id, nil, WithProviderVersion("v4.10.0", snapshots...), IncludeDeprecated())
+	require.NoError(t, err)
+	require.Contains(t, tfTypes(types), "azurerm_linux_web_app")
+	require.Contains(t, tfTypes(types), "azurerm_app_service")
+	for _, typ := range types {
+		switch typ.TFType {
+		case "azurerm_app_service", "azurerm_function_app":
+			require.NotNil(t, typ.Deprecation, typ.TFType)
+		case "azurerm_linux_web_app", "azurerm_windows_web_app":
+			require.Nil(t, typ.Deprecation, typ.TFType)
+		}
+	}
+
+	types, exact, err = QueryType("/subscriptions/sub1/resourceGroups/rg1/providers/Microsoft.Web/serverFarms/plan1", nil, WithProviderVersion("3.0.0", snapshots...))
+	require.NoError(t, err)
+	require.False(t, exact)
+	require.Empty(t, types)
+
+	_, _, err = QueryType(id, nil, WithProviderVersion("2.99.0", snapshots...))
+	require.ErrorContains(t, err, "no provider snapshot found")
+
+	_, _, err = QueryType(id, nil, WithProviderVersion("latest"))
+	require.ErrorContains(t, err, "invalid provider version")
+
+	_, _, err = QueryType(id, nil, WithProviderVersion("3.0.0", ProviderSnapshot{ProviderVersion: "~> 3.0"}))
+	require.ErrorContains(t, err, "invalid provider version")
+}
+
+func TestMappingOverlay(t *testing.T) {
+	m, err := DefaultMapping()
+	require.NoError(t, err)
+
+	_, err = m.Overlay([]byte(`{"azurerm_foo": {"scope": []}}`))
+	require.ErrorContains(t, err, "unknown field")
+	_, err = m.Overlay([]byte(`{"azurerm_linux_web_app": {"is_removed": true}}`))
+	require.ErrorContains(t, err, `removed resource type "azurerm_linux_web_app" for resolver`)
+
+	om, err := m.Overlay([]byte(`{
+  "azurerm_foo": {
+    "management_plane": {
+      "scopes": ["/subscriptions/resourceGroups"],
+      "provider": "Microsoft.Foo",
+      "types": ["foos"],
+      "import_specs": ["/subscriptions/resourceGroups/Microsoft.Foo/foos"]
+    }
+  },
+  "azurerm_service_plan": {
+    "is_removed": true,
+    "remove_reason": "This is deprecated in favor of ` + "`azurerm_foo`" + `"
+  }
+}`))
+	require.NoError(t, err)
+
+	fooId := "/subscriptions/sub1/resourceGroups/rg1/providers/microsoft.foo/FOOS/foo1"
+	types, ids, exact, err := QueryTypeAndId(fooId, nil, WithMapping(om))
+	require.NoError(t, err)
+	require.True(t, exact)
+	require.Equal(t, "azurerm_foo", types[0].TFType)
+	require.Equal(t, []string{"/subscriptions/sub1/resourceGroups/rg1/providers/Microsoft.Foo/foos/foo1"}, ids)
+
+	// The original mapping is not affected.
+	types, _, err = QueryType(fooId, nil, WithMapping(m))
+	require.NoError(t, err)
+	require.Empty(t, types)
+	types, _, err = QueryType(fooId, nil)
+	require.NoError(t, err)
+	require.Empty(t, types)
+
+	types, _, err = QueryType("/subscriptions/sub1/resourceGroups/rg1/providers/Microsoft.Web/serverFarms/plan1", nil, WithMapping(om), IncludeDeprecated())
+	require.NoError(t, err)
+	require.Len(t, types, 2)
+	for _, typ := range types {
+		require.NotNil(t, typ.Deprecation)
+		if typ.TFType == "azurerm_service_plan" {
+			require.Equal(t, []string{"azurerm_foo"}, typ.Deprecation.Successors)
+		}
+	}
+}
+
+func TestLoadMapping(t *testing.T) {
+	m, err := LoadMapping([]byte(`{
+  "azurerm_foo": {
+    "management_plane": {
+      "scopes": ["/subscriptions/resourceGroups"],
+      "provider": "Microsoft.Foo",
+      "types": ["foos"],
+      "import_specs": ["/subscriptions/resourceGroups/Microsoft.Foo/foos"]
+    }
+  }
+}`))
+	require.NoError(t, err)
+
+	types, _, err := QueryType("/subscriptions/sub1/resourceGroups/rg1/providers/Microsoft.Foo/foos/foo1", nil, WithMapping(m))
+	require.NoError(t, err)
+	require.Len(t, types, 1)
+	types, _, err = QueryType("/subscriptions/sub1/resourceGroups/rg1", nil, WithMapping(m))
+	require.NoError(t, err)
+	require.Empty(t, types)
+
+	azureId, err := QueryAzureId("azurerm_foo", "/subscriptions/sub1/resourceGroups/rg1/providers/Microsoft.Foo/foos/foo1", WithMapping(m))
+	require.NoError(t, err)
+	require.Equal(t, "/subscriptions/sub1/resourceGroups/rg1/providers/Microsoft.Foo/foos/foo1", azureId)
+	_, err = QueryAzureId("azurerm_resource_group", "/subscriptions/sub1/resourceGroups/rg1", WithMapping(m))
+	require.ErrorContains(t, err, "unknown resource type")
+
+	_, err = LoadMapping([]byte(`[]`))
+	require.Error(t, err)
+}
+
+func TestQueryAzapiFallback(t *testing.T) {
+	id := "/subscriptions/sub1/resourceGroups/rg1/providers/Microsoft.Foo/foos/foo1/bars/bar1"
+
+	types, exact, err := QueryType(id, nil)
+	require.NoError(t, err)
+	require.False(t, exact)
+	require.Empty(t, types)
+
+	types, ids, exact, err := QueryTypeAndId(id, nil, WithAzapiFallback(map[string]string{"microsoft.foo/FOOS/bars": "2023-01-01"}))
+	require.NoError(t, err)
+	require.True(t, exact)
+	require.Equal(t, []Type{
+		{
+			AzureId:   MustParseId(t, id),
+			TFType:    AzapiResourceType,
+			AzapiType: "Microsoft.Foo/foos/bars@2023-01-01",
+		},
+	}, types)
+	require.Equal(t, []string{id + "?api-version=2023-01-01"}, ids)
+
+	azureId, err := QueryAzureId(AzapiResourceType, ids[0])
+	require.NoError(t, err)
+	require.Equal(t, id, azureId)
+
+	// The mapped resources are not affected.
+	types, _, err = QueryType("/subscriptions/sub1/resourceGroups/rg1/providers/Microsoft.Network/virtualNetworks/vnet1", nil, WithAzapiFallback(nil))
+	require.NoError(t, err)
+	require.Equal(t, "azurerm_virtual_network", types[0].TFType)
+
+	_, _, err = QueryType(id, nil, WithAzapiFallback(nil))
+	require.ErrorContains(t, err, "no API version specified for Microsoft.Foo/foos/bars")
+
+	srv := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/subscriptions/sub1/providers/Microsoft.Foo":
+			fmt.Fprint(w, `{"namespace": "Microsoft.Foo", "resourceTypes": [
+	{"resourceType": "foos", "apiVersions": ["2024-01-01"]},
+	{"resourceType": "foos/bars", "apiVersions": ["2024-05-01-preview", "2024-01-01", "2023-01-01"]},
+	{"resourceType": "bazs", "apiVersions": ["2024-05-01-preview"]}
+]}`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer srv.Close()
+
+	opt := &APIOption{
+		Cred: fakeCredential{},
+		ClientOption: arm.ClientOptions{
+			ClientOptions: policy.ClientOptions{
+				Cloud: cloud.Configuration{
+					Services: map[cloud.ServiceName]cloud.ServiceConfiguration{
+						cloud.ResourceManager: {Endpoint: srv.URL, Audience: "https://management.core.windows.net/"},
+					},
+				},
+				Transport: srv.Client(),
+				Retry:     policy.RetryOptions{MaxRetries: -1},
+			},
+		},
+	}
+
+	tfid, err := QueryId(id, AzapiResourceType, opt, WithAzapiFallback(nil))
+	require.NoError(t, err)
+	require.Equal(t, id+"?api-version=2024-01-01", tfid)
+
+	types, _, err = QueryType("/subscriptions/sub1/resourceGroups/rg1/providers/Microsoft.Foo/bazs/baz1", opt, WithAzapiFallback(nil))
+	require.NoError(t, err)
+	require.Len(t, types, 1)
+	require.Equal(t, "Microsoft.Foo/bazs@2024-05-01-preview", types[0].AzapiType)
+
+	_, _, err = QueryType("/subscriptions/sub1/resourceGroups/rg1/providers/Microsoft.Foo/quxs/qux1", opt, WithAzapiFallback(nil))
+	require.ErrorContains(t, err, "resource type quxs not found in Microsoft.Foo")
+}
+
+func TestMappingDiff(t *testing.T) {
+	oldMapping, err := LoadMapping([]byte(`{
+  "azurerm_foo": {
+    "management_plane": {
+      "scopes": ["/subscriptions/resourceGroups"],
+      "provider": "Microsoft.Foo",
+      "types": ["foos"],
+      "import_specs": ["/subscriptions/resourceGroups/Microsoft.Foo/foos"]
+    }
+  },
+  "azurerm_bar": {
+    "management_plane": {
+      "scopes": ["/subscriptions/resourceGroups"],
+      "provider": "Microsoft.Foo",
+      "types": ["bars"]
+    }
+  },
+  "azurerm_linux_web_app": {
+    "management_plane": {
+      "scopes": ["/subscriptions/resourceGroups"],
+      "provider": "Microsoft.Web",
+      "types": ["sites"]
+    }
+  }
+}`))
+	require.NoError(t, err)
+	newMapping, err := LoadMapping([]byte(`{
+  "azurerm_foo": {
+    "is_removed": true,
+    "management_plane": {
+      "scopes": ["/subscriptions/resourceGroups", "/subscriptions"],
+      "provider": "Microsoft.Foo",
+      "types": ["foos"],
+      "import_specs": ["/subscriptions/resourceGroups/Microsoft.Foo/foos", "/subscriptions/Microsoft.Foo/foos"]
+    }
+  },
+  "azurerm_baz": {
+    "management_plane": {
+      "scopes": ["/subscriptions/resourceGroups"],
+      "provider": "Microsoft.Foo",
+      "types": ["bars"]
+    }
+  },
+  "azurerm_qux": {
+    "management_plane": {
+      "scopes": ["/subscriptions/resourceGroups"],
+      "provider": "Microsoft.Foo",
+      "types": ["bars"]
+    }
+  },
+  "azurerm_linux_web_app": {
+    "management_plane": {
+      "scopes": ["/subscriptions/resourceGroups"],
+      "provider": "Microsoft.Web",
+      "types": ["sites"]
+    }
+  },
+  "azurerm_windows_web_app": {
+    "management_plane": {
+      "scopes": ["/subscriptions/resourceGroups"],
+      "provider": "Microsoft.Web",
+      "types": ["sites"]
+    }
+  }
+}`))
+	require.NoError(t, err)
+
+	require.True(t, oldMapping.Diff(oldMapping).Empty())
+
+	diff := oldMapping.Diff(newMapping)
+	require.Equal(t, []string{"azurerm_baz", "azurerm_qux", "azurerm_windows_web_app"}, diff.Added)
+	require.Equal(t, []string{"azurerm_bar"}, diff.Removed)
+	require.Equal(t, []MappingChange{
+		{
+			TFType: "azurerm_foo",
+			Field:  "import_specs",
+			Old:    "[/subscriptions/resourceGroups/Microsoft.Foo/foos]",
+			New:    "[/subscriptions/resourceGroups/Microsoft.Foo/foos, /subscriptions/Microsoft.Foo/foos]",
+		},
+		{
+			TFType: "azurerm_foo",
+			Field:  "is_removed",
+			Old:    "false",
+			New:    "true",
+		},
+		{
+			TFType: "azurerm_foo",
+			Field:  "scopes",
+			Old:    "[/subscriptions/resourceGroups]",
+			New:    "[/subscriptions/resourceGroups, /subscriptions]",
+		},
+	}, diff.Changed)
+	require.Equal(t, []MappingAmbiguity{
+		{
+			RoutingKey:    "/MICROSOFT.FOO/BARS",
+			Scope:         "/SUBSCRIPTIONS/RESOURCEGROUPS",
+			ResourceTypes: []string{"azurerm_baz", "azurerm_qux"},
+		},
+		{
+			RoutingKey:    "/MICROSOFT.WEB/SITES",
+			Scope:         "/SUBSCRIPTIONS/RESOURCEGROUPS",
+			ResourceTypes: []string{"azurerm_linux_web_app", "azurerm_windows_web_app"},
+			Resolved:      true,
+		},
+	}, diff.NewAmbiguities)
+}
@@ -0,0 +1,195 @@
+package aztft
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"sync/atomic"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/policy"
+	"github.com/magodo/aztft/internal/client"
+)
+
+const defaultBatchConcurrency = 10
+
+type BatchOption struct {
+	// APIOption is used for all the queries in the batch. Leave it nil to query statically.
+	APIOption *APIOption
+
+	// Concurrency is the maximum number of ids being queried at the same time. Defaults to 10 if not positive.
+	Concurrency int
+
+	// QueryOptions are applied to each of the queries in the batch.
+	QueryOptions []QueryOption
+
+	// ResourceGraph, if true, resolves the ambiguous resources in the batch from bulk Azure Resource Graph queries (see PrefetchResourceGraph),
+	// instead of one GET request per resource. It only takes effect when the "APIOption" is specified.
+	// If the Azure Resource Graph queries fail (e.g. for lack of permission), the error is reported to the WithTrace hook (if any) of the QueryOptions
+	// as a TracePrefetch event, and the resources are resolved as if it is false.
+	ResourceGraph bool
+
+	// TypesOnly, if true, only queries the types of each id (as QueryType does), leaving the TFIds and TFIdErrs of the results nil.
+	TypesOnly bool
+}
+
+// BatchResult is the query result of a single id in the batch, which is equivalent to the result of QueryTypeAndId,
+// except that the Types (and Exact) are kept when building any of the Terraform resource IDs fails.
+type BatchResult struct {
+	Id    string
+	Types []Type
+	TFIds []string
+	Exact bool
+	Err   error
+
+	// TFIdErrs has the same length as the Types, which records the error of building each Terraform resource ID, whose TFIds item is empty then.
+	TFIdErrs []error
+
+	// API indicates whether the Azure API is actually called to derive the result, i.e. any request is sent (or shared with another id in the batch),
+	// or the resource is resolved from the Azure Resource Graph (see BatchOption.ResourceGraph). It is always false without the "APIOption",
+	// or if the APIOption serves the resources offline by its BodyProvider.
+	API bool
+}
+
+// QueryBatch queries a list of ARM resource IDs concurrently, and returns the results in the same order as the input ids.
+// When the "opt.APIOption" is specified, identical Azure GET requests issued during the batch (e.g. the same storage account
+// retrieved by multiple blob id builders) are only sent once.
+func QueryBatch(ctx context.Context, ids []string, opt *BatchOption) []BatchResult {
+	results := make([]BatchResult, 0, len(ids))
+	QueryStream(ctx, ids, opt, func(result BatchResult) error {
+		results = append(results, result)
+		return nil
+	})
+	return results
+}
+
+// QueryStream is similar to QueryBatch, except each result is passed to the emit function once it and all the results before it are available,
+// in the same order as the input ids. Once emit returns an error, the remaining queries are cancelled and the error is returned.
+func QueryStream(ctx context.Context, ids []string, opt *BatchOption, emit func(BatchResult) error) error {
+	if opt == nil {
+		opt = &BatchOption{}
+	}
+	concurrency := opt.Concurrency
+	if concurrency <= 0 {
+		concurrency = defaultBatchConcurrency
+	}
+
+	apiOpt := opt.APIOption
+	online := apiOpt != nil && apiOpt.BodyProvider == nil
+	qopts := newQueryOptions(opt.QueryOptions)
+	if apiOpt != nil {
+		apiOpt = withDedup(*apiOpt)
+		if opt.ResourceGraph {
+			popt, err := PrefetchResourceGraph(ctx, ids, apiOpt)
+			if err != nil {
+				qopts.tracef(TracePrefetch, nil, "", "prefetching from Azure Resource Graph failed, the resources are resolved by their own GET requests: %v", err)
+			} else {
+				apiOpt = popt
+			}
+		}
+	}
+	apiOpt = apiOpt.normalize()
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	// Each result is sent to its own channel, so that the results are emitted in the input order, while the workers move on to the next ids.
+	results := make([]chan BatchResult, len(ids))
+	for i := range results {
+		results[i] = make(chan BatchResult, 1)
+	}
+
+	// The stop channel is closed once emit fails, the remaining ids are not queried then.
+	// Otherwise, all the ids get their results, which is the context error once the context is done.
+	stop := make(chan struct{})
+	jobs := make(chan int)
+	go func() {
+		defer close(jobs)
+		for i := range ids {
+			select {
+			case jobs <- i:
+			case <-stop:
+				return
+			}
+		}
+	}()
+
+	// The fixed number of workers bounds the number of in-flight queries.
+	var wg sync.WaitGroup
+	defer wg.Wait()
+	for w := 0; w < concurrency; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range jobs {
+				if !online {
+					results[i] <- queryBatchItem(ctx, ids[i], apiOpt, qopts, opt.TypesOnly)
+					continue
+				}
+				var use apiUse
+				result := queryBatchItem(ctx, ids[i], use.apiOption(apiOpt), qopts, opt.TypesOnly)
+				result.API = use.used.Load() || apiOpt.isPrefetched(ids[i])
+				results[i] <- result
+			}
+		}()
+	}
+
+	for _, ch := range results {
+		if err := emit(<-ch); err != nil {
+			close(stop)
+			cancel()
+			return err
+		}
+	}
+	return nil
+}
+
+// queryBatchItem queries a single id of the batch.
+func queryBatchItem(ctx context.Context, id string, apiOpt *APIOption, qopts queryOptions, typesOnly bool) BatchResult {
+	result := BatchResult{Id: id}
+	if err := ctx.Err(); err != nil {
+		result.Err = err
+		return result
+	}
+	if typesOnly {
+		result.Types, result.Exact, result.Err = queryType(ctx, id, apiOpt, qopts)
+		result.Err = apiError(result.Err)
+		return result
+	}
+	result.Types, result.TFIds, result.TFIdErrs, result.Exact, result.Err = queryTypeAndIds(ctx, id, apiOpt, qopts)
+	if result.Err == nil {
+		for _, err := range result.TFIdErrs {
+			if err != nil {
+				result.Err = err
+				break
+			}
+		}
+	}
+	return result
+}
+
+// withDedup returns a copy of the API option, whose clients share a single DedupPolicy.
+func withDedup(opt APIOption) *APIOption {
+	perCall := make([]policy.Policy, 0, len(opt.ClientOption.PerCallPolicies)+1)
+	perCall = append(perCall, opt.ClientOption.PerCallPolicies...)
+	perCall = append(perCall, client.NewDedupPolicy())
+	opt.ClientOption.PerCallPolicies = perCall
+	return &opt
+}
+
+// apiUse is a policy that records whether any request is sent via the API option.
+type apiUse struct {
+	used atomic.Bool
+}
+
+func (u *apiUse) Do(req *policy.Request) (*http.Response, error) {
+	u.used.Store(true)
+	return req.Next()
+}
+
+// apiOption returns a copy of the API option, whose clients are recorded by the apiUse.
+// The apiUse goes before the other policies, so that the requests served by the DedupPolicy are recorded as well.
+func (u *apiUse) apiOption(opt *APIOption) *APIOption {
+	nopt := *opt
+	nopt.ClientOption.PerCallPolicies = append([]policy.Policy{u}, opt.ClientOption.PerCallPolicies...)
+	return &nopt
+}
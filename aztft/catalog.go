@@ -0,0 +1,206 @@
+package aztft
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/magodo/armid"
+	"github.com/magodo/aztft/internal/populate"
+	"github.com/magodo/aztft/internal/resmap"
+	"github.com/magodo/aztft/internal/resolve"
+	"github.com/magodo/aztft/internal/tfid"
+)
+
+// CatalogEntry describes how a Terraform resource type is supported by aztft.
+type CatalogEntry struct {
+	TFType string `json:"tf_type"`
+
+	// Provider and Types are the resource provider and the resource types of the (pesudo) Azure resource ID.
+	Provider string   `json:"provider,omitempty"`
+	Types    []string `json:"types,omitempty"`
+
+	// ParentScopes are the parent scopes in the scope string literal form, where "any" means any scope. This is empty for the root scope resources.
+	ParentScopes []string `json:"parent_scopes,omitempty"`
+
+	// ImportSpecs are the import specs in the scope string literal form, corresponding to each of the ParentScopes.
+	ImportSpecs []string `json:"import_specs,omitempty"`
+
+	Removed      bool   `json:"removed"`
+	RemoveReason string `json:"remove_reason,omitempty"`
+
+	// Successors are the TF resource types that supersede this removed one, if any.
+	Successors []string `json:"successors,omitempty"`
+
+	// ResolveNeedsAPI indicates whether the Azure API is needed to resolve this TF resource type from its ambiguous matches.
+	ResolveNeedsAPI bool `json:"resolve_needs_api"`
+
+	// IdNeedsAPI indicates whether the Azure API is needed to build the TF resource ID.
+	IdNeedsAPI bool `json:"id_needs_api"`
+
+	// PropertyLike indicates whether this is a property-like resource, which is only returned by querying its main resource with the API option.
+	PropertyLike bool `json:"property_like"`
+
+	// PopulatedBy are the TF resource types of the main resources, which populate this property-like resource.
+	PopulatedBy []string `json:"populated_by,omitempty"`
+
+	// DataPlaneOnly indicates whether this is a data plane only resource.
+	DataPlaneOnly bool `json:"data_plane_only"`
+
+	// PesudoId is an example of the pesudo Azure resource ID, for the property-like or data plane only resources.
+	PesudoId string `json:"pesudo_id,omitempty"`
+
+	// PesudoIdNote is the note about how to compose the pesudo Azure resource ID, if any.
+	PesudoIdNote string `json:"pesudo_id_note,omitempty"`
+}
+
+// Catalog lists all the Terraform resource types known by this mapping, sorted by the Terraform resource type.
+// The fake resource types that are only used internally are not included.
+func (m *Mapping) Catalog() []CatalogEntry {
+	resolvable := map[string]bool{}
+	for _, rm := range resolve.Resolvers {
+		for _, r := range rm {
+			for _, rt := range r.ResourceTypes() {
+				resolvable[rt] = true
+			}
+		}
+	}
+
+	populatedBy := map[string][]string{}
+	refTypes := map[string]string{}
+	for rt := range m.m.TF2ARMIdMap {
+		for _, plt := range populate.PropertyLikeTypes(rt) {
+			populatedBy[plt.ResourceType] = append(populatedBy[plt.ResourceType], rt)
+			refTypes[plt.ResourceType] = plt.RefResourceType
+		}
+	}
+
+	var entries []CatalogEntry
+	for rt, item := range m.m.TF2ARMIdMap {
+		if strings.HasPrefix(rt, "fake_") {
+			continue
+		}
+		entry := CatalogEntry{
+			TFType:          rt,
+			Removed:         item.IsRemoved,
+			RemoveReason:    item.RemoveReason,
+			ResolveNeedsAPI: resolvable[rt],
+			IdNeedsAPI:      tfid.NeedsAPI(rt),
+			PropertyLike:    len(populatedBy[rt]) != 0,
+			DataPlaneOnly:   tfid.IsDataPlaneOnly(rt),
+		}
+		if item.Deprecation != nil {
+			entry.Successors = item.Deprecation.Successors
+		}
+		if mm := item.ManagementPlane; mm != nil {
+			entry.Provider = mm.Provider
+			entry.Types = mm.Types
+			entry.ParentScopes = mm.ParentScopes
+			entry.ImportSpecs = mm.ImportSpecs
+			if entry.PropertyLike || entry.DataPlaneOnly {
+				entry.PesudoId = examplePesudoId(mm, refTypes[rt])
+				entry.PesudoIdNote = tfid.PesudoIdNote(rt)
+			}
+		}
+		if entry.PropertyLike {
+			sort.Strings(populatedBy[rt])
+			entry.PopulatedBy = populatedBy[rt]
+		}
+		entries = append(entries, entry)
+	}
+	sort.Slice(entries, func(i, j int) bool {
+		return entries[i].TFType < entries[j].TFType
+	})
+	return entries
+}
+
+// wellKnownNames are the names of the singleton resource types, which are the only valid names of them (e.g. ".../blobServices/default").
+var wellKnownNames = map[string]string{
+	"blobServices":  "default",
+	"fileServices":  "default",
+	"queueServices": "default",
+	"tableServices": "default",
+}
+
+// fakeRefIds describe the referenced resources whose TF resource type is internal (i.e. "fake_*"), in terms of the real TF resource attribute.
+var fakeRefIds = map[string]string{
+	"fake_azurerm_application_gateway_backend_address_pool": "azurerm_application_gateway.example.backend_address_pool.n.id",
+}
+
+// examplePesudoId builds an example pesudo resource ID under the first parent scope, where the names are the resource types suffixed with "1".
+// The constant names of the id transform, or the well-known names, are used when available, and the last name is a placeholder if it is the
+// base64 encoded ID of the referenced resource.
+func examplePesudoId(mm *resmap.MapManagementPlane, refType string) string {
+	scope := "/subscriptions/resourceGroups"
+	if len(mm.ParentScopes) != 0 && mm.ParentScopes[0] != resmap.ScopeAny {
+		scope = mm.ParentScopes[0]
+	}
+
+	var names []string
+	for _, t := range mm.Types {
+		if name, ok := mm.IdTransform.ConstantName(t); ok {
+			names = append(names, name)
+			continue
+		}
+		if name, ok := wellKnownNames[t]; ok {
+			names = append(names, name)
+			continue
+		}
+		names = append(names, t+"1")
+	}
+	if refType != "" && len(names) != 0 {
+		names[len(names)-1] = refIdPlaceholder(refType)
+	}
+
+	id := &armid.ScopedResourceId{
+		AttrParentScope: exampleScopeId(scope),
+		AttrProvider:    mm.Provider,
+		AttrTypes:       mm.Types,
+		AttrNames:       names,
+	}
+	return id.String()
+}
+
+// refIdPlaceholder returns the placeholder of the base64 encoded ID of the referenced resource, which never exposes the internal "fake_*" TF resource types.
+func refIdPlaceholder(refType string) string {
+	if !strings.HasPrefix(refType, "fake_") {
+		return fmt.Sprintf("<base64 id of %s>", refType)
+	}
+	if attr, ok := fakeRefIds[refType]; ok {
+		return fmt.Sprintf("<base64 of %s>", attr)
+	}
+	return "<base64 id of the referenced resource>"
+}
+
+// exampleScopeId builds an example resource ID from the scope string literal, where the names are the resource types suffixed with "1".
+func exampleScopeId(scope string) armid.ResourceId {
+	var (
+		sub = &armid.SubscriptionId{Id: "sub1"}
+		rg  = &armid.ResourceGroup{SubscriptionId: "sub1", Name: "rg1"}
+		mg  = &armid.ManagementGroup{Name: "grp1"}
+	)
+
+	var parentScope armid.ResourceId = &armid.TenantId{}
+	for _, id := range []armid.ResourceId{rg, sub, mg} {
+		if strings.HasPrefix(strings.ToUpper(scope), strings.ToUpper(id.ScopeString())) {
+			parentScope = id
+			break
+		}
+	}
+
+	left := strings.Trim(scope[len(parentScope.ScopeString()):], "/")
+	if left == "" {
+		return parentScope
+	}
+	segs := strings.Split(left, "/")
+	var names []string
+	for _, seg := range segs[1:] {
+		names = append(names, seg+"1")
+	}
+	return &armid.ScopedResourceId{
+		AttrParentScope: parentScope,
+		AttrProvider:    segs[0],
+		AttrTypes:       segs[1:],
+		AttrNames:       names,
+	}
+}
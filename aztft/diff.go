@@ -0,0 +1,185 @@
+package aztft
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/magodo/aztft/internal/resmap"
+	"github.com/magodo/aztft/internal/resolve"
+)
+
+// MappingDiff is the difference between two mappings, see Diff.
+type MappingDiff struct {
+	// Added are the TF resource types only in the new mapping.
+	Added []string `json:"added"`
+
+	// Removed are the TF resource types only in the old mapping.
+	Removed []string `json:"removed"`
+
+	// Changed are the changes of the TF resource types in both mappings.
+	Changed []MappingChange `json:"changed"`
+
+	// NewAmbiguities are the ARM routing keys that are ambiguous in the new mapping, with any TF resource type that
+	// isn't one of its ambiguous TF resource types in the old mapping. They need to be resolved by a resolver.
+	NewAmbiguities []MappingAmbiguity `json:"new_ambiguities"`
+}
+
+// MappingChange is the change of a field of a TF resource type's mapping item.
+type MappingChange struct {
+	TFType string `json:"tf_type"`
+
+	// Field is the JSON field name of the mapping item, e.g. "is_removed", "provider", "types", "scopes", "import_specs" or "id_transform".
+	Field string `json:"field"`
+
+	Old string `json:"old"`
+	New string `json:"new"`
+}
+
+// MappingAmbiguity is an ARM routing key that maps to multiple (non-removed) TF resource types.
+type MappingAmbiguity struct {
+	// RoutingKey is the upper cased "/<provider>/<types>" of the ARM resource type.
+	RoutingKey string `json:"routing_key"`
+
+	// Scope is the upper cased parent scope string of the ARM resource type, or "ANY" for any scope.
+	Scope string `json:"scope"`
+
+	ResourceTypes []string `json:"resource_types"`
+
+	// Resolved indicates whether there is a resolver that covers all the ResourceTypes.
+	Resolved bool `json:"resolved"`
+}
+
+// Empty tells whether there is no difference.
+func (d MappingDiff) Empty() bool {
+	return len(d.Added) == 0 && len(d.Removed) == 0 && len(d.Changed) == 0 && len(d.NewAmbiguities) == 0
+}
+
+// Diff compares this (old) mapping to the new mapping, and returns the difference, where each part is sorted.
+func (m *Mapping) Diff(newMapping *Mapping) MappingDiff {
+	oldMap, newMap := m.m.TF2ARMIdMap, newMapping.m.TF2ARMIdMap
+	diff := MappingDiff{
+		Added:          []string{},
+		Removed:        []string{},
+		Changed:        []MappingChange{},
+		NewAmbiguities: []MappingAmbiguity{},
+	}
+
+	for rt, newItem := range newMap {
+		oldItem, ok := oldMap[rt]
+		if !ok {
+			diff.Added = append(diff.Added, rt)
+			continue
+		}
+		diff.Changed = append(diff.Changed, diffMapItem(rt, oldItem, newItem)...)
+	}
+	for rt := range oldMap {
+		if _, ok := newMap[rt]; !ok {
+			diff.Removed = append(diff.Removed, rt)
+		}
+	}
+	sort.Strings(diff.Added)
+	sort.Strings(diff.Removed)
+	sort.Slice(diff.Changed, func(i, j int) bool {
+		if diff.Changed[i].TFType != diff.Changed[j].TFType {
+			return diff.Changed[i].TFType < diff.Changed[j].TFType
+		}
+		return diff.Changed[i].Field < diff.Changed[j].Field
+	})
+
+	for k1, b := range newMapping.m.ARMId2TFMap {
+		for k2, l := range b {
+			if len(l) <= 1 {
+				continue
+			}
+			oldTypes := map[string]bool{}
+			if ol := m.m.ARMId2TFMap[k1][k2]; len(ol) > 1 {
+				for _, item := range ol {
+					oldTypes[item.ResourceType] = true
+				}
+			}
+			var isNew bool
+			var resourceTypes []string
+			for _, item := range l {
+				resourceTypes = append(resourceTypes, item.ResourceType)
+				if !oldTypes[item.ResourceType] {
+					isNew = true
+				}
+			}
+			if !isNew {
+				continue
+			}
+			sort.Strings(resourceTypes)
+			diff.NewAmbiguities = append(diff.NewAmbiguities, MappingAmbiguity{
+				RoutingKey:    k1,
+				Scope:         k2,
+				ResourceTypes: resourceTypes,
+				Resolved:      resolverCovers(k1, k2, resourceTypes),
+			})
+		}
+	}
+	sort.Slice(diff.NewAmbiguities, func(i, j int) bool {
+		if diff.NewAmbiguities[i].RoutingKey != diff.NewAmbiguities[j].RoutingKey {
+			return diff.NewAmbiguities[i].RoutingKey < diff.NewAmbiguities[j].RoutingKey
+		}
+		return diff.NewAmbiguities[i].Scope < diff.NewAmbiguities[j].Scope
+	})
+
+	return diff
+}
+
+func diffMapItem(rt string, oldItem, newItem resmap.TF2ARMIdMapItem) []MappingChange {
+	var changes []MappingChange
+	add := func(field, o, n string) {
+		if o != n {
+			changes = append(changes, MappingChange{TFType: rt, Field: field, Old: o, New: n})
+		}
+	}
+	add("is_removed", fmt.Sprint(oldItem.IsRemoved), fmt.Sprint(newItem.IsRemoved))
+
+	var omm, nmm resmap.MapManagementPlane
+	if oldItem.ManagementPlane != nil {
+		omm = *oldItem.ManagementPlane
+	}
+	if newItem.ManagementPlane != nil {
+		nmm = *newItem.ManagementPlane
+	}
+	add("provider", omm.Provider, nmm.Provider)
+	add("types", formatList(omm.Types), formatList(nmm.Types))
+	add("scopes", formatList(omm.ParentScopes), formatList(nmm.ParentScopes))
+	add("import_specs", formatList(omm.ImportSpecs), formatList(nmm.ImportSpecs))
+	add("id_transform", formatIdTransform(omm.IdTransform), formatIdTransform(nmm.IdTransform))
+	return changes
+}
+
+// formatIdTransform formats the id transform as JSON, whose map keys are sorted.
+func formatIdTransform(t *resmap.IdTransform) string {
+	if t == nil {
+		return ""
+	}
+	b, _ := json.Marshal(t)
+	return string(b)
+}
+
+func formatList(l []string) string {
+	return "[" + strings.Join(l, ", ") + "]"
+}
+
+// resolverCovers tells whether there is a resolver for the ARM routing key and scope, which covers all the TF resource types.
+func resolverCovers(k1, k2 string, resourceTypes []string) bool {
+	resolver, ok := resolve.Resolvers[k1][k2]
+	if !ok {
+		return false
+	}
+	covered := map[string]bool{}
+	for _, rt := range resolver.ResourceTypes() {
+		covered[rt] = true
+	}
+	for _, rt := range resourceTypes {
+		if !covered[rt] {
+			return false
+		}
+	}
+	return true
+}
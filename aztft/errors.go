@@ -0,0 +1,80 @@
+package aztft
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/magodo/aztft/internal/errs"
+	"github.com/magodo/aztft/internal/resolve"
+)
+
+// The sentinel errors, which are tested by errors.Is against the errors returned by this package.
+var (
+	// ErrInvalidId indicates the Azure resource ID, or the TF resource ID, is malformed.
+	ErrInvalidId = errs.ErrInvalidId
+
+	// ErrNoMatch indicates the TF resource type is unknown, or it doesn't match the resource ID.
+	ErrNoMatch = errs.ErrNoMatch
+
+	// ErrNeedsAPI indicates the query needs to call the Azure API, while the API option is not specified.
+	ErrNeedsAPI = errs.ErrNeedsAPI
+
+	// ErrResourceNotFound indicates the Azure API responds that the resource is not found (i.e. 404).
+	ErrResourceNotFound = errs.ErrResourceNotFound
+
+	// ErrUnauthorized indicates the Azure API rejects the request due to the authentication or authorization (i.e. 401 or 403).
+	ErrUnauthorized = errs.ErrUnauthorized
+
+	// ErrUnknownKind indicates the resolver can't tell the TF resource type by the kind (or the type, the SKU, etc.) of the resource.
+	ErrUnknownKind = errs.ErrUnknownKind
+)
+
+// ResolveError is the error of resolving the TF resource type of a resource via the Azure API, which is usually the Err of an *Ambiguity.
+type ResolveError = resolve.ResolveError
+
+// APIError is the error of an Azure API call, e.g. when resolving or populating the resource, or listing the resources.
+// It is ErrResourceNotFound for 404, and ErrUnauthorized for 401 and 403.
+type APIError struct {
+	StatusCode int
+
+	// ErrorCode is the ARM error code, e.g. "ResourceNotFound", "AuthorizationFailed".
+	ErrorCode string
+
+	// Err is the underlying error, which wraps the *azcore.ResponseError.
+	Err error
+}
+
+func (e *APIError) Error() string { return e.Err.Error() }
+
+func (e *APIError) Unwrap() error { return e.Err }
+
+func (e *APIError) Is(target error) bool {
+	switch target {
+	case ErrResourceNotFound:
+		return e.StatusCode == http.StatusNotFound
+	case ErrUnauthorized:
+		return e.StatusCode == http.StatusUnauthorized || e.StatusCode == http.StatusForbidden
+	}
+	return false
+}
+
+// apiError wraps the error as an *APIError, if it is caused by an Azure API response. For an *Ambiguity, its Err is wrapped instead.
+func apiError(err error) error {
+	if err == nil {
+		return nil
+	}
+	if amb, ok := err.(*Ambiguity); ok {
+		amb.Err = apiError(amb.Err)
+		return amb
+	}
+	var ae *APIError
+	if errors.As(err, &ae) {
+		return err
+	}
+	var re *azcore.ResponseError
+	if !errors.As(err, &re) {
+		return err
+	}
+	return &APIError{StatusCode: re.StatusCode, ErrorCode: re.ErrorCode, Err: err}
+}
@@ -0,0 +1,99 @@
+package aztft
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/magodo/aztft/internal/mapcheck"
+	"github.com/magodo/aztft/internal/resmap"
+)
+
+// Mapping is the mapping between the Terraform resource types and the ARM resource types, which is used by the queries via the WithMapping option.
+// It is immutable, so that multiple mappings can be used at the same time (e.g. to compare the query results between them).
+type Mapping struct {
+	m *resmap.Mapping
+}
+
+// DefaultMapping returns the embedded mapping, which is used by the queries by default.
+func DefaultMapping() (*Mapping, error) {
+	m, err := resmap.Embedded()
+	if err != nil {
+		return nil, err
+	}
+	return &Mapping{m: m}, nil
+}
+
+// LoadMapping loads the mapping from the JSON, which has the same schema as the embedded "map.json".
+// Different from Overlay, the mapping is not validated, which can be done by Lint.
+func LoadMapping(b []byte) (*Mapping, error) {
+	m, err := resmap.LoadMapping(b)
+	if err != nil {
+		return nil, fmt.Errorf("loading mapping: %w", err)
+	}
+	return &Mapping{m: m}, nil
+}
+
+// Overlay returns a new mapping that is the overlay JSON merged over this mapping.
+// The overlay has the same schema as the embedded "map.json", i.e. a map from the TF resource type to its mapping item, e.g.:
+//
+//	{
+//	  "azurerm_foo": {
+//	    "management_plane": {
+//	      "scopes": ["/subscriptions/resourceGroups"],
+//	      "provider": "Microsoft.Foo",
+//	      "types": ["foos"],
+//	      "import_specs": ["/subscriptions/resourceGroups/Microsoft.Foo/foos"]
+//	    }
+//	  },
+//	  "azurerm_bar": {
+//	    "is_removed": true,
+//	    "remove_reason": "This is deprecated in favor of `azurerm_foo`"
+//	  }
+//	}
+//
+// A new TF resource type is added as is, while for an existing one, the non-empty fields of the overlay item override the existing ones.
+// The merged mapping is validated by Lint, and an error is returned if there is any problem.
+func (m *Mapping) Overlay(b []byte) (*Mapping, error) {
+	var overlay resmap.TF2ARMIdMapType
+	dec := json.NewDecoder(bytes.NewReader(b))
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(&overlay); err != nil {
+		return nil, fmt.Errorf("unmarshalling mapping overlay: %w", err)
+	}
+
+	merged, err := resmap.NewMapping(m.m.TF2ARMIdMap.Merge(overlay))
+	if err != nil {
+		return nil, fmt.Errorf("building the merged mapping: %w", err)
+	}
+	if findings := mapcheck.Check(merged); len(findings) != 0 {
+		var problems []string
+		for _, f := range findings {
+			problems = append(problems, f.Message)
+		}
+		return nil, fmt.Errorf("validating the merged mapping:\n%s", strings.Join(problems, "\n"))
+	}
+	return &Mapping{m: merged}, nil
+}
+
+// LintFinding is a problem found in the mapping by Lint.
+type LintFinding struct {
+	// Check is the kind of the check that finds this problem, e.g. "ambiguous-route".
+	Check string `json:"check"`
+
+	// ResourceType is the TF resource type that has this problem, if any.
+	ResourceType string `json:"resource_type,omitempty"`
+
+	Message string `json:"message"`
+}
+
+// Lint checks the consistency of this mapping, and between this mapping and the resolvers, the ID builders and the populaters,
+// and returns the problems found, sorted by the message. It is the same validation as Overlay does for the merged mapping.
+func (m *Mapping) Lint() []LintFinding {
+	out := []LintFinding{}
+	for _, f := range mapcheck.Check(m.m) {
+		out = append(out, LintFinding{Check: f.Check, ResourceType: f.ResourceType, Message: f.Message})
+	}
+	return out
+}
@@ -0,0 +1,107 @@
+package aztft
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/policy"
+	"github.com/magodo/aztft/internal/client"
+)
+
+// ResourceBodyProvider provides the ARM resource JSON body (i.e. the response of the GET request) by its resource ID.
+// It is used by the APIOption to resolve the resources offline, without calling the Azure API.
+type ResourceBodyProvider interface {
+	// ResourceBody returns the ARM resource JSON body of the resource ID. The bool is false if the resource is not provided.
+	ResourceBody(id string) ([]byte, bool)
+}
+
+// resourceBodies is a ResourceBodyProvider, which is keyed by the upper cased resource ID.
+type resourceBodies map[string][]byte
+
+func (m resourceBodies) ResourceBody(id string) ([]byte, bool) {
+	b, ok := m[strings.ToUpper(strings.TrimSuffix(id, "/"))]
+	return b, ok
+}
+
+// NewResourceBodyProvider returns a ResourceBodyProvider from the JSON, which is either an ARM resource object (e.g. the output of "az resource show --ids <id>"),
+// or an array of ARM resource objects (e.g. the output of "az resource show --ids <id1> <id2>").
+func NewResourceBodyProvider(b []byte) (ResourceBodyProvider, error) {
+	m := resourceBodies{}
+	if err := m.add(b); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// NewResourceBodyProviderFromDir returns a ResourceBodyProvider from all the ".json" files under the directory (recursively).
+// Each file has the same format as is accepted by NewResourceBodyProvider.
+func NewResourceBodyProviderFromDir(dir string) (ResourceBodyProvider, error) {
+	m := resourceBodies{}
+	err := filepath.WalkDir(dir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() || !strings.EqualFold(filepath.Ext(path), ".json") {
+			return nil
+		}
+		b, err := os.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		if err := m.add(b); err != nil {
+			return fmt.Errorf("loading %s: %w", path, err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (m resourceBodies) add(b []byte) error {
+	b = bytes.TrimSpace(b)
+	if len(b) != 0 && b[0] == '[' {
+		var l []json.RawMessage
+		if err := json.Unmarshal(b, &l); err != nil {
+			return err
+		}
+		for i, body := range l {
+			if err := m.add(body); err != nil {
+				return fmt.Errorf("element %d: %w", i, err)
+			}
+		}
+		return nil
+	}
+
+	var res struct {
+		Id string `json:"id"`
+	}
+	if err := json.Unmarshal(b, &res); err != nil {
+		return err
+	}
+	if res.Id == "" {
+		return fmt.Errorf(`no "id" found in the resource body`)
+	}
+	m[strings.ToUpper(strings.TrimSuffix(res.Id, "/"))] = b
+	return nil
+}
+
+// normalize returns the API option that is actually used to build the Azure clients.
+// If the BodyProvider is specified, the Azure API calls are served by it offline, where the credential is not needed.
+func (opt *APIOption) normalize() *APIOption {
+	if opt == nil || opt.BodyProvider == nil {
+		return opt
+	}
+	nopt := *opt
+	nopt.Cred = client.OfflineCredential{}
+	nopt.ClientOption.Transport = client.NewOfflineTransport(opt.BodyProvider.ResourceBody)
+	nopt.ClientOption.Retry = policy.RetryOptions{MaxRetries: -1}
+	nopt.BodyProvider = nil
+	return &nopt
+}
@@ -0,0 +1,48 @@
+package aztft
+
+import (
+	"context"
+
+	"github.com/magodo/armid"
+	"github.com/magodo/aztft/internal/errs"
+	"github.com/magodo/aztft/internal/resolve"
+)
+
+// PrefetchResourceGraph retrieves the ambiguous resources among the ids in bulk via Azure Resource Graph queries, and returns a copy of the API option
+// that resolves these resources from the query results, instead of sending one GET request per resource.
+// The resources that are not indexed by the Azure Resource Graph (e.g. most of the child resources), or whose query results can't be resolved,
+// are still resolved by their own GET requests.
+// The offline API option (i.e. with the BodyProvider) is returned as is, since its resources are resolved without calling the Azure API.
+func PrefetchResourceGraph(ctx context.Context, ids []string, apiOpt *APIOption) (*APIOption, error) {
+	if apiOpt == nil {
+		return nil, errs.Mark(ErrNeedsAPI, "prefetching from Azure Resource Graph requires the API option")
+	}
+	if apiOpt.BodyProvider != nil {
+		return apiOpt, nil
+	}
+	var rids []armid.ResourceId
+	for _, idStr := range ids {
+		id, err := armid.ParseResourceId(idStr)
+		if err != nil {
+			// The invalid resource ids are reported when they are queried.
+			continue
+		}
+		rids = append(rids, id)
+	}
+	prefetched, err := resolve.Prefetch(ctx, rids, apiOpt.Cred, apiOpt.ClientOption)
+	if err != nil {
+		return nil, apiError(err)
+	}
+	nopt := *apiOpt
+	nopt.prefetched = prefetched
+	return &nopt, nil
+}
+
+// isPrefetched tells whether the resource is retrieved by PrefetchResourceGraph, which is then resolved from the Azure Resource Graph.
+func (opt *APIOption) isPrefetched(idStr string) bool {
+	id, err := armid.ParseResourceId(idStr)
+	if err != nil {
+		return false
+	}
+	return opt.prefetched.Has(id)
+}
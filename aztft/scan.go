@@ -0,0 +1,131 @@
+package aztft
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/magodo/armid"
+	"github.com/magodo/aztft/internal/client"
+	"github.com/magodo/aztft/internal/errs"
+)
+
+const (
+	resourcesAPIVersion        = "2021-04-01"
+	managementGroupsAPIVersion = "2020-05-01"
+)
+
+// ListResources lists the ARM resource IDs under the scope, which is either a resource group, a subscription or a management group:
+//
+//   - For a resource group, the resource group itself and the resources in it are listed.
+//   - For a subscription, the resource groups and the resources in them are listed.
+//   - For a management group, the management group itself and its descendant management groups, together with the resources of the descendant subscriptions are listed.
+//
+// The subscriptions themselves are not listed, as the azurerm_subscription is imported by the subscription alias ID, which can't be derived from the subscription ID.
+//
+// Note that the ARM resources API only lists the top level resources, while the property-like resources can be populated when querying each of them with the API option.
+func ListResources(ctx context.Context, scope string, apiOpt *APIOption) ([]string, error) {
+	ids, err := listResources(ctx, scope, apiOpt)
+	return ids, apiError(err)
+}
+
+func listResources(ctx context.Context, scope string, apiOpt *APIOption) ([]string, error) {
+	if apiOpt == nil {
+		return nil, errs.Mark(ErrNeedsAPI, "listing resources requires the API option")
+	}
+	apiOpt = apiOpt.normalize()
+	id, err := armid.ParseResourceId(scope)
+	if err != nil {
+		return nil, errs.Mark(ErrInvalidId, "parsing scope: %w", err)
+	}
+	b := &client.ClientBuilder{Cred: apiOpt.Cred, ClientOpt: apiOpt.ClientOption}
+	c, err := b.NewRawClient()
+	if err != nil {
+		return nil, err
+	}
+
+	switch id := id.(type) {
+	case *armid.ResourceGroup:
+		ids, err := listIds(ctx, c, id.String()+"/resources", resourcesAPIVersion)
+		if err != nil {
+			return nil, err
+		}
+		return append([]string{id.String()}, ids...), nil
+	case *armid.SubscriptionId:
+		return listSubscription(ctx, c, id.String())
+	case *armid.ManagementGroup:
+		values, err := c.List(ctx, id.String()+"/descendants", managementGroupsAPIVersion)
+		if err != nil {
+			return nil, fmt.Errorf("listing descendants of %s: %w", id, err)
+		}
+		ids := []string{id.String()}
+		for _, v := range values {
+			desc, ok := v.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			typ, _ := desc["type"].(string)
+			switch typ {
+			case "Microsoft.Management/managementGroups":
+				if descId, ok := desc["id"].(string); ok {
+					ids = append(ids, descId)
+				}
+			case "Microsoft.Management/managementGroups/subscriptions":
+				name, ok := desc["name"].(string)
+				if !ok {
+					continue
+				}
+				subIds, err := listSubscription(ctx, c, "/subscriptions/"+name)
+				if err != nil {
+					return nil, err
+				}
+				ids = append(ids, subIds...)
+			}
+		}
+		return ids, nil
+	default:
+		return nil, errs.Mark(ErrInvalidId, "scope %q is not a resource group, subscription or management group", scope)
+	}
+}
+
+// Scan lists the ARM resource IDs under the scope (see ListResources), and queries each of them as QueryBatch does.
+// The "opt.APIOption" is required, which is used both to list the resources and to query them.
+func Scan(ctx context.Context, scope string, opt *BatchOption) ([]BatchResult, error) {
+	if opt == nil || opt.APIOption == nil {
+		return nil, errs.Mark(ErrNeedsAPI, "scanning requires the API option")
+	}
+	ids, err := ListResources(ctx, scope, opt.APIOption)
+	if err != nil {
+		return nil, err
+	}
+	return QueryBatch(ctx, ids, opt), nil
+}
+
+func listSubscription(ctx context.Context, c *client.RawClient, subId string) ([]string, error) {
+	rgIds, err := listIds(ctx, c, subId+"/resourceGroups", resourcesAPIVersion)
+	if err != nil {
+		return nil, err
+	}
+	resIds, err := listIds(ctx, c, subId+"/resources", resourcesAPIVersion)
+	if err != nil {
+		return nil, err
+	}
+	return append(rgIds, resIds...), nil
+}
+
+func listIds(ctx context.Context, c *client.RawClient, collectionPath, apiVersion string) ([]string, error) {
+	values, err := c.List(ctx, collectionPath, apiVersion)
+	if err != nil {
+		return nil, fmt.Errorf("listing %s: %w", collectionPath, err)
+	}
+	var ids []string
+	for _, v := range values {
+		res, ok := v.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		if id, ok := res["id"].(string); ok {
+			ids = append(ids, id)
+		}
+	}
+	return ids, nil
+}
@@ -0,0 +1,129 @@
+package aztft
+
+import (
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/magodo/aztft/internal/resmap"
+)
+
+// ProviderSnapshot records the TF resource types that exist in a range of the azurerm provider versions, which is generated by "tool/aztft-import".
+// The embedded mapping only corresponds to the latest provider versions (i.e. LatestProviderVersion), the snapshots of other versions can be
+// loaded by LoadProviderSnapshots and specified via the WithProviderVersion option.
+//
+// Note that a snapshot only records the TF resource type names, the mapping of each type (i.e. its ARM resource types, scopes and import specs)
+// is still the one of the latest provider versions. A type whose mapping has changed since the snapshot (e.g. split into multiple types, or
+// having a different import spec) is therefore queried as it is in the latest provider versions. Use a mapping overlay (see WithMapping)
+// for such types if needed.
+type ProviderSnapshot struct {
+	// ProviderVersion is a comma separated list of version constraints, e.g. ">= 3.0.0, < 4.0.0".
+	ProviderVersion string `json:"provider_version"`
+
+	ResourceTypes []string `json:"resource_types"`
+}
+
+// LatestProviderVersion is the azurerm provider version range that the embedded mapping corresponds to.
+const LatestProviderVersion = resmap.LatestProviderVersion
+
+// LoadProviderSnapshots loads the provider snapshot from the JSON file, or all the ".json" files under the directory (recursively).
+func LoadProviderSnapshots(path string) ([]ProviderSnapshot, error) {
+	fi, err := os.Stat(path)
+	if err != nil {
+		return nil, err
+	}
+	if !fi.IsDir() {
+		s, err := loadProviderSnapshot(path)
+		if err != nil {
+			return nil, err
+		}
+		return []ProviderSnapshot{*s}, nil
+	}
+
+	var snapshots []ProviderSnapshot
+	err = filepath.WalkDir(path, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() || !strings.EqualFold(filepath.Ext(path), ".json") {
+			return nil
+		}
+		s, err := loadProviderSnapshot(path)
+		if err != nil {
+			return err
+		}
+		snapshots = append(snapshots, *s)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return snapshots, nil
+}
+
+func loadProviderSnapshot(path string) (*ProviderSnapshot, error) {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	s, err := resmap.ParseSnapshot(b)
+	if err != nil {
+		return nil, fmt.Errorf("loading provider snapshot %s: %w", path, err)
+	}
+	return &ProviderSnapshot{ProviderVersion: s.ProviderVersion, ResourceTypes: s.ResourceTypes}, nil
+}
+
+// WithProviderVersion makes the query only return the TF resource types that exist in the azurerm provider version.
+// The snapshot matching the version is searched from the specified snapshots in order, and then the mapping in use (see WithMapping).
+// It is an error if no snapshot matches the version. See ProviderSnapshot for the limitation of the snapshots.
+// The snapshots are parsed once when the option is built, so it is cheap to reuse the option for multiple queries.
+func WithProviderVersion(version string, snapshots ...ProviderSnapshot) QueryOption {
+	snapshot, err := matchProviderSnapshot(version, snapshots)
+	return func(opts *queryOptions) {
+		opts.providerVersion = version
+		opts.providerSnapshot = snapshot
+		opts.providerSnapshotErr = err
+	}
+}
+
+// matchProviderSnapshot returns the first one of the snapshots that matches the provider version, or nil if none matches.
+func matchProviderSnapshot(version string, snapshots []ProviderSnapshot) (*resmap.Snapshot, error) {
+	for _, ps := range snapshots {
+		s := &resmap.Snapshot{ProviderVersion: ps.ProviderVersion, ResourceTypes: ps.ResourceTypes}
+		if err := s.Init(); err != nil {
+			return nil, err
+		}
+		ok, err := s.Match(version)
+		if err != nil {
+			return nil, fmt.Errorf("invalid provider version: %w", err)
+		}
+		if ok {
+			return s, nil
+		}
+	}
+	return nil, nil
+}
+
+// snapshot returns the provider snapshot that matches the provider version option, or nil if the option is not specified.
+func (opts queryOptions) snapshot(m *resmap.Mapping) (*resmap.Snapshot, error) {
+	if opts.providerVersion == "" {
+		return nil, nil
+	}
+	if opts.providerSnapshotErr != nil {
+		return nil, opts.providerSnapshotErr
+	}
+	if opts.providerSnapshot != nil {
+		return opts.providerSnapshot, nil
+	}
+	s := m.LatestSnapshot()
+	ok, err := s.Match(opts.providerVersion)
+	if err != nil {
+		return nil, fmt.Errorf("invalid provider version: %w", err)
+	}
+	if !ok {
+		return nil, fmt.Errorf("no provider snapshot found for the provider version %s", opts.providerVersion)
+	}
+	return s, nil
+}
@@ -0,0 +1,142 @@
+package aztft
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/magodo/armid"
+	"github.com/magodo/aztft/internal/resmap"
+	"github.com/magodo/aztft/internal/resolve"
+	"github.com/magodo/aztft/internal/tfid"
+)
+
+// TraceStage is the stage of the query that a TraceEvent is reported from.
+type TraceStage string
+
+const (
+	// TraceLookup reports the routing key and the parent scope key looked up in the mapping, and whether the "any" scope is fallen back to.
+	TraceLookup TraceStage = "lookup"
+
+	// TraceCandidates reports the TF resource types found by the lookup.
+	TraceCandidates TraceStage = "candidates"
+
+	// TraceResolve reports the resolver that tells the TF resource type among the candidates via the Azure API, and the evidence it has checked.
+	TraceResolve TraceStage = "resolve"
+
+	// TraceImportSpec reports the import spec chosen for the TF resource type, and why it is chosen.
+	TraceImportSpec TraceStage = "import-spec"
+
+	// TraceBuild reports each transformation applied to the Azure resource ID to build the TF resource ID.
+	TraceBuild TraceStage = "build"
+
+	// TracePrefetch reports the failure of prefetching the resources of a batch from the Azure Resource Graph (see BatchOption.ResourceGraph).
+	TracePrefetch TraceStage = "prefetch"
+)
+
+// TraceEvent is one step of how a query result is derived.
+type TraceEvent struct {
+	Stage TraceStage
+
+	// AzureId is the queried Azure resource ID, or the populated property-like resource ID. It is nil for the TracePrefetch stage, which is about the whole batch.
+	AzureId armid.ResourceId
+
+	// TFType is the TF resource type being built, which is only set for the TraceImportSpec and TraceBuild stages.
+	TFType string
+
+	Message string
+}
+
+// WithTrace makes the query report how its result is derived to the hook, step by step, e.g. to debug an unexpected mapping.
+// The hook is called synchronously, in the order of the steps.
+func WithTrace(hook func(TraceEvent)) QueryOption {
+	return func(opts *queryOptions) {
+		opts.trace = hook
+	}
+}
+
+func (opts queryOptions) tracef(stage TraceStage, id armid.ResourceId, rt string, format string, a ...interface{}) {
+	if opts.trace == nil {
+		return
+	}
+	opts.trace(TraceEvent{
+		Stage:   stage,
+		AzureId: id,
+		TFType:  rt,
+		Message: fmt.Sprintf(format, a...),
+	})
+}
+
+// traceLookup reports the lookups of the id in the mapping (see lookupARMId2TFMap), and the candidates found.
+func (opts queryOptions) traceLookup(id armid.ResourceId, lookups []armId2TFMapLookup, snapshot *resmap.Snapshot, l []resmap.ARMId2TFMapItem) {
+	if opts.trace == nil {
+		return
+	}
+	for _, lookup := range lookups {
+		switch {
+		case !lookup.routeFound:
+			opts.tracef(TraceLookup, id, "", "%s: routing key %q is not found", lookup.table, lookup.routeKey)
+		case lookup.anyScope:
+			opts.tracef(TraceLookup, id, "", "%s: routing key %q, parent scope key %q is not found, fell back to the %q scope", lookup.table, lookup.routeKey, lookup.parentScopeKey, resmap.ScopeAny)
+		case lookup.scopeFound:
+			opts.tracef(TraceLookup, id, "", "%s: routing key %q, parent scope key %q is found", lookup.table, lookup.routeKey, lookup.parentScopeKey)
+		default:
+			opts.tracef(TraceLookup, id, "", "%s: routing key %q, parent scope key %q is not found, neither the %q scope", lookup.table, lookup.routeKey, lookup.parentScopeKey, resmap.ScopeAny)
+		}
+	}
+
+	var rts []string
+	for _, item := range l {
+		rts = append(rts, item.ResourceType)
+	}
+	if len(rts) == 0 {
+		rts = []string{"<none>"}
+	}
+	if snapshot != nil {
+		opts.tracef(TraceCandidates, id, "", "%s (in the provider snapshot)", strings.Join(rts, ", "))
+		return
+	}
+	opts.tracef(TraceCandidates, id, "", "%s", strings.Join(rts, ", "))
+}
+
+// tfidTracer returns the tracer that reports the import spec and the transformations of building the TF resource ID of the id, if tracing.
+func (opts queryOptions) tfidTracer(id armid.ResourceId) *tfid.Tracer {
+	if opts.trace == nil {
+		return nil
+	}
+	return &tfid.Tracer{
+		ImportSpec: func(rt, importSpec, reason string) {
+			opts.tracef(TraceImportSpec, id, rt, "%q (%s)", importSpec, reason)
+		},
+		Transform: func(rt, format string, a ...interface{}) {
+			opts.tracef(TraceBuild, id, rt, format, a...)
+		},
+	}
+}
+
+// traceResolve reports the resolver of the id, the candidates it has explained, and its result.
+func (opts queryOptions) traceResolve(id armid.ResourceId, candidates []resolve.Candidate, rt string, err error) {
+	if opts.trace == nil {
+		return
+	}
+	name, ok := resolve.ResolverName(id)
+	if !ok {
+		name = "<none>"
+	}
+	opts.tracef(TraceResolve, id, "", "resolver: %s", name)
+	for _, c := range candidates {
+		verdict := "ruled out"
+		if c.Matched {
+			verdict = "matched"
+		}
+		if len(c.Evidence) == 0 {
+			opts.tracef(TraceResolve, id, "", "%s is %s: %s", c.ResourceType, verdict, c.Reason)
+			continue
+		}
+		opts.tracef(TraceResolve, id, "", "%s is %s: %s (evidence: %s)", c.ResourceType, verdict, c.Reason, strings.Join(c.Evidence, ", "))
+	}
+	if err != nil {
+		opts.tracef(TraceResolve, id, "", "failed: %v", err)
+		return
+	}
+	opts.tracef(TraceResolve, id, "", "resolved to %s", rt)
+}
@@ -0,0 +1,111 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/magodo/aztft/aztft"
+)
+
+const (
+	catalogFormatJSON     = "json"
+	catalogFormatMarkdown = "markdown"
+)
+
+var catalogFormats = []string{catalogFormatJSON, catalogFormatMarkdown}
+
+const (
+	catalogKindAll           = "all"
+	catalogKindPropertyLike  = "property-like"
+	catalogKindDataPlaneOnly = "data-plane-only"
+)
+
+var catalogKinds = []string{catalogKindAll, catalogKindPropertyLike, catalogKindDataPlaneOnly}
+
+func filterCatalog(entries []aztft.CatalogEntry, kind string) []aztft.CatalogEntry {
+	out := []aztft.CatalogEntry{}
+	for _, entry := range entries {
+		switch kind {
+		case catalogKindPropertyLike:
+			if !entry.PropertyLike || entry.Removed {
+				continue
+			}
+		case catalogKindDataPlaneOnly:
+			if !entry.DataPlaneOnly || entry.Removed {
+				continue
+			}
+		}
+		out = append(out, entry)
+	}
+	return out
+}
+
+func formatCatalog(entries []aztft.CatalogEntry, kind, format string) (string, error) {
+	switch format {
+	case catalogFormatJSON:
+		b, err := json.MarshalIndent(entries, "", "  ")
+		if err != nil {
+			return "", err
+		}
+		return string(b), nil
+	case catalogFormatMarkdown:
+		if kind == catalogKindAll {
+			return catalogMarkdownTable(entries), nil
+		}
+		return catalogPesudoIdMarkdownTable(entries), nil
+	default:
+		return "", fmt.Errorf("unknown catalog format %q, must be one of %q", format, catalogFormats)
+	}
+}
+
+func catalogMarkdownTable(entries []aztft.CatalogEntry) string {
+	lines := []string{
+		"|Resource Type|Resource Type (ARM)|Parent Scopes|Removed|Resolve Needs API|ID Needs API|Property-like|Data Plane Only|",
+		"|-|-|-|-|-|-|-|-|",
+	}
+	for _, entry := range entries {
+		var armType string
+		if entry.Provider != "" {
+			armType = fmt.Sprintf("`%s`", strings.Join(append([]string{entry.Provider}, entry.Types...), "/"))
+		}
+		var scopes []string
+		for _, scope := range entry.ParentScopes {
+			scopes = append(scopes, fmt.Sprintf("`%s`", scope))
+		}
+		removed := markdownBool(entry.Removed)
+		if entry.RemoveReason != "" {
+			removed += ": " + entry.RemoveReason
+		}
+		lines = append(lines, fmt.Sprintf("|`%s`|%s|%s|%s|%s|%s|%s|%s|",
+			entry.TFType,
+			armType,
+			strings.Join(scopes, "<br>"),
+			removed,
+			markdownBool(entry.ResolveNeedsAPI),
+			markdownBool(entry.IdNeedsAPI),
+			markdownBool(entry.PropertyLike),
+			markdownBool(entry.DataPlaneOnly),
+		))
+	}
+	return strings.Join(lines, "\n")
+}
+
+// catalogPesudoIdMarkdownTable formats the entries in the same layout as the pesudo resource id tables in the README.
+func catalogPesudoIdMarkdownTable(entries []aztft.CatalogEntry) string {
+	lines := []string{
+		"|Resource Type|Pesudo Resource ID|Comment|",
+		"|-|-|-|",
+	}
+	for _, entry := range entries {
+		lines = append(lines, fmt.Sprintf("|`%s`|`%s`|%s|", entry.TFType, entry.PesudoId, entry.PesudoIdNote))
+	}
+	return strings.Join(lines, "\n")
+}
+
+func markdownBool(b bool) string {
+	if b {
+		return "Yes"
+	}
+	return "No"
+}
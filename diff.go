@@ -0,0 +1,100 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/magodo/aztft/aztft"
+)
+
+const (
+	diffFormatText = "text"
+	diffFormatJSON = "json"
+)
+
+var diffFormats = []string{diffFormatText, diffFormatJSON}
+
+func loadMappingFile(path string) (*aztft.Mapping, error) {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	m, err := aztft.LoadMapping(b)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %v", path, err)
+	}
+	return m, nil
+}
+
+// diffMappings compares the old and the new mapping files, and prints the difference in the format.
+func diffMappings(oldPath, newPath, format string) error {
+	oldMapping, err := loadMappingFile(oldPath)
+	if err != nil {
+		return err
+	}
+	newMapping, err := loadMappingFile(newPath)
+	if err != nil {
+		return err
+	}
+	diff := oldMapping.Diff(newMapping)
+	switch format {
+	case diffFormatJSON:
+		b, err := json.MarshalIndent(diff, "", "  ")
+		if err != nil {
+			return err
+		}
+		fmt.Println(string(b))
+	case diffFormatText:
+		if s := formatMappingDiff(diff); s != "" {
+			fmt.Println(s)
+		}
+	default:
+		return fmt.Errorf("unknown format %q, must be one of %q", format, diffFormats)
+	}
+	return nil
+}
+
+// formatMappingDiff formats the mapping difference in a human readable form, where each section is omitted if it is empty.
+func formatMappingDiff(diff aztft.MappingDiff) string {
+	var sections []string
+	if len(diff.Added) != 0 {
+		lines := []string{"Added:"}
+		for _, rt := range diff.Added {
+			lines = append(lines, "  + "+rt)
+		}
+		sections = append(sections, strings.Join(lines, "\n"))
+	}
+	if len(diff.Removed) != 0 {
+		lines := []string{"Removed:"}
+		for _, rt := range diff.Removed {
+			lines = append(lines, "  - "+rt)
+		}
+		sections = append(sections, strings.Join(lines, "\n"))
+	}
+	if len(diff.Changed) != 0 {
+		lines := []string{"Changed:"}
+		var last string
+		for _, c := range diff.Changed {
+			if c.TFType != last {
+				lines = append(lines, "  ~ "+c.TFType)
+				last = c.TFType
+			}
+			lines = append(lines, fmt.Sprintf("      %s: %s -> %s", c.Field, c.Old, c.New))
+		}
+		sections = append(sections, strings.Join(lines, "\n"))
+	}
+	if len(diff.NewAmbiguities) != 0 {
+		lines := []string{"New ambiguities:"}
+		for _, a := range diff.NewAmbiguities {
+			status := "needs resolver"
+			if a.Resolved {
+				status = "resolved"
+			}
+			lines = append(lines, fmt.Sprintf("  ! %s in scope of %s (%s): %s", a.RoutingKey, a.Scope, status, strings.Join(a.ResourceTypes, ", ")))
+		}
+		sections = append(sections, strings.Join(lines, "\n"))
+	}
+	return strings.Join(sections, "\n\n")
+}
@@ -0,0 +1,49 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/magodo/aztft/aztft"
+)
+
+// explain queries the Azure resource id with tracing, and prints each trace event followed by the results.
+func explain(ctx context.Context, w io.Writer, id string, opt *aztft.APIOption, qopts []aztft.QueryOption) error {
+	qopts = append(qopts, aztft.WithTrace(func(ev aztft.TraceEvent) {
+		fmt.Fprintln(w, traceLine(ev))
+	}))
+	types, ids, exact, err := aztft.QueryTypeAndIdWithContext(ctx, id, opt, qopts...)
+	var amb *aztft.Ambiguity
+	if errors.As(err, &amb) {
+		for _, line := range ambiguityLines(amb) {
+			fmt.Fprintf(w, "[result] %s\n", line)
+		}
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if len(types) == 0 {
+		fmt.Fprintln(w, "[result] No match")
+		return nil
+	}
+	for i, t := range types {
+		line := fmt.Sprintf("[result] %s: %s", typeString(t), ids[i])
+		if !exact {
+			line += " (not exact)"
+		}
+		fmt.Fprintln(w, line)
+	}
+	return nil
+}
+
+// traceLine returns the text line of the trace event, which is prefixed by its stage and subject, i.e. the TF resource type being built, or the Azure resource ID.
+func traceLine(ev aztft.TraceEvent) string {
+	subject := ev.TFType
+	if subject == "" {
+		subject = ev.AzureId.String()
+	}
+	return fmt.Sprintf("[%s] %s: %s", ev.Stage, subject, ev.Message)
+}
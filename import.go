@@ -0,0 +1,138 @@
+package main
+
+import (
+	"encoding/base64"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclwrite"
+	"github.com/magodo/armid"
+	"github.com/magodo/aztft/aztft"
+	"github.com/zclconf/go-cty/cty"
+)
+
+const (
+	importFormatCmd         = "cmd"
+	importFormatImportBlock = "import-block"
+	importFormatJSON        = "json"
+)
+
+var importFormats = []string{importFormatCmd, importFormatImportBlock, importFormatJSON}
+
+type importItem struct {
+	AzureId string `json:"azure_id"`
+	Type    string `json:"type"`
+	Name    string `json:"name"`
+	Id      string `json:"id"`
+
+	// AzapiType is the "type" of the azapi_resource, only set when the Type is "azapi_resource".
+	AzapiType string `json:"azapi_type,omitempty"`
+}
+
+// importItemNamer generates unique Terraform resource names (per resource type) for the import items.
+type importItemNamer struct {
+	used map[string]map[string]bool
+}
+
+func newImportItemNamer() *importItemNamer {
+	return &importItemNamer{used: map[string]map[string]bool{}}
+}
+
+func (n *importItemNamer) Name(rt string, id armid.ResourceId) string {
+	used, ok := n.used[rt]
+	if !ok {
+		used = map[string]bool{}
+		n.used[rt] = used
+	}
+	base := sanitizeIdentifier(resourceName(id))
+	name := base
+	for i := 2; used[name]; i++ {
+		name = fmt.Sprintf("%s_%d", base, i)
+	}
+	used[name] = true
+	return name
+}
+
+// resourceName returns a human readable name of the Azure resource id.
+// For the pesudo resource id of the property-like resources, it combines the names of both the main resource and the referenced resource.
+func resourceName(id armid.ResourceId) string {
+	names := id.Names()
+	if len(names) == 0 {
+		switch id := id.(type) {
+		case *armid.SubscriptionId:
+			return id.Id
+		case *armid.ResourceGroup:
+			return id.Name
+		case *armid.ManagementGroup:
+			return id.Name
+		}
+		return ""
+	}
+	name := names[len(names)-1]
+	if b, err := base64.StdEncoding.DecodeString(name); err == nil {
+		if refId, err := armid.ParseResourceId(string(b)); err == nil {
+			return resourceName(id.Parent()) + "_" + resourceName(refId)
+		}
+	}
+	return name
+}
+
+var invalidIdentifierChars = regexp.MustCompile(`[^a-zA-Z0-9_-]+`)
+
+// sanitizeIdentifier turns the input into a valid HCL identifier, which starts with a letter or underscore, followed by letters, digits, underscores or dashes.
+func sanitizeIdentifier(s string) string {
+	s = invalidIdentifierChars.ReplaceAllString(strings.ToLower(s), "_")
+	if s == "" {
+		return "res"
+	}
+	if c := s[0]; !(c >= 'a' && c <= 'z') && c != '_' {
+		s = "res_" + s
+	}
+	return s
+}
+
+func buildImportItems(types []aztft.Type, ids []string, namer *importItemNamer) []importItem {
+	var items []importItem
+	for i, t := range types {
+		items = append(items, importItem{
+			AzureId:   t.AzureId.String(),
+			Type:      t.TFType,
+			Name:      namer.Name(t.TFType, t.AzureId),
+			Id:        ids[i],
+			AzapiType: t.AzapiType,
+		})
+	}
+	return items
+}
+
+// formatImportItems formats the import items in the line based formats, i.e. the command or the import block.
+func formatImportItems(items []importItem, format string) (string, error) {
+	switch format {
+	case importFormatCmd:
+		var lines []string
+		for _, item := range items {
+			lines = append(lines, fmt.Sprintf("terraform import %s.%s %s", item.Type, item.Name, item.Id))
+		}
+		return strings.Join(lines, "\n"), nil
+	case importFormatImportBlock:
+		f := hclwrite.NewEmptyFile()
+		body := f.Body()
+		for i, item := range items {
+			if i != 0 {
+				body.AppendNewline()
+			}
+			blkBody := body.AppendNewBlock("import", nil).Body()
+			blkBody.SetAttributeTraversal("to", hcl.Traversal{
+				hcl.TraverseRoot{Name: item.Type},
+				hcl.TraverseAttr{Name: item.Name},
+			})
+			blkBody.SetAttributeValue("id", cty.StringVal(item.Id))
+		}
+		return strings.TrimSuffix(string(f.Bytes()), "\n"), nil
+	default:
+		// The JSON format is streamed by the jsonArrayWriter.
+		return "", fmt.Errorf("unsupported import format %q", format)
+	}
+}
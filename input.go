@@ -0,0 +1,90 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+)
+
+// readIds reads the Azure resource ids from the input file, or from the stdin if the path is "-".
+// The input is either newline-delimited resource ids, or the JSON output of "az resource list" (or "az resource show").
+func readIds(path string) ([]string, error) {
+	var (
+		b   []byte
+		err error
+	)
+	if path == "-" {
+		b, err = io.ReadAll(os.Stdin)
+	} else {
+		b, err = os.ReadFile(path)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("reading input %q: %v", path, err)
+	}
+	ids, err := parseIds(b)
+	if err != nil {
+		return nil, fmt.Errorf("parsing input %q: %v", path, err)
+	}
+	return ids, nil
+}
+
+func parseIds(b []byte) ([]string, error) {
+	b = bytes.TrimSpace(b)
+	if len(b) == 0 {
+		return nil, nil
+	}
+
+	switch b[0] {
+	case '[':
+		var l []json.RawMessage
+		if err := json.Unmarshal(b, &l); err != nil {
+			return nil, err
+		}
+		var ids []string
+		for i, raw := range l {
+			id, err := parseJSONId(raw)
+			if err != nil {
+				return nil, fmt.Errorf("element %d: %v", i, err)
+			}
+			ids = append(ids, id)
+		}
+		return ids, nil
+	case '{':
+		id, err := parseJSONId(b)
+		if err != nil {
+			return nil, err
+		}
+		return []string{id}, nil
+	}
+
+	var ids []string
+	for _, line := range strings.Split(string(b), "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		ids = append(ids, line)
+	}
+	return ids, nil
+}
+
+// parseJSONId parses the resource id from either a JSON string, or a JSON object that has an "id" field (e.g. the output of "az resource show").
+func parseJSONId(b []byte) (string, error) {
+	var id string
+	if err := json.Unmarshal(b, &id); err == nil {
+		return id, nil
+	}
+	var res struct {
+		Id string `json:"id"`
+	}
+	if err := json.Unmarshal(b, &res); err != nil {
+		return "", err
+	}
+	if res.Id == "" {
+		return "", fmt.Errorf(`no "id" found`)
+	}
+	return res.Id, nil
+}
@@ -3,53 +3,35 @@ package client
 import (
 	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
 	"github.com/Azure/azure-sdk-for-go/sdk/azcore/arm"
-	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/alertsmanagement/armalertsmanagement"
 	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/apimanagement/armapimanagement/v3"
 	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/appcontainers/armappcontainers/v3"
-	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/applicationinsights/armapplicationinsights"
 	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/appplatform/armappplatform/v2"
 	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/appservice/armappservice/v4"
 	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/automation/armautomation"
-	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/botservice/armbotservice"
-	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/cdn/armcdn/v2"
-	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/cognitiveservices/armcognitiveservices"
 	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/compute/armcompute/v5"
-	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/containerservice/armcontainerservice/v9"
-	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/costmanagement/armcostmanagement/v2"
 	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/datafactory/armdatafactory/v7"
 	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/dataprotection/armdataprotection/v3"
-	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/datashare/armdatashare"
 	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/desktopvirtualization/armdesktopvirtualization/v2"
 	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/devtestlabs/armdevtestlabs"
-	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/digitaltwins/armdigitaltwins"
 	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/domainservices/armdomainservices"
-	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/frontdoor/armfrontdoor"
 	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/hdinsight/armhdinsight"
 	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/hybridkubernetes/armhybridkubernetes"
 	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/iothub/armiothub"
 	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/keyvault/armkeyvault"
-	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/kusto/armkusto/v2"
 	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/logic/armlogic"
 	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/machinelearning/armmachinelearning/v4"
 	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/netapp/armnetapp/v10"
 	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/network/armnetwork/v6"
 	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/operationalinsights/armoperationalinsights/v3"
-	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/oracledatabase/armoracledatabase"
-	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/paloaltonetworksngfw/armpanngfw"
 	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/recoveryservices/armrecoveryservicesbackup/v4"
 	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/recoveryservices/armrecoveryservicessiterecovery/v2"
 	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/redisenterprise/armredisenterprise"
-	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/resources/armdeploymentscripts/v2"
 	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/securityinsights/armsecurityinsights/v2"
 	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/sql/armsql"
 	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/storage/armstorage"
-	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/storagecache/armstoragecache/v4"
-	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/storagemover/armstoragemover/v2"
 	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/storagepool/armstoragepool"
 	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/streamanalytics/armstreamanalytics"
-	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/synapse/armsynapse"
 	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/timeseriesinsights/armtimeseriesinsights"
-	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/webpubsub/armwebpubsub"
 	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/workloads/armworkloads"
 )
 
@@ -66,14 +48,6 @@ func (b *ClientBuilder) NewVirtualMachinesClient(subscriptionId string) (*armcom
 	)
 }
 
-func (b *ClientBuilder) NewVirtualMachineScaleSetsClient(subscriptionId string) (*armcompute.VirtualMachineScaleSetsClient, error) {
-	return armcompute.NewVirtualMachineScaleSetsClient(
-		subscriptionId,
-		b.Cred,
-		&b.ClientOpt,
-	)
-}
-
 func (b *ClientBuilder) NewDevTestVirtualMachinesClient(subscriptionId string) (*armdevtestlabs.VirtualMachinesClient, error) {
 	return armdevtestlabs.NewVirtualMachinesClient(
 		subscriptionId,
@@ -114,22 +88,6 @@ func (b *ClientBuilder) NewDataProtectionBackupInstancesClient(subscriptionId st
 	)
 }
 
-func (b *ClientBuilder) NewSynapseIntegrationRuntimesClient(subscriptionId string) (*armsynapse.IntegrationRuntimesClient, error) {
-	return armsynapse.NewIntegrationRuntimesClient(
-		subscriptionId,
-		b.Cred,
-		&b.ClientOpt,
-	)
-}
-
-func (b *ClientBuilder) NewDigitalTwinsEndpointsClient(subscriptionId string) (*armdigitaltwins.EndpointClient, error) {
-	return armdigitaltwins.NewEndpointClient(
-		subscriptionId,
-		b.Cred,
-		&b.ClientOpt,
-	)
-}
-
 func (b *ClientBuilder) NewDataFactoryTriggersClient(subscriptionId string) (*armdatafactory.TriggersClient, error) {
 	return armdatafactory.NewTriggersClient(
 		subscriptionId,
@@ -178,14 +136,6 @@ func (b *ClientBuilder) NewDataFactoryCredentialsClient(subscriptionId string) (
 	)
 }
 
-func (b *ClientBuilder) NewKustoDataConnectionsClient(subscriptionId string) (*armkusto.DataConnectionsClient, error) {
-	return armkusto.NewDataConnectionsClient(
-		subscriptionId,
-		b.Cred,
-		&b.ClientOpt,
-	)
-}
-
 func (b *ClientBuilder) NewMachineLearningWorkspaceClient(subscriptionId string) (*armmachinelearning.WorkspacesClient, error) {
 	return armmachinelearning.NewWorkspacesClient(
 		subscriptionId,
@@ -233,14 +183,6 @@ func (b *ClientBuilder) NewTimeSeriesInsightEventSourcesClient(subscriptionId st
 	)
 }
 
-func (b *ClientBuilder) NewStorageCacheTargetsClient(subscriptionId string) (*armstoragecache.StorageTargetsClient, error) {
-	return armstoragecache.NewStorageTargetsClient(
-		subscriptionId,
-		b.Cred,
-		&b.ClientOpt,
-	)
-}
-
 func (b *ClientBuilder) NewAutomationConnectionClient(subscriptionId string) (*armautomation.ConnectionClient, error) {
 	return armautomation.NewConnectionClient(
 		subscriptionId,
@@ -265,22 +207,6 @@ func (b *ClientBuilder) NewAutomationJobScheduleClient(subscriptionId string) (*
 	)
 }
 
-func (b *ClientBuilder) NewBotServiceBotsClient(subscriptionId string) (*armbotservice.BotsClient, error) {
-	return armbotservice.NewBotsClient(
-		subscriptionId,
-		b.Cred,
-		&b.ClientOpt,
-	)
-}
-
-func (b *ClientBuilder) NewBotServiceChannelsClient(subscriptionId string) (*armbotservice.ChannelsClient, error) {
-	return armbotservice.NewChannelsClient(
-		subscriptionId,
-		b.Cred,
-		&b.ClientOpt,
-	)
-}
-
 func (b *ClientBuilder) NewSecurityInsightsDataConnectorsClient(subscriptionId string) (*armsecurityinsights.DataConnectorsClient, error) {
 	return armsecurityinsights.NewDataConnectorsClient(
 		subscriptionId,
@@ -337,14 +263,6 @@ func (b *ClientBuilder) NewAppPlatformDeploymentsClient(subscriptionId string) (
 	)
 }
 
-func (b *ClientBuilder) NewDatashareDatasetsClient(subscriptionId string) (*armdatashare.DataSetsClient, error) {
-	return armdatashare.NewDataSetsClient(
-		subscriptionId,
-		b.Cred,
-		&b.ClientOpt,
-	)
-}
-
 func (b *ClientBuilder) NewHDInsightClustersClient(subscriptionId string) (*armhdinsight.ClustersClient, error) {
 	return armhdinsight.NewClustersClient(
 		subscriptionId,
@@ -385,22 +303,6 @@ func (b *ClientBuilder) NewStreamAnalyticsJobsClient(subscriptionId string) (*ar
 	)
 }
 
-func (b *ClientBuilder) NewCdnProfilesClient(subscriptionId string) (*armcdn.ProfilesClient, error) {
-	return armcdn.NewProfilesClient(
-		subscriptionId,
-		b.Cred,
-		&b.ClientOpt,
-	)
-}
-
-func (b *ClientBuilder) NewCdnRuleSetsClient(subscriptionId string) (*armcdn.RuleSetsClient, error) {
-	return armcdn.NewRuleSetsClient(
-		subscriptionId,
-		b.Cred,
-		&b.ClientOpt,
-	)
-}
-
 func (b *ClientBuilder) NewAppServiceCertificatesClient(subscriptionId string) (*armappservice.CertificatesClient, error) {
 	return armappservice.NewCertificatesClient(
 		subscriptionId,
@@ -425,14 +327,6 @@ func (b *ClientBuilder) NewAppServiceEnvironmentsClient(subscriptionId string) (
 	)
 }
 
-func (b *ClientBuilder) NewAlertsManagementProcessingRulesClient(subscriptionId string) (*armalertsmanagement.AlertProcessingRulesClient, error) {
-	return armalertsmanagement.NewAlertProcessingRulesClient(
-		subscriptionId,
-		b.Cred,
-		&b.ClientOpt,
-	)
-}
-
 func (b *ClientBuilder) NewDomainServiceClient(subscriptionId string) (*armdomainservices.Client, error) {
 	return armdomainservices.NewClient(
 		subscriptionId,
@@ -553,14 +447,6 @@ func (b *ClientBuilder) NewNetworkLoadBalancersClient(subscriptionId string) (*a
 	)
 }
 
-func (b *ClientBuilder) NewFrontdoorPoliciesClient(subscriptionId string) (*armfrontdoor.PoliciesClient, error) {
-	return armfrontdoor.NewPoliciesClient(
-		subscriptionId,
-		b.Cred,
-		&b.ClientOpt,
-	)
-}
-
 func (b *ClientBuilder) NewDesktopVirtualizationWorkspacesClient(subscriptionId string) (*armdesktopvirtualization.WorkspacesClient, error) {
 	return armdesktopvirtualization.NewWorkspacesClient(
 		subscriptionId,
@@ -585,14 +471,6 @@ func (b *ClientBuilder) NewStoragePoolIscsiTargetsClient(subscriptionId string)
 	)
 }
 
-func (b *ClientBuilder) NewDeploymentScriptsClient(subscriptionId string) (*armdeploymentscripts.Client, error) {
-	return armdeploymentscripts.NewClient(
-		subscriptionId,
-		b.Cred,
-		&b.ClientOpt,
-	)
-}
-
 func (b *ClientBuilder) NewSiteRecoveryReplicationPoliciesClient(subscriptionId string) (*armrecoveryservicessiterecovery.ReplicationPoliciesClient, error) {
 	return armrecoveryservicessiterecovery.NewReplicationPoliciesClient(
 		subscriptionId,
@@ -633,29 +511,6 @@ func (b *ClientBuilder) NewSiteRecoveryReplicationNetworkMappingsClient(subscrip
 	)
 }
 
-func (b *ClientBuilder) NewStorageMoverEndpointsClient(subscriptionId string) (*armstoragemover.EndpointsClient, error) {
-	return armstoragemover.NewEndpointsClient(
-		subscriptionId,
-		b.Cred,
-		&b.ClientOpt,
-	)
-}
-
-func (b *ClientBuilder) NewCostManagementScheduledActionsClient() (*armcostmanagement.ScheduledActionsClient, error) {
-	return armcostmanagement.NewScheduledActionsClient(
-		b.Cred,
-		&b.ClientOpt,
-	)
-}
-
-func (b *ClientBuilder) NewApplicationInsightsWebTestsClient(subscriptionId string) (*armapplicationinsights.WebTestsClient, error) {
-	return armapplicationinsights.NewWebTestsClient(
-		subscriptionId,
-		b.Cred,
-		&b.ClientOpt,
-	)
-}
-
 func (b *ClientBuilder) NewLogicWorkflowsClient(subscriptionId string) (*armlogic.WorkflowsClient, error) {
 	return armlogic.NewWorkflowsClient(
 		subscriptionId,
@@ -664,14 +519,6 @@ func (b *ClientBuilder) NewLogicWorkflowsClient(subscriptionId string) (*armlogi
 	)
 }
 
-func (b *ClientBuilder) NewPaloalToNetworkFirewallsClient(subscriptionId string) (*armpanngfw.FirewallsClient, error) {
-	return armpanngfw.NewFirewallsClient(
-		subscriptionId,
-		b.Cred,
-		&b.ClientOpt,
-	)
-}
-
 func (b *ClientBuilder) NewIothubsClient(subscriptionId string) (*armiothub.ResourceClient, error) {
 	return armiothub.NewResourceClient(
 		subscriptionId,
@@ -720,14 +567,6 @@ func (b *ClientBuilder) NewContainerAppEnvironmentsClient(subscriptionId string)
 	)
 }
 
-func (b *ClientBuilder) NewCognitiveServiceAccountsClient(subscriptionId string) (*armcognitiveservices.AccountsClient, error) {
-	return armcognitiveservices.NewAccountsClient(subscriptionId, b.Cred, &b.ClientOpt)
-}
-
-func (b *ClientBuilder) NewCognitiveServiceAccountConnectionsClient(subscriptionId string) (*armcognitiveservices.AccountConnectionsClient, error) {
-	return armcognitiveservices.NewAccountConnectionsClient(subscriptionId, b.Cred, &b.ClientOpt)
-}
-
 func (b *ClientBuilder) NewHybridKubernetesConnectedClient(subscriptionId string) (*armhybridkubernetes.ConnectedClusterClient, error) {
 	return armhybridkubernetes.NewConnectedClusterClient(subscriptionId, b.Cred, &b.ClientOpt)
 }
@@ -736,14 +575,6 @@ func (b *ClientBuilder) NewSqlJobsClient(subscriptionId string) (*armsql.JobsCli
 	return armsql.NewJobsClient(subscriptionId, b.Cred, &b.ClientOpt)
 }
 
-func (b *ClientBuilder) NewWebPubSubsClient(subscriptionId string) (*armwebpubsub.Client, error) {
-	return armwebpubsub.NewClient(subscriptionId, b.Cred, &b.ClientOpt)
-}
-
-func (b *ClientBuilder) NewOracleAutonomousDatabaseClient(subscriptionId string) (*armoracledatabase.AutonomousDatabasesClient, error) {
-	return armoracledatabase.NewAutonomousDatabasesClient(subscriptionId, b.Cred, &b.ClientOpt)
-}
-
 func (b *ClientBuilder) NewRedisEnterpriseDatabaseClient(subscriptionId string) (*armredisenterprise.DatabasesClient, error) {
 	return armredisenterprise.NewDatabasesClient(subscriptionId, b.Cred, &b.ClientOpt)
 }
@@ -751,7 +582,3 @@ func (b *ClientBuilder) NewRedisEnterpriseDatabaseClient(subscriptionId string)
 func (b *ClientBuilder) NewNetAppVolumeBucketClient(subscriptionId string) (*armnetapp.BucketsClient, error) {
 	return armnetapp.NewBucketsClient(subscriptionId, b.Cred, &b.ClientOpt)
 }
-
-func (b *ClientBuilder) NewKubernetesClusterClient(subscription string) (*armcontainerservice.ManagedClustersClient, error) {
-	return armcontainerservice.NewManagedClustersClient(subscription, b.Cred, &b.ClientOpt)
-}
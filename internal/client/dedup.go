@@ -0,0 +1,113 @@
+package client
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"sync"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/policy"
+)
+
+// DedupPolicy is a pipeline policy that deduplicates identical GET requests sent through any pipeline it is attached to.
+// Concurrent requests against the same URL wait for the first one in flight, and later requests are served from the recorded response.
+// Failed requests (i.e. transport errors, including the cancelled ones, and the throttled or server error responses) are not recorded,
+// the requests waiting for them are sent again instead, as are the later ones.
+// The recorded responses are never evicted, so a DedupPolicy is meant to be used for a bounded number of requests, e.g. a batch of queries.
+type DedupPolicy struct {
+	mu      sync.Mutex
+	entries map[string]*dedupEntry
+}
+
+type dedupEntry struct {
+	done chan struct{}
+
+	// failed indicates the request failed, whose entry is removed and shall not be used by the waiting requests.
+	failed bool
+
+	statusCode int
+	status     string
+	header     http.Header
+	body       []byte
+}
+
+func NewDedupPolicy() *DedupPolicy {
+	return &DedupPolicy{
+		entries: map[string]*dedupEntry{},
+	}
+}
+
+func (p *DedupPolicy) Do(req *policy.Request) (*http.Response, error) {
+	raw := req.Raw()
+	if raw.Method != http.MethodGet {
+		return req.Next()
+	}
+	key := raw.URL.String()
+
+	for {
+		p.mu.Lock()
+		entry, ok := p.entries[key]
+		if !ok {
+			entry = &dedupEntry{done: make(chan struct{})}
+			p.entries[key] = entry
+			p.mu.Unlock()
+			return p.fill(key, entry, req)
+		}
+		p.mu.Unlock()
+
+		select {
+		case <-entry.done:
+		case <-raw.Context().Done():
+			return nil, raw.Context().Err()
+		}
+		if !entry.failed {
+			return entry.response(raw), nil
+		}
+	}
+}
+
+// fill sends the request, and records its response in the entry unless it fails.
+func (p *DedupPolicy) fill(key string, entry *dedupEntry, req *policy.Request) (*http.Response, error) {
+	defer close(entry.done)
+
+	resp, err := req.Next()
+	if err == nil && !dedupFailedStatus(resp.StatusCode) {
+		defer resp.Body.Close()
+		entry.body, err = io.ReadAll(resp.Body)
+		if err == nil {
+			entry.statusCode = resp.StatusCode
+			entry.status = resp.Status
+			entry.header = resp.Header
+			return entry.response(req.Raw()), nil
+		}
+	}
+
+	entry.failed = true
+	p.mu.Lock()
+	delete(p.entries, key)
+	p.mu.Unlock()
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// dedupFailedStatus tells whether the response of the status code is a failure that is worth retrying, which is not recorded.
+func dedupFailedStatus(statusCode int) bool {
+	return statusCode == http.StatusTooManyRequests || statusCode >= http.StatusInternalServerError
+}
+
+// response returns a new response of the recorded entry, for the request.
+func (e *dedupEntry) response(raw *http.Request) *http.Response {
+	return &http.Response{
+		Status:        e.status,
+		StatusCode:    e.statusCode,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        e.header.Clone(),
+		Body:          io.NopCloser(bytes.NewReader(e.body)),
+		ContentLength: int64(len(e.body)),
+		Request:       raw,
+	}
+}
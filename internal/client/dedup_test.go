@@ -0,0 +1,124 @@
+package client
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/policy"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/runtime"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDedupPolicy(t *testing.T) {
+	var hits atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		hits.Add(1)
+		w.Write([]byte(r.URL.Path))
+	}))
+	defer srv.Close()
+
+	pl := runtime.NewPipeline("test", "v0.1.0", runtime.PipelineOptions{PerCall: []policy.Policy{NewDedupPolicy()}}, &policy.ClientOptions{
+		Transport: srv.Client(),
+	})
+
+	get := func(path string) string {
+		req, err := runtime.NewRequest(context.Background(), http.MethodGet, srv.URL+path)
+		require.NoError(t, err)
+		resp, err := pl.Do(req)
+		require.NoError(t, err)
+		defer resp.Body.Close()
+		b, err := io.ReadAll(resp.Body)
+		require.NoError(t, err)
+		return string(b)
+	}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			require.Equal(t, "/foo", get("/foo"))
+		}()
+	}
+	wg.Wait()
+	require.Equal(t, "/bar", get("/bar"))
+	require.Equal(t, "/foo", get("/foo"))
+	require.EqualValues(t, 2, hits.Load())
+}
+
+func TestDedupPolicyFailure(t *testing.T) {
+	var (
+		hits    atomic.Int32
+		fail    atomic.Bool
+		release = make(chan struct{})
+	)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		hits.Add(1)
+		if r.URL.Path == "/slow" && hits.Load() == 1 {
+			<-release
+		}
+		if fail.Load() {
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+		w.Write([]byte(r.URL.Path))
+	}))
+	defer srv.Close()
+	defer close(release)
+
+	pl := runtime.NewPipeline("test", "v0.1.0", runtime.PipelineOptions{PerCall: []policy.Policy{NewDedupPolicy()}}, &policy.ClientOptions{
+		Transport: srv.Client(),
+		Retry:     policy.RetryOptions{MaxRetries: -1},
+	})
+
+	get := func(ctx context.Context, path string) (int, string, error) {
+		req, err := runtime.NewRequest(ctx, http.MethodGet, srv.URL+path)
+		require.NoError(t, err)
+		resp, err := pl.Do(req)
+		if err != nil {
+			return 0, "", err
+		}
+		defer resp.Body.Close()
+		b, err := io.ReadAll(resp.Body)
+		require.NoError(t, err)
+		return resp.StatusCode, string(b), nil
+	}
+
+	// The waiting request is sent again if the request in flight is cancelled through its own context.
+	ctx, cancel := context.WithCancel(context.Background())
+	leaderErr := make(chan error, 1)
+	go func() {
+		_, _, err := get(ctx, "/slow")
+		leaderErr <- err
+	}()
+	require.Eventually(t, func() bool { return hits.Load() == 1 }, time.Second, time.Millisecond)
+	waiterErr := make(chan error, 1)
+	go func() {
+		_, _, err := get(context.Background(), "/slow")
+		waiterErr <- err
+	}()
+	cancel()
+	require.ErrorIs(t, <-leaderErr, context.Canceled)
+	require.NoError(t, <-waiterErr)
+	require.EqualValues(t, 2, hits.Load())
+
+	// The server error response is not recorded.
+	fail.Store(true)
+	code, _, err := get(context.Background(), "/foo")
+	require.NoError(t, err)
+	require.Equal(t, http.StatusInternalServerError, code)
+	fail.Store(false)
+	code, body, err := get(context.Background(), "/foo")
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, code)
+	require.Equal(t, "/foo", body)
+	code, _, err = get(context.Background(), "/foo")
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, code)
+	require.EqualValues(t, 4, hits.Load())
+}
@@ -0,0 +1,67 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/policy"
+)
+
+// OfflineTransport is a transport that serves the GET requests of the ARM resources from the provided resource bodies, instead of sending them to Azure.
+// A resource that is not provided is responded as "ResourceNotFound", the same as the ARM API does.
+type OfflineTransport struct {
+	body func(id string) ([]byte, bool)
+}
+
+var _ policy.Transporter = &OfflineTransport{}
+
+// NewOfflineTransport returns an offline transport, where the body function returns the ARM resource JSON body by its resource id.
+func NewOfflineTransport(body func(id string) ([]byte, bool)) *OfflineTransport {
+	return &OfflineTransport{body: body}
+}
+
+func (t *OfflineTransport) Do(req *http.Request) (*http.Response, error) {
+	if req.Method != http.MethodGet {
+		return nil, fmt.Errorf("offline transport only supports GET, got %s %s", req.Method, req.URL.Path)
+	}
+	id := req.URL.Path
+	if b, ok := t.body(id); ok {
+		return newResponse(req, http.StatusOK, b), nil
+	}
+	b, _ := json.Marshal(map[string]interface{}{
+		"error": map[string]string{
+			"code":    "ResourceNotFound",
+			"message": fmt.Sprintf("The resource %q is not found in the offline resource bodies.", id),
+		},
+	})
+	return newResponse(req, http.StatusNotFound, b), nil
+}
+
+func newResponse(req *http.Request, statusCode int, body []byte) *http.Response {
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", statusCode, http.StatusText(statusCode)),
+		StatusCode:    statusCode,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        http.Header{"Content-Type": []string{"application/json"}},
+		Body:          io.NopCloser(bytes.NewReader(body)),
+		ContentLength: int64(len(body)),
+		Request:       req,
+	}
+}
+
+// OfflineCredential is a token credential that returns a fake token, which is used together with the OfflineTransport.
+type OfflineCredential struct{}
+
+var _ azcore.TokenCredential = OfflineCredential{}
+
+func (OfflineCredential) GetToken(context.Context, policy.TokenRequestOptions) (azcore.AccessToken, error) {
+	return azcore.AccessToken{Token: "offline", ExpiresOn: time.Now().Add(24 * time.Hour)}, nil
+}
@@ -16,21 +16,25 @@ type RawClient struct {
 }
 
 func (b *ClientBuilder) NewRawClient() (*RawClient, error) {
-	ep := cloud.AzurePublic.Services[cloud.ResourceManager].Endpoint
-	if c, ok := b.ClientOpt.Cloud.Services[cloud.ResourceManager]; ok {
-		ep = c.Endpoint
-	}
 	pl, err := armruntime.NewPipeline("resource", "v0.1.0", b.Cred, runtime.PipelineOptions{}, &b.ClientOpt)
 	if err != nil {
 		return nil, err
 	}
 	client := &RawClient{
-		host: ep,
+		host: b.resourceManagerEndpoint(),
 		pl:   pl,
 	}
 	return client, nil
 }
 
+// resourceManagerEndpoint returns the ARM endpoint of the cloud in the client option, which defaults to the Azure public cloud.
+func (b *ClientBuilder) resourceManagerEndpoint() string {
+	if c, ok := b.ClientOpt.Cloud.Services[cloud.ResourceManager]; ok {
+		return c.Endpoint
+	}
+	return cloud.AzurePublic.Services[cloud.ResourceManager].Endpoint
+}
+
 func (client *RawClient) Get(ctx context.Context, resourceID string, apiVersion string) (interface{}, error) {
 	req, err := client.getCreateRequest(ctx, resourceID, apiVersion)
 	if err != nil {
@@ -51,6 +55,41 @@ func (client *RawClient) Get(ctx context.Context, resourceID string, apiVersion
 	return responseBody, nil
 }
 
+// List lists the collection of the resource path (e.g. "/subscriptions/xxx/resources"), following the "nextLink" until all the pages are retrieved.
+// It returns the elements in the "value" of all the pages.
+func (client *RawClient) List(ctx context.Context, collectionPath string, apiVersion string) ([]interface{}, error) {
+	req, err := client.getCreateRequest(ctx, collectionPath, apiVersion)
+	if err != nil {
+		return nil, err
+	}
+	var values []interface{}
+	for {
+		resp, err := client.pl.Do(req)
+		if err != nil {
+			return nil, err
+		}
+		if !runtime.HasStatusCode(resp, http.StatusOK) {
+			return nil, runtime.NewResponseError(resp)
+		}
+		var page struct {
+			Value    []interface{} `json:"value"`
+			NextLink string        `json:"nextLink"`
+		}
+		if err := runtime.UnmarshalAsJSON(resp, &page); err != nil {
+			return nil, err
+		}
+		values = append(values, page.Value...)
+		if page.NextLink == "" {
+			return values, nil
+		}
+		req, err = runtime.NewRequest(ctx, http.MethodGet, page.NextLink)
+		if err != nil {
+			return nil, err
+		}
+		req.Raw().Header.Set("Accept", "application/json")
+	}
+}
+
 func (client *RawClient) getCreateRequest(ctx context.Context, resourceID string, apiVersion string) (*policy.Request, error) {
 	urlPath := resourceID
 	req, err := runtime.NewRequest(ctx, http.MethodGet, runtime.JoinPaths(client.host, urlPath))
@@ -0,0 +1,162 @@
+package client
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+	"sync"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/arm"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/policy"
+)
+
+// Fixture is a list of captured ARM interactions, which is recorded by the RecordTransport and replayed by the ReplayTransport.
+// It is stored as JSON, e.g.:
+//
+//	{
+//	  "interactions": [
+//	    {
+//	      "method": "GET",
+//	      "path": "/subscriptions/xxx/resourceGroups/rg/providers/Microsoft.Compute/virtualMachines/vm",
+//	      "status_code": 200,
+//	      "body": {"properties": {}}
+//	    }
+//	  ]
+//	}
+type Fixture struct {
+	Interactions []Interaction `json:"interactions"`
+}
+
+// Interaction is a captured request and its response. The request is identified by its method and path, i.e. the query parameters (e.g. the "api-version") are ignored.
+type Interaction struct {
+	Method     string          `json:"method"`
+	Path       string          `json:"path"`
+	StatusCode int             `json:"status_code"`
+	Body       json.RawMessage `json:"body,omitempty"`
+}
+
+func LoadFixture(path string) (*Fixture, error) {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var fixture Fixture
+	if err := json.Unmarshal(b, &fixture); err != nil {
+		return nil, fmt.Errorf("unmarshalling fixture %s: %w", path, err)
+	}
+	return &fixture, nil
+}
+
+func (f *Fixture) Save(path string) error {
+	b, err := json.MarshalIndent(f, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, append(b, '\n'), 0644)
+}
+
+// ReplayTransport is a transport that responds the requests with the interactions of the fixture, without network access.
+// The request path is matched case insensitively. If there are multiple interactions for the same request, they are replayed in the recorded order,
+// where the last one is repeated. A request that has no matching interaction results into an error.
+type ReplayTransport struct {
+	mu       sync.Mutex
+	fixture  *Fixture
+	replayed map[int]bool
+}
+
+var _ policy.Transporter = &ReplayTransport{}
+
+func NewReplayTransport(fixture *Fixture) *ReplayTransport {
+	return &ReplayTransport{fixture: fixture, replayed: map[int]bool{}}
+}
+
+func (t *ReplayTransport) Do(req *http.Request) (*http.Response, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	match := -1
+	for i, it := range t.fixture.Interactions {
+		if !strings.EqualFold(it.Method, req.Method) || !strings.EqualFold(it.Path, req.URL.Path) {
+			continue
+		}
+		match = i
+		if !t.replayed[i] {
+			break
+		}
+	}
+	if match == -1 {
+		return nil, fmt.Errorf("no recorded interaction for %s %s", req.Method, req.URL.Path)
+	}
+	t.replayed[match] = true
+	return newResponse(req, t.fixture.Interactions[match].StatusCode, t.fixture.Interactions[match].Body), nil
+}
+
+// NewReplayClientBuilder returns a client builder whose clients are served by the fixture, which is meant to be used in tests.
+func NewReplayClientBuilder(fixture *Fixture) *ClientBuilder {
+	return &ClientBuilder{
+		Cred: OfflineCredential{},
+		ClientOpt: arm.ClientOptions{
+			ClientOptions: policy.ClientOptions{
+				Transport: NewReplayTransport(fixture),
+				Retry:     policy.RetryOptions{MaxRetries: -1},
+			},
+		},
+	}
+}
+
+// RecordTransport is a transport that sends the requests by the underlying transport, and records the interactions into the fixture.
+// It shall only be used for the ARM clients (i.e. not for the credential), as the fixture is meant to be committed.
+type RecordTransport struct {
+	mu      sync.Mutex
+	fixture Fixture
+	inner   policy.Transporter
+}
+
+var _ policy.Transporter = &RecordTransport{}
+
+// NewRecordTransport returns a record transport, which sends the requests by the inner transport, or the http.DefaultClient if it is nil.
+func NewRecordTransport(inner policy.Transporter) *RecordTransport {
+	if inner == nil {
+		inner = http.DefaultClient
+	}
+	return &RecordTransport{inner: inner}
+}
+
+func (t *RecordTransport) Do(req *http.Request) (*http.Response, error) {
+	resp, err := t.inner.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	b, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(b))
+
+	it := Interaction{
+		Method:     req.Method,
+		Path:       req.URL.Path,
+		StatusCode: resp.StatusCode,
+	}
+	// Only the JSON body is recorded, so that the fixture remains a valid JSON. The ARM API always responds with a JSON body, if any.
+	if json.Valid(b) {
+		it.Body = b
+	}
+
+	t.mu.Lock()
+	t.fixture.Interactions = append(t.fixture.Interactions, it)
+	t.mu.Unlock()
+	return resp, nil
+}
+
+// Fixture returns a copy of the recorded interactions.
+func (t *RecordTransport) Fixture() *Fixture {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return &Fixture{Interactions: append([]Interaction{}, t.fixture.Interactions...)}
+}
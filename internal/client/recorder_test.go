@@ -0,0 +1,66 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/policy"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/runtime"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRecordAndReplay(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/foo" {
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`{"error": {"code": "ResourceNotFound"}}`))
+			return
+		}
+		w.Write([]byte(`{"name": "foo"}`))
+	}))
+	defer srv.Close()
+
+	get := func(transport policy.Transporter, path string) (int, string) {
+		pl := runtime.NewPipeline("test", "v0.1.0", runtime.PipelineOptions{}, &policy.ClientOptions{
+			Transport: transport,
+			Retry:     policy.RetryOptions{MaxRetries: -1},
+		})
+		req, err := runtime.NewRequest(context.Background(), http.MethodGet, srv.URL+path+"?api-version=2020-01-01")
+		require.NoError(t, err)
+		resp, err := pl.Do(req)
+		require.NoError(t, err)
+		b, err := runtime.Payload(resp)
+		require.NoError(t, err)
+		return resp.StatusCode, string(b)
+	}
+
+	recorder := NewRecordTransport(srv.Client())
+	code, body := get(recorder, "/foo")
+	require.Equal(t, http.StatusOK, code)
+	require.JSONEq(t, `{"name": "foo"}`, body)
+	code, _ = get(recorder, "/bar")
+	require.Equal(t, http.StatusNotFound, code)
+
+	path := filepath.Join(t.TempDir(), "fixture.json")
+	require.NoError(t, recorder.Fixture().Save(path))
+	fixture, err := LoadFixture(path)
+	require.NoError(t, err)
+	require.Len(t, fixture.Interactions, 2)
+
+	srv.Close()
+	replayer := NewReplayTransport(fixture)
+	code, body = get(replayer, "/FOO")
+	require.Equal(t, http.StatusOK, code)
+	require.JSONEq(t, `{"name": "foo"}`, body)
+	code, _ = get(replayer, "/bar")
+	require.Equal(t, http.StatusNotFound, code)
+
+	pl := runtime.NewPipeline("test", "v0.1.0", runtime.PipelineOptions{}, &policy.ClientOptions{Transport: replayer, Retry: policy.RetryOptions{MaxRetries: -1}})
+	req, err := runtime.NewRequest(context.Background(), http.MethodGet, srv.URL+"/baz")
+	require.NoError(t, err)
+	_, err = pl.Do(req)
+	require.Error(t, err)
+}
@@ -0,0 +1,86 @@
+package client
+
+import (
+	"context"
+	"net/http"
+
+	armruntime "github.com/Azure/azure-sdk-for-go/sdk/azcore/arm/runtime"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/runtime"
+)
+
+const resourceGraphAPIVersion = "2021-03-01"
+
+// ResourceGraphClient queries the Azure Resource Graph, which returns the (indexed) resources of many subscriptions by a single KQL query.
+type ResourceGraphClient struct {
+	host string
+	pl   runtime.Pipeline
+}
+
+func (b *ClientBuilder) NewResourceGraphClient() (*ResourceGraphClient, error) {
+	pl, err := armruntime.NewPipeline("resourcegraph", "v0.1.0", b.Cred, runtime.PipelineOptions{}, &b.ClientOpt)
+	if err != nil {
+		return nil, err
+	}
+	client := &ResourceGraphClient{
+		host: b.resourceManagerEndpoint(),
+		pl:   pl,
+	}
+	return client, nil
+}
+
+type resourceGraphRequest struct {
+	Subscriptions []string                    `json:"subscriptions"`
+	Query         string                      `json:"query"`
+	Options       resourceGraphRequestOptions `json:"options"`
+}
+
+type resourceGraphRequestOptions struct {
+	ResultFormat string `json:"resultFormat"`
+	SkipToken    string `json:"$skipToken,omitempty"`
+}
+
+// Query runs the KQL query against the subscriptions, following the "$skipToken" until all the pages are retrieved.
+// It returns the rows of all the pages, each of which is an object keyed by the column names.
+func (client *ResourceGraphClient) Query(ctx context.Context, subscriptions []string, query string) ([]interface{}, error) {
+	body := resourceGraphRequest{
+		Subscriptions: subscriptions,
+		Query:         query,
+		Options: resourceGraphRequestOptions{
+			ResultFormat: "objectArray",
+		},
+	}
+	var rows []interface{}
+	for {
+		req, err := runtime.NewRequest(ctx, http.MethodPost, runtime.JoinPaths(client.host, "/providers/Microsoft.ResourceGraph/resources"))
+		if err != nil {
+			return nil, err
+		}
+		reqQP := req.Raw().URL.Query()
+		reqQP.Set("api-version", resourceGraphAPIVersion)
+		req.Raw().URL.RawQuery = reqQP.Encode()
+		req.Raw().Header.Set("Accept", "application/json")
+		if err := runtime.MarshalAsJSON(req, body); err != nil {
+			return nil, err
+		}
+
+		resp, err := client.pl.Do(req)
+		if err != nil {
+			return nil, err
+		}
+		if !runtime.HasStatusCode(resp, http.StatusOK) {
+			return nil, runtime.NewResponseError(resp)
+		}
+		var page struct {
+			Data      []interface{} `json:"data"`
+			SkipToken string        `json:"$skipToken"`
+		}
+		if err := runtime.UnmarshalAsJSON(resp, &page); err != nil {
+			return nil, err
+		}
+		rows = append(rows, page.Data...)
+		if page.SkipToken == "" {
+			return rows, nil
+		}
+		body.Options.SkipToken = page.SkipToken
+	}
+}
@@ -0,0 +1,79 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/arm"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/cloud"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/policy"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResourceGraphQuery(t *testing.T) {
+	var requests []resourceGraphRequest
+	srv := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != "/providers/Microsoft.ResourceGraph/resources" || r.URL.Query().Get("api-version") == "" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		var req resourceGraphRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		if req.Query == "invalid" {
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write([]byte(`{"error": {"code": "BadRequest"}}`))
+			return
+		}
+		requests = append(requests, req)
+		switch req.Options.SkipToken {
+		case "":
+			w.Write([]byte(`{"count": 1, "data": [{"id": "/subscriptions/sub1/resourceGroups/rg1/providers/Microsoft.Compute/virtualMachines/vm1", "kind": ""}], "$skipToken": "page2"}`))
+		case "page2":
+			w.Write([]byte(`{"count": 1, "data": [{"id": "/subscriptions/sub2/resourceGroups/rg1/providers/Microsoft.Compute/virtualMachines/vm2", "kind": ""}]}`))
+		default:
+			w.WriteHeader(http.StatusBadRequest)
+		}
+	}))
+	defer srv.Close()
+
+	b := &ClientBuilder{
+		Cred: OfflineCredential{},
+		ClientOpt: arm.ClientOptions{
+			ClientOptions: policy.ClientOptions{
+				Cloud: cloud.Configuration{
+					Services: map[cloud.ServiceName]cloud.ServiceConfiguration{
+						cloud.ResourceManager: {Endpoint: srv.URL, Audience: "https://management.core.windows.net/"},
+					},
+				},
+				Transport: srv.Client(),
+				Retry:     policy.RetryOptions{MaxRetries: -1},
+			},
+		},
+	}
+	c, err := b.NewResourceGraphClient()
+	require.NoError(t, err)
+
+	rows, err := c.Query(context.Background(), []string{"sub1", "sub2"}, "resources | project id, kind")
+	require.NoError(t, err)
+	require.Equal(t, []interface{}{
+		map[string]interface{}{"id": "/subscriptions/sub1/resourceGroups/rg1/providers/Microsoft.Compute/virtualMachines/vm1", "kind": ""},
+		map[string]interface{}{"id": "/subscriptions/sub2/resourceGroups/rg1/providers/Microsoft.Compute/virtualMachines/vm2", "kind": ""},
+	}, rows)
+
+	require.Len(t, requests, 2)
+	for _, req := range requests {
+		require.Equal(t, []string{"sub1", "sub2"}, req.Subscriptions)
+		require.Equal(t, "resources | project id, kind", req.Query)
+		require.Equal(t, "objectArray", req.Options.ResultFormat)
+	}
+	require.Equal(t, "page2", requests[1].Options.SkipToken)
+
+	_, err = c.Query(context.Background(), []string{"sub1"}, "invalid")
+	require.Error(t, err)
+}
@@ -0,0 +1,32 @@
+package errs
+
+import (
+	"errors"
+	"fmt"
+)
+
+// The sentinel errors shared by the internal packages, which are exported by the aztft package. See there for their meanings.
+var (
+	ErrInvalidId        = errors.New("invalid resource id")
+	ErrNoMatch          = errors.New("no matching resource type")
+	ErrNeedsAPI         = errors.New("the Azure API is needed")
+	ErrResourceNotFound = errors.New("resource not found")
+	ErrUnauthorized     = errors.New("unauthorized")
+	ErrUnknownKind      = errors.New("unknown resource kind")
+)
+
+// Mark returns the error formatted as fmt.Errorf does, which is also regarded as the sentinel error by errors.Is, without changing the error message.
+func Mark(sentinel error, format string, a ...interface{}) error {
+	return &markedError{err: fmt.Errorf(format, a...), sentinel: sentinel}
+}
+
+type markedError struct {
+	err      error
+	sentinel error
+}
+
+func (e *markedError) Error() string { return e.err.Error() }
+
+func (e *markedError) Unwrap() error { return errors.Unwrap(e.err) }
+
+func (e *markedError) Is(target error) bool { return target == e.sentinel }
@@ -0,0 +1,258 @@
+package mapcheck
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/magodo/armid"
+	"github.com/magodo/aztft/internal/populate"
+	"github.com/magodo/aztft/internal/resmap"
+	"github.com/magodo/aztft/internal/resolve"
+	"github.com/magodo/aztft/internal/tfid"
+)
+
+// The kinds of the checks.
+const (
+	CheckResolverType       = "resolver-type"
+	CheckAmbiguousRoute     = "ambiguous-route"
+	CheckImportSpecs        = "import-specs"
+	CheckScope              = "scope"
+	CheckDynamicBuildType   = "dynamic-build-type"
+	CheckPopulaterType      = "populater-type"
+	CheckPropertyLikeSource = "property-like-source"
+	CheckIdTransform        = "id-transform"
+)
+
+// Finding is a problem found in the mapping.
+type Finding struct {
+	// Check is the kind of the check that finds this problem.
+	Check string `json:"check"`
+
+	// ResourceType is the TF resource type that has this problem, if any.
+	ResourceType string `json:"resource_type,omitempty"`
+
+	Message string `json:"message"`
+}
+
+func (f Finding) String() string {
+	return f.Message
+}
+
+// Check checks the consistency of the mapping, and between the mapping and the code, and returns the problems found, sorted by the message.
+// It checks that:
+//   - The resource types of each resolver exist in the mapping, and are not removed.
+//   - Each ambiguous ARM resource type (i.e. mapped by multiple TF resource types) has a resolver, which covers all the TF resource types.
+//   - The import specs of each TF resource type correspond to its parent scopes.
+//   - The parent scopes and the import specs are valid scope strings.
+//   - The resource types that are built by tfid.DynamicBuild or have a populater exist in the mapping, and are not removed.
+//   - The property-like resource types that are built by tfid.StaticBuild are populated by some populater.
+//   - The id transform refers to the resource types of the TF resource type, and projects no more than its resource types.
+func Check(m *resmap.Mapping) []Finding {
+	var findings []Finding
+	add := func(check, rt, format string, a ...interface{}) {
+		findings = append(findings, Finding{Check: check, ResourceType: rt, Message: fmt.Sprintf(format, a...)})
+	}
+
+	// Check whether this resolver's resource types contain deprecated/non-existed resource.
+	for k1, rm := range resolve.Resolvers {
+		for k2, resolver := range rm {
+			for _, rt := range resolver.ResourceTypes() {
+				item, ok := m.TF2ARMIdMap[rt]
+				if !ok {
+					add(CheckResolverType, rt, "non-exist resource type %q for resolver %s in scope of %s", rt, k1, k2)
+					continue
+				}
+				if item.IsRemoved {
+					add(CheckResolverType, rt, "removed resource type %q for resolver %s in scope of %s", rt, k1, k2)
+					continue
+				}
+			}
+		}
+	}
+
+	for k1, b := range m.ARMId2TFMap {
+		for k2, l := range b {
+			if len(l) <= 1 {
+				continue
+			}
+			if rm, ok := resolve.Resolvers[k1]; ok {
+				if resolver, ok := rm[k2]; ok {
+					// Check whether all the TF candidates are covered by this resolver.
+					for _, item := range l {
+						if !stringInSlice(item.ResourceType, resolver.ResourceTypes()) {
+							add(CheckAmbiguousRoute, item.ResourceType, "%s in scope of %s has ambiguous resource type %q that isn't covered by that resolver", k1, k2, item.ResourceType)
+						}
+					}
+				}
+				continue
+			}
+			resourceTypes := []string{}
+			for _, item := range l {
+				resourceTypes = append(resourceTypes, item.ResourceType)
+			}
+			sort.Strings(resourceTypes)
+			add(CheckAmbiguousRoute, "", "multiple matches found for %s in scope of %s: %v", k1, k2, resourceTypes)
+		}
+	}
+
+	for rt, item := range m.TF2ARMIdMap {
+		mm := item.ManagementPlane
+		if mm == nil {
+			continue
+		}
+		if len(mm.ParentScopes) == 0 {
+			// For root scope resource, there is at most one import spec.
+			if len(mm.ImportSpecs) > 1 {
+				add(CheckImportSpecs, rt, "root scope resource type %q has %d import specs", rt, len(mm.ImportSpecs))
+			}
+		} else if len(mm.ImportSpecs) != 0 && len(mm.ImportSpecs) != len(mm.ParentScopes) {
+			add(CheckImportSpecs, rt, "resource type %q has %d import specs, which doesn't match its %d parent scopes", rt, len(mm.ImportSpecs), len(mm.ParentScopes))
+		}
+		for _, scope := range mm.ParentScopes {
+			if scope == resmap.ScopeAny {
+				continue
+			}
+			if err := parseScope(scope); err != nil {
+				add(CheckScope, rt, "resource type %q has invalid parent scope %q: %v", rt, scope, err)
+			}
+		}
+		for _, spec := range mm.ImportSpecs {
+			if err := parseScope(spec); err != nil {
+				add(CheckScope, rt, "resource type %q has invalid import spec %q: %v", rt, spec, err)
+			}
+		}
+		if err := checkIdTransform(mm); err != nil {
+			add(CheckIdTransform, rt, "resource type %q has invalid id transform: %v", rt, err)
+		}
+	}
+
+	for _, rt := range tfid.DynamicBuildTypes() {
+		item, ok := m.TF2ARMIdMap[rt]
+		if !ok {
+			add(CheckDynamicBuildType, rt, "non-exist resource type %q for dynamic build", rt)
+			continue
+		}
+		if item.IsRemoved {
+			add(CheckDynamicBuildType, rt, "removed resource type %q for dynamic build", rt)
+		}
+	}
+
+	populated := map[string]bool{}
+	for _, rt := range populate.PopulaterTypes() {
+		for _, plt := range populate.PropertyLikeTypes(rt) {
+			populated[plt.ResourceType] = true
+		}
+		item, ok := m.TF2ARMIdMap[rt]
+		if !ok {
+			add(CheckPopulaterType, rt, "non-exist resource type %q for populater", rt)
+			continue
+		}
+		if item.IsRemoved {
+			add(CheckPopulaterType, rt, "removed resource type %q for populater", rt)
+		}
+	}
+
+	for _, rt := range tfid.PropertyLikeTypes() {
+		if !populated[rt] {
+			add(CheckPropertyLikeSource, rt, "property-like resource type %q isn't populated by any populater", rt)
+		}
+	}
+
+	sort.Slice(findings, func(i, j int) bool {
+		return findings[i].Message < findings[j].Message
+	})
+	return findings
+}
+
+// parseScope validates the scope string by building an example resource id from it, whose scope string shall be the same.
+// The scope string is in the form of armid.ResourceId.ScopeString(), e.g. "/subscriptions/resourceGroups/Microsoft.Network/virtualNetworks".
+func parseScope(scope string) error {
+	if !strings.HasPrefix(scope, "/") {
+		return fmt.Errorf(`not starting with "/"`)
+	}
+	segs := strings.Split(strings.TrimPrefix(scope, "/"), "/")
+	if len(segs) == 1 && segs[0] == "" {
+		segs = nil
+	}
+	if len(segs) != 0 && strings.EqualFold(segs[0], "subscriptions") {
+		segs = segs[1:]
+		idSegs := []string{"", "subscriptions", "sub1"}
+		if len(segs) != 0 && strings.EqualFold(segs[0], "resourceGroups") {
+			segs = segs[1:]
+			idSegs = append(idSegs, "resourceGroups", "rg1")
+		}
+		return checkScopeId(scope, idSegs, segs)
+	}
+	return checkScopeId(scope, []string{""}, segs)
+}
+
+func checkScopeId(scope string, idSegs []string, segs []string) error {
+	if len(segs) != 0 && !strings.Contains(segs[0], ".") {
+		return fmt.Errorf("expect a resource provider namespace, got %q", segs[0])
+	}
+	for _, seg := range segs {
+		if seg == "" {
+			return fmt.Errorf("empty segment")
+		}
+		if strings.Contains(seg, ".") {
+			idSegs = append(idSegs, "providers", seg)
+			continue
+		}
+		idSegs = append(idSegs, seg, seg+"1")
+	}
+	idStr := strings.Join(idSegs, "/")
+	if idStr == "" {
+		idStr = "/"
+	}
+	id, err := armid.ParseResourceId(idStr)
+	if err != nil {
+		return err
+	}
+	if !strings.EqualFold(id.ScopeString(), scope) {
+		return fmt.Errorf("mismatches the scope string %q of the example id %q", id.ScopeString(), idStr)
+	}
+	return nil
+}
+
+func checkIdTransform(mm *resmap.MapManagementPlane) error {
+	t := mm.IdTransform
+	if t == nil {
+		return nil
+	}
+	switch t.Projection {
+	case "", resmap.ProjectionParent, resmap.ProjectionParentScope:
+	case resmap.ProjectionGrandParent:
+		if len(mm.Types) < 2 {
+			return fmt.Errorf("projection %q needs at least 2 resource types, got %d", t.Projection, len(mm.Types))
+		}
+	default:
+		return fmt.Errorf("unknown projection %q", t.Projection)
+	}
+	for _, m := range []map[string]string{t.ConstantNames, t.TypeRenames} {
+		for typ := range m {
+			if !stringInSliceFold(typ, mm.Types) {
+				return fmt.Errorf("%q isn't one of the resource types %v", typ, mm.Types)
+			}
+		}
+	}
+	return nil
+}
+
+func stringInSliceFold(s string, l []string) bool {
+	for _, item := range l {
+		if strings.EqualFold(s, item) {
+			return true
+		}
+	}
+	return false
+}
+
+func stringInSlice(s string, l []string) bool {
+	for _, item := range l {
+		if s == item {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,96 @@
+package mapcheck
+
+import (
+	"testing"
+
+	"github.com/magodo/aztft/internal/resmap"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCheckEmbedded(t *testing.T) {
+	m, err := resmap.Embedded()
+	require.NoError(t, err)
+	require.Empty(t, Check(m))
+}
+
+func TestCheck(t *testing.T) {
+	m, err := resmap.Embedded()
+	require.NoError(t, err)
+
+	mps := m.TF2ARMIdMap.Merge(resmap.TF2ARMIdMapType{
+		"azurerm_foo": {
+			ManagementPlane: &resmap.MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups", "/subscriptions/Microsoft.Foo/bars"},
+				Provider:     "Microsoft.Foo",
+				Types:        []string{"foos"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Foo/foos"},
+			},
+		},
+		"azurerm_bar": {
+			ManagementPlane: &resmap.MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups/foos"},
+				Provider:     "Microsoft.Foo",
+				Types:        []string{"bars"},
+			},
+		},
+		"azurerm_baz": {
+			ManagementPlane: &resmap.MapManagementPlane{
+				ParentScopes: []string{"/subscriptions/resourceGroups"},
+				Provider:     "Microsoft.Foo",
+				Types:        []string{"bazs"},
+				ImportSpecs:  []string{"/subscriptions/resourceGroups/Microsoft.Foo/bazs"},
+				IdTransform: &resmap.IdTransform{
+					ConstantNames: map[string]string{"foos": "default"},
+					Projection:    resmap.ProjectionParent,
+				},
+			},
+		},
+		"azurerm_key_vault_secret": {
+			IsRemoved: true,
+		},
+		"azurerm_subnet": {
+			IsRemoved: true,
+		},
+	})
+	nm, err := resmap.NewMapping(mps)
+	require.NoError(t, err)
+
+	var checks []string
+	for _, f := range Check(nm) {
+		checks = append(checks, f.Check+": "+f.ResourceType)
+	}
+	require.ElementsMatch(t, []string{
+		CheckImportSpecs + ": azurerm_foo",
+		CheckScope + ": azurerm_bar",
+		CheckIdTransform + ": azurerm_baz",
+		CheckDynamicBuildType + ": azurerm_key_vault_secret",
+		CheckPopulaterType + ": azurerm_subnet",
+	}, checks)
+}
+
+func TestParseScope(t *testing.T) {
+	cases := []struct {
+		scope string
+		err   bool
+	}{
+		{scope: "/"},
+		{scope: "/subscriptions"},
+		{scope: "/subscriptions/resourceGroups"},
+		{scope: "/Microsoft.Management/managementGroups"},
+		{scope: "/subscriptions/resourceGroups/Microsoft.Network/virtualNetworks/subnets"},
+		{scope: "/subscriptions/resourceGroups/Microsoft.Network/virtualNetworks/Microsoft.Authorization/roleAssignments"},
+		{scope: "/Microsoft.Management/managementGroups/Microsoft.Blueprint/blueprintAssignments"},
+		{scope: "subscriptions", err: true},
+		{scope: "/subscriptions/", err: true},
+		{scope: "/subscriptions/resourceGroups/virtualNetworks", err: true},
+		{scope: "/Microsoft.Management/managementGroups/providers/Microsoft.Blueprint/blueprintAssignments", err: true},
+	}
+	for _, c := range cases {
+		err := parseScope(c.scope)
+		if c.err {
+			require.Error(t, err, c.scope)
+			continue
+		}
+		require.NoError(t, err, c.scope)
+	}
+}
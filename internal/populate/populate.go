@@ -1,6 +1,9 @@
 package populate
 
 import (
+	"context"
+	"sort"
+
 	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
 	"github.com/Azure/azure-sdk-for-go/sdk/azcore/arm"
 	"github.com/magodo/armid"
@@ -8,23 +11,136 @@ import (
 )
 
 // populateFunc populates the hypothetic azure resource ids that represent the property like resources of the specified resource.
-type populateFunc func(*client.ClientBuilder, armid.ResourceId) ([]armid.ResourceId, error)
-
-var populaters = map[string]populateFunc{
-	"azurerm_linux_virtual_machine":     populateVirtualMachine,
-	"azurerm_windows_virtual_machine":   populateVirtualMachine,
-	"azurerm_network_interface":         populateNetworkInterface,
-	"azurerm_virtual_desktop_workspace": populateVirtualDesktopWorkspace,
-	"azurerm_nat_gateway":               populateNatGateway,
-	"azurerm_subnet":                    populateSubnet,
-	"azurerm_logic_app_workflow":        populateLogicAppWorkflow,
-	"azurerm_iothub":                    populateIotHub,
-	"azurerm_netapp_account":            populateNetAppAccount,
-	"azurerm_lb":                        populateLoadBalancer,
-	"azurerm_container_app_environment": populateContainerAppEnv,
-	"azurerm_mssql_job":                 populateMssqlJob,
-	"azurerm_stream_analytics_job":      populateStreamAnalyticsJob,
-	"azurerm_managed_redis":             populateRedisEnterpriseDatabase,
+type populateFunc func(context.Context, *client.ClientBuilder, armid.ResourceId) ([]armid.ResourceId, error)
+
+// PropertyLikeType describes a kind of property-like resource that is populated.
+type PropertyLikeType struct {
+	// ResourceType is the TF resource type of the property-like resource.
+	ResourceType string
+
+	// RefResourceType is the TF resource type of the referenced resource, whose id is base64 encoded as the last name of the pesudo resource id.
+	// It is empty if the last name of the pesudo resource id is a plain name.
+	RefResourceType string
+}
+
+// populater populates the property-like resources of a TF resource type.
+type populater struct {
+	populate populateFunc
+
+	// types are the property-like resources that the populate function populates.
+	types []PropertyLikeType
+}
+
+var virtualMachinePropertyLikeTypes = []PropertyLikeType{
+	{ResourceType: "azurerm_virtual_machine_data_disk_attachment"},
+	{ResourceType: "azurerm_virtual_machine_implicit_data_disk_from_source"},
+}
+
+var populaters = map[string]populater{
+	"azurerm_linux_virtual_machine":   {populate: populateVirtualMachine, types: virtualMachinePropertyLikeTypes},
+	"azurerm_windows_virtual_machine": {populate: populateVirtualMachine, types: virtualMachinePropertyLikeTypes},
+	"azurerm_network_interface": {
+		populate: populateNetworkInterface,
+		types: []PropertyLikeType{
+			{ResourceType: "azurerm_network_interface_security_group_association", RefResourceType: "azurerm_network_security_group"},
+			{ResourceType: "azurerm_network_interface_application_gateway_backend_address_pool_association", RefResourceType: "fake_azurerm_application_gateway_backend_address_pool"},
+			{ResourceType: "azurerm_network_interface_application_security_group_association", RefResourceType: "azurerm_application_security_group"},
+			{ResourceType: "azurerm_network_interface_nat_rule_association", RefResourceType: "azurerm_lb_nat_rule"},
+			{ResourceType: "azurerm_network_interface_backend_address_pool_association", RefResourceType: "azurerm_lb_backend_address_pool"},
+		},
+	},
+	"azurerm_virtual_desktop_workspace": {
+		populate: populateVirtualDesktopWorkspace,
+		types: []PropertyLikeType{
+			{ResourceType: "azurerm_virtual_desktop_workspace_application_group_association", RefResourceType: "azurerm_virtual_desktop_application_group"},
+		},
+	},
+	"azurerm_nat_gateway": {
+		populate: populateNatGateway,
+		types: []PropertyLikeType{
+			{ResourceType: "azurerm_nat_gateway_public_ip_association", RefResourceType: "azurerm_public_ip"},
+			{ResourceType: "azurerm_nat_gateway_public_ip_prefix_association", RefResourceType: "azurerm_public_ip_prefix"},
+		},
+	},
+	"azurerm_subnet": {
+		populate: populateSubnet,
+		types: []PropertyLikeType{
+			{ResourceType: "azurerm_subnet_route_table_association", RefResourceType: "azurerm_route_table"},
+			{ResourceType: "azurerm_subnet_network_security_group_association", RefResourceType: "azurerm_network_security_group"},
+			{ResourceType: "azurerm_subnet_nat_gateway_association", RefResourceType: "azurerm_nat_gateway"},
+		},
+	},
+	"azurerm_logic_app_workflow": {
+		populate: populateLogicAppWorkflow,
+		types: []PropertyLikeType{
+			{ResourceType: "azurerm_logic_app_action_custom"},
+			{ResourceType: "azurerm_logic_app_action_http"},
+			{ResourceType: "azurerm_logic_app_trigger_custom"},
+			{ResourceType: "azurerm_logic_app_trigger_http_request"},
+			{ResourceType: "azurerm_logic_app_trigger_recurrence"},
+		},
+	},
+	"azurerm_iothub": {
+		populate: populateIotHub,
+		types: []PropertyLikeType{
+			{ResourceType: "azurerm_iothub_endpoint_eventhub"},
+			{ResourceType: "azurerm_iothub_endpoint_servicebus_queue"},
+			{ResourceType: "azurerm_iothub_endpoint_servicebus_topic"},
+			{ResourceType: "azurerm_iothub_endpoint_storage_container"},
+		},
+	},
+	"azurerm_netapp_account": {
+		populate: populateNetAppAccount,
+		types: []PropertyLikeType{
+			{ResourceType: "azurerm_netapp_account_encryption"},
+		},
+	},
+	"azurerm_lb": {
+		populate: populateLoadBalancer,
+		types: []PropertyLikeType{
+			{ResourceType: "azurerm_lb_rule"},
+			{ResourceType: "azurerm_lb_probe"},
+		},
+	},
+	"azurerm_container_app_environment": {
+		populate: populateContainerAppEnv,
+		types: []PropertyLikeType{
+			{ResourceType: "azurerm_container_app_environment_custom_domain"},
+		},
+	},
+	"azurerm_mssql_job": {
+		populate: populateMssqlJob,
+		types: []PropertyLikeType{
+			{ResourceType: "azurerm_mssql_job_schedule"},
+		},
+	},
+	"azurerm_stream_analytics_job": {
+		populate: populateStreamAnalyticsJob,
+		types: []PropertyLikeType{
+			{ResourceType: "azurerm_stream_analytics_job_storage_account"},
+		},
+	},
+	"azurerm_managed_redis": {
+		populate: populateRedisEnterpriseDatabase,
+		types: []PropertyLikeType{
+			{ResourceType: "azurerm_managed_redis_geo_replication"},
+		},
+	},
+}
+
+// PropertyLikeTypes returns the property-like resources that can be populated for the specified TF resource type.
+func PropertyLikeTypes(rt string) []PropertyLikeType {
+	return populaters[rt].types
+}
+
+// PopulaterTypes returns the TF resource types that have a populater, sorted.
+func PopulaterTypes() []string {
+	var out []string
+	for rt := range populaters {
+		out = append(out, rt)
+	}
+	sort.Strings(out)
+	return out
 }
 
 func NeedsAPI(rt string) bool {
@@ -32,8 +148,8 @@ func NeedsAPI(rt string) bool {
 	return ok
 }
 
-func Populate(id armid.ResourceId, rt string, cred azcore.TokenCredential, clientOpt arm.ClientOptions) ([]armid.ResourceId, error) {
-	populater, ok := populaters[rt]
+func Populate(ctx context.Context, id armid.ResourceId, rt string, cred azcore.TokenCredential, clientOpt arm.ClientOptions) ([]armid.ResourceId, error) {
+	p, ok := populaters[rt]
 	if !ok {
 		return nil, nil
 	}
@@ -43,5 +159,5 @@ func Populate(id armid.ResourceId, rt string, cred azcore.TokenCredential, clien
 		ClientOpt: clientOpt,
 	}
 
-	return populater(b, id)
+	return p.populate(ctx, b, id)
 }
@@ -8,15 +8,15 @@ import (
 	"github.com/magodo/aztft/internal/client"
 )
 
-func populateLoadBalancer(b *client.ClientBuilder, id armid.ResourceId) ([]armid.ResourceId, error) {
+func populateLoadBalancer(ctx context.Context, b *client.ClientBuilder, id armid.ResourceId) ([]armid.ResourceId, error) {
 	resourceGroupId := id.RootScope().(*armid.ResourceGroup)
 	client, err := b.NewNetworkLoadBalancersClient(resourceGroupId.SubscriptionId)
 	if err != nil {
 		return nil, err
 	}
-	resp, err := client.Get(context.Background(), resourceGroupId.Name, id.Names()[0], nil)
+	resp, err := client.Get(ctx, resourceGroupId.Name, id.Names()[0], nil)
 	if err != nil {
-		return nil, fmt.Errorf("retrieving %q: %v", id, err)
+		return nil, fmt.Errorf("retrieving %q: %w", id, err)
 	}
 	props := resp.LoadBalancer.Properties
 	if props == nil {
@@ -31,7 +31,7 @@ func populateLoadBalancer(b *client.ClientBuilder, id armid.ResourceId) ([]armid
 		}
 		id, err := armid.ParseResourceId(*rule.ID)
 		if err != nil {
-			return nil, fmt.Errorf("parsing %s: %v", *rule.ID, err)
+			return nil, fmt.Errorf("parsing %s: %w", *rule.ID, err)
 		}
 		result = append(result, id)
 	}
@@ -42,7 +42,7 @@ func populateLoadBalancer(b *client.ClientBuilder, id armid.ResourceId) ([]armid
 		}
 		id, err := armid.ParseResourceId(*probe.ID)
 		if err != nil {
-			return nil, fmt.Errorf("parsing %s: %v", *probe.ID, err)
+			return nil, fmt.Errorf("parsing %s: %w", *probe.ID, err)
 		}
 		result = append(result, id)
 	}
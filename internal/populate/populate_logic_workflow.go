@@ -8,15 +8,15 @@ import (
 	"github.com/magodo/aztft/internal/client"
 )
 
-func populateLogicAppWorkflow(b *client.ClientBuilder, id armid.ResourceId) ([]armid.ResourceId, error) {
+func populateLogicAppWorkflow(ctx context.Context, b *client.ClientBuilder, id armid.ResourceId) ([]armid.ResourceId, error) {
 	resourceGroupId := id.RootScope().(*armid.ResourceGroup)
 	client, err := b.NewLogicWorkflowsClient(resourceGroupId.SubscriptionId)
 	if err != nil {
 		return nil, err
 	}
-	resp, err := client.Get(context.Background(), resourceGroupId.Name, id.Names()[0], nil)
+	resp, err := client.Get(ctx, resourceGroupId.Name, id.Names()[0], nil)
 	if err != nil {
-		return nil, fmt.Errorf("retrieving %q: %v", id, err)
+		return nil, fmt.Errorf("retrieving %q: %w", id, err)
 	}
 	props := resp.Workflow.Properties
 	if props == nil {
@@ -8,15 +8,15 @@ import (
 	"github.com/magodo/aztft/internal/client"
 )
 
-func populateMssqlJob(b *client.ClientBuilder, id armid.ResourceId) ([]armid.ResourceId, error) {
+func populateMssqlJob(ctx context.Context, b *client.ClientBuilder, id armid.ResourceId) ([]armid.ResourceId, error) {
 	resourceGroupId := id.RootScope().(*armid.ResourceGroup)
 	client, err := b.NewSqlJobsClient(resourceGroupId.SubscriptionId)
 	if err != nil {
 		return nil, err
 	}
-	resp, err := client.Get(context.Background(), resourceGroupId.Name, id.Names()[0], id.Names()[1], id.Names()[2], nil)
+	resp, err := client.Get(ctx, resourceGroupId.Name, id.Names()[0], id.Names()[1], id.Names()[2], nil)
 	if err != nil {
-		return nil, fmt.Errorf("retrieving %q: %v", id, err)
+		return nil, fmt.Errorf("retrieving %q: %w", id, err)
 	}
 	props := resp.Job.Properties
 	if props == nil {
@@ -10,15 +10,15 @@ import (
 	"github.com/magodo/aztft/internal/client"
 )
 
-func populateNatGateway(b *client.ClientBuilder, id armid.ResourceId) ([]armid.ResourceId, error) {
+func populateNatGateway(ctx context.Context, b *client.ClientBuilder, id armid.ResourceId) ([]armid.ResourceId, error) {
 	resourceGroupId := id.RootScope().(*armid.ResourceGroup)
 	client, err := b.NewNetworkNatGatewaysClient(resourceGroupId.SubscriptionId)
 	if err != nil {
 		return nil, err
 	}
-	resp, err := client.Get(context.Background(), resourceGroupId.Name, id.Names()[0], nil)
+	resp, err := client.Get(ctx, resourceGroupId.Name, id.Names()[0], nil)
 	if err != nil {
-		return nil, fmt.Errorf("retrieving %q: %v", id, err)
+		return nil, fmt.Errorf("retrieving %q: %w", id, err)
 	}
 	props := resp.NatGateway.Properties
 	if props == nil {
@@ -27,11 +27,11 @@ func populateNatGateway(b *client.ClientBuilder, id armid.ResourceId) ([]armid.R
 
 	pipAssociations, err := natGatewayPopulatePublicIpAssociation(id, props)
 	if err != nil {
-		return nil, fmt.Errorf("populating for public ip associations: %v", err)
+		return nil, fmt.Errorf("populating for public ip associations: %w", err)
 	}
 	pipPrefixAssociations, err := natGatewayPopulatePublicIpPrefixAssociation(id, props)
 	if err != nil {
-		return nil, fmt.Errorf("populating for public ip prefix associations: %v", err)
+		return nil, fmt.Errorf("populating for public ip prefix associations: %w", err)
 	}
 
 	var result []armid.ResourceId
@@ -53,7 +53,7 @@ func natGatewayPopulatePublicIpAssociation(id armid.ResourceId, props *armnetwor
 		}
 		pipId, err := armid.ParseResourceId(*pip.ID)
 		if err != nil {
-			return nil, fmt.Errorf("parsing resource id %q: %v", *pip.ID, err)
+			return nil, fmt.Errorf("parsing resource id %q: %w", *pip.ID, err)
 		}
 		azureId := id.Clone().(*armid.ScopedResourceId)
 		azureId.AttrTypes = append(azureId.AttrTypes, "publicIPAddresses")
@@ -77,7 +77,7 @@ func natGatewayPopulatePublicIpPrefixAssociation(id armid.ResourceId, props *arm
 		}
 		prefixId, err := armid.ParseResourceId(*prefix.ID)
 		if err != nil {
-			return nil, fmt.Errorf("parsing resource id %q: %v", *prefix.ID, err)
+			return nil, fmt.Errorf("parsing resource id %q: %w", *prefix.ID, err)
 		}
 		azureId := id.Clone().(*armid.ScopedResourceId)
 		azureId.AttrTypes = append(azureId.AttrTypes, "publicIPPrefixes")
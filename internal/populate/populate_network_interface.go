@@ -10,15 +10,15 @@ import (
 	"github.com/magodo/aztft/internal/client"
 )
 
-func populateNetworkInterface(b *client.ClientBuilder, id armid.ResourceId) ([]armid.ResourceId, error) {
+func populateNetworkInterface(ctx context.Context, b *client.ClientBuilder, id armid.ResourceId) ([]armid.ResourceId, error) {
 	resourceGroupId := id.RootScope().(*armid.ResourceGroup)
 	client, err := b.NewNetworkInterfacesClient(resourceGroupId.SubscriptionId)
 	if err != nil {
 		return nil, err
 	}
-	resp, err := client.Get(context.Background(), resourceGroupId.Name, id.Names()[0], nil)
+	resp, err := client.Get(ctx, resourceGroupId.Name, id.Names()[0], nil)
 	if err != nil {
-		return nil, fmt.Errorf("retrieving %q: %v", id, err)
+		return nil, fmt.Errorf("retrieving %q: %w", id, err)
 	}
 	props := resp.Interface.Properties
 	if props == nil {
@@ -27,12 +27,12 @@ func populateNetworkInterface(b *client.ClientBuilder, id armid.ResourceId) ([]a
 
 	nsgAssociations, err := networkInterfacePopulateNSGAssociation(id, props)
 	if err != nil {
-		return nil, fmt.Errorf("populating for NSG associations: %v", err)
+		return nil, fmt.Errorf("populating for NSG associations: %w", err)
 	}
 
 	bapAssociations, err := networkInterfacePopulateIpConfigAssociations(id, props)
 	if err != nil {
-		return nil, fmt.Errorf("populating for Application Gateway BAP associations: %v", err)
+		return nil, fmt.Errorf("populating for Application Gateway BAP associations: %w", err)
 	}
 
 	var result []armid.ResourceId
@@ -53,7 +53,7 @@ func networkInterfacePopulateNSGAssociation(id armid.ResourceId, props *armnetwo
 
 	nsgAzureId, err := armid.ParseResourceId(*nsgId)
 	if err != nil {
-		return nil, fmt.Errorf("parsing resource id %q: %v", *nsgId, err)
+		return nil, fmt.Errorf("parsing resource id %q: %w", *nsgId, err)
 	}
 	azureId := id.Clone().(*armid.ScopedResourceId)
 	azureId.AttrTypes = append(azureId.AttrTypes, "networkSecurityGroups")
@@ -80,7 +80,7 @@ func networkInterfacePopulateIpConfigAssociations(id armid.ResourceId, props *ar
 
 		ipConfigId, err := armid.ParseResourceId(*ipConfig.ID)
 		if err != nil {
-			return nil, fmt.Errorf("parsing %s: %v", *ipConfig.ID, err)
+			return nil, fmt.Errorf("parsing %s: %w", *ipConfig.ID, err)
 		}
 
 		for _, bap := range ipConfigProps.ApplicationGatewayBackendAddressPools {
@@ -133,7 +133,7 @@ func networkInterfacePopulateIpConfigAssociations(id armid.ResourceId, props *ar
 func networkInterfacePopulateIpConfigApplicationGatewayBackendAddressPoolAssociation(ipConfigId armid.ResourceId, id string) (armid.ResourceId, error) {
 	bapId, err := armid.ParseResourceId(id)
 	if err != nil {
-		return nil, fmt.Errorf("parsing %s: %v", id, err)
+		return nil, fmt.Errorf("parsing %s: %w", id, err)
 	}
 	azureId := ipConfigId.Clone().(*armid.ScopedResourceId)
 	azureId.AttrTypes = append(azureId.AttrTypes, "applicationGatewayBackendAddressPools")
@@ -144,7 +144,7 @@ func networkInterfacePopulateIpConfigApplicationGatewayBackendAddressPoolAssocia
 func networkInterfacePopulateIpConfigApplicationSecurityGroupAssociation(ipConfigId armid.ResourceId, id string) (armid.ResourceId, error) {
 	asgId, err := armid.ParseResourceId(id)
 	if err != nil {
-		return nil, fmt.Errorf("parsing %s: %v", id, err)
+		return nil, fmt.Errorf("parsing %s: %w", id, err)
 	}
 	azureId := ipConfigId.Clone().(*armid.ScopedResourceId)
 	azureId.AttrTypes = append(azureId.AttrTypes, "applicationSecurityGroups")
@@ -155,7 +155,7 @@ func networkInterfacePopulateIpConfigApplicationSecurityGroupAssociation(ipConfi
 func networkInterfacePopulateIpConfigLoadBalancerNatRuleAssociation(ipConfigId armid.ResourceId, id string) (armid.ResourceId, error) {
 	natRuleId, err := armid.ParseResourceId(id)
 	if err != nil {
-		return nil, fmt.Errorf("parsing %s: %v", id, err)
+		return nil, fmt.Errorf("parsing %s: %w", id, err)
 	}
 	azureId := ipConfigId.Clone().(*armid.ScopedResourceId)
 	azureId.AttrTypes = append(azureId.AttrTypes, "loadBalancerInboundNatRules")
@@ -166,7 +166,7 @@ func networkInterfacePopulateIpConfigLoadBalancerNatRuleAssociation(ipConfigId a
 func networkInterfacePopulateIpConfigLoadBalancerBackendAddressPoolAssociation(ipConfigId armid.ResourceId, id string) (armid.ResourceId, error) {
 	bapId, err := armid.ParseResourceId(id)
 	if err != nil {
-		return nil, fmt.Errorf("parsing %s: %v", id, err)
+		return nil, fmt.Errorf("parsing %s: %w", id, err)
 	}
 	azureId := ipConfigId.Clone().(*armid.ScopedResourceId)
 	azureId.AttrTypes = append(azureId.AttrTypes, "loadBalancerBackendAddressPools")
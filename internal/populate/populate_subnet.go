@@ -9,15 +9,15 @@ import (
 	"github.com/magodo/aztft/internal/client"
 )
 
-func populateSubnet(b *client.ClientBuilder, id armid.ResourceId) ([]armid.ResourceId, error) {
+func populateSubnet(ctx context.Context, b *client.ClientBuilder, id armid.ResourceId) ([]armid.ResourceId, error) {
 	resourceGroupId := id.RootScope().(*armid.ResourceGroup)
 	client, err := b.NewNetworkSubnetsClient(resourceGroupId.SubscriptionId)
 	if err != nil {
 		return nil, err
 	}
-	resp, err := client.Get(context.Background(), resourceGroupId.Name, id.Names()[0], id.Names()[1], nil)
+	resp, err := client.Get(ctx, resourceGroupId.Name, id.Names()[0], id.Names()[1], nil)
 	if err != nil {
-		return nil, fmt.Errorf("retrieving %q: %v", id, err)
+		return nil, fmt.Errorf("retrieving %q: %w", id, err)
 	}
 	props := resp.Subnet.Properties
 	if props == nil {
@@ -29,7 +29,7 @@ func populateSubnet(b *client.ClientBuilder, id armid.ResourceId) ([]armid.Resou
 	if props.RouteTable != nil && props.RouteTable.ID != nil {
 		routeTableId, err := armid.ParseResourceId(*props.RouteTable.ID)
 		if err != nil {
-			return nil, fmt.Errorf("parsing %s: %v", *props.RouteTable.ID, err)
+			return nil, fmt.Errorf("parsing %s: %w", *props.RouteTable.ID, err)
 		}
 		azureId := id.Clone().(*armid.ScopedResourceId)
 		azureId.AttrTypes = append(azureId.AttrTypes, "routeTables")
@@ -40,7 +40,7 @@ func populateSubnet(b *client.ClientBuilder, id armid.ResourceId) ([]armid.Resou
 	if props.NetworkSecurityGroup != nil && props.NetworkSecurityGroup.ID != nil {
 		nsgId, err := armid.ParseResourceId(*props.NetworkSecurityGroup.ID)
 		if err != nil {
-			return nil, fmt.Errorf("parsing %s: %v", *props.NetworkSecurityGroup.ID, err)
+			return nil, fmt.Errorf("parsing %s: %w", *props.NetworkSecurityGroup.ID, err)
 		}
 		azureId := id.Clone().(*armid.ScopedResourceId)
 		azureId.AttrTypes = append(azureId.AttrTypes, "networkSecurityGroups")
@@ -51,7 +51,7 @@ func populateSubnet(b *client.ClientBuilder, id armid.ResourceId) ([]armid.Resou
 	if props.NatGateway != nil && props.NatGateway.ID != nil {
 		natGwId, err := armid.ParseResourceId(*props.NatGateway.ID)
 		if err != nil {
-			return nil, fmt.Errorf("parsing %s: %v", *props.NatGateway.ID, err)
+			return nil, fmt.Errorf("parsing %s: %w", *props.NatGateway.ID, err)
 		}
 		azureId := id.Clone().(*armid.ScopedResourceId)
 		azureId.AttrTypes = append(azureId.AttrTypes, "natGateways")
@@ -0,0 +1,124 @@
+package populate
+
+import (
+	"context"
+	"encoding/base64"
+	"strings"
+	"testing"
+
+	"github.com/magodo/armid"
+	"github.com/magodo/aztft/internal/client"
+	"github.com/magodo/aztft/internal/resmap"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPopulate(t *testing.T) {
+	fixture, err := client.LoadFixture("testdata/populate.json")
+	require.NoError(t, err)
+	m, err := resmap.Embedded()
+	require.NoError(t, err)
+
+	b64 := func(s string) string { return base64.StdEncoding.EncodeToString([]byte(s)) }
+
+	cases := []struct {
+		name   string
+		id     string
+		rt     string
+		expect []string
+		err    bool
+	}{
+		{
+			name: "virtual machine",
+			id:   "/subscriptions/sub1/resourceGroups/rg1/providers/Microsoft.Compute/virtualMachines/vm1",
+			rt:   "azurerm_linux_virtual_machine",
+			expect: []string{
+				"/subscriptions/sub1/resourceGroups/rg1/providers/Microsoft.Compute/virtualMachines/vm1/dataDisks/disk1",
+			},
+		},
+		{
+			name: "nat gateway",
+			id:   "/subscriptions/sub1/resourceGroups/rg1/providers/Microsoft.Network/natGateways/ngw1",
+			rt:   "azurerm_nat_gateway",
+			expect: []string{
+				"/subscriptions/sub1/resourceGroups/rg1/providers/Microsoft.Network/natGateways/ngw1/publicIPAddresses/" + b64("/subscriptions/sub1/resourceGroups/rg1/providers/Microsoft.Network/publicIPAddresses/pip1"),
+				"/subscriptions/sub1/resourceGroups/rg1/providers/Microsoft.Network/natGateways/ngw1/publicIPPrefixes/" + b64("/subscriptions/sub1/resourceGroups/rg1/providers/Microsoft.Network/publicIPPrefixes/prefix1"),
+			},
+		},
+		{
+			name: "network interface",
+			id:   "/subscriptions/sub1/resourceGroups/rg1/providers/Microsoft.Network/networkInterfaces/nic1",
+			rt:   "azurerm_network_interface",
+			expect: []string{
+				"/subscriptions/sub1/resourceGroups/rg1/providers/Microsoft.Network/networkInterfaces/nic1/networkSecurityGroups/" + b64("/subscriptions/sub1/resourceGroups/rg1/providers/Microsoft.Network/networkSecurityGroups/nsg1"),
+				"/subscriptions/sub1/resourceGroups/rg1/providers/Microsoft.Network/networkInterfaces/nic1/ipConfigurations/ipconfig1/loadBalancerBackendAddressPools/" + b64("/subscriptions/sub1/resourceGroups/rg1/providers/Microsoft.Network/loadBalancers/lb1/backendAddressPools/pool1"),
+			},
+		},
+		{
+			name: "no populater",
+			id:   "/subscriptions/sub1/resourceGroups/rg1/providers/Microsoft.Network/virtualNetworks/vnet1",
+			rt:   "azurerm_virtual_network",
+		},
+		{
+			name: "resource not recorded",
+			id:   "/subscriptions/sub1/resourceGroups/rg1/providers/Microsoft.Compute/virtualMachines/vm2",
+			rt:   "azurerm_windows_virtual_machine",
+			err:  true,
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			id, err := armid.ParseResourceId(tt.id)
+			require.NoError(t, err)
+			b := client.NewReplayClientBuilder(fixture)
+			ids, err := Populate(context.Background(), id, tt.rt, b.Cred, b.ClientOpt)
+			if tt.err {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			var actual []string
+			for _, id := range ids {
+				actual = append(actual, id.String())
+				require.True(t, populatedType(m, id, PropertyLikeTypes(tt.rt)), "%s isn't any of the property-like types of %s", id, tt.rt)
+			}
+			require.Equal(t, tt.expect, actual)
+		})
+	}
+}
+
+func TestPropertyLikeTypes(t *testing.T) {
+	m, err := resmap.Embedded()
+	require.NoError(t, err)
+	for rt := range populaters {
+		plts := PropertyLikeTypes(rt)
+		require.NotEmpty(t, plts, rt)
+		for _, plt := range plts {
+			require.Contains(t, m.TF2ARMIdMap, plt.ResourceType)
+			if plt.RefResourceType != "" {
+				require.Contains(t, m.TF2ARMIdMap, plt.RefResourceType)
+			}
+		}
+	}
+}
+
+// populatedType tells whether the populated pesudo resource id is of any of the property-like types.
+func populatedType(m *resmap.Mapping, id armid.ResourceId, plts []PropertyLikeType) bool {
+	for _, plt := range plts {
+		mp := m.TF2ARMIdMap[plt.ResourceType].ManagementPlane
+		if mp == nil || !strings.EqualFold(mp.Provider, id.Provider()) || len(mp.Types) != len(id.Types()) {
+			continue
+		}
+		match := true
+		for i, typ := range mp.Types {
+			if !strings.EqualFold(typ, id.Types()[i]) {
+				match = false
+				break
+			}
+		}
+		if match {
+			return true
+		}
+	}
+	return false
+}
@@ -9,15 +9,15 @@ import (
 	"github.com/magodo/aztft/internal/client"
 )
 
-func populateVirtualDesktopWorkspace(b *client.ClientBuilder, id armid.ResourceId) ([]armid.ResourceId, error) {
+func populateVirtualDesktopWorkspace(ctx context.Context, b *client.ClientBuilder, id armid.ResourceId) ([]armid.ResourceId, error) {
 	resourceGroupId := id.RootScope().(*armid.ResourceGroup)
 	client, err := b.NewDesktopVirtualizationWorkspacesClient(resourceGroupId.SubscriptionId)
 	if err != nil {
 		return nil, err
 	}
-	resp, err := client.Get(context.Background(), resourceGroupId.Name, id.Names()[0], nil)
+	resp, err := client.Get(ctx, resourceGroupId.Name, id.Names()[0], nil)
 	if err != nil {
-		return nil, fmt.Errorf("retrieving %q: %v", id, err)
+		return nil, fmt.Errorf("retrieving %q: %w", id, err)
 	}
 	props := resp.Workspace.Properties
 	if props == nil {
@@ -35,7 +35,7 @@ func populateVirtualDesktopWorkspace(b *client.ClientBuilder, id armid.ResourceI
 	for _, applicationGroupId := range applicationGroupIds {
 		applicationGroupAzureId, err := armid.ParseResourceId(applicationGroupId)
 		if err != nil {
-			return nil, fmt.Errorf("parsing resource id %q: %v", applicationGroupId, err)
+			return nil, fmt.Errorf("parsing resource id %q: %w", applicationGroupId, err)
 		}
 		azureId := id.Clone().(*armid.ScopedResourceId)
 		azureId.AttrTypes = append(azureId.AttrTypes, "applicationGroups")
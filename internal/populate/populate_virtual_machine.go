@@ -8,15 +8,15 @@ import (
 	"github.com/magodo/aztft/internal/client"
 )
 
-func populateVirtualMachine(b *client.ClientBuilder, id armid.ResourceId) ([]armid.ResourceId, error) {
+func populateVirtualMachine(ctx context.Context, b *client.ClientBuilder, id armid.ResourceId) ([]armid.ResourceId, error) {
 	resourceGroupId := id.RootScope().(*armid.ResourceGroup)
 	client, err := b.NewVirtualMachinesClient(resourceGroupId.SubscriptionId)
 	if err != nil {
 		return nil, err
 	}
-	resp, err := client.Get(context.Background(), resourceGroupId.Name, id.Names()[0], nil)
+	resp, err := client.Get(ctx, resourceGroupId.Name, id.Names()[0], nil)
 	if err != nil {
-		return nil, fmt.Errorf("retrieving %q: %v", id, err)
+		return nil, fmt.Errorf("retrieving %q: %w", id, err)
 	}
 	props := resp.VirtualMachine.Properties
 	if props == nil {
@@ -46,7 +46,7 @@ func populateVirtualMachine(b *client.ClientBuilder, id armid.ResourceId) ([]arm
 	for _, mdiskId := range mdiskIds {
 		mdiskAzureId, err := armid.ParseResourceId(mdiskId)
 		if err != nil {
-			return nil, fmt.Errorf("parsing resource id %q: %v", mdiskId, err)
+			return nil, fmt.Errorf("parsing resource id %q: %w", mdiskId, err)
 		}
 		diskName := mdiskAzureId.Names()[0]
 
@@ -1,32 +1,116 @@
 package resmap
 
 import (
-	_ "embed"
 	"encoding/json"
+	"regexp"
+	"sort"
 	"strings"
 	"sync"
 )
 
+//go:generate go run ../../tool/gen-resmap-tables -o tables_gen.go map.json
+
 var (
-	//go:embed map.json
-	mappingContent []byte
+	embedded     *Mapping
+	embeddedOnce sync.Once
+)
 
+// Mapping is the mapping between the TF resource types and the ARM resource types. It is immutable once built.
+type Mapping struct {
 	TF2ARMIdMap TF2ARMIdMapType
 	ARMId2TFMap ARMId2TFMapType
 
-	once sync.Once
-)
+	// RemovedARMId2TFMap is similar to ARMId2TFMap, except it only contains the removed TF resource types.
+	RemovedARMId2TFMap ARMId2TFMapType
 
-func Init() {
-	once.Do(func() {
-		if err := json.Unmarshal(mappingContent, &TF2ARMIdMap); err != nil {
-			panic(err.Error())
+	latestSnapshot *Snapshot
+}
+
+// Embedded returns the mapping of the embedded "map.json", which is only built once.
+// It is built from the tables generated from the "map.json" (see tables_gen.go), instead of unmarshalling and indexing the JSON at runtime.
+func Embedded() (*Mapping, error) {
+	embeddedOnce.Do(func() {
+		embedded = newEmbeddedMapping()
+	})
+	return embedded, nil
+}
+
+// tf2ARMIdEntry is an entry of the generated table of the TF2ARMIdMapType, which is sorted by the resource type.
+type tf2ARMIdEntry struct {
+	resourceType string
+	item         TF2ARMIdMapItem
+}
+
+// armId2TFEntry is an entry of the generated table of the ARMId2TFMapType, which is sorted by the routing key and then the scope.
+type armId2TFEntry struct {
+	routingKey string
+	scope      string
+	items      []ARMId2TFMapItem
+}
+
+func newEmbeddedMapping() *Mapping {
+	m := make(TF2ARMIdMapType, len(tf2ARMIdTable))
+	for _, e := range tf2ARMIdTable {
+		m[e.resourceType] = e.item
+	}
+	snapshot := &Snapshot{ProviderVersion: LatestProviderVersion, ResourceTypes: latestResourceTypes}
+	if err := snapshot.Init(); err != nil {
+		panic(err.Error())
+	}
+	return &Mapping{
+		TF2ARMIdMap:        m,
+		ARMId2TFMap:        newARMId2TFMap(armId2TFTable),
+		RemovedARMId2TFMap: newARMId2TFMap(removedARMId2TFTable),
+		latestSnapshot:     snapshot,
+	}
+}
+
+func newARMId2TFMap(table []armId2TFEntry) ARMId2TFMapType {
+	out := ARMId2TFMapType{}
+	for _, e := range table {
+		b, ok := out[e.routingKey]
+		if !ok {
+			b = map[string][]ARMId2TFMapItem{}
+			out[e.routingKey] = b
 		}
-		var err error
-		if ARMId2TFMap, err = TF2ARMIdMap.toARM2TFMap(); err != nil {
-			panic(err.Error())
+		b[e.scope] = e.items
+	}
+	return out
+}
+
+// LoadMapping loads the mapping from the JSON of the TF2ARMIdMapType.
+func LoadMapping(b []byte) (*Mapping, error) {
+	var m TF2ARMIdMapType
+	if err := json.Unmarshal(b, &m); err != nil {
+		return nil, err
+	}
+	return NewMapping(m)
+}
+
+// NewMapping builds the mapping from the TF2ARMIdMapType, which is copied.
+func NewMapping(mps TF2ARMIdMapType) (*Mapping, error) {
+	m := TF2ARMIdMapType{}
+	for rt, item := range mps {
+		item.Deprecation = nil
+		if item.IsRemoved {
+			item.Deprecation = newDeprecation(item.RemoveReason)
 		}
-	})
+		m[rt] = item
+	}
+	armMap, err := m.toARM2TFMap(false)
+	if err != nil {
+		return nil, err
+	}
+	removedArmMap, err := m.toARM2TFMap(true)
+	if err != nil {
+		return nil, err
+	}
+	return &Mapping{
+		TF2ARMIdMap:        m,
+		ARMId2TFMap:        armMap,
+		RemovedARMId2TFMap: removedArmMap,
+		latestSnapshot:     newLatestSnapshot(m),
+	}, nil
 }
 
 // TF2ARMIdMapType maps from TF resource type to the ARM item
@@ -37,6 +121,76 @@ type TF2ARMIdMapItem struct {
 
 	// Indicates whether this TF resource is removed/deprecated
 	IsRemoved bool `json:"is_removed,omitempty"`
+
+	// RemoveReason explains why this TF resource is removed/deprecated
+	RemoveReason string `json:"remove_reason,omitempty"`
+
+	// Deprecation is the structured form of the RemoveReason, which is only set for the removed TF resource.
+	Deprecation *Deprecation `json:"-"`
+}
+
+type Deprecation struct {
+	Reason string
+
+	// Successors are the TF resource types that supersede the removed one, which are parsed from the reason.
+	Successors []string
+}
+
+var successorPattern = regexp.MustCompile(`\bazurerm_[a-z0-9_]+\b`)
+
+func newDeprecation(reason string) *Deprecation {
+	return &Deprecation{
+		Reason:     reason,
+		Successors: successorPattern.FindAllString(reason, -1),
+	}
+}
+
+// Merge returns a new map that is the overlay merged over this map. For each TF resource type in the overlay:
+//   - If it doesn't exist in this map, it is added.
+//   - Otherwise, the non-empty fields of the overlay item (including each field of the management plane) override the ones of this map.
+//     Especially, a TF resource type can be marked as removed, but can't be unmarked.
+func (mps TF2ARMIdMapType) Merge(overlay TF2ARMIdMapType) TF2ARMIdMapType {
+	out := TF2ARMIdMapType{}
+	for rt, item := range mps {
+		out[rt] = item
+	}
+	for rt, oitem := range overlay {
+		item, ok := out[rt]
+		if !ok {
+			out[rt] = oitem
+			continue
+		}
+		if oitem.IsRemoved {
+			item.IsRemoved = true
+		}
+		if oitem.RemoveReason != "" {
+			item.RemoveReason = oitem.RemoveReason
+		}
+		if omm := oitem.ManagementPlane; omm != nil {
+			mm := &MapManagementPlane{}
+			if item.ManagementPlane != nil {
+				*mm = *item.ManagementPlane
+			}
+			if omm.ParentScopes != nil {
+				mm.ParentScopes = omm.ParentScopes
+			}
+			if omm.Provider != "" {
+				mm.Provider = omm.Provider
+			}
+			if omm.Types != nil {
+				mm.Types = omm.Types
+			}
+			if omm.ImportSpecs != nil {
+				mm.ImportSpecs = omm.ImportSpecs
+			}
+			if omm.IdTransform != nil {
+				mm.IdTransform = omm.IdTransform
+			}
+			item.ManagementPlane = mm
+		}
+		out[rt] = item
+	}
+	return out
 }
 
 const ScopeAny string = "any"
@@ -54,6 +208,72 @@ type MapManagementPlane struct {
 	// Each item should correspond to the item in the ParentScopes, representing a valid import spec in that parent scope.
 	// Exceptionally, this might be empty given no import spec is available. This maybe because the parent scope is "any", or this is a root scope resource id.
 	ImportSpecs []string `json:"import_specs,omitempty"`
+
+	// IdTransform describes how the Azure resource id is transformed to the TF resource id, before being normalized by the import spec.
+	IdTransform *IdTransform `json:"id_transform,omitempty"`
+}
+
+// The projections of the IdTransform.
+const (
+	ProjectionParent      = "parent"
+	ProjectionGrandParent = "grandparent"
+	ProjectionParentScope = "parent_scope"
+)
+
+// IdTransform describes how the Azure resource id is transformed to the TF resource id, which is applied in the order of the fields.
+type IdTransform struct {
+	// ConstantNames maps the resource type (case insensitively) of the segments to their constant names, e.g. "default" for the "blobServices".
+	// For the segments dropped by the Projection, the constant names are used to build the Azure resource id back from the TF resource id.
+	ConstantNames map[string]string `json:"constant_names,omitempty"`
+
+	// TypeRenames maps the resource type (case insensitively) of the segments to the ones used in the TF resource id,
+	// e.g. from the pesudo resource type "endpointsEventhub" to "endpoints".
+	TypeRenames map[string]string `json:"type_renames,omitempty"`
+
+	// Projection projects the Azure resource id to its ancestor as the TF resource id, which is one of "parent", "grandparent" and "parent_scope".
+	Projection string `json:"projection,omitempty"`
+}
+
+// ProjectedTypes returns the number of the trailing resource types of the management plane that are dropped by the projection.
+func (mm *MapManagementPlane) ProjectedTypes() int {
+	if mm.IdTransform == nil {
+		return 0
+	}
+	switch mm.IdTransform.Projection {
+	case ProjectionParent:
+		return 1
+	case ProjectionGrandParent:
+		return 2
+	case ProjectionParentScope:
+		return len(mm.Types)
+	}
+	return 0
+}
+
+// ConstantName returns the constant name of the resource type segment, if any.
+func (t *IdTransform) ConstantName(rt string) (string, bool) {
+	if t == nil {
+		return "", false
+	}
+	for k, v := range t.ConstantNames {
+		if strings.EqualFold(k, rt) {
+			return v, true
+		}
+	}
+	return "", false
+}
+
+// RenameType returns the resource type used in the TF resource id for the resource type segment.
+func (t *IdTransform) RenameType(rt string) string {
+	if t == nil {
+		return rt
+	}
+	for k, v := range t.TypeRenames {
+		if strings.EqualFold(k, rt) {
+			return v
+		}
+	}
+	return rt
 }
 
 // ARMId2TFMapType maps from "<provider>/<types>" (routing scope) to "<parent scope string> | any" to the TF item(s)
@@ -64,10 +284,11 @@ type ARMId2TFMapItem struct {
 	ImportSpec   string
 }
 
-func (mps TF2ARMIdMapType) toARM2TFMap() (ARMId2TFMapType, error) {
+// toARM2TFMap builds the ARMId2TFMapType from either the removed TF resource types, or the others.
+func (mps TF2ARMIdMapType) toARM2TFMap(removed bool) (ARMId2TFMapType, error) {
 	out := ARMId2TFMapType{}
 	for rt, item := range mps {
-		if item.IsRemoved {
+		if item.IsRemoved != removed {
 			continue
 		}
 		if item.ManagementPlane == nil {
@@ -109,6 +330,14 @@ func (mps TF2ARMIdMapType) toARM2TFMap() (ARMId2TFMapType, error) {
 			b[k2] = append(b[k2], item)
 		}
 	}
+	// Sort the TF items to make the output deterministic.
+	for _, b := range out {
+		for _, l := range b {
+			sort.Slice(l, func(i, j int) bool {
+				return l[i].ResourceType < l[j].ResourceType
+			})
+		}
+	}
 	return out, nil
 }
 
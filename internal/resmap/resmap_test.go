@@ -0,0 +1,51 @@
+package resmap
+
+import (
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// TestEmbeddedUpToDate ensures the generated tables are regenerated (via `go generate`) after the "map.json" changes.
+func TestEmbeddedUpToDate(t *testing.T) {
+	b, err := os.ReadFile("map.json")
+	require.NoError(t, err)
+	expect, err := LoadMapping(b)
+	require.NoError(t, err)
+	require.Equal(t, expect, newEmbeddedMapping(), "the generated tables are out of date, run `go generate ./internal/resmap`")
+}
+
+// TestEmbeddedAllocs guards that building the mapping from the generated tables stays much cheaper than loading it from the JSON.
+func TestEmbeddedAllocs(t *testing.T) {
+	b, err := os.ReadFile("map.json")
+	require.NoError(t, err)
+	jsonAllocs := testing.AllocsPerRun(1, func() {
+		if _, err := LoadMapping(b); err != nil {
+			t.Fatal(err)
+		}
+	})
+	tableAllocs := testing.AllocsPerRun(1, func() {
+		newEmbeddedMapping()
+	})
+	require.Less(t, tableAllocs*4, jsonAllocs)
+}
+
+func BenchmarkEmbedded(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		newEmbeddedMapping()
+	}
+}
+
+func BenchmarkLoadMapping(b *testing.B) {
+	content, err := os.ReadFile("map.json")
+	require.NoError(b, err)
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := LoadMapping(content); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
@@ -0,0 +1,167 @@
+package resmap
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// LatestProviderVersion is the azurerm provider version range that the mapping corresponds to, where the TF resource types
+// that exist are exactly the ones not removed.
+const LatestProviderVersion = ">= 4.0.0"
+
+// Snapshot records the TF resource types that exist in a range of the azurerm provider versions.
+// The mapping of each type is still looked up from the Mapping, where a removed type is regarded as existing if it is in the snapshot.
+type Snapshot struct {
+	// ProviderVersion is a comma separated list of version constraints, e.g. ">= 3.0.0, < 4.0.0".
+	// Each constraint has an operator of "=", "!=", ">", ">=", "<" or "<=", which defaults to "=" if omitted.
+	ProviderVersion string `json:"provider_version"`
+
+	ResourceTypes []string `json:"resource_types"`
+
+	constraints []constraint
+	types       map[string]bool
+}
+
+func ParseSnapshot(b []byte) (*Snapshot, error) {
+	var s Snapshot
+	if err := json.Unmarshal(b, &s); err != nil {
+		return nil, err
+	}
+	if err := s.Init(); err != nil {
+		return nil, err
+	}
+	return &s, nil
+}
+
+// LatestSnapshot returns the snapshot of the mapping, i.e. all the TF resource types that are not removed.
+func (m *Mapping) LatestSnapshot() *Snapshot {
+	return m.latestSnapshot
+}
+
+func newLatestSnapshot(m TF2ARMIdMapType) *Snapshot {
+	s := Snapshot{ProviderVersion: LatestProviderVersion}
+	for rt, item := range m {
+		if !item.IsRemoved {
+			s.ResourceTypes = append(s.ResourceTypes, rt)
+		}
+	}
+	sort.Strings(s.ResourceTypes)
+	if err := s.Init(); err != nil {
+		panic(err.Error())
+	}
+	return &s
+}
+
+// Init validates the provider version and indexes the resource types, which must be called before using a snapshot that is not built by ParseSnapshot.
+func (s *Snapshot) Init() error {
+	constraints, err := parseConstraints(s.ProviderVersion)
+	if err != nil {
+		return fmt.Errorf("invalid provider version %q: %w", s.ProviderVersion, err)
+	}
+	s.constraints = constraints
+	s.types = map[string]bool{}
+	for _, rt := range s.ResourceTypes {
+		s.types[rt] = true
+	}
+	return nil
+}
+
+// Match tells whether the provider version is within the range of this snapshot.
+func (s *Snapshot) Match(version string) (bool, error) {
+	v, err := parseVersion(version)
+	if err != nil {
+		return false, err
+	}
+	for _, c := range s.constraints {
+		if !c.check(v) {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+// Has tells whether the TF resource type exists in this snapshot. The fake resource types that are only used internally always exist.
+func (s *Snapshot) Has(rt string) bool {
+	return strings.HasPrefix(rt, "fake_") || s.types[rt]
+}
+
+type version [3]int
+
+func parseVersion(input string) (version, error) {
+	var v version
+	s := strings.TrimPrefix(strings.TrimSpace(input), "v")
+	// Ignore the pre-release and the build metadata
+	if i := strings.IndexAny(s, "-+"); i != -1 {
+		s = s[:i]
+	}
+	segs := strings.Split(s, ".")
+	if len(segs) > len(v) {
+		return v, fmt.Errorf("malformed version %q", input)
+	}
+	for i, seg := range segs {
+		n, err := strconv.Atoi(seg)
+		if err != nil || n < 0 {
+			return v, fmt.Errorf("malformed version %q", input)
+		}
+		v[i] = n
+	}
+	return v, nil
+}
+
+func (v version) compare(o version) int {
+	for i := range v {
+		if v[i] != o[i] {
+			if v[i] < o[i] {
+				return -1
+			}
+			return 1
+		}
+	}
+	return 0
+}
+
+type constraint struct {
+	op      string
+	version version
+}
+
+func (c constraint) check(v version) bool {
+	n := v.compare(c.version)
+	switch c.op {
+	case "!=":
+		return n != 0
+	case ">":
+		return n > 0
+	case ">=":
+		return n >= 0
+	case "<":
+		return n < 0
+	case "<=":
+		return n <= 0
+	default:
+		return n == 0
+	}
+}
+
+func parseConstraints(input string) ([]constraint, error) {
+	var constraints []constraint
+	for _, s := range strings.Split(input, ",") {
+		s = strings.TrimSpace(s)
+		var op string
+		for _, candidate := range []string{"!=", ">=", "<=", ">", "<", "="} {
+			if strings.HasPrefix(s, candidate) {
+				op = candidate
+				break
+			}
+		}
+		v, err := parseVersion(strings.TrimPrefix(s, op))
+		if err != nil {
+			return nil, err
+		}
+		constraints = append(constraints, constraint{op: op, version: v})
+	}
+	return constraints, nil
+}
@@ -0,0 +1,2041 @@
+// Code generated by gen-resmap-tables; DO NOT EDIT.
+
+package resmap
+
+var tf2ARMIdTable = []tf2ARMIdEntry{
+	{"azurerm_aadb2c_directory", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.AzureActiveDirectory", Types: []string{"b2cDirectories"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.AzureActiveDirectory/b2cDirectories"}}}},
+	{"azurerm_active_directory_domain_service", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.AAD", Types: []string{"domainServices"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.AAD/domainServices/initialReplicaSetId"}}}},
+	{"azurerm_active_directory_domain_service_replica_set", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.AAD", Types: []string{"domainServices", "replicaSets"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.AAD/domainServices/replicaSets"}}}},
+	{"azurerm_active_directory_domain_service_trust", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.AAD", Types: []string{"domainServices", "trusts"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.AAD/domainServices/trusts"}}, IsRemoved: true, RemoveReason: "This is a property rather than a resource", Deprecation: &Deprecation{Reason: "This is a property rather than a resource", Successors: nil}}},
+	{"azurerm_advanced_threat_protection", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups/Microsoft.Storage/storageAccounts"}, Provider: "Microsoft.Security", Types: []string{"advancedThreatProtectionSettings"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Storage/storageAccounts/Microsoft.Security/advancedThreatProtectionSettings"}}}},
+	{"azurerm_advisor_suppression", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions"}, Provider: "Microsoft.Advisor", Types: []string{"recommendations", "suppressions"}, ImportSpecs: []string{"/subscriptions/Microsoft.Advisor/recommendations/suppressions"}}}},
+	{"azurerm_ai_foundry", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.MachineLearningServices", Types: []string{"workspaces"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.MachineLearningServices/workspaces"}}}},
+	{"azurerm_ai_foundry_project", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.MachineLearningServices", Types: []string{"workspaces"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.MachineLearningServices/workspaces"}}}},
+	{"azurerm_ai_services", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.CognitiveServices", Types: []string{"accounts"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.CognitiveServices/accounts"}}, IsRemoved: true, RemoveReason: "This is deprecated in favor of `azurerm_cognitive_account`", Deprecation: &Deprecation{Reason: "This is deprecated in favor of `azurerm_cognitive_account`", Successors: []string{"azurerm_cognitive_account"}}}},
+	{"azurerm_analysis_services_server", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.AnalysisServices", Types: []string{"servers"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.AnalysisServices/servers"}}}},
+	{"azurerm_api_connection", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Web", Types: []string{"connections"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Web/connections"}}}},
+	{"azurerm_api_management", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.ApiManagement", Types: []string{"service"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.ApiManagement/service"}}}},
+	{"azurerm_api_management_api", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.ApiManagement", Types: []string{"service", "apis"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.ApiManagement/service/apis"}}}},
+	{"azurerm_api_management_api_diagnostic", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.ApiManagement", Types: []string{"service", "apis", "diagnostics"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.ApiManagement/service/apis/diagnostics"}}}},
+	{"azurerm_api_management_api_operation", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.ApiManagement", Types: []string{"service", "apis", "operations"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.ApiManagement/service/apis/operations"}}}},
+	{"azurerm_api_management_api_operation_policy", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.ApiManagement", Types: []string{"service", "apis", "operations", "policies"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.ApiManagement/service/apis/operations"}, IdTransform: &IdTransform{ConstantNames: map[string]string{"policies": "policy"}, Projection: "parent"}}}},
+	{"azurerm_api_management_api_operation_tag", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.ApiManagement", Types: []string{"service", "apis", "operations", "tags"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.ApiManagement/service/apis/operations/tags"}}}},
+	{"azurerm_api_management_api_policy", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.ApiManagement", Types: []string{"service", "apis", "policies"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.ApiManagement/service/apis"}, IdTransform: &IdTransform{ConstantNames: map[string]string{"policies": "policy"}, Projection: "parent"}}}},
+	{"azurerm_api_management_api_release", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.ApiManagement", Types: []string{"service", "apis", "releases"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.ApiManagement/service/apis/releases"}}}},
+	{"azurerm_api_management_api_schema", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.ApiManagement", Types: []string{"service", "apis", "schemas"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.ApiManagement/service/apis/schemas"}}}},
+	{"azurerm_api_management_api_tag", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.ApiManagement", Types: []string{"service", "apis", "tags"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.ApiManagement/service/apis/tags"}}}},
+	{"azurerm_api_management_api_tag_description", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.ApiManagement", Types: []string{"service", "apis", "tagDescriptions"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.ApiManagement/service/apis/tagDescriptions"}}}},
+	{"azurerm_api_management_api_version_set", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.ApiManagement", Types: []string{"service", "apiVersionSets"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.ApiManagement/service/apiVersionSets"}}}},
+	{"azurerm_api_management_authorization_server", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.ApiManagement", Types: []string{"service", "authorizationServers"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.ApiManagement/service/authorizationServers"}}}},
+	{"azurerm_api_management_backend", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.ApiManagement", Types: []string{"service", "backends"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.ApiManagement/service/backends"}}}},
+	{"azurerm_api_management_certificate", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.ApiManagement", Types: []string{"service", "certificates"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.ApiManagement/service/certificates"}}}},
+	{"azurerm_api_management_custom_domain", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.ApiManagement", Types: []string{"service", "customDomains"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.ApiManagement/service/customDomains"}}}},
+	{"azurerm_api_management_diagnostic", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.ApiManagement", Types: []string{"service", "diagnostics"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.ApiManagement/service/diagnostics"}}}},
+	{"azurerm_api_management_email_template", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.ApiManagement", Types: []string{"service", "templates"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.ApiManagement/service/templates"}}}},
+	{"azurerm_api_management_gateway", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.ApiManagement", Types: []string{"service", "gateways"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.ApiManagement/service/gateways"}}}},
+	{"azurerm_api_management_gateway_api", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.ApiManagement", Types: []string{"service", "gateways", "apis"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.ApiManagement/service/gateways/apis"}}}},
+	{"azurerm_api_management_gateway_certificate_authority", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.ApiManagement", Types: []string{"service", "gateways", "certificateAuthorities"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.ApiManagement/service/gateways/certificateAuthorities"}}}},
+	{"azurerm_api_management_gateway_host_name_configuration", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.ApiManagement", Types: []string{"service", "gateways", "hostnameConfigurations"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.ApiManagement/service/gateways/hostnameConfigurations"}}}},
+	{"azurerm_api_management_global_schema", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.ApiManagement", Types: []string{"service", "schemas"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.ApiManagement/service/schemas"}}}},
+	{"azurerm_api_management_group", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.ApiManagement", Types: []string{"service", "groups"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.ApiManagement/service/groups"}}}},
+	{"azurerm_api_management_group_user", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.ApiManagement", Types: []string{"service", "groups", "users"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.ApiManagement/service/groups/users"}}}},
+	{"azurerm_api_management_identity_provider_aad", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.ApiManagement", Types: []string{"service", "identityProviders"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.ApiManagement/service/identityProviders"}}}},
+	{"azurerm_api_management_identity_provider_aadb2c", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.ApiManagement", Types: []string{"service", "identityProviders"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.ApiManagement/service/identityProviders"}}}},
+	{"azurerm_api_management_identity_provider_facebook", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.ApiManagement", Types: []string{"service", "identityProviders"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.ApiManagement/service/identityProviders"}}}},
+	{"azurerm_api_management_identity_provider_google", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.ApiManagement", Types: []string{"service", "identityProviders"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.ApiManagement/service/identityProviders"}}}},
+	{"azurerm_api_management_identity_provider_microsoft", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.ApiManagement", Types: []string{"service", "identityProviders"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.ApiManagement/service/identityProviders"}}}},
+	{"azurerm_api_management_identity_provider_twitter", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.ApiManagement", Types: []string{"service", "identityProviders"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.ApiManagement/service/identityProviders"}}}},
+	{"azurerm_api_management_logger", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.ApiManagement", Types: []string{"service", "loggers"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.ApiManagement/service/loggers"}}}},
+	{"azurerm_api_management_named_value", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.ApiManagement", Types: []string{"service", "namedValues"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.ApiManagement/service/namedValues"}}}},
+	{"azurerm_api_management_notification_recipient_email", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.ApiManagement", Types: []string{"service", "notifications", "recipientEmails"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.ApiManagement/service/notifications/recipientEmails"}}}},
+	{"azurerm_api_management_notification_recipient_user", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.ApiManagement", Types: []string{"service", "notifications", "recipientUsers"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.ApiManagement/service/notifications/recipientUsers"}}}},
+	{"azurerm_api_management_openid_connect_provider", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.ApiManagement", Types: []string{"service", "openidConnectProviders"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.ApiManagement/service/openidConnectProviders"}}}},
+	{"azurerm_api_management_policy", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.ApiManagement", Types: []string{"service", "policies"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.ApiManagement/service"}, IdTransform: &IdTransform{ConstantNames: map[string]string{"policies": "policy"}, Projection: "parent"}}}},
+	{"azurerm_api_management_policy_fragment", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.ApiManagement", Types: []string{"service", "policyFragments"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.ApiManagement/service/policyFragments"}}}},
+	{"azurerm_api_management_product", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.ApiManagement", Types: []string{"service", "products"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.ApiManagement/service/products"}}}},
+	{"azurerm_api_management_product_api", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.ApiManagement", Types: []string{"service", "products", "apis"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.ApiManagement/service/products/apis"}}}},
+	{"azurerm_api_management_product_group", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.ApiManagement", Types: []string{"service", "products", "groups"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.ApiManagement/service/products/groups"}}}},
+	{"azurerm_api_management_product_policy", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.ApiManagement", Types: []string{"service", "products", "policies"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.ApiManagement/service/products"}, IdTransform: &IdTransform{ConstantNames: map[string]string{"policies": "policy"}, Projection: "parent"}}}},
+	{"azurerm_api_management_product_tag", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.ApiManagement", Types: []string{"service", "products", "tags"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.ApiManagement/service/products/tags"}}}},
+	{"azurerm_api_management_redis_cache", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.ApiManagement", Types: []string{"service", "caches"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.ApiManagement/service/caches"}}}},
+	{"azurerm_api_management_standalone_gateway", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.ApiManagement", Types: []string{"gateways"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.ApiManagement/gateways"}}}},
+	{"azurerm_api_management_subscription", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.ApiManagement", Types: []string{"service", "subscriptions"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.ApiManagement/service/subscriptions"}}}},
+	{"azurerm_api_management_tag", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.ApiManagement", Types: []string{"service", "tags"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.ApiManagement/service/tags"}}}},
+	{"azurerm_api_management_user", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.ApiManagement", Types: []string{"service", "users"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.ApiManagement/service/users"}}}},
+	{"azurerm_api_management_workspace", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.ApiManagement", Types: []string{"service", "workspaces"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.ApiManagement/service/workspaces"}}}},
+	{"azurerm_api_management_workspace_api_version_set", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.ApiManagement", Types: []string{"service", "workspaces", "apiVersionSets"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.ApiManagement/service/workspaces/apiVersionSets"}}}},
+	{"azurerm_api_management_workspace_certificate", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.ApiManagement", Types: []string{"service", "workspaces", "certificates"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.ApiManagement/service/workspaces/certificates"}}}},
+	{"azurerm_api_management_workspace_named_value", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.ApiManagement", Types: []string{"service", "workspaces", "namedValues"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.ApiManagement/service/workspaces/namedValues"}}}},
+	{"azurerm_api_management_workspace_policy", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.ApiManagement", Types: []string{"service", "workspaces", "policies"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.ApiManagement/service/workspaces"}, IdTransform: &IdTransform{ConstantNames: map[string]string{"policies": "policy"}, Projection: "parent"}}}},
+	{"azurerm_api_management_workspace_policy_fragment", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.ApiManagement", Types: []string{"service", "workspaces", "policyFragments"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.ApiManagement/service/workspaces/policyFragments"}}}},
+	{"azurerm_app_configuration", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.AppConfiguration", Types: []string{"configurationStores"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.AppConfiguration/configurationStores"}}}},
+	{"azurerm_app_configuration_feature", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.AppConfiguration", Types: []string{"configurationStores", "AppConfigurationFeature", "Label"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.AppConfiguration/configurationStores/AppConfigurationFeature/Label"}}}},
+	{"azurerm_app_configuration_key", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.AppConfiguration", Types: []string{"configurationStores", "AppConfigurationKey", "Label"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.AppConfiguration/configurationStores/AppConfigurationKey/Label"}}}},
+	{"azurerm_app_service", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Web", Types: []string{"sites"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Web/sites"}}, IsRemoved: true, RemoveReason: "This is deprecated in favor of `azurerm_linux_web_app` and `azurerm_windows_web_app`", Deprecation: &Deprecation{Reason: "This is deprecated in favor of `azurerm_linux_web_app` and `azurerm_windows_web_app`", Successors: []string{"azurerm_linux_web_app", "azurerm_windows_web_app"}}}},
+	{"azurerm_app_service_certificate", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Web", Types: []string{"certificates"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Web/certificates"}}}},
+	{"azurerm_app_service_certificate_order", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.CertificateRegistration", Types: []string{"certificateOrders"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.CertificateRegistration/certificateOrders"}}}},
+	{"azurerm_app_service_connection", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups/Microsoft.Web/sites"}, Provider: "Microsoft.ServiceLinker", Types: []string{"linkers"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Web/sites/Microsoft.ServiceLinker/linkers"}}}},
+	{"azurerm_app_service_custom_hostname_binding", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Web", Types: []string{"sites", "hostNameBindings"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Web/sites/hostNameBindings"}}}},
+	{"azurerm_app_service_environment_v3", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Web", Types: []string{"hostingEnvironments"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Web/hostingEnvironments"}}}},
+	{"azurerm_app_service_hybrid_connection", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Web", Types: []string{"sites", "hybridConnectionNamespaces", "relays"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Web/sites/hybridConnectionNamespaces/relays"}}, IsRemoved: true, RemoveReason: "This is deprecated in favor of `azurerm_function_app_hybrid_connection` and `azurerm_web_app_hybrid_connection`", Deprecation: &Deprecation{Reason: "This is deprecated in favor of `azurerm_function_app_hybrid_connection` and `azurerm_web_app_hybrid_connection`", Successors: []string{"azurerm_function_app_hybrid_connection", "azurerm_web_app_hybrid_connection"}}}},
+	{"azurerm_app_service_managed_certificate", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Web", Types: []string{"certificates"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Web/certificates"}}}},
+	{"azurerm_app_service_plan", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Web", Types: []string{"serverFarms"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Web/serverFarms"}}, IsRemoved: true, RemoveReason: "This is deprecated in favor of `azurerm_service_plan`", Deprecation: &Deprecation{Reason: "This is deprecated in favor of `azurerm_service_plan`", Successors: []string{"azurerm_service_plan"}}}},
+	{"azurerm_app_service_public_certificate", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Web", Types: []string{"sites", "publicCertificates"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Web/sites/publicCertificates"}}}},
+	{"azurerm_app_service_slot", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Web", Types: []string{"sites", "slots"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Web/sites/slots"}}, IsRemoved: true, RemoveReason: "This is deprecated in favor of `azurerm_linux_web_app_slot` and `azurerm_windows_web_app_slot`", Deprecation: &Deprecation{Reason: "This is deprecated in favor of `azurerm_linux_web_app_slot` and `azurerm_windows_web_app_slot`", Successors: []string{"azurerm_linux_web_app_slot", "azurerm_windows_web_app_slot"}}}},
+	{"azurerm_app_service_slot_custom_hostname_binding", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Web", Types: []string{"sites", "slots", "hostNameBindings"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Web/sites/slots/hostNameBindings"}}}},
+	{"azurerm_app_service_slot_virtual_network_swift_connection", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Web", Types: []string{"sites", "slots", "networkConfig"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Web/sites/slots/config"}, IdTransform: &IdTransform{TypeRenames: map[string]string{"networkConfig": "config"}}}}},
+	{"azurerm_app_service_source_control", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Web", Types: []string{"sites"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Web/sites"}}, IsRemoved: true, RemoveReason: "This is a property rather than a resource", Deprecation: &Deprecation{Reason: "This is a property rather than a resource", Successors: nil}}},
+	{"azurerm_app_service_source_control_slot", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Web", Types: []string{"sites", "slots"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Web/sites/slots"}}, IsRemoved: true, RemoveReason: "This is a property rather than a resource", Deprecation: &Deprecation{Reason: "This is a property rather than a resource", Successors: nil}}},
+	{"azurerm_app_service_virtual_network_swift_connection", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Web", Types: []string{"sites", "networkConfig"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Web/sites/config"}, IdTransform: &IdTransform{TypeRenames: map[string]string{"networkConfig": "config"}}}}},
+	{"azurerm_application_gateway", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Network", Types: []string{"applicationGateways"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Network/applicationGateways"}}}},
+	{"azurerm_application_insights", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Insights", Types: []string{"components"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Insights/components"}}}},
+	{"azurerm_application_insights_analytics_item", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Insights", Types: []string{"components", "analyticsItems"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Insights/components/analyticsItems"}}}},
+	{"azurerm_application_insights_api_key", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Insights", Types: []string{"components", "apiKeys"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Insights/components/apiKeys"}}}},
+	{"azurerm_application_insights_smart_detection_rule", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Insights", Types: []string{"components", "proactiveDetectionConfigs"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Insights/components/proactiveDetectionConfigs"}}}},
+	{"azurerm_application_insights_standard_web_test", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Insights", Types: []string{"webTests"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Insights/webTests"}}}},
+	{"azurerm_application_insights_web_test", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Insights", Types: []string{"webTests"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Insights/webTests"}}}},
+	{"azurerm_application_insights_workbook", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Insights", Types: []string{"workbooks"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Insights/workbooks"}}}},
+	{"azurerm_application_insights_workbook_template", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Insights", Types: []string{"workbookTemplates"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Insights/workbookTemplates"}}}},
+	{"azurerm_application_load_balancer", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.ServiceNetworking", Types: []string{"trafficControllers"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.ServiceNetworking/trafficControllers"}}}},
+	{"azurerm_application_load_balancer_frontend", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.ServiceNetworking", Types: []string{"trafficControllers", "frontends"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.ServiceNetworking/trafficControllers/frontends"}}}},
+	{"azurerm_application_load_balancer_security_policy", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.ServiceNetworking", Types: []string{"trafficControllers", "securityPolicies"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.ServiceNetworking/trafficControllers/securityPolicies"}}}},
+	{"azurerm_application_load_balancer_subnet_association", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.ServiceNetworking", Types: []string{"trafficControllers", "associations"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.ServiceNetworking/trafficControllers/associations"}}}},
+	{"azurerm_application_security_group", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Network", Types: []string{"applicationSecurityGroups"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Network/applicationSecurityGroups"}}}},
+	{"azurerm_arc_kubernetes_cluster", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Kubernetes", Types: []string{"connectedClusters"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Kubernetes/connectedClusters"}}}},
+	{"azurerm_arc_kubernetes_cluster_extension", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups/Microsoft.Kubernetes/connectedClusters"}, Provider: "Microsoft.KubernetesConfiguration", Types: []string{"extensions"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Kubernetes/connectedClusters/Microsoft.KubernetesConfiguration/extensions"}}}},
+	{"azurerm_arc_kubernetes_flux_configuration", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups/Microsoft.Kubernetes/connectedClusters"}, Provider: "Microsoft.KubernetesConfiguration", Types: []string{"fluxConfigurations"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Kubernetes/connectedClusters/Microsoft.KubernetesConfiguration/fluxConfigurations"}}}},
+	{"azurerm_arc_kubernetes_provisioned_cluster", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Kubernetes", Types: []string{"connectedClusters"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Kubernetes/connectedClusters"}}}},
+	{"azurerm_arc_machine", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.HybridCompute", Types: []string{"machines"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.HybridCompute/machines"}}}},
+	{"azurerm_arc_machine_automanage_configuration_assignment", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups/Microsoft.HybridCompute/machines"}, Provider: "Microsoft.AutoManage", Types: []string{"configurationProfileAssignments"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.HybridCompute/machines/Microsoft.AutoManage/configurationProfileAssignments"}}}},
+	{"azurerm_arc_machine_extension", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.HybridCompute", Types: []string{"machines", "extensions"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.HybridCompute/machines/extensions"}}}},
+	{"azurerm_arc_private_link_scope", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.HybridCompute", Types: []string{"privateLinkScopes"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.HybridCompute/privateLinkScopes"}}}},
+	{"azurerm_arc_resource_bridge_appliance", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.ResourceConnector", Types: []string{"appliances"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.ResourceConnector/appliances"}}}},
+	{"azurerm_attestation_provider", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Attestation", Types: []string{"attestationProviders"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Attestation/attestationProviders"}}}},
+	{"azurerm_automanage_configuration", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.AutoManage", Types: []string{"configurationProfiles"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.AutoManage/configurationProfiles"}}}},
+	{"azurerm_automation_account", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Automation", Types: []string{"automationAccounts"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Automation/automationAccounts"}}}},
+	{"azurerm_automation_certificate", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Automation", Types: []string{"automationAccounts", "certificates"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Automation/automationAccounts/certificates"}}}},
+	{"azurerm_automation_connection", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Automation", Types: []string{"automationAccounts", "connections"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Automation/automationAccounts/connections"}}}},
+	{"azurerm_automation_connection_certificate", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Automation", Types: []string{"automationAccounts", "connections"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Automation/automationAccounts/connections"}}}},
+	{"azurerm_automation_connection_classic_certificate", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Automation", Types: []string{"automationAccounts", "connections"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Automation/automationAccounts/connections"}}}},
+	{"azurerm_automation_connection_service_principal", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Automation", Types: []string{"automationAccounts", "connections"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Automation/automationAccounts/connections"}}}},
+	{"azurerm_automation_connection_type", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Automation", Types: []string{"automationAccounts", "connectionTypes"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Automation/automationAccounts/connectionTypes"}}}},
+	{"azurerm_automation_credential", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Automation", Types: []string{"automationAccounts", "credentials"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Automation/automationAccounts/credentials"}}}},
+	{"azurerm_automation_dsc_configuration", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Automation", Types: []string{"automationAccounts", "configurations"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Automation/automationAccounts/configurations"}}}},
+	{"azurerm_automation_dsc_nodeconfiguration", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Automation", Types: []string{"automationAccounts", "nodeConfigurations"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Automation/automationAccounts/nodeConfigurations"}}}},
+	{"azurerm_automation_hybrid_runbook_worker", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Automation", Types: []string{"automationAccounts", "hybridRunbookWorkerGroups", "hybridRunbookWorkers"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Automation/automationAccounts/hybridRunbookWorkerGroups/hybridRunbookWorkers"}}}},
+	{"azurerm_automation_hybrid_runbook_worker_group", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Automation", Types: []string{"automationAccounts", "hybridRunbookWorkerGroups"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Automation/automationAccounts/hybridRunbookWorkerGroups"}}}},
+	{"azurerm_automation_job_schedule", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Automation", Types: []string{"automationAccounts", "jobSchedules"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Automation/automationAccounts/jobSchedules"}}}},
+	{"azurerm_automation_module", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Automation", Types: []string{"automationAccounts", "modules"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Automation/automationAccounts/modules"}}}},
+	{"azurerm_automation_powershell72_module", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Automation", Types: []string{"automationAccounts", "powerShell72Modules"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Automation/automationAccounts/powerShell72Modules"}}}},
+	{"azurerm_automation_python3_package", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Automation", Types: []string{"automationAccounts", "python3Packages"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Automation/automationAccounts/python3Packages"}}}},
+	{"azurerm_automation_runbook", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Automation", Types: []string{"automationAccounts", "runbooks"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Automation/automationAccounts/runbooks"}}}},
+	{"azurerm_automation_runtime_environment", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Automation", Types: []string{"automationAccounts", "runtimeEnvironments"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Automation/automationAccounts/runtimeEnvironments"}}}},
+	{"azurerm_automation_runtime_environment_package", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Automation", Types: []string{"automationAccounts", "runtimeEnvironments", "packages"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Automation/automationAccounts/runtimeEnvironments/packages"}}}},
+	{"azurerm_automation_schedule", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Automation", Types: []string{"automationAccounts", "schedules"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Automation/automationAccounts/schedules"}}}},
+	{"azurerm_automation_software_update_configuration", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Automation", Types: []string{"automationAccounts", "softwareUpdateConfigurations"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Automation/automationAccounts/softwareUpdateConfigurations"}}}},
+	{"azurerm_automation_source_control", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Automation", Types: []string{"automationAccounts", "sourceControls"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Automation/automationAccounts/sourceControls"}}}},
+	{"azurerm_automation_variable_bool", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Automation", Types: []string{"automationAccounts", "variables"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Automation/automationAccounts/variables"}}}},
+	{"azurerm_automation_variable_datetime", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Automation", Types: []string{"automationAccounts", "variables"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Automation/automationAccounts/variables"}}}},
+	{"azurerm_automation_variable_int", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Automation", Types: []string{"automationAccounts", "variables"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Automation/automationAccounts/variables"}}}},
+	{"azurerm_automation_variable_object", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Automation", Types: []string{"automationAccounts", "variables"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Automation/automationAccounts/variables"}}}},
+	{"azurerm_automation_variable_string", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Automation", Types: []string{"automationAccounts", "variables"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Automation/automationAccounts/variables"}}}},
+	{"azurerm_automation_watcher", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Automation", Types: []string{"automationAccounts", "watchers"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Automation/automationAccounts/watchers"}}}},
+	{"azurerm_automation_webhook", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Automation", Types: []string{"automationAccounts", "webHooks"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Automation/automationAccounts/webHooks"}}}},
+	{"azurerm_availability_set", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Compute", Types: []string{"availabilitySets"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Compute/availabilitySets"}}}},
+	{"azurerm_backup_container_storage_account", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.RecoveryServices", Types: []string{"vaults", "backupFabrics", "protectionContainers"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.RecoveryServices/vaults/backupFabrics/protectionContainers"}}}},
+	{"azurerm_backup_policy_file_share", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.RecoveryServices", Types: []string{"vaults", "backupPolicies"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.RecoveryServices/vaults/backupPolicies"}}}},
+	{"azurerm_backup_policy_vm", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.RecoveryServices", Types: []string{"vaults", "backupPolicies"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.RecoveryServices/vaults/backupPolicies"}}}},
+	{"azurerm_backup_policy_vm_workload", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.RecoveryServices", Types: []string{"vaults", "backupPolicies"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.RecoveryServices/vaults/backupPolicies"}}}},
+	{"azurerm_backup_protected_file_share", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.RecoveryServices", Types: []string{"vaults", "backupFabrics", "protectionContainers", "protectedItems"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.RecoveryServices/vaults/backupFabrics/protectionContainers/protectedItems"}}}},
+	{"azurerm_backup_protected_vm", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.RecoveryServices", Types: []string{"vaults", "backupFabrics", "protectionContainers", "protectedItems"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.RecoveryServices/vaults/backupFabrics/protectionContainers/protectedItems"}}}},
+	{"azurerm_bastion_host", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Network", Types: []string{"bastionHosts"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Network/bastionHosts"}}}},
+	{"azurerm_batch_account", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Batch", Types: []string{"batchAccounts"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Batch/batchAccounts"}}}},
+	{"azurerm_batch_application", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Batch", Types: []string{"batchAccounts", "applications"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Batch/batchAccounts/applications"}}}},
+	{"azurerm_batch_certificate", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Batch", Types: []string{"batchAccounts", "certificates"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Batch/batchAccounts/certificates"}}}},
+	{"azurerm_batch_job", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Batch", Types: []string{"batchAccounts", "pools", "jobs"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Batch/batchAccounts/pools/jobs"}}}},
+	{"azurerm_batch_pool", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Batch", Types: []string{"batchAccounts", "pools"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Batch/batchAccounts/pools"}}}},
+	{"azurerm_billing_account_cost_management_export", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/Microsoft.Billing/billingAccounts"}, Provider: "Microsoft.CostManagement", Types: []string{"exports"}, ImportSpecs: []string{"/Microsoft.Billing/billingAccounts/Microsoft.CostManagement/exports"}}}},
+	{"azurerm_blueprint_assignment", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions", "/Microsoft.Management/managementGroups"}, Provider: "Microsoft.Blueprint", Types: []string{"blueprintAssignments"}, ImportSpecs: []string{"/subscriptions/Microsoft.Blueprint/blueprintAssignments", "/Microsoft.Management/managementGroups/Microsoft.Blueprint/blueprintAssignments"}}}},
+	{"azurerm_bot_channel_alexa", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.BotService", Types: []string{"botServices", "channels"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.BotService/botServices/channels"}}}},
+	{"azurerm_bot_channel_direct_line_speech", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.BotService", Types: []string{"botServices", "channels"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.BotService/botServices/channels"}}}},
+	{"azurerm_bot_channel_directline", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.BotService", Types: []string{"botServices", "channels"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.BotService/botServices/channels"}}}},
+	{"azurerm_bot_channel_email", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.BotService", Types: []string{"botServices", "channels"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.BotService/botServices/channels"}}}},
+	{"azurerm_bot_channel_facebook", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.BotService", Types: []string{"botServices", "channels"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.BotService/botServices/channels"}}}},
+	{"azurerm_bot_channel_line", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.BotService", Types: []string{"botServices", "channels"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.BotService/botServices/channels"}}}},
+	{"azurerm_bot_channel_ms_teams", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.BotService", Types: []string{"botServices", "channels"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.BotService/botServices/channels"}}}},
+	{"azurerm_bot_channel_slack", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.BotService", Types: []string{"botServices", "channels"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.BotService/botServices/channels"}}}},
+	{"azurerm_bot_channel_sms", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.BotService", Types: []string{"botServices", "channels"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.BotService/botServices/channels"}}}},
+	{"azurerm_bot_channel_web_chat", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.BotService", Types: []string{"botServices", "channels"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.BotService/botServices/channels"}}}},
+	{"azurerm_bot_channels_registration", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.BotService", Types: []string{"botServices"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.BotService/botServices"}}}},
+	{"azurerm_bot_connection", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.BotService", Types: []string{"botServices", "connections"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.BotService/botServices/connections"}}}},
+	{"azurerm_bot_service_azure_bot", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.BotService", Types: []string{"botServices"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.BotService/botServices"}}}},
+	{"azurerm_bot_web_app", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.BotService", Types: []string{"botServices"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.BotService/botServices"}}}},
+	{"azurerm_capacity_reservation", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Compute", Types: []string{"capacityReservationGroups", "capacityReservations"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Compute/capacityReservationGroups/capacityReservations"}}}},
+	{"azurerm_capacity_reservation_group", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Compute", Types: []string{"capacityReservationGroups"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Compute/capacityReservationGroups"}}}},
+	{"azurerm_cdn_endpoint", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Cdn", Types: []string{"profiles", "endpoints"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Cdn/profiles/endpoints"}}}},
+	{"azurerm_cdn_endpoint_custom_domain", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Cdn", Types: []string{"profiles", "endpoints", "customDomains"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Cdn/profiles/endpoints/customDomains"}}}},
+	{"azurerm_cdn_frontdoor_batch_rule_set", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Cdn", Types: []string{"profiles", "ruleSets"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Cdn/profiles/ruleSets"}}}},
+	{"azurerm_cdn_frontdoor_custom_domain", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Cdn", Types: []string{"profiles", "customDomains"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Cdn/profiles/customDomains"}}}},
+	{"azurerm_cdn_frontdoor_custom_domain_association", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Cdn", Types: []string{"profiles", "associations"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Cdn/profiles/associations"}}}},
+	{"azurerm_cdn_frontdoor_endpoint", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Cdn", Types: []string{"profiles", "afdEndpoints"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Cdn/profiles/afdEndpoints"}}}},
+	{"azurerm_cdn_frontdoor_firewall_policy", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Network", Types: []string{"frontDoorWebApplicationFirewallPolicies"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Network/frontDoorWebApplicationFirewallPolicies"}}}},
+	{"azurerm_cdn_frontdoor_origin", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Cdn", Types: []string{"profiles", "originGroups", "origins"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Cdn/profiles/originGroups/origins"}}}},
+	{"azurerm_cdn_frontdoor_origin_group", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Cdn", Types: []string{"profiles", "originGroups"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Cdn/profiles/originGroups"}}}},
+	{"azurerm_cdn_frontdoor_profile", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Cdn", Types: []string{"profiles"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Cdn/profiles"}}}},
+	{"azurerm_cdn_frontdoor_route", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Cdn", Types: []string{"profiles", "afdEndpoints", "routes"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Cdn/profiles/afdEndpoints/routes"}}}},
+	{"azurerm_cdn_frontdoor_rule", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Cdn", Types: []string{"profiles", "ruleSets", "rules"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Cdn/profiles/ruleSets/rules"}}}},
+	{"azurerm_cdn_frontdoor_rule_set", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Cdn", Types: []string{"profiles", "ruleSets"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Cdn/profiles/ruleSets"}}}},
+	{"azurerm_cdn_frontdoor_secret", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Cdn", Types: []string{"profiles", "secrets"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Cdn/profiles/secrets"}}}},
+	{"azurerm_cdn_frontdoor_security_policy", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Cdn", Types: []string{"profiles", "securityPolicies"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Cdn/profiles/securityPolicies"}}}},
+	{"azurerm_cdn_profile", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Cdn", Types: []string{"profiles"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Cdn/profiles"}}}},
+	{"azurerm_chaos_studio_capability", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"any"}, Provider: "Microsoft.Chaos", Types: []string{"targets", "capabilities"}}}},
+	{"azurerm_chaos_studio_experiment", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Chaos", Types: []string{"experiments"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Chaos/experiments"}}}},
+	{"azurerm_chaos_studio_target", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"any"}, Provider: "Microsoft.Chaos", Types: []string{"targets"}}}},
+	{"azurerm_cognitive_account", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.CognitiveServices", Types: []string{"accounts"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.CognitiveServices/accounts"}}}},
+	{"azurerm_cognitive_account_connection_account_key", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.CognitiveServices", Types: []string{"accounts", "connections"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.CognitiveServices/accounts/connections"}}}},
+	{"azurerm_cognitive_account_connection_account_managed_identity", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.CognitiveServices", Types: []string{"accounts", "connections"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.CognitiveServices/accounts/connections"}}}},
+	{"azurerm_cognitive_account_connection_api_key", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.CognitiveServices", Types: []string{"accounts", "connections"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.CognitiveServices/accounts/connections"}}}},
+	{"azurerm_cognitive_account_connection_custom_keys", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.CognitiveServices", Types: []string{"accounts", "connections"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.CognitiveServices/accounts/connections"}}}},
+	{"azurerm_cognitive_account_connection_entra_id", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.CognitiveServices", Types: []string{"accounts", "connections"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.CognitiveServices/accounts/connections"}}}},
+	{"azurerm_cognitive_account_customer_managed_key", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.CognitiveServices", Types: []string{"accounts"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.CognitiveServices/accounts"}}, IsRemoved: true, RemoveReason: "This is a property rather than a resource", Deprecation: &Deprecation{Reason: "This is a property rather than a resource", Successors: nil}}},
+	{"azurerm_cognitive_account_project", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.CognitiveServices", Types: []string{"accounts", "projects"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.CognitiveServices/accounts/projects"}}}},
+	{"azurerm_cognitive_account_rai_blocklist", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.CognitiveServices", Types: []string{"accounts", "raiBlocklists"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.CognitiveServices/accounts/raiBlocklists"}}}},
+	{"azurerm_cognitive_account_rai_policy", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.CognitiveServices", Types: []string{"accounts", "raiPolicies"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.CognitiveServices/accounts/raiPolicies"}}}},
+	{"azurerm_cognitive_deployment", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.CognitiveServices", Types: []string{"accounts", "deployments"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.CognitiveServices/accounts/deployments"}}}},
+	{"azurerm_communication_service", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Communication", Types: []string{"communicationServices"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Communication/communicationServices"}}}},
+	{"azurerm_confidential_ledger", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.ConfidentialLedger", Types: []string{"ledgers"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.ConfidentialLedger/ledgers"}}}},
+	{"azurerm_consumption_budget_management_group", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/Microsoft.Management/managementGroups"}, Provider: "Microsoft.Consumption", Types: []string{"budgets"}, ImportSpecs: []string{"/Microsoft.Management/managementGroups/Microsoft.Consumption/budgets"}}}},
+	{"azurerm_consumption_budget_resource_group", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Consumption", Types: []string{"budgets"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Consumption/budgets"}}}},
+	{"azurerm_consumption_budget_subscription", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions"}, Provider: "Microsoft.Consumption", Types: []string{"budgets"}, ImportSpecs: []string{"/subscriptions/Microsoft.Consumption/budgets"}}}},
+	{"azurerm_container_app", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.App", Types: []string{"containerApps"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.App/containerApps"}}}},
+	{"azurerm_container_app_custom_domain", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.App", Types: []string{"containerApps", "customDomainName"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.App/containerApps/customDomainName"}}}},
+	{"azurerm_container_app_environment", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.App", Types: []string{"managedEnvironments"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.App/managedEnvironments"}}}},
+	{"azurerm_container_app_environment_certificate", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.App", Types: []string{"managedEnvironments", "certificates"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.App/managedEnvironments/certificates"}}}},
+	{"azurerm_container_app_environment_custom_domain", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.App", Types: []string{"managedEnvironments", "customDomains"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.App/managedEnvironments"}, IdTransform: &IdTransform{ConstantNames: map[string]string{"customDomains": "default"}, Projection: "parent"}}}},
+	{"azurerm_container_app_environment_dapr_component", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.App", Types: []string{"managedEnvironments", "daprComponents"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.App/managedEnvironments/daprComponents"}}}},
+	{"azurerm_container_app_environment_managed_certificate", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.App", Types: []string{"managedEnvironments", "managedCertificates"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.App/managedEnvironments/managedCertificates"}}}},
+	{"azurerm_container_app_environment_storage", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.App", Types: []string{"managedEnvironments", "storages"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.App/managedEnvironments/storages"}}}},
+	{"azurerm_container_app_job", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.App", Types: []string{"jobs"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.App/jobs"}}}},
+	{"azurerm_container_connected_registry", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.ContainerRegistry", Types: []string{"registries", "connectedRegistries"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.ContainerRegistry/registries/connectedRegistries"}}}},
+	{"azurerm_container_group", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.ContainerInstance", Types: []string{"containerGroups"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.ContainerInstance/containerGroups"}}}},
+	{"azurerm_container_registry", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.ContainerRegistry", Types: []string{"registries"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.ContainerRegistry/registries"}}}},
+	{"azurerm_container_registry_agent_pool", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.ContainerRegistry", Types: []string{"registries", "agentPools"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.ContainerRegistry/registries/agentPools"}}}},
+	{"azurerm_container_registry_cache_rule", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.ContainerRegistry", Types: []string{"registries", "cacheRules"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.ContainerRegistry/registries/cacheRules"}}}},
+	{"azurerm_container_registry_credential_set", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.ContainerRegistry", Types: []string{"registries", "credentialSets"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.ContainerRegistry/registries/credentialSets"}}}},
+	{"azurerm_container_registry_scope_map", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.ContainerRegistry", Types: []string{"registries", "scopeMaps"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.ContainerRegistry/registries/scopeMaps"}}}},
+	{"azurerm_container_registry_task", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.ContainerRegistry", Types: []string{"registries", "tasks"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.ContainerRegistry/registries/tasks"}}}},
+	{"azurerm_container_registry_token", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.ContainerRegistry", Types: []string{"registries", "tokens"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.ContainerRegistry/registries/tokens"}}}},
+	{"azurerm_container_registry_token_password", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.ContainerRegistry", Types: []string{"registries", "tokens", "passwords"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.ContainerRegistry/registries/tokens/passwords"}}}},
+	{"azurerm_container_registry_webhook", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.ContainerRegistry", Types: []string{"registries", "webHooks"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.ContainerRegistry/registries/webHooks"}}}},
+	{"azurerm_cosmosdb_account", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.DocumentDB", Types: []string{"databaseAccounts"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.DocumentDB/databaseAccounts"}}}},
+	{"azurerm_cosmosdb_cassandra_cluster", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.DocumentDB", Types: []string{"cassandraClusters"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.DocumentDB/cassandraClusters"}}}},
+	{"azurerm_cosmosdb_cassandra_datacenter", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.DocumentDB", Types: []string{"cassandraClusters", "dataCenters"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.DocumentDB/cassandraClusters/dataCenters"}}}},
+	{"azurerm_cosmosdb_cassandra_keyspace", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.DocumentDB", Types: []string{"databaseAccounts", "cassandraKeyspaces"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.DocumentDB/databaseAccounts/cassandraKeyspaces"}}}},
+	{"azurerm_cosmosdb_cassandra_table", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.DocumentDB", Types: []string{"databaseAccounts", "cassandraKeyspaces", "tables"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.DocumentDB/databaseAccounts/cassandraKeyspaces/tables"}}}},
+	{"azurerm_cosmosdb_gremlin_database", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.DocumentDB", Types: []string{"databaseAccounts", "gremlinDatabases"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.DocumentDB/databaseAccounts/gremlinDatabases"}}}},
+	{"azurerm_cosmosdb_gremlin_graph", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.DocumentDB", Types: []string{"databaseAccounts", "gremlinDatabases", "graphs"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.DocumentDB/databaseAccounts/gremlinDatabases/graphs"}}}},
+	{"azurerm_cosmosdb_mongo_collection", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.DocumentDB", Types: []string{"databaseAccounts", "mongodbDatabases", "collections"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.DocumentDB/databaseAccounts/mongodbDatabases/collections"}}}},
+	{"azurerm_cosmosdb_mongo_database", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.DocumentDB", Types: []string{"databaseAccounts", "mongodbDatabases"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.DocumentDB/databaseAccounts/mongodbDatabases"}}}},
+	{"azurerm_cosmosdb_mongo_role_definition", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.DocumentDB", Types: []string{"databaseAccounts", "mongodbRoleDefinitions"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.DocumentDB/databaseAccounts/mongodbRoleDefinitions"}}}},
+	{"azurerm_cosmosdb_mongo_user_definition", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.DocumentDB", Types: []string{"databaseAccounts", "mongodbUserDefinitions"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.DocumentDB/databaseAccounts/mongodbUserDefinitions"}}}},
+	{"azurerm_cosmosdb_postgresql_cluster", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.DBforPostgreSQL", Types: []string{"serverGroupsv2"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.DBforPostgreSQL/serverGroupsv2"}}}},
+	{"azurerm_cosmosdb_postgresql_coordinator_configuration", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.DBforPostgreSQL", Types: []string{"serverGroupsv2", "coordinatorConfigurations"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.DBforPostgreSQL/serverGroupsv2/coordinatorConfigurations"}}}},
+	{"azurerm_cosmosdb_postgresql_firewall_rule", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.DBforPostgreSQL", Types: []string{"serverGroupsv2", "firewallRules"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.DBforPostgreSQL/serverGroupsv2/firewallRules"}}}},
+	{"azurerm_cosmosdb_postgresql_node_configuration", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.DBforPostgreSQL", Types: []string{"serverGroupsv2", "nodeConfigurations"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.DBforPostgreSQL/serverGroupsv2/nodeConfigurations"}}}},
+	{"azurerm_cosmosdb_postgresql_role", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.DBforPostgreSQL", Types: []string{"serverGroupsv2", "roles"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.DBforPostgreSQL/serverGroupsv2/roles"}}}},
+	{"azurerm_cosmosdb_sql_container", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.DocumentDB", Types: []string{"databaseAccounts", "sqlDatabases", "containers"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.DocumentDB/databaseAccounts/sqlDatabases/containers"}}}},
+	{"azurerm_cosmosdb_sql_database", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.DocumentDB", Types: []string{"databaseAccounts", "sqlDatabases"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.DocumentDB/databaseAccounts/sqlDatabases"}}}},
+	{"azurerm_cosmosdb_sql_dedicated_gateway", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.DocumentDB", Types: []string{"databaseAccounts", "services"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.DocumentDB/databaseAccounts/services"}}}},
+	{"azurerm_cosmosdb_sql_function", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.DocumentDB", Types: []string{"databaseAccounts", "sqlDatabases", "containers", "userDefinedFunctions"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.DocumentDB/databaseAccounts/sqlDatabases/containers/userDefinedFunctions"}}}},
+	{"azurerm_cosmosdb_sql_role_assignment", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.DocumentDB", Types: []string{"databaseAccounts", "sqlRoleAssignments"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.DocumentDB/databaseAccounts/sqlRoleAssignments"}}}},
+	{"azurerm_cosmosdb_sql_role_definition", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.DocumentDB", Types: []string{"databaseAccounts", "sqlRoleDefinitions"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.DocumentDB/databaseAccounts/sqlRoleDefinitions"}}}},
+	{"azurerm_cosmosdb_sql_stored_procedure", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.DocumentDB", Types: []string{"databaseAccounts", "sqlDatabases", "containers", "storedProcedures"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.DocumentDB/databaseAccounts/sqlDatabases/containers/storedProcedures"}}}},
+	{"azurerm_cosmosdb_sql_trigger", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.DocumentDB", Types: []string{"databaseAccounts", "sqlDatabases", "containers", "triggers"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.DocumentDB/databaseAccounts/sqlDatabases/containers/triggers"}}}},
+	{"azurerm_cosmosdb_table", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.DocumentDB", Types: []string{"databaseAccounts", "tables"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.DocumentDB/databaseAccounts/tables"}}}},
+	{"azurerm_cost_anomaly_alert", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions"}, Provider: "Microsoft.CostManagement", Types: []string{"scheduledActions"}, ImportSpecs: []string{"/subscriptions/Microsoft.CostManagement/scheduledActions"}}}},
+	{"azurerm_cost_management_scheduled_action", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions"}, Provider: "Microsoft.CostManagement", Types: []string{"scheduledActions"}, ImportSpecs: []string{"/subscriptions/Microsoft.CostManagement/scheduledActions"}}}},
+	{"azurerm_custom_ip_prefix", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Network", Types: []string{"customIPPrefixes"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Network/customIPPrefixes"}}}},
+	{"azurerm_custom_provider", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.CustomProviders", Types: []string{"resourceProviders"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.CustomProviders/resourceProviders"}}}},
+	{"azurerm_dashboard_grafana", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Dashboard", Types: []string{"grafana"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Dashboard/grafana"}}}},
+	{"azurerm_dashboard_grafana_managed_private_endpoint", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Dashboard", Types: []string{"grafana", "managedPrivateEndpoints"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Dashboard/grafana/managedPrivateEndpoints"}}}},
+	{"azurerm_data_factory", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.DataFactory", Types: []string{"factories"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.DataFactory/factories"}}}},
+	{"azurerm_data_factory_credential_service_principal", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.DataFactory", Types: []string{"factories", "credentials"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.DataFactory/factories/credentials"}}}},
+	{"azurerm_data_factory_credential_user_managed_identity", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.DataFactory", Types: []string{"factories", "credentials"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.DataFactory/factories/credentials"}}}},
+	{"azurerm_data_factory_custom_dataset", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.DataFactory", Types: []string{"factories", "datasets"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.DataFactory/factories/datasets"}}}},
+	{"azurerm_data_factory_customer_managed_key", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.DataFactory", Types: []string{"factories"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.DataFactory/factories"}}, IsRemoved: true, RemoveReason: "This is a property rather than a resource", Deprecation: &Deprecation{Reason: "This is a property rather than a resource", Successors: nil}}},
+	{"azurerm_data_factory_data_flow", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.DataFactory", Types: []string{"factories", "dataflows"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.DataFactory/factories/dataflows"}}}},
+	{"azurerm_data_factory_dataset_azure_blob", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.DataFactory", Types: []string{"factories", "datasets"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.DataFactory/factories/datasets"}}}},
+	{"azurerm_data_factory_dataset_azure_sql_table", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.DataFactory", Types: []string{"factories", "datasets"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.DataFactory/factories/datasets"}}}},
+	{"azurerm_data_factory_dataset_binary", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.DataFactory", Types: []string{"factories", "datasets"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.DataFactory/factories/datasets"}}}},
+	{"azurerm_data_factory_dataset_cosmosdb_sqlapi", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.DataFactory", Types: []string{"factories", "datasets"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.DataFactory/factories/datasets"}}}},
+	{"azurerm_data_factory_dataset_delimited_text", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.DataFactory", Types: []string{"factories", "datasets"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.DataFactory/factories/datasets"}}}},
+	{"azurerm_data_factory_dataset_http", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.DataFactory", Types: []string{"factories", "datasets"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.DataFactory/factories/datasets"}}}},
+	{"azurerm_data_factory_dataset_json", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.DataFactory", Types: []string{"factories", "datasets"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.DataFactory/factories/datasets"}}}},
+	{"azurerm_data_factory_dataset_mysql", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.DataFactory", Types: []string{"factories", "datasets"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.DataFactory/factories/datasets"}}}},
+	{"azurerm_data_factory_dataset_parquet", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.DataFactory", Types: []string{"factories", "datasets"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.DataFactory/factories/datasets"}}}},
+	{"azurerm_data_factory_dataset_postgresql", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.DataFactory", Types: []string{"factories", "datasets"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.DataFactory/factories/datasets"}}}},
+	{"azurerm_data_factory_dataset_snowflake", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.DataFactory", Types: []string{"factories", "datasets"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.DataFactory/factories/datasets"}}}},
+	{"azurerm_data_factory_dataset_sql_server_table", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.DataFactory", Types: []string{"factories", "datasets"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.DataFactory/factories/datasets"}}}},
+	{"azurerm_data_factory_flowlet_data_flow", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.DataFactory", Types: []string{"factories", "dataflows"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.DataFactory/factories/dataflows"}}}},
+	{"azurerm_data_factory_integration_runtime_azure", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.DataFactory", Types: []string{"factories", "integrationRuntimes"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.DataFactory/factories/integrationRuntimes"}}}},
+	{"azurerm_data_factory_integration_runtime_azure_ssis", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.DataFactory", Types: []string{"factories", "integrationRuntimes"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.DataFactory/factories/integrationRuntimes"}}}},
+	{"azurerm_data_factory_integration_runtime_self_hosted", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.DataFactory", Types: []string{"factories", "integrationRuntimes"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.DataFactory/factories/integrationRuntimes"}}}},
+	{"azurerm_data_factory_linked_custom_service", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.DataFactory", Types: []string{"factories", "linkedservices"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.DataFactory/factories/linkedservices"}}}},
+	{"azurerm_data_factory_linked_service_azure_blob_storage", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.DataFactory", Types: []string{"factories", "linkedservices"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.DataFactory/factories/linkedservices"}}}},
+	{"azurerm_data_factory_linked_service_azure_databricks", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.DataFactory", Types: []string{"factories", "linkedservices"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.DataFactory/factories/linkedservices"}}}},
+	{"azurerm_data_factory_linked_service_azure_file_storage", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.DataFactory", Types: []string{"factories", "linkedservices"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.DataFactory/factories/linkedservices"}}}},
+	{"azurerm_data_factory_linked_service_azure_function", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.DataFactory", Types: []string{"factories", "linkedservices"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.DataFactory/factories/linkedservices"}}}},
+	{"azurerm_data_factory_linked_service_azure_search", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.DataFactory", Types: []string{"factories", "linkedservices"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.DataFactory/factories/linkedservices"}}}},
+	{"azurerm_data_factory_linked_service_azure_sql_database", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.DataFactory", Types: []string{"factories", "linkedservices"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.DataFactory/factories/linkedservices"}}}},
+	{"azurerm_data_factory_linked_service_azure_table_storage", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.DataFactory", Types: []string{"factories", "linkedservices"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.DataFactory/factories/linkedservices"}}}},
+	{"azurerm_data_factory_linked_service_cosmosdb", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.DataFactory", Types: []string{"factories", "linkedservices"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.DataFactory/factories/linkedservices"}}}},
+	{"azurerm_data_factory_linked_service_cosmosdb_mongoapi", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.DataFactory", Types: []string{"factories", "linkedservices"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.DataFactory/factories/linkedservices"}}}},
+	{"azurerm_data_factory_linked_service_data_lake_storage_gen2", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.DataFactory", Types: []string{"factories", "linkedservices"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.DataFactory/factories/linkedservices"}}}},
+	{"azurerm_data_factory_linked_service_key_vault", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.DataFactory", Types: []string{"factories", "linkedservices"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.DataFactory/factories/linkedservices"}}}},
+	{"azurerm_data_factory_linked_service_kusto", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.DataFactory", Types: []string{"factories", "linkedservices"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.DataFactory/factories/linkedservices"}}}},
+	{"azurerm_data_factory_linked_service_mysql", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.DataFactory", Types: []string{"factories", "linkedservices"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.DataFactory/factories/linkedservices"}}}},
+	{"azurerm_data_factory_linked_service_odata", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.DataFactory", Types: []string{"factories", "linkedservices"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.DataFactory/factories/linkedservices"}}}},
+	{"azurerm_data_factory_linked_service_odbc", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.DataFactory", Types: []string{"factories", "linkedservices"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.DataFactory/factories/linkedservices"}}}},
+	{"azurerm_data_factory_linked_service_postgresql", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.DataFactory", Types: []string{"factories", "linkedservices"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.DataFactory/factories/linkedservices"}}}},
+	{"azurerm_data_factory_linked_service_sftp", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.DataFactory", Types: []string{"factories", "linkedservices"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.DataFactory/factories/linkedservices"}}}},
+	{"azurerm_data_factory_linked_service_snowflake", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.DataFactory", Types: []string{"factories", "linkedservices"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.DataFactory/factories/linkedservices"}}}},
+	{"azurerm_data_factory_linked_service_sql_managed_instance", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.DataFactory", Types: []string{"factories", "linkedservices"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.DataFactory/factories/linkedservices"}}}},
+	{"azurerm_data_factory_linked_service_sql_server", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.DataFactory", Types: []string{"factories", "linkedservices"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.DataFactory/factories/linkedservices"}}}},
+	{"azurerm_data_factory_linked_service_synapse", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.DataFactory", Types: []string{"factories", "linkedservices"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.DataFactory/factories/linkedservices"}}}},
+	{"azurerm_data_factory_linked_service_web", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.DataFactory", Types: []string{"factories", "linkedservices"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.DataFactory/factories/linkedservices"}}}},
+	{"azurerm_data_factory_managed_private_endpoint", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.DataFactory", Types: []string{"factories", "managedVirtualNetworks", "managedPrivateEndpoints"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.DataFactory/factories/managedVirtualNetworks/managedPrivateEndpoints"}}}},
+	{"azurerm_data_factory_pipeline", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.DataFactory", Types: []string{"factories", "pipelines"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.DataFactory/factories/pipelines"}}}},
+	{"azurerm_data_factory_trigger_blob_event", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.DataFactory", Types: []string{"factories", "triggers"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.DataFactory/factories/triggers"}}}},
+	{"azurerm_data_factory_trigger_custom_event", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.DataFactory", Types: []string{"factories", "triggers"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.DataFactory/factories/triggers"}}}},
+	{"azurerm_data_factory_trigger_schedule", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.DataFactory", Types: []string{"factories", "triggers"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.DataFactory/factories/triggers"}}}},
+	{"azurerm_data_factory_trigger_tumbling_window", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.DataFactory", Types: []string{"factories", "triggers"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.DataFactory/factories/triggers"}}}},
+	{"azurerm_data_protection_backup_instance_blob_storage", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.DataProtection", Types: []string{"backupVaults", "backupInstances"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.DataProtection/backupVaults/backupInstances"}}}},
+	{"azurerm_data_protection_backup_instance_data_lake_storage", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.DataProtection", Types: []string{"backupVaults", "backupInstances"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.DataProtection/backupVaults/backupInstances"}}}},
+	{"azurerm_data_protection_backup_instance_disk", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.DataProtection", Types: []string{"backupVaults", "backupInstances"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.DataProtection/backupVaults/backupInstances"}}}},
+	{"azurerm_data_protection_backup_instance_kubernetes_cluster", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.DataProtection", Types: []string{"backupVaults", "backupInstances"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.DataProtection/backupVaults/backupInstances"}}}},
+	{"azurerm_data_protection_backup_instance_mysql_flexible_server", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.DataProtection", Types: []string{"backupVaults", "backupInstances"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.DataProtection/backupVaults/backupInstances"}}}},
+	{"azurerm_data_protection_backup_instance_postgresql", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.DataProtection", Types: []string{"backupVaults", "backupInstances"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.DataProtection/backupVaults/backupInstances"}}}},
+	{"azurerm_data_protection_backup_instance_postgresql_flexible_server", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.DataProtection", Types: []string{"backupVaults", "backupInstances"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.DataProtection/backupVaults/backupInstances"}}}},
+	{"azurerm_data_protection_backup_policy_blob_storage", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.DataProtection", Types: []string{"backupVaults", "backupPolicies"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.DataProtection/backupVaults/backupPolicies"}}}},
+	{"azurerm_data_protection_backup_policy_data_lake_storage", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.DataProtection", Types: []string{"backupVaults", "backupPolicies"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.DataProtection/backupVaults/backupPolicies"}}}},
+	{"azurerm_data_protection_backup_policy_disk", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.DataProtection", Types: []string{"backupVaults", "backupPolicies"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.DataProtection/backupVaults/backupPolicies"}}}},
+	{"azurerm_data_protection_backup_policy_kubernetes_cluster", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.DataProtection", Types: []string{"backupVaults", "backupPolicies"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.DataProtection/backupVaults/backupPolicies"}}}},
+	{"azurerm_data_protection_backup_policy_mysql_flexible_server", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.DataProtection", Types: []string{"backupVaults", "backupPolicies"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.DataProtection/backupVaults/backupPolicies"}}}},
+	{"azurerm_data_protection_backup_policy_postgresql", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.DataProtection", Types: []string{"backupVaults", "backupPolicies"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.DataProtection/backupVaults/backupPolicies"}}}},
+	{"azurerm_data_protection_backup_policy_postgresql_flexible_server", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.DataProtection", Types: []string{"backupVaults", "backupPolicies"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.DataProtection/backupVaults/backupPolicies"}}}},
+	{"azurerm_data_protection_backup_vault", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.DataProtection", Types: []string{"backupVaults"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.DataProtection/backupVaults"}}}},
+	{"azurerm_data_protection_backup_vault_customer_managed_key", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.DataProtection", Types: []string{"backupVaults"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.DataProtection/backupVaults"}}, IsRemoved: true, RemoveReason: "This is a property rather than a resource", Deprecation: &Deprecation{Reason: "This is a property rather than a resource", Successors: nil}}},
+	{"azurerm_data_protection_resource_guard", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.DataProtection", Types: []string{"resourceGuards"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.DataProtection/resourceGuards"}}}},
+	{"azurerm_data_share", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.DataShare", Types: []string{"accounts", "shares"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.DataShare/accounts/shares"}}}},
+	{"azurerm_data_share_account", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.DataShare", Types: []string{"accounts"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.DataShare/accounts"}}}},
+	{"azurerm_data_share_dataset_blob_storage", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.DataShare", Types: []string{"accounts", "shares", "dataSets"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.DataShare/accounts/shares/dataSets"}}}},
+	{"azurerm_data_share_dataset_data_lake_gen2", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.DataShare", Types: []string{"accounts", "shares", "dataSets"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.DataShare/accounts/shares/dataSets"}}}},
+	{"azurerm_data_share_dataset_kusto_cluster", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.DataShare", Types: []string{"accounts", "shares", "dataSets"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.DataShare/accounts/shares/dataSets"}}}},
+	{"azurerm_data_share_dataset_kusto_database", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.DataShare", Types: []string{"accounts", "shares", "dataSets"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.DataShare/accounts/shares/dataSets"}}}},
+	{"azurerm_database_migration_project", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.DataMigration", Types: []string{"services", "projects"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.DataMigration/services/projects"}}}},
+	{"azurerm_database_migration_service", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.DataMigration", Types: []string{"services"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.DataMigration/services"}}}},
+	{"azurerm_databox_edge_device", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.DataBoxEdge", Types: []string{"dataBoxEdgeDevices"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.DataBoxEdge/dataBoxEdgeDevices"}}}},
+	{"azurerm_databricks_access_connector", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Databricks", Types: []string{"accessConnectors"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Databricks/accessConnectors"}}}},
+	{"azurerm_databricks_virtual_network_peering", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Databricks", Types: []string{"workspaces", "virtualNetworkPeerings"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Databricks/workspaces/virtualNetworkPeerings"}}}},
+	{"azurerm_databricks_workspace", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Databricks", Types: []string{"workspaces"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Databricks/workspaces"}}}},
+	{"azurerm_databricks_workspace_root_dbfs_customer_managed_key", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Databricks", Types: []string{"workspaces"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Databricks/workspaces"}}, IsRemoved: true, RemoveReason: "This is a property rather than a resource", Deprecation: &Deprecation{Reason: "This is a property rather than a resource", Successors: nil}}},
+	{"azurerm_datadog_monitor", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Datadog", Types: []string{"monitors"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Datadog/monitors"}}}},
+	{"azurerm_datadog_monitor_sso_configuration", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Datadog", Types: []string{"monitors", "singleSignOnConfigurations"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Datadog/monitors/singleSignOnConfigurations"}}}},
+	{"azurerm_datadog_monitor_tag_rule", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Datadog", Types: []string{"monitors", "tagRules"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Datadog/monitors/tagRules"}}}},
+	{"azurerm_dedicated_hardware_security_module", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.HardwareSecurityModules", Types: []string{"dedicatedHSMs"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.HardwareSecurityModules/dedicatedHSMs"}}}},
+	{"azurerm_dedicated_host", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Compute", Types: []string{"hostGroups", "hosts"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Compute/hostGroups/hosts"}}}},
+	{"azurerm_dedicated_host_group", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Compute", Types: []string{"hostGroups"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Compute/hostGroups"}}}},
+	{"azurerm_dev_center", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.DevCenter", Types: []string{"devCenters"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.DevCenter/devCenters"}}}},
+	{"azurerm_dev_center_attached_network", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.DevCenter", Types: []string{"devCenters", "attachedNetworks"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.DevCenter/devCenters/attachedNetworks"}}}},
+	{"azurerm_dev_center_catalog", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.DevCenter", Types: []string{"devCenters", "catalogs"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.DevCenter/devCenters/catalogs"}}}},
+	{"azurerm_dev_center_dev_box_definition", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.DevCenter", Types: []string{"devCenters", "devBoxDefinitions"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.DevCenter/devCenters/devBoxDefinitions"}}}},
+	{"azurerm_dev_center_environment_type", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.DevCenter", Types: []string{"devCenters", "environmentTypes"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.DevCenter/devCenters/environmentTypes"}}}},
+	{"azurerm_dev_center_gallery", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.DevCenter", Types: []string{"devCenters", "galleries"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.DevCenter/devCenters/galleries"}}}},
+	{"azurerm_dev_center_network_connection", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.DevCenter", Types: []string{"networkConnections"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.DevCenter/networkConnections"}}}},
+	{"azurerm_dev_center_project", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.DevCenter", Types: []string{"projects"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.DevCenter/projects"}}}},
+	{"azurerm_dev_center_project_environment_type", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.DevCenter", Types: []string{"projects", "environmentTypes"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.DevCenter/projects/environmentTypes"}}}},
+	{"azurerm_dev_center_project_pool", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.DevCenter", Types: []string{"projects", "pools"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.DevCenter/projects/pools"}}}},
+	{"azurerm_dev_test_global_vm_shutdown_schedule", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.DevTestLab", Types: []string{"schedules"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.DevTestLab/schedules"}}}},
+	{"azurerm_dev_test_lab", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.DevTestLab", Types: []string{"labs"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.DevTestLab/labs"}}}},
+	{"azurerm_dev_test_linux_virtual_machine", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.DevTestLab", Types: []string{"labs", "virtualMachines"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.DevTestLab/labs/virtualMachines"}}}},
+	{"azurerm_dev_test_policy", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.DevTestLab", Types: []string{"labs", "policySets", "policies"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.DevTestLab/labs/policySets/policies"}}}},
+	{"azurerm_dev_test_schedule", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.DevTestLab", Types: []string{"labs", "schedules"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.DevTestLab/labs/schedules"}}}},
+	{"azurerm_dev_test_virtual_network", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.DevTestLab", Types: []string{"labs", "virtualNetworks"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.DevTestLab/labs/virtualNetworks"}}}},
+	{"azurerm_dev_test_windows_virtual_machine", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.DevTestLab", Types: []string{"labs", "virtualMachines"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.DevTestLab/labs/virtualMachines"}}}},
+	{"azurerm_digital_twins_endpoint_eventgrid", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.DigitalTwins", Types: []string{"digitalTwinsInstances", "endpoints"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.DigitalTwins/digitalTwinsInstances/endpoints"}}}},
+	{"azurerm_digital_twins_endpoint_eventhub", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.DigitalTwins", Types: []string{"digitalTwinsInstances", "endpoints"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.DigitalTwins/digitalTwinsInstances/endpoints"}}}},
+	{"azurerm_digital_twins_endpoint_servicebus", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.DigitalTwins", Types: []string{"digitalTwinsInstances", "endpoints"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.DigitalTwins/digitalTwinsInstances/endpoints"}}}},
+	{"azurerm_digital_twins_instance", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.DigitalTwins", Types: []string{"digitalTwinsInstances"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.DigitalTwins/digitalTwinsInstances"}}}},
+	{"azurerm_digital_twins_time_series_database_connection", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.DigitalTwins", Types: []string{"digitalTwinsInstances", "timeSeriesDatabaseConnections"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.DigitalTwins/digitalTwinsInstances/timeSeriesDatabaseConnections"}}}},
+	{"azurerm_disk_access", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Compute", Types: []string{"diskAccesses"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Compute/diskAccesses"}}}},
+	{"azurerm_disk_encryption_set", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Compute", Types: []string{"diskEncryptionSets"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Compute/diskEncryptionSets"}}}},
+	{"azurerm_dns_a_record", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Network", Types: []string{"dnszones", "A"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Network/dnsZones/A"}}}},
+	{"azurerm_dns_aaaa_record", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Network", Types: []string{"dnszones", "AAAA"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Network/dnsZones/AAAA"}}}},
+	{"azurerm_dns_caa_record", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Network", Types: []string{"dnszones", "CAA"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Network/dnsZones/CAA"}}}},
+	{"azurerm_dns_cname_record", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Network", Types: []string{"dnszones", "CNAME"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Network/dnsZones/CNAME"}}}},
+	{"azurerm_dns_mx_record", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Network", Types: []string{"dnszones", "MX"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Network/dnsZones/MX"}}}},
+	{"azurerm_dns_ns_record", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Network", Types: []string{"dnszones", "NS"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Network/dnsZones/NS"}}}},
+	{"azurerm_dns_ptr_record", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Network", Types: []string{"dnszones", "PTR"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Network/dnsZones/PTR"}}}},
+	{"azurerm_dns_srv_record", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Network", Types: []string{"dnszones", "SRV"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Network/dnsZones/SRV"}}}},
+	{"azurerm_dns_txt_record", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Network", Types: []string{"dnszones", "TXT"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Network/dnsZones/TXT"}}}},
+	{"azurerm_dns_zone", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Network", Types: []string{"dnszones"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Network/dnsZones"}}}},
+	{"azurerm_dynatrace_tag_rules", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Dynatrace.Observability", Types: []string{"monitors", "tagRules"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Dynatrace.Observability/monitors/tagRules"}}}},
+	{"azurerm_elastic_cloud_elasticsearch", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Elastic", Types: []string{"monitors"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Elastic/monitors"}}}},
+	{"azurerm_elastic_san", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.ElasticSan", Types: []string{"elasticSans"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.ElasticSan/elasticSans"}}}},
+	{"azurerm_elastic_san_volume", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.ElasticSan", Types: []string{"elasticSans", "volumeGroups", "volumes"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.ElasticSan/elasticSans/volumeGroups/volumes"}}}},
+	{"azurerm_elastic_san_volume_group", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.ElasticSan", Types: []string{"elasticSans", "volumeGroups"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.ElasticSan/elasticSans/volumeGroups"}}}},
+	{"azurerm_email_communication_service", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Communication", Types: []string{"emailServices"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Communication/emailServices"}}}},
+	{"azurerm_email_communication_service_domain", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Communication", Types: []string{"emailServices", "domains"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Communication/emailServices/domains"}}}},
+	{"azurerm_email_communication_service_domain_sender_username", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Communication", Types: []string{"emailServices", "domains", "senderUsernames"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Communication/emailServices/domains/senderUsernames"}}}},
+	{"azurerm_eventgrid_domain", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.EventGrid", Types: []string{"domains"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.EventGrid/domains"}}}},
+	{"azurerm_eventgrid_domain_topic", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.EventGrid", Types: []string{"domains", "topics"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.EventGrid/domains/topics"}}}},
+	{"azurerm_eventgrid_event_subscription", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"any"}, Provider: "Microsoft.EventGrid", Types: []string{"eventSubscriptions"}}}},
+	{"azurerm_eventgrid_namespace", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.EventGrid", Types: []string{"namespaces"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.EventGrid/namespaces"}}}},
+	{"azurerm_eventgrid_namespace_topic", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.EventGrid", Types: []string{"namespaces", "topics"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.EventGrid/namespaces/topics"}}}},
+	{"azurerm_eventgrid_partner_configuration", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.EventGrid", Types: []string{"partnerConfigurations"}, ImportSpecs: []string{"/subscriptions/resourceGroups"}, IdTransform: &IdTransform{ConstantNames: map[string]string{"partnerConfigurations": "default"}, Projection: "parent_scope"}}}},
+	{"azurerm_eventgrid_partner_namespace", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.EventGrid", Types: []string{"partnerNamespaces"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.EventGrid/partnerNamespaces"}}}},
+	{"azurerm_eventgrid_partner_registration", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.EventGrid", Types: []string{"partnerRegistrations"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.EventGrid/partnerRegistrations"}}}},
+	{"azurerm_eventgrid_system_topic", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.EventGrid", Types: []string{"systemTopics"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.EventGrid/systemTopics"}}}},
+	{"azurerm_eventgrid_system_topic_event_subscription", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.EventGrid", Types: []string{"systemTopics", "eventSubscriptions"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.EventGrid/systemTopics/eventSubscriptions"}}}},
+	{"azurerm_eventgrid_topic", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.EventGrid", Types: []string{"topics"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.EventGrid/topics"}}}},
+	{"azurerm_eventhub", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.EventHub", Types: []string{"namespaces", "eventhubs"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.EventHub/namespaces/eventhubs"}}}},
+	{"azurerm_eventhub_authorization_rule", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.EventHub", Types: []string{"namespaces", "eventhubs", "authorizationRules"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.EventHub/namespaces/eventhubs/authorizationRules"}}}},
+	{"azurerm_eventhub_cluster", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.EventHub", Types: []string{"clusters"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.EventHub/clusters"}}}},
+	{"azurerm_eventhub_consumer_group", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.EventHub", Types: []string{"namespaces", "eventhubs", "consumerGroups"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.EventHub/namespaces/eventhubs/consumerGroups"}}}},
+	{"azurerm_eventhub_namespace", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.EventHub", Types: []string{"namespaces"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.EventHub/namespaces"}}}},
+	{"azurerm_eventhub_namespace_authorization_rule", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.EventHub", Types: []string{"namespaces", "authorizationRules"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.EventHub/namespaces/authorizationRules"}}}},
+	{"azurerm_eventhub_namespace_customer_managed_key", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.EventHub", Types: []string{"namespaces"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.EventHub/namespaces"}}, IsRemoved: true, RemoveReason: "This is a property rather than a resource", Deprecation: &Deprecation{Reason: "This is a property rather than a resource", Successors: nil}}},
+	{"azurerm_eventhub_namespace_disaster_recovery_config", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.EventHub", Types: []string{"namespaces", "disasterRecoveryConfigs"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.EventHub/namespaces/disasterRecoveryConfigs"}}}},
+	{"azurerm_eventhub_namespace_schema_group", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.EventHub", Types: []string{"namespaces", "schemaGroups"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.EventHub/namespaces/schemaGroups"}}}},
+	{"azurerm_express_route_circuit", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Network", Types: []string{"expressRouteCircuits"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Network/expressRouteCircuits"}}}},
+	{"azurerm_express_route_circuit_authorization", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Network", Types: []string{"expressRouteCircuits", "authorizations"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Network/expressRouteCircuits/authorizations"}}}},
+	{"azurerm_express_route_circuit_connection", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Network", Types: []string{"expressRouteCircuits", "peerings", "connections"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Network/expressRouteCircuits/peerings/connections"}}}},
+	{"azurerm_express_route_circuit_peering", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Network", Types: []string{"expressRouteCircuits", "peerings"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Network/expressRouteCircuits/peerings"}}}},
+	{"azurerm_express_route_connection", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Network", Types: []string{"expressRouteGateways", "expressRouteConnections"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Network/expressRouteGateways/expressRouteConnections"}}}},
+	{"azurerm_express_route_gateway", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Network", Types: []string{"expressRouteGateways"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Network/expressRouteGateways"}}}},
+	{"azurerm_express_route_port", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Network", Types: []string{"expressRoutePorts"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Network/expressRoutePorts"}}}},
+	{"azurerm_express_route_port_authorization", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Network", Types: []string{"expressRoutePorts", "authorizations"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Network/expressRoutePorts/authorizations"}}}},
+	{"azurerm_extended_location_custom_location", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.ExtendedLocation", Types: []string{"customLocations"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.ExtendedLocation/customLocations"}}}},
+	{"azurerm_fabric_capacity", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Fabric", Types: []string{"capacities"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Fabric/capacities"}}}},
+	{"azurerm_federated_identity_credential", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.ManagedIdentity", Types: []string{"userAssignedIdentities", "federatedIdentityCredentials"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.ManagedIdentity/userAssignedIdentities/federatedIdentityCredentials"}}}},
+	{"azurerm_firewall", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Network", Types: []string{"azureFirewalls"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Network/azureFirewalls"}}}},
+	{"azurerm_firewall_application_rule_collection", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Network", Types: []string{"azureFirewalls", "applicationRuleCollections"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Network/azureFirewalls/applicationRuleCollections"}}}},
+	{"azurerm_firewall_nat_rule_collection", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Network", Types: []string{"azureFirewalls", "natRuleCollections"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Network/azureFirewalls/natRuleCollections"}}}},
+	{"azurerm_firewall_network_rule_collection", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Network", Types: []string{"azureFirewalls", "networkRuleCollections"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Network/azureFirewalls/networkRuleCollections"}}}},
+	{"azurerm_firewall_policy", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Network", Types: []string{"firewallPolicies"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Network/firewallPolicies"}}}},
+	{"azurerm_firewall_policy_rule_collection_group", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Network", Types: []string{"firewallPolicies", "ruleCollectionGroups"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Network/firewallPolicies/ruleCollectionGroups"}}}},
+	{"azurerm_fluid_relay_server", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.FluidRelay", Types: []string{"fluidRelayServers"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.FluidRelay/fluidRelayServers"}}}},
+	{"azurerm_frontdoor", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Network", Types: []string{"frontDoors"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Network/frontDoors"}}}},
+	{"azurerm_frontdoor_custom_https_configuration", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Network", Types: []string{"frontDoors", "customHttpsConfiguration"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Network/frontDoors/customHttpsConfiguration"}}}},
+	{"azurerm_frontdoor_firewall_policy", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Network", Types: []string{"frontDoorWebApplicationFirewallPolicies"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Network/frontDoorWebApplicationFirewallPolicies"}}}},
+	{"azurerm_frontdoor_rules_engine", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Network", Types: []string{"frontdoors", "rulesEngines"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Network/frontdoors/rulesEngines"}}}},
+	{"azurerm_function_app", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Web", Types: []string{"sites"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Web/sites"}}, IsRemoved: true, RemoveReason: "This is deprecated in favor of `azurerm_linux_function_app` and `azurerm_windows_function_app`", Deprecation: &Deprecation{Reason: "This is deprecated in favor of `azurerm_linux_function_app` and `azurerm_windows_function_app`", Successors: []string{"azurerm_linux_function_app", "azurerm_windows_function_app"}}}},
+	{"azurerm_function_app_active_slot", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Web", Types: []string{"sites"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Web/sites"}}, IsRemoved: true, RemoveReason: "This is a property rather than a resource", Deprecation: &Deprecation{Reason: "This is a property rather than a resource", Successors: nil}}},
+	{"azurerm_function_app_connection", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups/Microsoft.Web/sites"}, Provider: "Microsoft.ServiceLinker", Types: []string{"linkers"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Web/sites/Microsoft.ServiceLinker/linkers"}}}},
+	{"azurerm_function_app_flex_consumption", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Web", Types: []string{"sites"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Web/sites"}}}},
+	{"azurerm_function_app_function", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Web", Types: []string{"sites", "functions"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Web/sites/functions"}}}},
+	{"azurerm_function_app_hybrid_connection", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Web", Types: []string{"sites", "hybridConnectionNamespaces", "relays"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Web/sites/hybridConnectionNamespaces/relays"}}}},
+	{"azurerm_function_app_slot", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Web", Types: []string{"sites", "slots"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Web/sites/slots"}}, IsRemoved: true, RemoveReason: "This is deprecated in favor of `azurerm_linux_function_app_slot` and `azurerm_windows_function_app_slot`", Deprecation: &Deprecation{Reason: "This is deprecated in favor of `azurerm_linux_function_app_slot` and `azurerm_windows_function_app_slot`", Successors: []string{"azurerm_linux_function_app_slot", "azurerm_windows_function_app_slot"}}}},
+	{"azurerm_gallery_application", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Compute", Types: []string{"galleries", "applications"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Compute/galleries/applications"}}}},
+	{"azurerm_gallery_application_version", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Compute", Types: []string{"galleries", "applications", "versions"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Compute/galleries/applications/versions"}}}},
+	{"azurerm_graph_services_account", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.GraphServices", Types: []string{"accounts"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.GraphServices/accounts"}}}},
+	{"azurerm_hdinsight_hadoop_cluster", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.HDInsight", Types: []string{"clusters"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.HDInsight/clusters"}}}},
+	{"azurerm_hdinsight_hbase_cluster", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.HDInsight", Types: []string{"clusters"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.HDInsight/clusters"}}}},
+	{"azurerm_hdinsight_interactive_query_cluster", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.HDInsight", Types: []string{"clusters"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.HDInsight/clusters"}}}},
+	{"azurerm_hdinsight_kafka_cluster", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.HDInsight", Types: []string{"clusters"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.HDInsight/clusters"}}}},
+	{"azurerm_hdinsight_spark_cluster", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.HDInsight", Types: []string{"clusters"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.HDInsight/clusters"}}}},
+	{"azurerm_healthbot", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.HealthBot", Types: []string{"healthBots"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.HealthBot/healthBots"}}}},
+	{"azurerm_healthcare_dicom_service", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.HealthcareApis", Types: []string{"workspaces", "dicomServices"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.HealthcareApis/workspaces/dicomServices"}}}},
+	{"azurerm_healthcare_fhir_service", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.HealthcareApis", Types: []string{"workspaces", "fhirServices"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.HealthcareApis/workspaces/fhirServices"}}}},
+	{"azurerm_healthcare_medtech_service", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.HealthcareApis", Types: []string{"workspaces", "iotConnectors"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.HealthcareApis/workspaces/iotConnectors"}}}},
+	{"azurerm_healthcare_medtech_service_fhir_destination", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.HealthcareApis", Types: []string{"workspaces", "iotConnectors", "fhirDestinations"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.HealthcareApis/workspaces/iotConnectors/fhirDestinations"}}}},
+	{"azurerm_healthcare_service", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.HealthcareApis", Types: []string{"services"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.HealthcareApis/services"}}}},
+	{"azurerm_healthcare_workspace", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.HealthcareApis", Types: []string{"workspaces"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.HealthcareApis/workspaces"}}}},
+	{"azurerm_hpc_cache", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.StorageCache", Types: []string{"caches"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.StorageCache/caches"}}}},
+	{"azurerm_hpc_cache_access_policy", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.StorageCache", Types: []string{"caches", "cacheAccessPolicies"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.StorageCache/caches/cacheAccessPolicies"}}}},
+	{"azurerm_hpc_cache_blob_nfs_target", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.StorageCache", Types: []string{"caches", "storageTargets"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.StorageCache/caches/storageTargets"}}}},
+	{"azurerm_hpc_cache_blob_target", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.StorageCache", Types: []string{"caches", "storageTargets"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.StorageCache/caches/storageTargets"}}}},
+	{"azurerm_hpc_cache_nfs_target", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.StorageCache", Types: []string{"caches", "storageTargets"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.StorageCache/caches/storageTargets"}}}},
+	{"azurerm_image", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Compute", Types: []string{"images"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Compute/images"}}}},
+	{"azurerm_iot_security_device_group", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"any"}, Provider: "Microsoft.Security", Types: []string{"deviceSecurityGroups"}}}},
+	{"azurerm_iot_security_solution", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Security", Types: []string{"iotSecuritySolutions"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Security/iotSecuritySolutions"}}}},
+	{"azurerm_iotcentral_application", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.IoTCentral", Types: []string{"iotApps"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.IoTCentral/iotApps"}}}},
+	{"azurerm_iotcentral_application_network_rule_set", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.IoTCentral", Types: []string{"iotApps", "networkRuleSets"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.IoTCentral/iotApps"}, IdTransform: &IdTransform{ConstantNames: map[string]string{"networkRuleSets": "default"}, Projection: "parent"}}}},
+	{"azurerm_iotcentral_organization", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.IoTCentral", Types: []string{"iotApps", "organizations"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.IoTCentral/iotApps/organizations"}}}},
+	{"azurerm_iothub", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Devices", Types: []string{"iotHubs"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Devices/iotHubs"}}}},
+	{"azurerm_iothub_certificate", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Devices", Types: []string{"iotHubs", "certificates"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Devices/iotHubs/certificates"}}}},
+	{"azurerm_iothub_consumer_group", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Devices", Types: []string{"iotHubs", "eventHubEndpoints", "consumerGroups"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Devices/iotHubs/eventHubEndpoints/consumerGroups"}}}},
+	{"azurerm_iothub_device_update_account", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.DeviceUpdate", Types: []string{"accounts"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.DeviceUpdate/accounts"}}}},
+	{"azurerm_iothub_device_update_instance", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.DeviceUpdate", Types: []string{"accounts", "instances"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.DeviceUpdate/accounts/instances"}}}},
+	{"azurerm_iothub_dps", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Devices", Types: []string{"provisioningServices"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Devices/provisioningServices"}}}},
+	{"azurerm_iothub_dps_certificate", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Devices", Types: []string{"provisioningServices", "certificates"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Devices/provisioningServices/certificates"}}}},
+	{"azurerm_iothub_dps_shared_access_policy", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Devices", Types: []string{"provisioningServices", "keys"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Devices/provisioningServices/keys"}}}},
+	{"azurerm_iothub_endpoint_cosmosdb_account", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Devices", Types: []string{"iotHubs", "endpointsCosmosdbAccount"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Devices/iotHubs/endpoints"}, IdTransform: &IdTransform{TypeRenames: map[string]string{"endpointsCosmosdbAccount": "endpoints"}}}}},
+	{"azurerm_iothub_endpoint_eventhub", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Devices", Types: []string{"iotHubs", "endpointsEventhub"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Devices/iotHubs/endpoints"}, IdTransform: &IdTransform{TypeRenames: map[string]string{"endpointsEventhub": "endpoints"}}}}},
+	{"azurerm_iothub_endpoint_servicebus_queue", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Devices", Types: []string{"iotHubs", "endpointsServicebusQueue"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Devices/iotHubs/endpoints"}, IdTransform: &IdTransform{TypeRenames: map[string]string{"endpointsServicebusQueue": "endpoints"}}}}},
+	{"azurerm_iothub_endpoint_servicebus_topic", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Devices", Types: []string{"iotHubs", "endpointsServicebusTopic"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Devices/iotHubs/endpoints"}, IdTransform: &IdTransform{TypeRenames: map[string]string{"endpointsServicebusTopic": "endpoints"}}}}},
+	{"azurerm_iothub_endpoint_storage_container", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Devices", Types: []string{"iotHubs", "endpointsStorageContainer"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Devices/iotHubs/endpoints"}, IdTransform: &IdTransform{TypeRenames: map[string]string{"endpointsStorageContainer": "endpoints"}}}}},
+	{"azurerm_iothub_enrichment", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Devices", Types: []string{"iotHubs", "enrichments"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Devices/iotHubs/enrichments"}}}},
+	{"azurerm_iothub_fallback_route", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Devices", Types: []string{"iotHubs", "fallbackRoute"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Devices/iotHubs/fallbackRoute"}}}},
+	{"azurerm_iothub_file_upload", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Devices", Types: []string{"iotHubs"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Devices/iotHubs"}}, IsRemoved: true, RemoveReason: "This is a property rather than a resource", Deprecation: &Deprecation{Reason: "This is a property rather than a resource", Successors: nil}}},
+	{"azurerm_iothub_route", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Devices", Types: []string{"iotHubs", "routes"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Devices/iotHubs/routes"}}}},
+	{"azurerm_iothub_shared_access_policy", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Devices", Types: []string{"iotHubs", "iotHubKeys"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Devices/iotHubs/iotHubKeys"}}}},
+	{"azurerm_ip_group", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Network", Types: []string{"ipGroups"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Network/ipGroups"}}}},
+	{"azurerm_ip_group_cidr", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Network", Types: []string{"ipGroups", "cidrs"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Network/ipGroups/cidrs"}}}},
+	{"azurerm_key_vault", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.KeyVault", Types: []string{"vaults"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.KeyVault/vaults"}}}},
+	{"azurerm_key_vault_access_policy", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.KeyVault", Types: []string{"vaults", "objectId"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.KeyVault/vaults/objectId"}}}},
+	{"azurerm_key_vault_certificate", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.KeyVault", Types: []string{"vaults", "certificates"}}}},
+	{"azurerm_key_vault_certificate_contacts", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.KeyVault", Types: []string{"vaults", "certificates", "contacts"}}}},
+	{"azurerm_key_vault_certificate_issuer", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.KeyVault", Types: []string{"vaults", "certificates", "issuers"}}}},
+	{"azurerm_key_vault_key", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.KeyVault", Types: []string{"vaults", "keys"}}}},
+	{"azurerm_key_vault_managed_hardware_security_module", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.KeyVault", Types: []string{"managedHSMs"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.KeyVault/managedHSMs"}}}},
+	{"azurerm_key_vault_managed_storage_account", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.KeyVault", Types: []string{"vaults", "storage"}}}},
+	{"azurerm_key_vault_managed_storage_account_sas_token_definition", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.KeyVault", Types: []string{"vaults", "storage", "sas"}}}},
+	{"azurerm_key_vault_secret", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.KeyVault", Types: []string{"vaults", "secrets"}}}},
+	{"azurerm_kubernetes_automatic_cluster", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.ContainerService", Types: []string{"managedClusters"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.ContainerService/managedClusters"}}}},
+	{"azurerm_kubernetes_cluster", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.ContainerService", Types: []string{"managedClusters"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.ContainerService/managedClusters"}}}},
+	{"azurerm_kubernetes_cluster_deployment_safeguard", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups/Microsoft.ContainerService/managedClusters"}, Provider: "Microsoft.ContainerService", Types: []string{"deploymentSafeguards"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.ContainerService/managedClusters"}, IdTransform: &IdTransform{ConstantNames: map[string]string{"deploymentSafeguards": "default"}, Projection: "parent_scope"}}}},
+	{"azurerm_kubernetes_cluster_extension", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups/Microsoft.ContainerService/managedClusters"}, Provider: "Microsoft.KubernetesConfiguration", Types: []string{"extensions"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.ContainerService/managedClusters/Microsoft.KubernetesConfiguration/extensions"}}}},
+	{"azurerm_kubernetes_cluster_node_pool", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.ContainerService", Types: []string{"managedClusters", "agentPools"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.ContainerService/managedClusters/agentPools"}}}},
+	{"azurerm_kubernetes_cluster_trusted_access_role_binding", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.ContainerService", Types: []string{"managedClusters", "trustedAccessRoleBindings"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.ContainerService/managedClusters/trustedAccessRoleBindings"}}}},
+	{"azurerm_kubernetes_fleet_manager", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.ContainerService", Types: []string{"fleets"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.ContainerService/fleets"}}}},
+	{"azurerm_kubernetes_fleet_member", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.ContainerService", Types: []string{"fleets", "members"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.ContainerService/fleets/members"}}}},
+	{"azurerm_kubernetes_fleet_update_run", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.ContainerService", Types: []string{"fleets", "updateRuns"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.ContainerService/fleets/updateRuns"}}}},
+	{"azurerm_kubernetes_fleet_update_strategy", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.ContainerService", Types: []string{"fleets", "updateStrategies"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.ContainerService/fleets/updateStrategies"}}}},
+	{"azurerm_kubernetes_flux_configuration", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups/Microsoft.ContainerService/managedClusters"}, Provider: "Microsoft.KubernetesConfiguration", Types: []string{"fluxConfigurations"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.ContainerService/managedClusters/Microsoft.KubernetesConfiguration/fluxConfigurations"}}}},
+	{"azurerm_kusto_attached_database_configuration", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Kusto", Types: []string{"clusters", "attachedDatabaseConfigurations"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Kusto/clusters/attachedDatabaseConfigurations"}}}},
+	{"azurerm_kusto_cluster", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Kusto", Types: []string{"clusters"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Kusto/clusters"}}}},
+	{"azurerm_kusto_cluster_customer_managed_key", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Kusto", Types: []string{"clusters"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Kusto/clusters"}}, IsRemoved: true, RemoveReason: "This is a property rather than a resource", Deprecation: &Deprecation{Reason: "This is a property rather than a resource", Successors: nil}}},
+	{"azurerm_kusto_cluster_managed_private_endpoint", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Kusto", Types: []string{"clusters", "managedPrivateEndpoints"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Kusto/clusters/managedPrivateEndpoints"}}}},
+	{"azurerm_kusto_cluster_principal_assignment", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Kusto", Types: []string{"clusters", "principalAssignments"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Kusto/clusters/principalAssignments"}}}},
+	{"azurerm_kusto_cosmosdb_data_connection", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Kusto", Types: []string{"clusters", "databases", "dataConnections"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Kusto/clusters/databases/dataConnections"}}}},
+	{"azurerm_kusto_database", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Kusto", Types: []string{"clusters", "databases"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Kusto/clusters/databases"}}}},
+	{"azurerm_kusto_database_principal_assignment", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Kusto", Types: []string{"clusters", "databases", "principalAssignments"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Kusto/clusters/databases/principalAssignments"}}}},
+	{"azurerm_kusto_eventgrid_data_connection", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Kusto", Types: []string{"clusters", "databases", "dataConnections"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Kusto/clusters/databases/dataConnections"}}}},
+	{"azurerm_kusto_eventhub_data_connection", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Kusto", Types: []string{"clusters", "databases", "dataConnections"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Kusto/clusters/databases/dataConnections"}}}},
+	{"azurerm_kusto_iothub_data_connection", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Kusto", Types: []string{"clusters", "databases", "dataConnections"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Kusto/clusters/databases/dataConnections"}}}},
+	{"azurerm_kusto_script", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Kusto", Types: []string{"clusters", "databases", "scripts"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Kusto/clusters/databases/scripts"}}}},
+	{"azurerm_lb", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Network", Types: []string{"loadBalancers"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Network/loadBalancers"}}}},
+	{"azurerm_lb_backend_address_pool", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Network", Types: []string{"loadBalancers", "backendAddressPools"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Network/loadBalancers/backendAddressPools"}}}},
+	{"azurerm_lb_backend_address_pool_address", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Network", Types: []string{"loadBalancers", "backendAddressPools", "addresses"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Network/loadBalancers/backendAddressPools/addresses"}}}},
+	{"azurerm_lb_nat_pool", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Network", Types: []string{"loadBalancers", "inboundNatPools"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Network/loadBalancers/inboundNatPools"}}}},
+	{"azurerm_lb_nat_rule", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Network", Types: []string{"loadBalancers", "inboundNatRules"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Network/loadBalancers/inboundNatRules"}}}},
+	{"azurerm_lb_outbound_rule", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Network", Types: []string{"loadBalancers", "outboundRules"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Network/loadBalancers/outboundRules"}}}},
+	{"azurerm_lb_probe", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Network", Types: []string{"loadBalancers", "probes"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Network/loadBalancers/probes"}}}},
+	{"azurerm_lb_rule", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Network", Types: []string{"loadBalancers", "loadBalancingRules"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Network/loadBalancers/loadBalancingRules"}}}},
+	{"azurerm_lighthouse_assignment", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions", "/subscriptions/resourceGroups"}, Provider: "Microsoft.ManagedServices", Types: []string{"registrationAssignments"}, ImportSpecs: []string{"/subscriptions/Microsoft.ManagedServices/registrationAssignments", "/subscriptions/resourceGroups/Microsoft.ManagedServices/registrationAssignments"}}}},
+	{"azurerm_lighthouse_definition", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions"}, Provider: "Microsoft.ManagedServices", Types: []string{"registrationDefinitions"}, ImportSpecs: []string{"/subscriptions/Microsoft.ManagedServices/registrationDefinitions"}}}},
+	{"azurerm_linux_function_app", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Web", Types: []string{"sites"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Web/sites"}}}},
+	{"azurerm_linux_function_app_slot", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Web", Types: []string{"sites", "slots"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Web/sites/slots"}}}},
+	{"azurerm_linux_virtual_machine", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Compute", Types: []string{"virtualMachines"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Compute/virtualMachines"}}}},
+	{"azurerm_linux_virtual_machine_scale_set", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Compute", Types: []string{"virtualMachineScaleSets"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Compute/virtualMachineScaleSets"}}}},
+	{"azurerm_linux_web_app", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Web", Types: []string{"sites"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Web/sites"}}}},
+	{"azurerm_linux_web_app_slot", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Web", Types: []string{"sites", "slots"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Web/sites/slots"}}}},
+	{"azurerm_load_test", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.LoadTestService", Types: []string{"loadTests"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.LoadTestService/loadTests"}}}},
+	{"azurerm_local_network_gateway", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Network", Types: []string{"localNetworkGateways"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Network/localNetworkGateways"}}}},
+	{"azurerm_log_analytics_cluster", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.OperationalInsights", Types: []string{"clusters"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.OperationalInsights/clusters"}}}},
+	{"azurerm_log_analytics_cluster_customer_managed_key", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.OperationalInsights", Types: []string{"clusters"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.OperationalInsights/clusters"}}, IsRemoved: true, RemoveReason: "This is a property rather than a resource", Deprecation: &Deprecation{Reason: "This is a property rather than a resource", Successors: nil}}},
+	{"azurerm_log_analytics_data_export_rule", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.OperationalInsights", Types: []string{"workspaces", "dataExports"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.OperationalInsights/workspaces/dataExports"}}}},
+	{"azurerm_log_analytics_datasource_windows_event", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.OperationalInsights", Types: []string{"workspaces", "dataSources"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.OperationalInsights/workspaces/dataSources"}}}},
+	{"azurerm_log_analytics_datasource_windows_performance_counter", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.OperationalInsights", Types: []string{"workspaces", "dataSources"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.OperationalInsights/workspaces/dataSources"}}}},
+	{"azurerm_log_analytics_linked_service", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.OperationalInsights", Types: []string{"workspaces", "linkedServices"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.OperationalInsights/workspaces/linkedServices"}}}},
+	{"azurerm_log_analytics_linked_storage_account", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.OperationalInsights", Types: []string{"workspaces", "linkedStorageAccounts"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.OperationalInsights/workspaces/linkedStorageAccounts"}}}},
+	{"azurerm_log_analytics_query_pack", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.OperationalInsights", Types: []string{"queryPacks"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.OperationalInsights/queryPacks"}}}},
+	{"azurerm_log_analytics_query_pack_query", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.OperationalInsights", Types: []string{"queryPacks", "queries"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.OperationalInsights/queryPacks/queries"}}}},
+	{"azurerm_log_analytics_saved_search", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.OperationalInsights", Types: []string{"workspaces", "savedSearches"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.OperationalInsights/workspaces/savedSearches"}}}},
+	{"azurerm_log_analytics_solution", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.OperationsManagement", Types: []string{"solutions"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.OperationsManagement/solutions"}}}},
+	{"azurerm_log_analytics_storage_insights", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.OperationalInsights", Types: []string{"workspaces", "storageInsightConfigs"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.OperationalInsights/workspaces/storageInsightConfigs"}}}},
+	{"azurerm_log_analytics_workspace", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.OperationalInsights", Types: []string{"workspaces"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.OperationalInsights/workspaces"}}}},
+	{"azurerm_log_analytics_workspace_table_custom_log", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.OperationalInsights", Types: []string{"workspaces", "tables"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.OperationalInsights/workspaces/tables"}}}},
+	{"azurerm_log_analytics_workspace_table_microsoft", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.OperationalInsights", Types: []string{"workspaces", "tables"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.OperationalInsights/workspaces/tables"}}}},
+	{"azurerm_logic_app_action_custom", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Logic", Types: []string{"workflows", "actions"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Logic/workflows/actions"}}}},
+	{"azurerm_logic_app_action_http", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Logic", Types: []string{"workflows", "actions"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Logic/workflows/actions"}}}},
+	{"azurerm_logic_app_integration_account", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Logic", Types: []string{"integrationAccounts"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Logic/integrationAccounts"}}}},
+	{"azurerm_logic_app_integration_account_agreement", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Logic", Types: []string{"integrationAccounts", "agreements"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Logic/integrationAccounts/agreements"}}}},
+	{"azurerm_logic_app_integration_account_assembly", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Logic", Types: []string{"integrationAccounts", "assemblies"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Logic/integrationAccounts/assemblies"}}}},
+	{"azurerm_logic_app_integration_account_batch_configuration", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Logic", Types: []string{"integrationAccounts", "batchConfigurations"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Logic/integrationAccounts/batchConfigurations"}}}},
+	{"azurerm_logic_app_integration_account_certificate", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Logic", Types: []string{"integrationAccounts", "certificates"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Logic/integrationAccounts/certificates"}}}},
+	{"azurerm_logic_app_integration_account_map", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Logic", Types: []string{"integrationAccounts", "maps"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Logic/integrationAccounts/maps"}}}},
+	{"azurerm_logic_app_integration_account_partner", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Logic", Types: []string{"integrationAccounts", "partners"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Logic/integrationAccounts/partners"}}}},
+	{"azurerm_logic_app_integration_account_schema", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Logic", Types: []string{"integrationAccounts", "schemas"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Logic/integrationAccounts/schemas"}}}},
+	{"azurerm_logic_app_integration_account_session", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Logic", Types: []string{"integrationAccounts", "sessions"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Logic/integrationAccounts/sessions"}}}},
+	{"azurerm_logic_app_standard", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Web", Types: []string{"sites"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Web/sites"}}}},
+	{"azurerm_logic_app_trigger_custom", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Logic", Types: []string{"workflows", "triggers"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Logic/workflows/triggers"}}}},
+	{"azurerm_logic_app_trigger_http_request", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Logic", Types: []string{"workflows", "triggers"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Logic/workflows/triggers"}}}},
+	{"azurerm_logic_app_trigger_recurrence", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Logic", Types: []string{"workflows", "triggers"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Logic/workflows/triggers"}}}},
+	{"azurerm_logic_app_workflow", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Logic", Types: []string{"workflows"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Logic/workflows"}}}},
+	{"azurerm_machine_learning_compute_cluster", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.MachineLearningServices", Types: []string{"workspaces", "computes"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.MachineLearningServices/workspaces/computes"}}}},
+	{"azurerm_machine_learning_compute_instance", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.MachineLearningServices", Types: []string{"workspaces", "computes"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.MachineLearningServices/workspaces/computes"}}}},
+	{"azurerm_machine_learning_datastore_blobstorage", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.MachineLearningServices", Types: []string{"workspaces", "dataStores"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.MachineLearningServices/workspaces/dataStores"}}}},
+	{"azurerm_machine_learning_datastore_datalake_gen2", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.MachineLearningServices", Types: []string{"workspaces", "dataStores"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.MachineLearningServices/workspaces/dataStores"}}}},
+	{"azurerm_machine_learning_datastore_fileshare", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.MachineLearningServices", Types: []string{"workspaces", "dataStores"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.MachineLearningServices/workspaces/dataStores"}}}},
+	{"azurerm_machine_learning_inference_cluster", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.MachineLearningServices", Types: []string{"workspaces", "computes"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.MachineLearningServices/workspaces/computes"}}}},
+	{"azurerm_machine_learning_synapse_spark", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.MachineLearningServices", Types: []string{"workspaces", "computes"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.MachineLearningServices/workspaces/computes"}}}},
+	{"azurerm_machine_learning_workspace", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.MachineLearningServices", Types: []string{"workspaces"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.MachineLearningServices/workspaces"}}}},
+	{"azurerm_machine_learning_workspace_network_outbound_rule_fqdn", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.MachineLearningServices", Types: []string{"workspaces", "outboundRules"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.MachineLearningServices/workspaces/outboundRules"}}}},
+	{"azurerm_machine_learning_workspace_network_outbound_rule_private_endpoint", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.MachineLearningServices", Types: []string{"workspaces", "outboundRules"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.MachineLearningServices/workspaces/outboundRules"}}}},
+	{"azurerm_machine_learning_workspace_network_outbound_rule_service_tag", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.MachineLearningServices", Types: []string{"workspaces", "outboundRules"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.MachineLearningServices/workspaces/outboundRules"}}}},
+	{"azurerm_maintenance_assignment_dedicated_host", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups/Microsoft.Compute/hostGroups/hosts"}, Provider: "Microsoft.Maintenance", Types: []string{"configurationAssignments"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Compute/hostGroups/hosts/Microsoft.Maintenance/configurationAssignments"}}}},
+	{"azurerm_maintenance_assignment_dynamic_scope", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions"}, Provider: "Microsoft.Maintenance", Types: []string{"configurationAssignments"}, ImportSpecs: []string{"/subscriptions/Microsoft.Maintenance/configurationAssignments"}}}},
+	{"azurerm_maintenance_assignment_virtual_machine", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups/Microsoft.Compute/virtualMachines"}, Provider: "Microsoft.Maintenance", Types: []string{"configurationAssignments"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Compute/virtualMachines/Microsoft.Maintenance/configurationAssignments"}}}},
+	{"azurerm_maintenance_assignment_virtual_machine_scale_set", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups/Microsoft.Compute/virtualMachineScaleSets"}, Provider: "Microsoft.Maintenance", Types: []string{"configurationAssignments"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Compute/virtualMachineScaleSets/Microsoft.Maintenance/configurationAssignments"}}}},
+	{"azurerm_maintenance_configuration", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Maintenance", Types: []string{"maintenanceConfigurations"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Maintenance/maintenanceConfigurations"}}}},
+	{"azurerm_managed_application", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Solutions", Types: []string{"applications"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Solutions/applications"}}}},
+	{"azurerm_managed_application_definition", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Solutions", Types: []string{"applicationDefinitions"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Solutions/applicationDefinitions"}}}},
+	{"azurerm_managed_devops_pool", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.DevOpsInfrastructure", Types: []string{"pools"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.DevOpsInfrastructure/pools"}}}},
+	{"azurerm_managed_disk", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Compute", Types: []string{"disks"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Compute/disks"}}}},
+	{"azurerm_managed_disk_sas_token", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Compute", Types: []string{"disks"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Compute/disks"}}, IsRemoved: true, RemoveReason: "This is a property rather than a resource", Deprecation: &Deprecation{Reason: "This is a property rather than a resource", Successors: nil}}},
+	{"azurerm_managed_lustre_file_system", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.StorageCache", Types: []string{"amlFilesystems"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.StorageCache/amlFilesystems"}}}},
+	{"azurerm_managed_redis", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Cache", Types: []string{"redisEnterprise", "databasese"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Cache/redisEnterprise"}, IdTransform: &IdTransform{ConstantNames: map[string]string{"databasese": "default"}, Projection: "parent"}}}},
+	{"azurerm_managed_redis_access_policy_assignment", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Cache", Types: []string{"redisEnterprise", "databases", "accessPolicyAssignments"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Cache/redisEnterprise/databases/accessPolicyAssignments"}}}},
+	{"azurerm_managed_redis_geo_replication", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Cache", Types: []string{"redisEnterprise", "databasese", "replications"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Cache/redisEnterprise"}, IdTransform: &IdTransform{ConstantNames: map[string]string{"databasese": "default", "replications": "default"}, Projection: "grandparent"}}}},
+	{"azurerm_management_group", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{Provider: "Microsoft.Management", Types: []string{"managementGroups"}, ImportSpecs: []string{"/Microsoft.Management/managementGroups"}}}},
+	{"azurerm_management_group_policy_assignment", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/Microsoft.Management/managementGroups"}, Provider: "Microsoft.Authorization", Types: []string{"policyAssignments"}, ImportSpecs: []string{"/Microsoft.Management/managementGroups/Microsoft.Authorization/policyAssignments"}}}},
+	{"azurerm_management_group_policy_exemption", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/Microsoft.Management/managementGroups"}, Provider: "Microsoft.Authorization", Types: []string{"policyExemptions"}, ImportSpecs: []string{"/Microsoft.Management/managementGroups/Microsoft.Authorization/policyExemptions"}}}},
+	{"azurerm_management_group_policy_remediation", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/Microsoft.Management/managementGroups"}, Provider: "Microsoft.PolicyInsights", Types: []string{"remediations"}, ImportSpecs: []string{"/Microsoft.Management/managementGroups/Microsoft.PolicyInsights/remediations"}}}},
+	{"azurerm_management_group_policy_set_definition", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/Microsoft.Management/managementGroups"}, Provider: "Microsoft.Authorization", Types: []string{"policySetDefinitions"}, ImportSpecs: []string{"/Microsoft.Management/managementGroups/Microsoft.Authorization/policySetDefinitions"}}}},
+	{"azurerm_management_group_template_deployment", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/Microsoft.Management/managementGroups"}, Provider: "Microsoft.Resources", Types: []string{"deployments"}, ImportSpecs: []string{"/Microsoft.Management/managementGroups/Microsoft.Resources/deployments"}}}},
+	{"azurerm_management_lock", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"any"}, Provider: "Microsoft.Authorization", Types: []string{"locks"}}}},
+	{"azurerm_maps_account", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Maps", Types: []string{"accounts"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Maps/accounts"}}}},
+	{"azurerm_maps_creator", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Maps", Types: []string{"accounts", "creators"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Maps/accounts/creators"}}}},
+	{"azurerm_marketplace_agreement", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions"}, Provider: "Microsoft.MarketplaceOrdering", Types: []string{"agreements", "offers", "plans"}, ImportSpecs: []string{"/subscriptions/Microsoft.MarketplaceOrdering/agreements/offers/plans"}}}},
+	{"azurerm_marketplace_role_assignment", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/Microsoft.Marketplace"}, Provider: "Microsoft.Authorization", Types: []string{"roleAssignments"}, ImportSpecs: []string{"/Microsoft.Marketplace/Microsoft.Authorization/roleAssignments"}}}},
+	{"azurerm_mongo_cluster", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.DocumentDB", Types: []string{"mongoClusters"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.DocumentDB/mongoClusters"}}}},
+	{"azurerm_mongo_cluster_firewall_rule", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.DocumentDB", Types: []string{"mongoClusters", "firewallRules"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.DocumentDB/mongoClusters/firewallRules"}}}},
+	{"azurerm_mongo_cluster_user", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.DocumentDB", Types: []string{"mongoClusters", "users"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.DocumentDB/mongoClusters/users"}}}},
+	{"azurerm_monitor_aad_diagnostic_setting", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/"}, Provider: "Microsoft.AADIAM", Types: []string{"diagnosticSettings"}, ImportSpecs: []string{"/Microsoft.AADIAM/diagnosticSettings"}}}},
+	{"azurerm_monitor_action_group", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Insights", Types: []string{"actionGroups"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Insights/actionGroups"}}}},
+	{"azurerm_monitor_activity_log_alert", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Insights", Types: []string{"activityLogAlerts"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Insights/activityLogAlerts"}}}},
+	{"azurerm_monitor_alert_processing_rule_action_group", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.AlertsManagement", Types: []string{"actionRules"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.AlertsManagement/actionRules"}}}},
+	{"azurerm_monitor_alert_processing_rule_suppression", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.AlertsManagement", Types: []string{"actionRules"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.AlertsManagement/actionRules"}}}},
+	{"azurerm_monitor_alert_prometheus_rule_group", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.AlertsManagement", Types: []string{"prometheusRuleGroups"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.AlertsManagement/prometheusRuleGroups"}}}},
+	{"azurerm_monitor_autoscale_setting", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Insights", Types: []string{"autoScaleSettings"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Insights/autoScaleSettings"}}}},
+	{"azurerm_monitor_data_collection_endpoint", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Insights", Types: []string{"dataCollectionEndpoints"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Insights/dataCollectionEndpoints"}}}},
+	{"azurerm_monitor_data_collection_rule", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Insights", Types: []string{"dataCollectionRules"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Insights/dataCollectionRules"}}}},
+	{"azurerm_monitor_data_collection_rule_association", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"any"}, Provider: "Microsoft.Insights", Types: []string{"dataCollectionRuleAssociations"}}}},
+	{"azurerm_monitor_diagnostic_setting", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"any"}, Provider: "Microsoft.Insights", Types: []string{"diagnosticSettings"}}}},
+	{"azurerm_monitor_metric_alert", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Insights", Types: []string{"metricAlerts"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Insights/metricAlerts"}}}},
+	{"azurerm_monitor_private_link_scope", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Insights", Types: []string{"privateLinkScopes"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Insights/privateLinkScopes"}}}},
+	{"azurerm_monitor_private_link_scoped_service", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Insights", Types: []string{"privateLinkScopes", "scopedResources"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Insights/privateLinkScopes/scopedResources"}}}},
+	{"azurerm_monitor_scheduled_query_rules_alert", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Insights", Types: []string{"scheduledQueryRules"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Insights/scheduledQueryRules"}}, IsRemoved: true, RemoveReason: "This is deprecated in favor of `azurerm_monitor_scheduled_query_rules_alert_v2`", Deprecation: &Deprecation{Reason: "This is deprecated in favor of `azurerm_monitor_scheduled_query_rules_alert_v2`", Successors: []string{"azurerm_monitor_scheduled_query_rules_alert_v2"}}}},
+	{"azurerm_monitor_scheduled_query_rules_alert_v2", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Insights", Types: []string{"scheduledQueryRules"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Insights/scheduledQueryRules"}}}},
+	{"azurerm_monitor_scheduled_query_rules_log", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Insights", Types: []string{"scheduledQueryRules"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Insights/scheduledQueryRules"}}, IsRemoved: true, RemoveReason: "This is deprecated in favor of azurerm_monitor_scheduled_query_rules_alert_v2", Deprecation: &Deprecation{Reason: "This is deprecated in favor of azurerm_monitor_scheduled_query_rules_alert_v2", Successors: []string{"azurerm_monitor_scheduled_query_rules_alert_v2"}}}},
+	{"azurerm_monitor_smart_detector_alert_rule", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.AlertsManagement", Types: []string{"smartDetectorAlertRules"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.AlertsManagement/smartDetectorAlertRules"}}}},
+	{"azurerm_monitor_workspace", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Monitor", Types: []string{"accounts"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Monitor/accounts"}}}},
+	{"azurerm_mssql_database", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Sql", Types: []string{"servers", "databases"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Sql/servers/databases"}}}},
+	{"azurerm_mssql_database_extended_auditing_policy", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Sql", Types: []string{"servers", "databases", "extendedAuditingSettings"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Sql/servers/databases/extendedAuditingSettings"}}}},
+	{"azurerm_mssql_database_vulnerability_assessment_rule_baseline", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Sql", Types: []string{"servers", "databases", "vulnerabilityAssessments", "rules", "baselines"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Sql/servers/databases/vulnerabilityAssessments/rules/baselines"}}}},
+	{"azurerm_mssql_elasticpool", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Sql", Types: []string{"servers", "elasticPools"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Sql/servers/elasticPools"}}}},
+	{"azurerm_mssql_failover_group", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Sql", Types: []string{"servers", "failoverGroups"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Sql/servers/failoverGroups"}}}},
+	{"azurerm_mssql_firewall_rule", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Sql", Types: []string{"servers", "firewallRules"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Sql/servers/firewallRules"}}}},
+	{"azurerm_mssql_job", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Sql", Types: []string{"servers", "jobAgents", "jobs"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Sql/servers/jobAgents/jobs"}}}},
+	{"azurerm_mssql_job_agent", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Sql", Types: []string{"servers", "jobAgents"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Sql/servers/jobAgents"}}}},
+	{"azurerm_mssql_job_credential", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Sql", Types: []string{"servers", "jobAgents", "credentials"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Sql/servers/jobAgents/credentials"}}}},
+	{"azurerm_mssql_job_schedule", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Sql", Types: []string{"servers", "jobAgents", "jobs", "schedules"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Sql/servers/jobAgents/jobs"}, IdTransform: &IdTransform{ConstantNames: map[string]string{"schedules": "default"}, Projection: "parent"}}}},
+	{"azurerm_mssql_job_step", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Sql", Types: []string{"servers", "jobAgents", "jobs", "steps"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Sql/servers/jobAgents/jobs/steps"}}}},
+	{"azurerm_mssql_job_target_group", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Sql", Types: []string{"servers", "jobAgents", "targetGroups"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Sql/servers/jobAgents/targetGroups"}}}},
+	{"azurerm_mssql_managed_database", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Sql", Types: []string{"managedInstances", "databases"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Sql/managedInstances/databases"}}}},
+	{"azurerm_mssql_managed_instance", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Sql", Types: []string{"managedInstances"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Sql/managedInstances"}}}},
+	{"azurerm_mssql_managed_instance_active_directory_administrator", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Sql", Types: []string{"managedInstances", "administrators"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Sql/managedInstances/administrators"}}}},
+	{"azurerm_mssql_managed_instance_failover_group", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Sql", Types: []string{"locations", "instanceFailoverGroups"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Sql/locations/instanceFailoverGroups"}}}},
+	{"azurerm_mssql_managed_instance_security_alert_policy", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Sql", Types: []string{"managedInstances", "securityAlertPolicies"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Sql/managedInstances/securityAlertPolicies"}}}},
+	{"azurerm_mssql_managed_instance_start_stop_schedule", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Sql", Types: []string{"managedInstances", "startStopSchedules"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Sql/managedInstances/startStopSchedules"}}}},
+	{"azurerm_mssql_managed_instance_transparent_data_encryption", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Sql", Types: []string{"managedInstances", "encryptionProtector"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Sql/managedInstances/encryptionProtector"}}}},
+	{"azurerm_mssql_managed_instance_vulnerability_assessment", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Sql", Types: []string{"managedInstances", "vulnerabilityAssessments"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Sql/managedInstances/vulnerabilityAssessments"}}}},
+	{"azurerm_mssql_outbound_firewall_rule", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Sql", Types: []string{"servers", "outboundFirewallRules"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Sql/servers/outboundFirewallRules"}}}},
+	{"azurerm_mssql_server", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Sql", Types: []string{"servers"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Sql/servers"}}}},
+	{"azurerm_mssql_server_dns_alias", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Sql", Types: []string{"servers", "dnsAliases"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Sql/servers/dnsAliases"}}}},
+	{"azurerm_mssql_server_extended_auditing_policy", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Sql", Types: []string{"servers", "extendedAuditingSettings"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Sql/servers/extendedAuditingSettings"}}}},
+	{"azurerm_mssql_server_microsoft_support_auditing_policy", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Sql", Types: []string{"servers", "devOpsAuditingSettings"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Sql/servers/devOpsAuditingSettings"}}}},
+	{"azurerm_mssql_server_security_alert_policy", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Sql", Types: []string{"servers", "securityAlertPolicies"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Sql/servers/securityAlertPolicies"}}}},
+	{"azurerm_mssql_server_transparent_data_encryption", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Sql", Types: []string{"servers", "encryptionProtector"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Sql/servers/encryptionProtector"}}}},
+	{"azurerm_mssql_server_vulnerability_assessment", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Sql", Types: []string{"servers", "vulnerabilityAssessments"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Sql/servers/vulnerabilityAssessments"}}}},
+	{"azurerm_mssql_virtual_machine", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.SqlVirtualMachine", Types: []string{"sqlVirtualMachines"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.SqlVirtualMachine/sqlVirtualMachines"}}}},
+	{"azurerm_mssql_virtual_machine_availability_group_listener", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.SqlVirtualMachine", Types: []string{"sqlVirtualMachineGroups", "availabilityGroupListeners"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.SqlVirtualMachine/sqlVirtualMachineGroups/availabilityGroupListeners"}}}},
+	{"azurerm_mssql_virtual_machine_group", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.SqlVirtualMachine", Types: []string{"sqlVirtualMachineGroups"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.SqlVirtualMachine/sqlVirtualMachineGroups"}}}},
+	{"azurerm_mssql_virtual_network_rule", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Sql", Types: []string{"servers", "virtualNetworkRules"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Sql/servers/virtualNetworkRules"}}}},
+	{"azurerm_mysql_flexible_database", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.DBforMySQL", Types: []string{"flexibleServers", "databases"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.DBforMySQL/flexibleServers/databases"}}}},
+	{"azurerm_mysql_flexible_server", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.DBforMySQL", Types: []string{"flexibleServers"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.DBforMySQL/flexibleServers"}}}},
+	{"azurerm_mysql_flexible_server_active_directory_administrator", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.DBforMySQL", Types: []string{"flexibleServers", "administrators"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.DBforMySQL/flexibleServers/administrators"}}}},
+	{"azurerm_mysql_flexible_server_configuration", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.DBforMySQL", Types: []string{"flexibleServers", "configurations"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.DBforMySQL/flexibleServers/configurations"}}}},
+	{"azurerm_mysql_flexible_server_firewall_rule", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.DBforMySQL", Types: []string{"flexibleServers", "firewallRules"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.DBforMySQL/flexibleServers/firewallRules"}}}},
+	{"azurerm_nat_gateway", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Network", Types: []string{"natGateways"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Network/natGateways"}}}},
+	{"azurerm_nat_gateway_public_ip_association", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Network", Types: []string{"natGateways", "publicIPAddresses"}}}},
+	{"azurerm_nat_gateway_public_ip_prefix_association", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Network", Types: []string{"natGateways", "publicIPPrefixes"}}}},
+	{"azurerm_netapp_account", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.NetApp", Types: []string{"netAppAccounts"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.NetApp/netAppAccounts"}}}},
+	{"azurerm_netapp_account_encryption", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.NetApp", Types: []string{"netAppAccounts", "encryptions"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.NetApp/netAppAccounts"}, IdTransform: &IdTransform{ConstantNames: map[string]string{"encryptions": "enc1"}, Projection: "parent"}}}},
+	{"azurerm_netapp_backup_policy", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.NetApp", Types: []string{"netAppAccounts", "backupPolicies"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.NetApp/netAppAccounts/backupPolicies"}}}},
+	{"azurerm_netapp_backup_vault", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.NetApp", Types: []string{"netAppAccounts", "backupVaults"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.NetApp/netAppAccounts/backupVaults"}}}},
+	{"azurerm_netapp_pool", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.NetApp", Types: []string{"netAppAccounts", "capacityPools"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.NetApp/netAppAccounts/capacityPools"}}}},
+	{"azurerm_netapp_snapshot", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.NetApp", Types: []string{"netAppAccounts", "capacityPools", "volumes", "snapshots"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.NetApp/netAppAccounts/capacityPools/volumes/snapshots"}}}},
+	{"azurerm_netapp_snapshot_policy", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.NetApp", Types: []string{"netAppAccounts", "snapshotPolicies"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.NetApp/netAppAccounts/snapshotPolicies"}}}},
+	{"azurerm_netapp_volume", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.NetApp", Types: []string{"netAppAccounts", "capacityPools", "volumes"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.NetApp/netAppAccounts/capacityPools/volumes"}}}},
+	{"azurerm_netapp_volume_bucket", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.NetApp", Types: []string{"netAppAccounts", "capacityPools", "volumes", "buckets"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.NetApp/netAppAccounts/capacityPools/volumes/buckets"}}}},
+	{"azurerm_netapp_volume_bucket_with_server", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.NetApp", Types: []string{"netAppAccounts", "capacityPools", "volumes", "buckets"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.NetApp/netAppAccounts/capacityPools/volumes/buckets"}}}},
+	{"azurerm_netapp_volume_group_oracle", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.NetApp", Types: []string{"netAppAccounts", "volumeGroups"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.NetApp/netAppAccounts/volumeGroups"}}}},
+	{"azurerm_netapp_volume_group_sap_hana", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.NetApp", Types: []string{"netAppAccounts", "volumeGroups"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.NetApp/netAppAccounts/volumeGroups"}}}},
+	{"azurerm_netapp_volume_quota_rule", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.NetApp", Types: []string{"netAppAccounts", "capacityPools", "volumes", "volumeQuotaRules"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.NetApp/netAppAccounts/capacityPools/volumes/volumeQuotaRules"}}}},
+	{"azurerm_network_connection_monitor", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Network", Types: []string{"networkWatchers", "connectionMonitors"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Network/networkWatchers/connectionMonitors"}}}},
+	{"azurerm_network_ddos_protection_plan", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Network", Types: []string{"ddosProtectionPlans"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Network/ddosProtectionPlans"}}}},
+	{"azurerm_network_function_azure_traffic_collector", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.NetworkFunction", Types: []string{"azureTrafficCollectors"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.NetworkFunction/azureTrafficCollectors"}}}},
+	{"azurerm_network_function_collector_policy", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.NetworkFunction", Types: []string{"azureTrafficCollectors", "collectorPolicies"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.NetworkFunction/azureTrafficCollectors/collectorPolicies"}}}},
+	{"azurerm_network_interface", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Network", Types: []string{"networkInterfaces"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Network/networkInterfaces"}}}},
+	{"azurerm_network_interface_application_gateway_backend_address_pool_association", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Network", Types: []string{"networkInterfaces", "ipConfigurations", "applicationGatewayBackendAddressPools"}}}},
+	{"azurerm_network_interface_application_security_group_association", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Network", Types: []string{"networkInterfaces", "ipConfigurations", "applicationSecurityGroups"}}}},
+	{"azurerm_network_interface_backend_address_pool_association", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Network", Types: []string{"networkInterfaces", "ipConfigurations", "loadBalancerBackendAddressPools"}}}},
+	{"azurerm_network_interface_nat_rule_association", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Network", Types: []string{"networkInterfaces", "ipConfigurations", "loadBalancerInboundNatRules"}}}},
+	{"azurerm_network_interface_security_group_association", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Network", Types: []string{"networkInterfaces", "networkSecurityGroups"}}}},
+	{"azurerm_network_manager", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Network", Types: []string{"networkManagers"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Network/networkManagers"}}}},
+	{"azurerm_network_manager_admin_rule", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Network", Types: []string{"networkManagers", "securityAdminConfigurations", "ruleCollections", "rules"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Network/networkManagers/securityAdminConfigurations/ruleCollections/rules"}}}},
+	{"azurerm_network_manager_admin_rule_collection", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Network", Types: []string{"networkManagers", "securityAdminConfigurations", "ruleCollections"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Network/networkManagers/securityAdminConfigurations/ruleCollections"}}}},
+	{"azurerm_network_manager_connectivity_configuration", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Network", Types: []string{"networkManagers", "connectivityConfigurations"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Network/networkManagers/connectivityConfigurations"}}}},
+	{"azurerm_network_manager_deployment", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Network", Types: []string{"networkManagers", "locations", "types"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Network/networkManagers"}}}},
+	{"azurerm_network_manager_ipam_pool", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Network", Types: []string{"networkManagers", "ipamPools"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Network/networkManagers/ipamPools"}}}},
+	{"azurerm_network_manager_ipam_pool_static_cidr", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Network", Types: []string{"networkManagers", "ipamPools", "staticCidrs"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Network/networkManagers/ipamPools/staticCidrs"}}}},
+	{"azurerm_network_manager_management_group_connection", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/Microsoft.Management/managementGroups"}, Provider: "Microsoft.Network", Types: []string{"networkManagerConnections"}, ImportSpecs: []string{"/Microsoft.Management/managementGroups/Microsoft.Network/networkManagerConnections"}}}},
+	{"azurerm_network_manager_network_group", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Network", Types: []string{"networkManagers", "networkGroups"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Network/networkManagers/networkGroups"}}}},
+	{"azurerm_network_manager_routing_configuration", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Network", Types: []string{"networkManagers", "routingConfigurations"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Network/networkManagers/routingConfigurations"}}}},
+	{"azurerm_network_manager_routing_rule", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Network", Types: []string{"networkManagers", "routingConfigurations", "ruleCollections", "rules"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Network/networkManagers/routingConfigurations/ruleCollections/rules"}}}},
+	{"azurerm_network_manager_routing_rule_collection", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Network", Types: []string{"networkManagers", "routingConfigurations", "ruleCollections"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Network/networkManagers/routingConfigurations/ruleCollections"}}}},
+	{"azurerm_network_manager_scope_connection", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Network", Types: []string{"networkManagers", "scopeConnections"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Network/networkManagers/scopeConnections"}}}},
+	{"azurerm_network_manager_security_admin_configuration", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Network", Types: []string{"networkManagers", "securityAdminConfigurations"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Network/networkManagers/securityAdminConfigurations"}}}},
+	{"azurerm_network_manager_static_member", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Network", Types: []string{"networkManagers", "networkGroups", "staticMembers"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Network/networkManagers/networkGroups/staticMembers"}}}},
+	{"azurerm_network_manager_subscription_connection", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions"}, Provider: "Microsoft.Network", Types: []string{"networkManagerConnections"}, ImportSpecs: []string{"/subscriptions/Microsoft.Network/networkManagerConnections"}}}},
+	{"azurerm_network_manager_verifier_workspace", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Network", Types: []string{"networkManagers", "verifierWorkspaces"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Network/networkManagers/verifierWorkspaces"}}}},
+	{"azurerm_network_manager_verifier_workspace_reachability_analysis_intent", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Network", Types: []string{"networkManagers", "verifierWorkspaces", "reachabilityAnalysisIntents"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Network/networkManagers/verifierWorkspaces/reachabilityAnalysisIntents"}}}},
+	{"azurerm_network_profile", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Network", Types: []string{"networkProfiles"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Network/networkProfiles"}}}},
+	{"azurerm_network_security_group", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Network", Types: []string{"networkSecurityGroups"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Network/networkSecurityGroups"}}}},
+	{"azurerm_network_security_perimeter", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Network", Types: []string{"networkSecurityPerimeters"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Network/networkSecurityPerimeters"}}}},
+	{"azurerm_network_security_perimeter_access_rule", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Network", Types: []string{"networkSecurityPerimeters", "profiles", "accessRules"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Network/networkSecurityPerimeters/profiles/accessRules"}}}},
+	{"azurerm_network_security_perimeter_association", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Network", Types: []string{"networkSecurityPerimeters", "resourceAssociations"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Network/networkSecurityPerimeters/resourceAssociations"}}}},
+	{"azurerm_network_security_perimeter_profile", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Network", Types: []string{"networkSecurityPerimeters", "profiles"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Network/networkSecurityPerimeters/profiles"}}}},
+	{"azurerm_network_security_rule", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Network", Types: []string{"networkSecurityGroups", "securityRules"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Network/networkSecurityGroups/securityRules"}}}},
+	{"azurerm_network_watcher", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Network", Types: []string{"networkWatchers"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Network/networkWatchers"}}}},
+	{"azurerm_network_watcher_flow_log", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Network", Types: []string{"networkWatchers", "flowLogs"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Network/networkWatchers/flowLogs"}}}},
+	{"azurerm_new_relic_monitor", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "NewRelic.Observability", Types: []string{"monitors"}, ImportSpecs: []string{"/subscriptions/resourceGroups/NewRelic.Observability/monitors"}}}},
+	{"azurerm_new_relic_tag_rule", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "NewRelic.Observability", Types: []string{"monitors", "tagRules"}, ImportSpecs: []string{"/subscriptions/resourceGroups/NewRelic.Observability/monitors/tagRules"}}}},
+	{"azurerm_nginx_api_key", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Nginx.NginxPlus", Types: []string{"nginxDeployments", "apiKeys"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Nginx.NginxPlus/nginxDeployments/apiKeys"}}}},
+	{"azurerm_nginx_certificate", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Nginx.NginxPlus", Types: []string{"nginxDeployments", "certificates"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Nginx.NginxPlus/nginxDeployments/certificates"}}}},
+	{"azurerm_nginx_configuration", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Nginx.NginxPlus", Types: []string{"nginxDeployments", "configurations"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Nginx.NginxPlus/nginxDeployments/configurations"}}}},
+	{"azurerm_nginx_deployment", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Nginx.NginxPlus", Types: []string{"nginxDeployments"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Nginx.NginxPlus/nginxDeployments"}}}},
+	{"azurerm_notification_hub", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.NotificationHubs", Types: []string{"namespaces", "notificationHubs"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.NotificationHubs/namespaces/notificationHubs"}}}},
+	{"azurerm_notification_hub_authorization_rule", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.NotificationHubs", Types: []string{"namespaces", "notificationHubs", "authorizationRules"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.NotificationHubs/namespaces/notificationHubs/authorizationRules"}}}},
+	{"azurerm_notification_hub_namespace", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.NotificationHubs", Types: []string{"namespaces"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.NotificationHubs/namespaces"}}}},
+	{"azurerm_oracle_autonomous_database", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Oracle.Database", Types: []string{"autonomousDatabases"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Oracle.Database/autonomousDatabases"}}}},
+	{"azurerm_oracle_autonomous_database_backup", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Oracle.Database", Types: []string{"autonomousDatabases", "autonomousDatabaseBackups"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Oracle.Database/autonomousDatabases/autonomousDatabaseBackups"}}}},
+	{"azurerm_oracle_autonomous_database_clone_from_backup", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Oracle.Database", Types: []string{"autonomousDatabases"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Oracle.Database/autonomousDatabases"}}}},
+	{"azurerm_oracle_autonomous_database_clone_from_database", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Oracle.Database", Types: []string{"autonomousDatabases"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Oracle.Database/autonomousDatabases"}}}},
+	{"azurerm_oracle_cloud_vm_cluster", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Oracle.Database", Types: []string{"cloudVmClusters"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Oracle.Database/cloudVmClusters"}}}},
+	{"azurerm_oracle_exadata_infrastructure", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Oracle.Database", Types: []string{"cloudExadataInfrastructures"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Oracle.Database/cloudExadataInfrastructures"}}}},
+	{"azurerm_oracle_exascale_database_storage_vault", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Oracle.Database", Types: []string{"exascaleDbStorageVaults"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Oracle.Database/exascaleDbStorageVaults"}}}},
+	{"azurerm_oracle_resource_anchor", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Oracle.Database", Types: []string{"resourceAnchors"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Oracle.Database/resourceAnchors"}}}},
+	{"azurerm_orbital_contact", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Orbital", Types: []string{"spacecrafts", "contacts"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Orbital/spacecrafts/contacts"}}}},
+	{"azurerm_orbital_contact_profile", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Orbital", Types: []string{"contactProfiles"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Orbital/contactProfiles"}}}},
+	{"azurerm_orbital_spacecraft", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Orbital", Types: []string{"spacecrafts"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Orbital/spacecrafts"}}}},
+	{"azurerm_orchestrated_virtual_machine_scale_set", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Compute", Types: []string{"virtualMachineScaleSets"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Compute/virtualMachineScaleSets"}}}},
+	{"azurerm_palo_alto_local_rulestack", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "PaloAltoNetworks.Cloudngfw", Types: []string{"localRulestacks"}, ImportSpecs: []string{"/subscriptions/resourceGroups/PaloAltoNetworks.Cloudngfw/localRulestacks"}}}},
+	{"azurerm_palo_alto_local_rulestack_certificate", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "PaloAltoNetworks.Cloudngfw", Types: []string{"localRulestacks", "certificates"}, ImportSpecs: []string{"/subscriptions/resourceGroups/PaloAltoNetworks.Cloudngfw/localRulestacks/certificates"}}}},
+	{"azurerm_palo_alto_local_rulestack_fqdn_list", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "PaloAltoNetworks.Cloudngfw", Types: []string{"localRulestacks", "fqdnLists"}, ImportSpecs: []string{"/subscriptions/resourceGroups/PaloAltoNetworks.Cloudngfw/localRulestacks/fqdnLists"}}}},
+	{"azurerm_palo_alto_local_rulestack_prefix_list", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "PaloAltoNetworks.Cloudngfw", Types: []string{"localRulestacks", "prefixLists"}, ImportSpecs: []string{"/subscriptions/resourceGroups/PaloAltoNetworks.Cloudngfw/localRulestacks/prefixLists"}}}},
+	{"azurerm_palo_alto_local_rulestack_rule", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "PaloAltoNetworks.Cloudngfw", Types: []string{"localRulestacks", "localRules"}, ImportSpecs: []string{"/subscriptions/resourceGroups/PaloAltoNetworks.Cloudngfw/localRulestacks/localRules"}}}},
+	{"azurerm_palo_alto_next_generation_firewall_virtual_hub_local_rulestack", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "PaloAltoNetworks.Cloudngfw", Types: []string{"firewalls"}, ImportSpecs: []string{"/subscriptions/resourceGroups/PaloAltoNetworks.Cloudngfw/firewalls"}}}},
+	{"azurerm_palo_alto_next_generation_firewall_virtual_hub_panorama", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "PaloAltoNetworks.Cloudngfw", Types: []string{"firewalls"}, ImportSpecs: []string{"/subscriptions/resourceGroups/PaloAltoNetworks.Cloudngfw/firewalls"}}}},
+	{"azurerm_palo_alto_next_generation_firewall_virtual_hub_strata_cloud_manager", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "PaloAltoNetworks.Cloudngfw", Types: []string{"firewalls"}, ImportSpecs: []string{"/subscriptions/resourceGroups/PaloAltoNetworks.Cloudngfw/firewalls"}}}},
+	{"azurerm_palo_alto_next_generation_firewall_virtual_network_local_rulestack", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "PaloAltoNetworks.Cloudngfw", Types: []string{"firewalls"}, ImportSpecs: []string{"/subscriptions/resourceGroups/PaloAltoNetworks.Cloudngfw/firewalls"}}}},
+	{"azurerm_palo_alto_next_generation_firewall_virtual_network_panorama", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "PaloAltoNetworks.Cloudngfw", Types: []string{"firewalls"}, ImportSpecs: []string{"/subscriptions/resourceGroups/PaloAltoNetworks.Cloudngfw/firewalls"}}}},
+	{"azurerm_palo_alto_next_generation_firewall_virtual_network_strata_cloud_manager", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "PaloAltoNetworks.Cloudngfw", Types: []string{"firewalls"}, ImportSpecs: []string{"/subscriptions/resourceGroups/PaloAltoNetworks.Cloudngfw/firewalls"}}}},
+	{"azurerm_palo_alto_virtual_network_appliance", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Network", Types: []string{"networkVirtualAppliances"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Network/networkVirtualAppliances"}}}},
+	{"azurerm_point_to_site_vpn_gateway", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Network", Types: []string{"p2sVpnGateways"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Network/p2sVpnGateways"}}}},
+	{"azurerm_policy_definition", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions", "/Microsoft.Management/managementGroups"}, Provider: "Microsoft.Authorization", Types: []string{"policyDefinitions"}, ImportSpecs: []string{"/subscriptions/Microsoft.Authorization/policyDefinitions", "/Microsoft.Management/managementgroups/Microsoft.Authorization/policyDefinitions"}}}},
+	{"azurerm_policy_set_definition", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions"}, Provider: "Microsoft.Authorization", Types: []string{"policySetDefinitions"}, ImportSpecs: []string{"/subscriptions/Microsoft.Authorization/policySetDefinitions"}}}},
+	{"azurerm_policy_virtual_machine_configuration_assignment", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups/Microsoft.Compute/virtualMachines"}, Provider: "Microsoft.GuestConfiguration", Types: []string{"guestConfigurationAssignments"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Compute/virtualMachines/Microsoft.GuestConfiguration/guestConfigurationAssignments"}}}},
+	{"azurerm_portal_dashboard", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Portal", Types: []string{"dashboards"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Portal/dashboards"}}}},
+	{"azurerm_portal_tenant_configuration", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/"}, Provider: "Microsoft.Portal", Types: []string{"tenantConfigurations"}, ImportSpecs: []string{"/Microsoft.Portal/tenantConfigurations"}}}},
+	{"azurerm_postgresql_active_directory_administrator", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.DBforPostgreSQL", Types: []string{"servers", "administrators"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.DBforPostgreSQL/servers"}, IdTransform: &IdTransform{ConstantNames: map[string]string{"administrators": "activeDirectory"}, Projection: "parent"}}}},
+	{"azurerm_postgresql_configuration", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.DBforPostgreSQL", Types: []string{"servers", "configurations"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.DBforPostgreSQL/servers/configurations"}}}},
+	{"azurerm_postgresql_database", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.DBforPostgreSQL", Types: []string{"servers", "databases"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.DBforPostgreSQL/servers/databases"}}}},
+	{"azurerm_postgresql_firewall_rule", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.DBforPostgreSQL", Types: []string{"servers", "firewallRules"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.DBforPostgreSQL/servers/firewallRules"}}}},
+	{"azurerm_postgresql_flexible_server", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.DBforPostgreSQL", Types: []string{"flexibleServers"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.DBforPostgreSQL/flexibleServers"}}}},
+	{"azurerm_postgresql_flexible_server_active_directory_administrator", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.DBforPostgreSQL", Types: []string{"flexibleServers", "administrators"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.DBforPostgreSQL/flexibleServers/administrators"}}}},
+	{"azurerm_postgresql_flexible_server_backup", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.DBforPostgreSQL", Types: []string{"flexibleServers", "backups"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.DBforPostgreSQL/flexibleServers/backups"}}}},
+	{"azurerm_postgresql_flexible_server_configuration", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.DBforPostgreSQL", Types: []string{"flexibleServers", "configurations"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.DBforPostgreSQL/flexibleServers/configurations"}}}},
+	{"azurerm_postgresql_flexible_server_database", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.DBforPostgreSQL", Types: []string{"flexibleServers", "databases"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.DBforPostgreSQL/flexibleServers/databases"}}}},
+	{"azurerm_postgresql_flexible_server_firewall_rule", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.DBforPostgreSQL", Types: []string{"flexibleServers", "firewallRules"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.DBforPostgreSQL/flexibleServers/firewallRules"}}}},
+	{"azurerm_postgresql_flexible_server_virtual_endpoint", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.DBforPostgreSQL", Types: []string{"flexibleServers", "virtualEndpoints"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.DBforPostgreSQL/flexibleServers/virtualEndpoints"}}}},
+	{"azurerm_postgresql_server", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.DBforPostgreSQL", Types: []string{"servers"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.DBforPostgreSQL/servers"}}}},
+	{"azurerm_postgresql_server_key", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.DBforPostgreSQL", Types: []string{"servers", "keys"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.DBforPostgreSQL/servers/keys"}}}},
+	{"azurerm_postgresql_virtual_network_rule", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.DBforPostgreSQL", Types: []string{"servers", "virtualNetworkRules"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.DBforPostgreSQL/servers/virtualNetworkRules"}}}},
+	{"azurerm_powerbi_embedded", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.PowerBIDedicated", Types: []string{"capacities"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.PowerBIDedicated/capacities"}}}},
+	{"azurerm_private_dns_a_record", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Network", Types: []string{"privateDnsZones", "A"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Network/privateDnsZones/A"}}}},
+	{"azurerm_private_dns_aaaa_record", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Network", Types: []string{"privateDnsZones", "AAAA"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Network/privateDnsZones/AAAA"}}}},
+	{"azurerm_private_dns_cname_record", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Network", Types: []string{"privateDnsZones", "CNAME"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Network/privateDnsZones/CNAME"}}}},
+	{"azurerm_private_dns_mx_record", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Network", Types: []string{"privateDnsZones", "MX"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Network/privateDnsZones/MX"}}}},
+	{"azurerm_private_dns_ptr_record", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Network", Types: []string{"privateDnsZones", "PTR"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Network/privateDnsZones/PTR"}}}},
+	{"azurerm_private_dns_resolver", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Network", Types: []string{"dnsResolvers"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Network/dnsResolvers"}}}},
+	{"azurerm_private_dns_resolver_dns_forwarding_ruleset", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Network", Types: []string{"dnsForwardingRulesets"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Network/dnsForwardingRulesets"}}}},
+	{"azurerm_private_dns_resolver_forwarding_rule", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Network", Types: []string{"dnsForwardingRulesets", "forwardingRules"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Network/dnsForwardingRulesets/forwardingRules"}}}},
+	{"azurerm_private_dns_resolver_inbound_endpoint", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Network", Types: []string{"dnsResolvers", "inboundEndpoints"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Network/dnsResolvers/inboundEndpoints"}}}},
+	{"azurerm_private_dns_resolver_outbound_endpoint", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Network", Types: []string{"dnsResolvers", "outboundEndpoints"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Network/dnsResolvers/outboundEndpoints"}}}},
+	{"azurerm_private_dns_resolver_virtual_network_link", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Network", Types: []string{"dnsForwardingRulesets", "virtualNetworkLinks"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Network/dnsForwardingRulesets/virtualNetworkLinks"}}}},
+	{"azurerm_private_dns_srv_record", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Network", Types: []string{"privateDnsZones", "SRV"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Network/privateDnsZones/SRV"}}}},
+	{"azurerm_private_dns_txt_record", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Network", Types: []string{"privateDnsZones", "TXT"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Network/privateDnsZones/TXT"}}}},
+	{"azurerm_private_dns_zone", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Network", Types: []string{"privateDnsZones"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Network/privateDnsZones"}}}},
+	{"azurerm_private_dns_zone_virtual_network_link", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Network", Types: []string{"privateDnsZones", "virtualNetworkLinks"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Network/privateDnsZones/virtualNetworkLinks"}}}},
+	{"azurerm_private_endpoint", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Network", Types: []string{"privateEndpoints"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Network/privateEndpoints"}}}},
+	{"azurerm_private_link_service", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Network", Types: []string{"privateLinkServices"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Network/privateLinkServices"}}}},
+	{"azurerm_proximity_placement_group", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Compute", Types: []string{"proximityPlacementGroups"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Compute/proximityPlacementGroups"}}}},
+	{"azurerm_public_ip", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Network", Types: []string{"publicIPAddresses"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Network/publicIPAddresses"}}}},
+	{"azurerm_public_ip_prefix", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Network", Types: []string{"publicIPPrefixes"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Network/publicIPPrefixes"}}}},
+	{"azurerm_purview_account", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Purview", Types: []string{"accounts"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Purview/accounts"}}}},
+	{"azurerm_qumulo_file_system", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Qumulo.Storage", Types: []string{"fileSystems"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Qumulo.Storage/fileSystems"}}}},
+	{"azurerm_recovery_services_vault", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.RecoveryServices", Types: []string{"vaults"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.RecoveryServices/vaults"}}}},
+	{"azurerm_recovery_services_vault_resource_guard_association", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.RecoveryServices", Types: []string{"vaults", "backupResourceGuardProxies"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.RecoveryServices/vaults/backupResourceGuardProxies"}}}},
+	{"azurerm_redhat_openshift_cluster", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.RedHatOpenShift", Types: []string{"openShiftClusters"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.RedHatOpenShift/openShiftClusters"}}}},
+	{"azurerm_redis_cache", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Cache", Types: []string{"redis"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Cache/redis"}}}},
+	{"azurerm_redis_cache_access_policy", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Cache", Types: []string{"redis", "accessPolicies"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Cache/redis/accessPolicies"}}}},
+	{"azurerm_redis_cache_access_policy_assignment", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Cache", Types: []string{"redis", "accessPolicyAssignments"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Cache/redis/accessPolicyAssignments"}}}},
+	{"azurerm_redis_enterprise_cluster", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Cache", Types: []string{"redisEnterprise"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Cache/redisEnterprise"}}, IsRemoved: true, RemoveReason: "Deprecated", Deprecation: &Deprecation{Reason: "Deprecated", Successors: nil}}},
+	{"azurerm_redis_enterprise_database", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Cache", Types: []string{"redisEnterprise", "databases"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Cache/redisEnterprise/databases"}}, IsRemoved: true, RemoveReason: "Deprecated", Deprecation: &Deprecation{Reason: "Deprecated", Successors: nil}}},
+	{"azurerm_redis_firewall_rule", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Cache", Types: []string{"redis", "firewallRules"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Cache/redis/firewallRules"}}}},
+	{"azurerm_redis_linked_server", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Cache", Types: []string{"redis", "linkedServers"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Cache/redis/linkedServers"}}}},
+	{"azurerm_relay_hybrid_connection", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Relay", Types: []string{"namespaces", "hybridConnections"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Relay/namespaces/hybridConnections"}}}},
+	{"azurerm_relay_hybrid_connection_authorization_rule", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Relay", Types: []string{"namespaces", "hybridConnections", "authorizationRules"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Relay/namespaces/hybridConnections/authorizationRules"}}}},
+	{"azurerm_relay_namespace", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Relay", Types: []string{"namespaces"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Relay/namespaces"}}}},
+	{"azurerm_relay_namespace_authorization_rule", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Relay", Types: []string{"namespaces", "authorizationRules"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Relay/namespaces/authorizationRules"}}}},
+	{"azurerm_resource_deployment_script_azure_cli", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Resources", Types: []string{"deploymentScripts"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Resources/deploymentScripts"}}}},
+	{"azurerm_resource_deployment_script_azure_power_shell", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Resources", Types: []string{"deploymentScripts"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Resources/deploymentScripts"}}}},
+	{"azurerm_resource_group", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{Provider: "Microsoft.Resources", Types: []string{"subscriptions", "resourceGroups"}, ImportSpecs: []string{"/subscriptions/resourceGroups"}}}},
+	{"azurerm_resource_group_cost_management_export", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.CostManagement", Types: []string{"exports"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.CostManagement/exports"}}}},
+	{"azurerm_resource_group_cost_management_view", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.CostManagement", Types: []string{"views"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.CostManagement/views"}}}},
+	{"azurerm_resource_group_policy_assignment", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Authorization", Types: []string{"policyAssignments"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Authorization/policyAssignments"}}}},
+	{"azurerm_resource_group_policy_exemption", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Authorization", Types: []string{"policyExemptions"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Authorization/policyExemptions"}}}},
+	{"azurerm_resource_group_policy_remediation", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.PolicyInsights", Types: []string{"remediations"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.PolicyInsights/remediations"}}}},
+	{"azurerm_resource_group_template_deployment", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Resources", Types: []string{"deployments"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Resources/deployments"}}}},
+	{"azurerm_resource_management_private_link", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Authorization", Types: []string{"resourceManagementPrivateLinks"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Authorization/resourceManagementPrivateLinks"}}}},
+	{"azurerm_resource_management_private_link_association", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/Microsoft.Management/managementGroups"}, Provider: "Microsoft.Authorization", Types: []string{"privateLinkAssociations"}, ImportSpecs: []string{"/Microsoft.Management/managementGroups/Microsoft.Authorization/privateLinkAssociations"}}}},
+	{"azurerm_resource_policy_assignment", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"any"}, Provider: "Microsoft.Authorization", Types: []string{"policyAssignments"}}}},
+	{"azurerm_resource_policy_exemption", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"any"}, Provider: "Microsoft.Authorization", Types: []string{"policyExemptions"}}}},
+	{"azurerm_resource_policy_remediation", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"any"}, Provider: "Microsoft.PolicyInsights", Types: []string{"remediations"}}}},
+	{"azurerm_resource_provider_feature_registration", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/Microsoft.Features"}, Provider: "{ResourceProviderName}", Types: []string{"features"}, ImportSpecs: []string{"/subscriptions/Microsoft.Features/{ResourceProviderName}/features"}}}},
+	{"azurerm_resource_provider_registration", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions"}, Provider: "Microsoft.PolicyInsights", Types: []string{}, ImportSpecs: []string{"/subscriptions/Microsoft.PolicyInsights"}}}},
+	{"azurerm_role_assignment", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"any"}, Provider: "Microsoft.Authorization", Types: []string{"roleAssignments"}, IdTransform: &IdTransform{TypeRenames: map[string]string{"roleAssignments": "roleAssignments"}}}}},
+	{"azurerm_role_definition", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"any"}, Provider: "Microsoft.Authorization", Types: []string{"roleDefinitions"}}}},
+	{"azurerm_role_management_policy", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"any"}, Provider: "Microsoft.Authorization", Types: []string{"roleManagementPolicies"}}}},
+	{"azurerm_route", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Network", Types: []string{"routeTables", "routes"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Network/routeTables/routes"}}}},
+	{"azurerm_route_filter", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Network", Types: []string{"routeFilters"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Network/routeFilters"}}}},
+	{"azurerm_route_map", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Network", Types: []string{"virtualHubs", "routeMaps"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Network/virtualHubs/routeMaps"}}}},
+	{"azurerm_route_server", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Network", Types: []string{"virtualHubs"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Network/virtualHubs"}}}},
+	{"azurerm_route_server_bgp_connection", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Network", Types: []string{"virtualHubs", "bgpConnections"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Network/virtualHubs/bgpConnections"}}}},
+	{"azurerm_route_table", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Network", Types: []string{"routeTables"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Network/routeTables"}}}},
+	{"azurerm_search_service", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Search", Types: []string{"searchServices"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Search/searchServices"}}}},
+	{"azurerm_search_shared_private_link_service", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Search", Types: []string{"searchServices", "sharedPrivateLinkResources"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Search/searchServices/sharedPrivateLinkResources"}}}},
+	{"azurerm_security_center_assessment", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"any"}, Provider: "Microsoft.Security", Types: []string{"assessments"}}}},
+	{"azurerm_security_center_assessment_policy", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions"}, Provider: "Microsoft.Security", Types: []string{"assessmentMetadata"}, ImportSpecs: []string{"/subscriptions/Microsoft.Security/assessmentMetadata"}}}},
+	{"azurerm_security_center_auto_provisioning", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions"}, Provider: "Microsoft.Security", Types: []string{"autoProvisioningSettings"}, ImportSpecs: []string{"/subscriptions/Microsoft.Security/autoProvisioningSettings"}}}},
+	{"azurerm_security_center_automation", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Security", Types: []string{"automations"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Security/automations"}}}},
+	{"azurerm_security_center_contact", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions"}, Provider: "Microsoft.Security", Types: []string{"securityContacts"}, ImportSpecs: []string{"/subscriptions/Microsoft.Security/securityContacts"}}}},
+	{"azurerm_security_center_server_vulnerability_assessment_virtual_machine", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups/Microsoft.Compute/virtualMachines"}, Provider: "Microsoft.Security", Types: []string{"serverVulnerabilityAssessments"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Compute/virtualMachines/Microsoft.Security/serverVulnerabilityAssessments"}}}},
+	{"azurerm_security_center_server_vulnerability_assessments_setting", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions"}, Provider: "Microsoft.Security", Types: []string{"serverVulnerabilityAssessmentsSettings"}, ImportSpecs: []string{"/subscriptions/Microsoft.Security/serverVulnerabilityAssessmentsSettings"}}}},
+	{"azurerm_security_center_setting", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions"}, Provider: "Microsoft.Security", Types: []string{"settings"}, ImportSpecs: []string{"/subscriptions/Microsoft.Security/settings"}}}},
+	{"azurerm_security_center_storage_defender", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups/Microsoft.Storage/storageAccounts"}, Provider: "Microsoft.Security", Types: []string{"defenderForStorageSettings"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Storage/storageAccounts/Microsoft.Security/defenderForStorageSettings"}}}},
+	{"azurerm_security_center_subscription_pricing", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions"}, Provider: "Microsoft.Security", Types: []string{"pricings"}, ImportSpecs: []string{"/subscriptions/Microsoft.Security/pricings"}}}},
+	{"azurerm_security_center_workspace", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions"}, Provider: "Microsoft.Security", Types: []string{"workspaceSettings"}, ImportSpecs: []string{"/subscriptions/Microsoft.Security/workspaceSettings"}}}},
+	{"azurerm_sentinel_alert_rule_anomaly_built_in", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups/Microsoft.OperationalInsights/workspaces"}, Provider: "Microsoft.SecurityInsights", Types: []string{"securityMLAnalyticsSettings"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.OperationalInsights/workspaces/Microsoft.SecurityInsights/securityMLAnalyticsSettings"}}}},
+	{"azurerm_sentinel_alert_rule_anomaly_duplicate", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups/Microsoft.OperationalInsights/workspaces"}, Provider: "Microsoft.SecurityInsights", Types: []string{"securityMLAnalyticsSettings"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.OperationalInsights/workspaces/Microsoft.SecurityInsights/securityMLAnalyticsSettings"}}}},
+	{"azurerm_sentinel_alert_rule_fusion", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups/Microsoft.OperationalInsights/workspaces"}, Provider: "Microsoft.SecurityInsights", Types: []string{"alertRules"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.OperationalInsights/workspaces/Microsoft.SecurityInsights/alertRules"}}}},
+	{"azurerm_sentinel_alert_rule_machine_learning_behavior_analytics", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups/Microsoft.OperationalInsights/workspaces"}, Provider: "Microsoft.SecurityInsights", Types: []string{"alertRules"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.OperationalInsights/workspaces/Microsoft.SecurityInsights/alertRules"}}}},
+	{"azurerm_sentinel_alert_rule_ms_security_incident", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups/Microsoft.OperationalInsights/workspaces"}, Provider: "Microsoft.SecurityInsights", Types: []string{"alertRules"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.OperationalInsights/workspaces/Microsoft.SecurityInsights/alertRules"}}}},
+	{"azurerm_sentinel_alert_rule_nrt", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups/Microsoft.OperationalInsights/workspaces"}, Provider: "Microsoft.SecurityInsights", Types: []string{"alertRules"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.OperationalInsights/workspaces/Microsoft.SecurityInsights/alertRules"}}}},
+	{"azurerm_sentinel_alert_rule_scheduled", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups/Microsoft.OperationalInsights/workspaces"}, Provider: "Microsoft.SecurityInsights", Types: []string{"alertRules"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.OperationalInsights/workspaces/Microsoft.SecurityInsights/alertRules"}}}},
+	{"azurerm_sentinel_alert_rule_threat_intelligence", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups/Microsoft.OperationalInsights/workspaces"}, Provider: "Microsoft.SecurityInsights", Types: []string{"alertRules"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.OperationalInsights/workspaces/Microsoft.SecurityInsights/alertRules"}}}},
+	{"azurerm_sentinel_automation_rule", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups/Microsoft.OperationalInsights/workspaces"}, Provider: "Microsoft.SecurityInsights", Types: []string{"automationRules"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.OperationalInsights/workspaces/Microsoft.SecurityInsights/automationRules"}}}},
+	{"azurerm_sentinel_data_connector_aws_cloud_trail", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups/Microsoft.OperationalInsights/workspaces"}, Provider: "Microsoft.SecurityInsights", Types: []string{"dataConnectors"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.OperationalInsights/workspaces/Microsoft.SecurityInsights/dataConnectors"}}}},
+	{"azurerm_sentinel_data_connector_aws_s3", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups/Microsoft.OperationalInsights/workspaces"}, Provider: "Microsoft.SecurityInsights", Types: []string{"dataConnectors"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.OperationalInsights/workspaces/Microsoft.SecurityInsights/dataConnectors"}}}},
+	{"azurerm_sentinel_data_connector_azure_active_directory", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups/Microsoft.OperationalInsights/workspaces"}, Provider: "Microsoft.SecurityInsights", Types: []string{"dataConnectors"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.OperationalInsights/workspaces/Microsoft.SecurityInsights/dataConnectors"}}}},
+	{"azurerm_sentinel_data_connector_azure_advanced_threat_protection", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups/Microsoft.OperationalInsights/workspaces"}, Provider: "Microsoft.SecurityInsights", Types: []string{"dataConnectors"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.OperationalInsights/workspaces/Microsoft.SecurityInsights/dataConnectors"}}}},
+	{"azurerm_sentinel_data_connector_azure_security_center", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups/Microsoft.OperationalInsights/workspaces"}, Provider: "Microsoft.SecurityInsights", Types: []string{"dataConnectors"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.OperationalInsights/workspaces/Microsoft.SecurityInsights/dataConnectors"}}}},
+	{"azurerm_sentinel_data_connector_dynamics_365", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups/Microsoft.OperationalInsights/workspaces"}, Provider: "Microsoft.SecurityInsights", Types: []string{"dataConnectors"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.OperationalInsights/workspaces/Microsoft.SecurityInsights/dataConnectors"}}}},
+	{"azurerm_sentinel_data_connector_iot", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups/Microsoft.OperationalInsights/workspaces"}, Provider: "Microsoft.SecurityInsights", Types: []string{"dataConnectors"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.OperationalInsights/workspaces/Microsoft.SecurityInsights/dataConnectors"}}}},
+	{"azurerm_sentinel_data_connector_microsoft_cloud_app_security", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups/Microsoft.OperationalInsights/workspaces"}, Provider: "Microsoft.SecurityInsights", Types: []string{"dataConnectors"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.OperationalInsights/workspaces/Microsoft.SecurityInsights/dataConnectors"}}}},
+	{"azurerm_sentinel_data_connector_microsoft_defender_advanced_threat_protection", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups/Microsoft.OperationalInsights/workspaces"}, Provider: "Microsoft.SecurityInsights", Types: []string{"dataConnectors"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.OperationalInsights/workspaces/Microsoft.SecurityInsights/dataConnectors"}}}},
+	{"azurerm_sentinel_data_connector_microsoft_threat_intelligence", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups/Microsoft.OperationalInsights/workspaces"}, Provider: "Microsoft.SecurityInsights", Types: []string{"dataConnectors"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.OperationalInsights/workspaces/Microsoft.SecurityInsights/dataConnectors"}}}},
+	{"azurerm_sentinel_data_connector_microsoft_threat_protection", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups/Microsoft.OperationalInsights/workspaces"}, Provider: "Microsoft.SecurityInsights", Types: []string{"dataConnectors"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.OperationalInsights/workspaces/Microsoft.SecurityInsights/dataConnectors"}}}},
+	{"azurerm_sentinel_data_connector_office_365", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups/Microsoft.OperationalInsights/workspaces"}, Provider: "Microsoft.SecurityInsights", Types: []string{"dataConnectors"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.OperationalInsights/workspaces/Microsoft.SecurityInsights/dataConnectors"}}}},
+	{"azurerm_sentinel_data_connector_office_365_project", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups/Microsoft.OperationalInsights/workspaces"}, Provider: "Microsoft.SecurityInsights", Types: []string{"dataConnectors"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.OperationalInsights/workspaces/Microsoft.SecurityInsights/dataConnectors"}}}},
+	{"azurerm_sentinel_data_connector_office_atp", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups/Microsoft.OperationalInsights/workspaces"}, Provider: "Microsoft.SecurityInsights", Types: []string{"dataConnectors"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.OperationalInsights/workspaces/Microsoft.SecurityInsights/dataConnectors"}}}},
+	{"azurerm_sentinel_data_connector_office_irm", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups/Microsoft.OperationalInsights/workspaces"}, Provider: "Microsoft.SecurityInsights", Types: []string{"dataConnectors"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.OperationalInsights/workspaces/Microsoft.SecurityInsights/dataConnectors"}}}},
+	{"azurerm_sentinel_data_connector_office_power_bi", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups/Microsoft.OperationalInsights/workspaces"}, Provider: "Microsoft.SecurityInsights", Types: []string{"dataConnectors"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.OperationalInsights/workspaces/Microsoft.SecurityInsights/dataConnectors"}}}},
+	{"azurerm_sentinel_data_connector_threat_intelligence", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups/Microsoft.OperationalInsights/workspaces"}, Provider: "Microsoft.SecurityInsights", Types: []string{"dataConnectors"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.OperationalInsights/workspaces/Microsoft.SecurityInsights/dataConnectors"}}}},
+	{"azurerm_sentinel_data_connector_threat_intelligence_taxii", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups/Microsoft.OperationalInsights/workspaces"}, Provider: "Microsoft.SecurityInsights", Types: []string{"dataConnectors"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.OperationalInsights/workspaces/Microsoft.SecurityInsights/dataConnectors"}}}},
+	{"azurerm_sentinel_log_analytics_workspace_onboarding", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups/Microsoft.OperationalInsights/workspaces"}, Provider: "Microsoft.SecurityInsights", Types: []string{"onboardingStates"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.OperationalInsights/workspaces/Microsoft.SecurityInsights/onboardingStates"}}}},
+	{"azurerm_sentinel_metadata", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups/Microsoft.OperationalInsights/workspaces"}, Provider: "Microsoft.SecurityInsights", Types: []string{"metadata"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.OperationalInsights/workspaces/Microsoft.SecurityInsights/metadata"}}}},
+	{"azurerm_sentinel_threat_intelligence_indicator", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups/Microsoft.OperationalInsights/workspaces"}, Provider: "Microsoft.SecurityInsights", Types: []string{"threatIntelligence", "indicators"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.OperationalInsights/workspaces/Microsoft.SecurityInsights/threatIntelligence/indicators"}}}},
+	{"azurerm_sentinel_watchlist", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups/Microsoft.OperationalInsights/workspaces"}, Provider: "Microsoft.SecurityInsights", Types: []string{"watchlists"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.OperationalInsights/workspaces/Microsoft.SecurityInsights/watchlists"}}}},
+	{"azurerm_sentinel_watchlist_item", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups/Microsoft.OperationalInsights/workspaces"}, Provider: "Microsoft.SecurityInsights", Types: []string{"watchlists", "watchlistItems"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.OperationalInsights/workspaces/Microsoft.SecurityInsights/watchlists/watchlistItems"}}}},
+	{"azurerm_service_fabric_cluster", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.ServiceFabric", Types: []string{"clusters"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.ServiceFabric/clusters"}}}},
+	{"azurerm_service_fabric_managed_cluster", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.ServiceFabric", Types: []string{"managedClusters"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.ServiceFabric/managedClusters"}}}},
+	{"azurerm_service_plan", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Web", Types: []string{"serverFarms"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Web/serverFarms"}}}},
+	{"azurerm_servicebus_namespace", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.ServiceBus", Types: []string{"namespaces"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.ServiceBus/namespaces"}}}},
+	{"azurerm_servicebus_namespace_authorization_rule", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.ServiceBus", Types: []string{"namespaces", "authorizationRules"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.ServiceBus/namespaces/authorizationRules"}}}},
+	{"azurerm_servicebus_namespace_customer_managed_key", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.ServiceBus", Types: []string{"namespaces"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.ServiceBus/namespaces"}}, IsRemoved: true, RemoveReason: "This is a property rather than a resource", Deprecation: &Deprecation{Reason: "This is a property rather than a resource", Successors: nil}}},
+	{"azurerm_servicebus_namespace_disaster_recovery_config", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.ServiceBus", Types: []string{"namespaces", "disasterRecoveryConfigs"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.ServiceBus/namespaces/disasterRecoveryConfigs"}}}},
+	{"azurerm_servicebus_queue", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.ServiceBus", Types: []string{"namespaces", "queues"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.ServiceBus/namespaces/queues"}}}},
+	{"azurerm_servicebus_queue_authorization_rule", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.ServiceBus", Types: []string{"namespaces", "queues", "authorizationRules"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.ServiceBus/namespaces/queues/authorizationRules"}}}},
+	{"azurerm_servicebus_subscription", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.ServiceBus", Types: []string{"namespaces", "topics", "subscriptions"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.ServiceBus/namespaces/topics/subscriptions"}}}},
+	{"azurerm_servicebus_subscription_rule", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.ServiceBus", Types: []string{"namespaces", "topics", "subscriptions", "rules"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.ServiceBus/namespaces/topics/subscriptions/rules"}}}},
+	{"azurerm_servicebus_topic", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.ServiceBus", Types: []string{"namespaces", "topics"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.ServiceBus/namespaces/topics"}}}},
+	{"azurerm_servicebus_topic_authorization_rule", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.ServiceBus", Types: []string{"namespaces", "topics", "authorizationRules"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.ServiceBus/namespaces/topics/authorizationRules"}}}},
+	{"azurerm_shared_image", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Compute", Types: []string{"galleries", "images"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Compute/galleries/images"}}}},
+	{"azurerm_shared_image_gallery", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Compute", Types: []string{"galleries"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Compute/galleries"}}}},
+	{"azurerm_shared_image_version", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Compute", Types: []string{"galleries", "images", "versions"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Compute/galleries/images/versions"}}}},
+	{"azurerm_signalr_service", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.SignalRService", Types: []string{"signalR"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.SignalRService/signalR"}}}},
+	{"azurerm_signalr_service_custom_certificate", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.SignalRService", Types: []string{"signalR", "customCertificates"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.SignalRService/signalR/customCertificates"}}}},
+	{"azurerm_signalr_service_custom_domain", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.SignalRService", Types: []string{"signalR", "customDomains"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.SignalRService/signalR/customDomains"}}}},
+	{"azurerm_signalr_service_network_acl", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.SignalRService", Types: []string{"signalR"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.SignalRService/signalR"}}, IsRemoved: true, RemoveReason: "This is a property rather than a resource", Deprecation: &Deprecation{Reason: "This is a property rather than a resource", Successors: nil}}},
+	{"azurerm_signalr_shared_private_link_resource", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.SignalRService", Types: []string{"signalR", "sharedPrivateLinkResources"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.SignalRService/signalR/sharedPrivateLinkResources"}}}},
+	{"azurerm_site_recovery_fabric", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.RecoveryServices", Types: []string{"vaults", "replicationFabrics"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.RecoveryServices/vaults/replicationFabrics"}}}},
+	{"azurerm_site_recovery_hyperv_network_mapping", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.RecoveryServices", Types: []string{"vaults", "replicationFabrics", "replicationNetworks", "replicationNetworkMappings"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.RecoveryServices/vaults/replicationFabrics/replicationNetworks/replicationNetworkMappings"}}}},
+	{"azurerm_site_recovery_hyperv_replication_policy", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.RecoveryServices", Types: []string{"vaults", "replicationPolicies"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.RecoveryServices/vaults/replicationPolicies"}}}},
+	{"azurerm_site_recovery_hyperv_replication_policy_association", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.RecoveryServices", Types: []string{"vaults", "replicationFabrics", "replicationProtectionContainers", "replicationProtectionContainerMappings"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.RecoveryServices/vaults/replicationFabrics/replicationProtectionContainers/replicationProtectionContainerMappings"}}}},
+	{"azurerm_site_recovery_network_mapping", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.RecoveryServices", Types: []string{"vaults", "replicationFabrics", "replicationNetworks", "replicationNetworkMappings"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.RecoveryServices/vaults/replicationFabrics/replicationNetworks/replicationNetworkMappings"}}}},
+	{"azurerm_site_recovery_protection_container", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.RecoveryServices", Types: []string{"vaults", "replicationFabrics", "replicationProtectionContainers"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.RecoveryServices/vaults/replicationFabrics/replicationProtectionContainers"}}}},
+	{"azurerm_site_recovery_protection_container_mapping", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.RecoveryServices", Types: []string{"vaults", "replicationFabrics", "replicationProtectionContainers", "replicationProtectionContainerMappings"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.RecoveryServices/vaults/replicationFabrics/replicationProtectionContainers/replicationProtectionContainerMappings"}}}},
+	{"azurerm_site_recovery_replicated_vm", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.RecoveryServices", Types: []string{"vaults", "replicationFabrics", "replicationProtectionContainers", "replicationProtectedItems"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.RecoveryServices/vaults/replicationFabrics/replicationProtectionContainers/replicationProtectedItems"}}}},
+	{"azurerm_site_recovery_replication_policy", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.RecoveryServices", Types: []string{"vaults", "replicationPolicies"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.RecoveryServices/vaults/replicationPolicies"}}}},
+	{"azurerm_site_recovery_replication_recovery_plan", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.RecoveryServices", Types: []string{"vaults", "replicationRecoveryPlans"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.RecoveryServices/vaults/replicationRecoveryPlans"}}}},
+	{"azurerm_site_recovery_services_vault_hyperv_site", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.RecoveryServices", Types: []string{"vaults", "replicationFabrics"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.RecoveryServices/vaults/replicationFabrics"}}}},
+	{"azurerm_site_recovery_vmware_replicated_vm", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.RecoveryServices", Types: []string{"vaults", "replicationFabrics", "replicationProtectionContainers", "replicationProtectedItems"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.RecoveryServices/vaults/replicationFabrics/replicationProtectionContainers/replicationProtectedItems"}}}},
+	{"azurerm_site_recovery_vmware_replication_policy", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.RecoveryServices", Types: []string{"vaults", "replicationPolicies"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.RecoveryServices/vaults/replicationPolicies"}}}},
+	{"azurerm_site_recovery_vmware_replication_policy_association", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.RecoveryServices", Types: []string{"vaults", "replicationFabrics", "replicationProtectionContainers", "replicationProtectionContainerMappings"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.RecoveryServices/vaults/replicationFabrics/replicationProtectionContainers/replicationProtectionContainerMappings"}}}},
+	{"azurerm_snapshot", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Compute", Types: []string{"snapshots"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Compute/snapshots"}}}},
+	{"azurerm_source_control_token", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/"}, Provider: "Microsoft.Web", Types: []string{"sourceControls"}, ImportSpecs: []string{"/Microsoft.Web/sourceControls"}}}},
+	{"azurerm_spring_cloud_accelerator", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.AppPlatform", Types: []string{"spring", "applicationAccelerators"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.AppPlatform/spring/applicationAccelerators"}}}},
+	{"azurerm_spring_cloud_active_deployment", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.AppPlatform", Types: []string{"spring", "apps"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.AppPlatform/spring/apps"}}, IsRemoved: true, RemoveReason: "This is a property rather than a resource", Deprecation: &Deprecation{Reason: "This is a property rather than a resource", Successors: nil}}},
+	{"azurerm_spring_cloud_api_portal", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.AppPlatform", Types: []string{"spring", "apiPortals"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.AppPlatform/spring/apiPortals"}}}},
+	{"azurerm_spring_cloud_api_portal_custom_domain", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.AppPlatform", Types: []string{"spring", "apiPortals", "domains"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.AppPlatform/spring/apiPortals/domains"}}}},
+	{"azurerm_spring_cloud_app", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.AppPlatform", Types: []string{"spring", "apps"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.AppPlatform/spring/apps"}}}},
+	{"azurerm_spring_cloud_app_cosmosdb_association", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.AppPlatform", Types: []string{"spring", "apps", "bindings"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.AppPlatform/spring/apps/bindings"}}}},
+	{"azurerm_spring_cloud_app_dynamics_application_performance_monitoring", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.AppPlatform", Types: []string{"spring", "apms"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.AppPlatform/spring/apms"}}}},
+	{"azurerm_spring_cloud_app_mysql_association", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.AppPlatform", Types: []string{"spring", "apps", "bindings"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.AppPlatform/spring/apps/bindings"}}}},
+	{"azurerm_spring_cloud_app_redis_association", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.AppPlatform", Types: []string{"spring", "apps", "bindings"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.AppPlatform/spring/apps/bindings"}}}},
+	{"azurerm_spring_cloud_application_insights_application_performance_monitoring", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.AppPlatform", Types: []string{"spring", "apms"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.AppPlatform/spring/apms"}}}},
+	{"azurerm_spring_cloud_application_live_view", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.AppPlatform", Types: []string{"spring", "applicationLiveViews"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.AppPlatform/spring/applicationLiveViews"}}}},
+	{"azurerm_spring_cloud_build_deployment", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.AppPlatform", Types: []string{"spring", "apps", "deployments"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.AppPlatform/spring/apps/deployments"}}}},
+	{"azurerm_spring_cloud_build_pack_binding", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.AppPlatform", Types: []string{"spring", "buildServices", "builders", "buildPackBindings"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.AppPlatform/spring/buildServices/builders/buildPackBindings"}}}},
+	{"azurerm_spring_cloud_builder", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.AppPlatform", Types: []string{"spring", "buildServices", "builders"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.AppPlatform/spring/buildServices/builders"}}}},
+	{"azurerm_spring_cloud_certificate", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.AppPlatform", Types: []string{"spring", "certificates"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.AppPlatform/spring/certificates"}}}},
+	{"azurerm_spring_cloud_configuration_service", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.AppPlatform", Types: []string{"spring", "configurationServices"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.AppPlatform/spring/configurationServices"}}}},
+	{"azurerm_spring_cloud_connection", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups/Microsoft.AppPlatform/Spring/apps/deployments"}, Provider: "Microsoft.ServiceLinker", Types: []string{"linkers"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.AppPlatform/Spring/apps/deployments/Microsoft.ServiceLinker/linkers"}}}},
+	{"azurerm_spring_cloud_container_deployment", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.AppPlatform", Types: []string{"spring", "apps", "deployments"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.AppPlatform/spring/apps/deployments"}}}},
+	{"azurerm_spring_cloud_custom_domain", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.AppPlatform", Types: []string{"spring", "apps", "domains"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.AppPlatform/spring/apps/domains"}}}},
+	{"azurerm_spring_cloud_customized_accelerator", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.AppPlatform", Types: []string{"spring", "applicationAccelerators", "customizedAccelerators"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.AppPlatform/spring/applicationAccelerators/customizedAccelerators"}}}},
+	{"azurerm_spring_cloud_dev_tool_portal", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.AppPlatform", Types: []string{"Spring", "DevToolPortals"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.AppPlatform/Spring/DevToolPortals"}}}},
+	{"azurerm_spring_cloud_dynatrace_application_performance_monitoring", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.AppPlatform", Types: []string{"spring", "apms"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.AppPlatform/spring/apms"}}}},
+	{"azurerm_spring_cloud_elastic_application_performance_monitoring", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.AppPlatform", Types: []string{"spring", "apms"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.AppPlatform/spring/apms"}}}},
+	{"azurerm_spring_cloud_gateway", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.AppPlatform", Types: []string{"spring", "gateways"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.AppPlatform/spring/gateways"}}}},
+	{"azurerm_spring_cloud_gateway_custom_domain", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.AppPlatform", Types: []string{"spring", "gateways", "domains"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.AppPlatform/spring/gateways/domains"}}}},
+	{"azurerm_spring_cloud_gateway_route_config", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.AppPlatform", Types: []string{"spring", "gateways", "routeConfigs"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.AppPlatform/spring/gateways/routeConfigs"}}}},
+	{"azurerm_spring_cloud_java_deployment", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.AppPlatform", Types: []string{"spring", "apps", "deployments"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.AppPlatform/spring/apps/deployments"}}}},
+	{"azurerm_spring_cloud_new_relic_application_performance_monitoring", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.AppPlatform", Types: []string{"spring", "apms"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.AppPlatform/spring/apms"}}}},
+	{"azurerm_spring_cloud_service", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.AppPlatform", Types: []string{"spring"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.AppPlatform/spring"}}}},
+	{"azurerm_spring_cloud_storage", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.AppPlatform", Types: []string{"spring", "storages"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.AppPlatform/spring/storages"}}}},
+	{"azurerm_ssh_public_key", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Compute", Types: []string{"sshPublicKeys"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Compute/sshPublicKeys"}}}},
+	{"azurerm_stack_hci_cluster", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.AzureStackHCI", Types: []string{"clusters"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.AzureStackHCI/clusters"}}}},
+	{"azurerm_stack_hci_deployment_setting", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.AzureStackHCI", Types: []string{"clusters", "deploymentSettings"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.AzureStackHCI/clusters/deploymentSettings"}}}},
+	{"azurerm_stack_hci_extension", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.AzureStackHCI", Types: []string{"clusters", "arcSettings", "extensions"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.AzureStackHCI/clusters/arcSettings/extensions"}}}},
+	{"azurerm_stack_hci_logical_network", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.AzureStackHCI", Types: []string{"logicalNetworks"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.AzureStackHCI/logicalNetworks"}}}},
+	{"azurerm_stack_hci_marketplace_gallery_image", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.AzureStackHCI", Types: []string{"marketplaceGalleryImages"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.AzureStackHCI/marketplaceGalleryImages"}}}},
+	{"azurerm_stack_hci_network_interface", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.AzureStackHCI", Types: []string{"networkInterfaces"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.AzureStackHCI/networkInterfaces"}}}},
+	{"azurerm_stack_hci_storage_path", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.AzureStackHCI", Types: []string{"storageContainers"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.AzureStackHCI/storageContainers"}}}},
+	{"azurerm_stack_hci_virtual_hard_disk", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.AzureStackHCI", Types: []string{"virtualHardDisks"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.AzureStackHCI/virtualHardDisks"}}}},
+	{"azurerm_static_site", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Web", Types: []string{"staticSites"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Web/staticSites"}}, IsRemoved: true, RemoveReason: "This is deprecated in favor of `azurerm_static_web_app`", Deprecation: &Deprecation{Reason: "This is deprecated in favor of `azurerm_static_web_app`", Successors: []string{"azurerm_static_web_app"}}}},
+	{"azurerm_static_site_custom_domain", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Web", Types: []string{"staticSites", "customDomains"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Web/staticSites/customDomains"}}, IsRemoved: true, RemoveReason: "This is deprecated in favor of `azurerm_static_web_app_custom_domain`", Deprecation: &Deprecation{Reason: "This is deprecated in favor of `azurerm_static_web_app_custom_domain`", Successors: []string{"azurerm_static_web_app_custom_domain"}}}},
+	{"azurerm_static_web_app", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Web", Types: []string{"staticSites"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Web/staticSites"}}}},
+	{"azurerm_static_web_app_custom_domain", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Web", Types: []string{"staticSites", "customDomains"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Web/staticSites/customDomains"}}}},
+	{"azurerm_static_web_app_function_app_registration", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Web", Types: []string{"staticSites", "userProvidedFunctionApps"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Web/staticSites/userProvidedFunctionApps"}}}},
+	{"azurerm_storage_account", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Storage", Types: []string{"storageAccounts"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Storage/storageAccounts"}}}},
+	{"azurerm_storage_account_customer_managed_key", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Storage", Types: []string{"storageAccounts"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Storage/storageAccounts"}}, IsRemoved: true, RemoveReason: "This is a property rather than a resource", Deprecation: &Deprecation{Reason: "This is a property rather than a resource", Successors: nil}}},
+	{"azurerm_storage_account_local_user", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Storage", Types: []string{"storageAccounts", "localUsers"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Storage/storageAccounts/localUsers"}}}},
+	{"azurerm_storage_account_network_rules", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Storage", Types: []string{"storageAccounts"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Storage/storageAccounts"}}, IsRemoved: true, RemoveReason: "This is a property rather than a resource", Deprecation: &Deprecation{Reason: "This is a property rather than a resource", Successors: nil}}},
+	{"azurerm_storage_account_queue_properties", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Storage", Types: []string{"storageAccounts", "queueServices"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Storage/storageAccounts"}, IdTransform: &IdTransform{ConstantNames: map[string]string{"queueServices": "default"}, Projection: "parent"}}}},
+	{"azurerm_storage_account_static_website", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Storage", Types: []string{"storageAccounts", "staticWebsites"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Storage/storageAccounts"}, IdTransform: &IdTransform{ConstantNames: map[string]string{"staticWebsites": "default"}, Projection: "parent"}}}},
+	{"azurerm_storage_account_table_properties", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Storage", Types: []string{"storageAccounts", "tableServices"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Storage/storageAccounts"}, IdTransform: &IdTransform{ConstantNames: map[string]string{"tableServices": "default"}, Projection: "parent"}}}},
+	{"azurerm_storage_blob", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Storage", Types: []string{"storageAccounts", "blobServices", "containers", "blobs"}}}},
+	{"azurerm_storage_blob_inventory_policy", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Storage", Types: []string{"storageAccounts", "invetoryPolicies"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Storage/storageAccounts"}, IdTransform: &IdTransform{ConstantNames: map[string]string{"invetoryPolicies": "default"}, Projection: "parent"}}}},
+	{"azurerm_storage_container", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Storage", Types: []string{"storageAccounts", "blobServices", "containers"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Storage/storageAccounts/blobServices/containers"}, IdTransform: &IdTransform{ConstantNames: map[string]string{"blobServices": "default"}}}}},
+	{"azurerm_storage_container_immutability_policy", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Storage", Types: []string{"storageAccounts", "blobServices", "containers", "immutabilityPolicies"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Storage/storageAccounts/blobServices/containers/immutabilityPolicies"}, IdTransform: &IdTransform{ConstantNames: map[string]string{"blobServices": "default", "immutabilityPolicies": "default"}}}}},
+	{"azurerm_storage_data_lake_gen2_filesystem", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Storage", Types: []string{"storageAccounts", "dfs"}}}},
+	{"azurerm_storage_data_lake_gen2_path", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Storage", Types: []string{"storageAccounts", "dfs", "paths"}}}},
+	{"azurerm_storage_encryption_scope", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Storage", Types: []string{"storageAccounts", "encryptionScopes"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Storage/storageAccounts/encryptionScopes"}}}},
+	{"azurerm_storage_management_policy", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Storage", Types: []string{"storageAccounts", "managementPolicies"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Storage/storageAccounts/managementPolicies"}}}},
+	{"azurerm_storage_mover", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.StorageMover", Types: []string{"storageMovers"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.StorageMover/storageMovers"}}}},
+	{"azurerm_storage_mover_agent", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.StorageMover", Types: []string{"storageMovers", "agents"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.StorageMover/storageMovers/agents"}}}},
+	{"azurerm_storage_mover_job_definition", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.StorageMover", Types: []string{"storageMovers", "projects", "jobDefinitions"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.StorageMover/storageMovers/projects/jobDefinitions"}}}},
+	{"azurerm_storage_mover_project", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.StorageMover", Types: []string{"storageMovers", "projects"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.StorageMover/storageMovers/projects"}}}},
+	{"azurerm_storage_mover_source_endpoint", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.StorageMover", Types: []string{"storageMovers", "endpoints"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.StorageMover/storageMovers/endpoints"}}}},
+	{"azurerm_storage_mover_target_endpoint", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.StorageMover", Types: []string{"storageMovers", "endpoints"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.StorageMover/storageMovers/endpoints"}}}},
+	{"azurerm_storage_object_replication", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Storage", Types: []string{"storageAccounts", "objectReplicationPolicies"}}}},
+	{"azurerm_storage_queue", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Storage", Types: []string{"storageAccounts", "queueServices", "queues"}}}},
+	{"azurerm_storage_share", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Storage", Types: []string{"storageAccounts", "fileServices", "shares"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Storage/storageAccounts/fileServices/shares"}, IdTransform: &IdTransform{ConstantNames: map[string]string{"fileServices": "default"}}}}},
+	{"azurerm_storage_share_directory", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Storage", Types: []string{"storageAccounts", "fileServices", "shares", "directories"}}}},
+	{"azurerm_storage_share_file", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Storage", Types: []string{"storageAccounts", "fileServices", "shares", "files"}}}},
+	{"azurerm_storage_sync", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.StorageSync", Types: []string{"storageSyncServices"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.StorageSync/storageSyncServices"}}}},
+	{"azurerm_storage_sync_cloud_endpoint", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.StorageSync", Types: []string{"storageSyncServices", "syncGroups", "cloudEndpoints"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.StorageSync/storageSyncServices/syncGroups/cloudEndpoints"}}}},
+	{"azurerm_storage_sync_group", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.StorageSync", Types: []string{"storageSyncServices", "syncGroups"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.StorageSync/storageSyncServices/syncGroups"}}}},
+	{"azurerm_storage_sync_server_endpoint", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.StorageSync", Types: []string{"storageSyncServices", "syncGroups", "serverEndpoints"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.StorageSync/storageSyncServices/syncGroups/serverEndpoints"}}}},
+	{"azurerm_storage_table", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Storage", Types: []string{"storageAccounts", "tableServices", "tables"}}}},
+	{"azurerm_storage_table_entity", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Storage", Types: []string{"storageAccounts", "tableServices", "tables", "partitionKeys", "rowKeys"}}}},
+	{"azurerm_stream_analytics_cluster", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.StreamAnalytics", Types: []string{"clusters"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.StreamAnalytics/clusters"}}}},
+	{"azurerm_stream_analytics_function_javascript_uda", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.StreamAnalytics", Types: []string{"streamingJobs", "functions"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.StreamAnalytics/streamingJobs/functions"}}}},
+	{"azurerm_stream_analytics_function_javascript_udf", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.StreamAnalytics", Types: []string{"streamingJobs", "functions"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.StreamAnalytics/streamingJobs/functions"}}}},
+	{"azurerm_stream_analytics_job", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.StreamAnalytics", Types: []string{"streamingJobs"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.StreamAnalytics/streamingJobs"}}}},
+	{"azurerm_stream_analytics_job_schedule", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.StreamAnalytics", Types: []string{"streamingJobs", "schedule"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.StreamAnalytics/streamingJobs/schedule"}}}},
+	{"azurerm_stream_analytics_job_storage_account", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.StreamAnalytics", Types: []string{"streamingJobs", "storageAccounts"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.StreamAnalytics/streamingJobs"}, IdTransform: &IdTransform{Projection: "parent"}}}},
+	{"azurerm_stream_analytics_managed_private_endpoint", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.StreamAnalytics", Types: []string{"clusters", "privateEndpoints"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.StreamAnalytics/clusters/privateEndpoints"}}}},
+	{"azurerm_stream_analytics_output_blob", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.StreamAnalytics", Types: []string{"streamingJobs", "outputs"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.StreamAnalytics/streamingJobs/outputs"}}}},
+	{"azurerm_stream_analytics_output_cosmosdb", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.StreamAnalytics", Types: []string{"streamingJobs", "outputs"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.StreamAnalytics/streamingJobs/outputs"}}}},
+	{"azurerm_stream_analytics_output_eventhub", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.StreamAnalytics", Types: []string{"streamingJobs", "outputs"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.StreamAnalytics/streamingJobs/outputs"}}}},
+	{"azurerm_stream_analytics_output_function", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.StreamAnalytics", Types: []string{"streamingJobs", "outputs"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.StreamAnalytics/streamingJobs/outputs"}}}},
+	{"azurerm_stream_analytics_output_mssql", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.StreamAnalytics", Types: []string{"streamingJobs", "outputs"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.StreamAnalytics/streamingJobs/outputs"}}}},
+	{"azurerm_stream_analytics_output_powerbi", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.StreamAnalytics", Types: []string{"streamingjobs", "outputs"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.StreamAnalytics/streamingJobs/outputs"}}}},
+	{"azurerm_stream_analytics_output_servicebus_queue", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.StreamAnalytics", Types: []string{"streamingJobs", "outputs"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.StreamAnalytics/streamingJobs/outputs"}}}},
+	{"azurerm_stream_analytics_output_servicebus_topic", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.StreamAnalytics", Types: []string{"streamingjobs", "outputs"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.StreamAnalytics/streamingJobs/outputs"}}}},
+	{"azurerm_stream_analytics_output_synapse", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.StreamAnalytics", Types: []string{"streamingJobs", "outputs"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.StreamAnalytics/streamingJobs/outputs"}}}},
+	{"azurerm_stream_analytics_output_table", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.StreamAnalytics", Types: []string{"streamingJobs", "outputs"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.StreamAnalytics/streamingJobs/outputs"}}}},
+	{"azurerm_stream_analytics_reference_input_blob", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.StreamAnalytics", Types: []string{"streamingjobs", "inputs"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.StreamAnalytics/streamingJobs/inputs"}}}},
+	{"azurerm_stream_analytics_reference_input_mssql", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.StreamAnalytics", Types: []string{"streamingJobs", "inputs"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.StreamAnalytics/streamingJobs/inputs"}}}},
+	{"azurerm_stream_analytics_stream_input_blob", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.StreamAnalytics", Types: []string{"streamingJobs", "inputs"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.StreamAnalytics/streamingJobs/inputs"}}}},
+	{"azurerm_stream_analytics_stream_input_eventhub", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.StreamAnalytics", Types: []string{"streamingJobs", "inputs"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.StreamAnalytics/streamingJobs/inputs"}}}},
+	{"azurerm_stream_analytics_stream_input_eventhub_v2", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.StreamAnalytics", Types: []string{"streamingJobs", "inputs"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.StreamAnalytics/streamingJobs/inputs"}}}},
+	{"azurerm_stream_analytics_stream_input_iothub", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.StreamAnalytics", Types: []string{"streamingJobs", "inputs"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.StreamAnalytics/streamingJobs/inputs"}}}},
+	{"azurerm_subnet", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Network", Types: []string{"virtualNetworks", "subnets"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Network/virtualNetworks/subnets"}}}},
+	{"azurerm_subnet_nat_gateway_association", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Network", Types: []string{"virtualNetworks", "subnets", "natGateways"}, IdTransform: &IdTransform{Projection: "parent"}}}},
+	{"azurerm_subnet_network_security_group_association", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Network", Types: []string{"virtualNetworks", "subnets", "networkSecurityGroups"}, IdTransform: &IdTransform{Projection: "parent"}}}},
+	{"azurerm_subnet_route_table_association", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Network", Types: []string{"virtualNetworks", "subnets", "routeTables"}, IdTransform: &IdTransform{Projection: "parent"}}}},
+	{"azurerm_subnet_service_endpoint_storage_policy", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Network", Types: []string{"serviceEndpointPolicies"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Network/serviceEndpointPolicies"}}}},
+	{"azurerm_subscription", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/"}, Provider: "Microsoft.Subscription", Types: []string{"aliases"}, ImportSpecs: []string{"/Microsoft.Subscription/aliases"}}}},
+	{"azurerm_subscription_cost_management_export", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions"}, Provider: "Microsoft.CostManagement", Types: []string{"exports"}, ImportSpecs: []string{"/subscriptions/Microsoft.CostManagement/exports"}}}},
+	{"azurerm_subscription_cost_management_view", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions"}, Provider: "Microsoft.CostManagement", Types: []string{"views"}, ImportSpecs: []string{"/subscriptions/Microsoft.CostManagement/views"}}}},
+	{"azurerm_subscription_policy_assignment", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions"}, Provider: "Microsoft.Authorization", Types: []string{"policyAssignments"}, ImportSpecs: []string{"/subscriptions/Microsoft.Authorization/policyAssignments"}}}},
+	{"azurerm_subscription_policy_exemption", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions"}, Provider: "Microsoft.Authorization", Types: []string{"policyExemptions"}, ImportSpecs: []string{"/subscriptions/Microsoft.Authorization/policyExemptions"}}}},
+	{"azurerm_subscription_policy_remediation", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions"}, Provider: "Microsoft.PolicyInsights", Types: []string{"remediations"}, ImportSpecs: []string{"/subscriptions/Microsoft.PolicyInsights/remediations"}}}},
+	{"azurerm_subscription_template_deployment", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions"}, Provider: "Microsoft.Resources", Types: []string{"deployments"}, ImportSpecs: []string{"/subscriptions/Microsoft.Resources/deployments"}}}},
+	{"azurerm_synapse_firewall_rule", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Synapse", Types: []string{"workspaces", "firewallRules"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Synapse/workspaces/firewallRules"}}}},
+	{"azurerm_synapse_integration_runtime_azure", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Synapse", Types: []string{"workspaces", "integrationRuntimes"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Synapse/workspaces/integrationRuntimes"}}}},
+	{"azurerm_synapse_integration_runtime_self_hosted", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Synapse", Types: []string{"workspaces", "integrationRuntimes"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Synapse/workspaces/integrationRuntimes"}}}},
+	{"azurerm_synapse_linked_service", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Synapse", Types: []string{"workspaces", "linkedServices"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Synapse/workspaces/linkedServices"}}}},
+	{"azurerm_synapse_managed_private_endpoint", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Synapse", Types: []string{"workspaces", "managedVirtualNetworks", "managedPrivateEndpoints"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Synapse/workspaces/managedVirtualNetworks/managedPrivateEndpoints"}}}},
+	{"azurerm_synapse_private_link_hub", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Synapse", Types: []string{"privateLinkHubs"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Synapse/privateLinkHubs"}}}},
+	{"azurerm_synapse_role_assignment", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Synapse", Types: []string{"workspaces", "roleAssignments"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Synapse/workspaces"}}}},
+	{"azurerm_synapse_spark_pool", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Synapse", Types: []string{"workspaces", "bigDataPools"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Synapse/workspaces/bigDataPools"}}}},
+	{"azurerm_synapse_sql_pool", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Synapse", Types: []string{"workspaces", "sqlPools"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Synapse/workspaces/sqlPools"}}}},
+	{"azurerm_synapse_sql_pool_extended_auditing_policy", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Synapse", Types: []string{"workspaces", "sqlPools", "extendedAuditingSettings"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Synapse/workspaces/sqlPools/extendedAuditingSettings"}}}},
+	{"azurerm_synapse_sql_pool_security_alert_policy", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Synapse", Types: []string{"workspaces", "sqlPools", "securityAlertPolicies"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Synapse/workspaces/sqlPools/securityAlertPolicies"}}}},
+	{"azurerm_synapse_sql_pool_vulnerability_assessment", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Synapse", Types: []string{"workspaces", "sqlPools", "vulnerabilityAssessments"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Synapse/workspaces/sqlPools/vulnerabilityAssessments"}}}},
+	{"azurerm_synapse_sql_pool_vulnerability_assessment_baseline", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Synapse", Types: []string{"workspaces", "sqlPools", "vulnerabilityAssessments", "rules", "baselines"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Synapse/workspaces/sqlPools/vulnerabilityAssessments/rules/baselines"}}}},
+	{"azurerm_synapse_sql_pool_workload_classifier", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Synapse", Types: []string{"workspaces", "sqlPools", "workloadGroups", "workloadClassifiers"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Synapse/workspaces/sqlPools/workloadGroups/workloadClassifiers"}}}},
+	{"azurerm_synapse_sql_pool_workload_group", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Synapse", Types: []string{"workspaces", "sqlPools", "workloadGroups"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Synapse/workspaces/sqlPools/workloadGroups"}}}},
+	{"azurerm_synapse_workspace", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Synapse", Types: []string{"workspaces"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Synapse/workspaces"}}}},
+	{"azurerm_synapse_workspace_aad_admin", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Synapse", Types: []string{"workspaces", "administrators"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Synapse/workspaces/administrators"}}}},
+	{"azurerm_synapse_workspace_extended_auditing_policy", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Synapse", Types: []string{"workspaces", "extendedAuditingSettings"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Synapse/workspaces/extendedAuditingSettings"}}}},
+	{"azurerm_synapse_workspace_key", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Synapse", Types: []string{"workspaces", "keys"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Synapse/workspaces/keys"}}}},
+	{"azurerm_synapse_workspace_security_alert_policy", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Synapse", Types: []string{"workspaces", "securityAlertPolicies"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Synapse/workspaces/securityAlertPolicies"}}}},
+	{"azurerm_synapse_workspace_sql_aad_admin", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Synapse", Types: []string{"workspaces", "administrators"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Synapse/workspaces/sqlAdministrators"}, IdTransform: &IdTransform{TypeRenames: map[string]string{"administrators": "sqlAdministrators"}}}, IsRemoved: true, RemoveReason: "This is the same as `azurerm_synapse_workspace_aad_admin`", Deprecation: &Deprecation{Reason: "This is the same as `azurerm_synapse_workspace_aad_admin`", Successors: []string{"azurerm_synapse_workspace_aad_admin"}}}},
+	{"azurerm_synapse_workspace_vulnerability_assessment", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Synapse", Types: []string{"workspaces", "vulnerabilityAssessments"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Synapse/workspaces/vulnerabilityAssessments"}}}},
+	{"azurerm_system_center_virtual_machine_manager_availability_set", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.ScVmm", Types: []string{"availabilitySets"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.ScVmm/availabilitySets"}}}},
+	{"azurerm_system_center_virtual_machine_manager_cloud", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.ScVmm", Types: []string{"clouds"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.ScVmm/clouds"}}}},
+	{"azurerm_system_center_virtual_machine_manager_server", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.ScVmm", Types: []string{"vmmServers"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.ScVmm/vmmServers"}}}},
+	{"azurerm_system_center_virtual_machine_manager_virtual_machine_instance", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups/Microsoft.HybridCompute/machines"}, Provider: "Microsoft.ScVmm", Types: []string{"virtualMachineInstances"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.HybridCompute/machines/Microsoft.ScVmm/virtualMachineInstances"}}}},
+	{"azurerm_system_center_virtual_machine_manager_virtual_machine_instance_guest_agent", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups/Microsoft.HybridCompute/machines"}, Provider: "Microsoft.ScVmm", Types: []string{"virtualMachineInstances", "guestAgents"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.HybridCompute/machines/Microsoft.ScVmm/virtualMachineInstances/guestAgents"}}}},
+	{"azurerm_system_center_virtual_machine_manager_virtual_machine_template", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.ScVmm", Types: []string{"virtualMachineTemplates"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.ScVmm/virtualMachineTemplates"}}}},
+	{"azurerm_system_center_virtual_machine_manager_virtual_network", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.ScVmm", Types: []string{"virtualNetworks"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.ScVmm/virtualNetworks"}}}},
+	{"azurerm_tenant_template_deployment", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/"}, Provider: "Microsoft.Resources", Types: []string{"deployments"}, ImportSpecs: []string{"/Microsoft.Resources/deployments"}}}},
+	{"azurerm_traffic_manager_azure_endpoint", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Network", Types: []string{"trafficManagerProfiles", "AzureEndpoints"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Network/trafficManagerProfiles/AzureEndpoints"}}}},
+	{"azurerm_traffic_manager_external_endpoint", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Network", Types: []string{"trafficManagerProfiles", "ExternalEndpoints"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Network/trafficManagerProfiles/ExternalEndpoints"}}}},
+	{"azurerm_traffic_manager_nested_endpoint", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Network", Types: []string{"trafficManagerProfiles", "NestedEndpoints"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Network/trafficManagerProfiles/NestedEndpoints"}}}},
+	{"azurerm_traffic_manager_profile", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Network", Types: []string{"trafficManagerProfiles"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Network/trafficManagerProfiles"}}}},
+	{"azurerm_trusted_signing_account", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.CodeSigning", Types: []string{"codeSigningAccounts"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.CodeSigning/codeSigningAccounts"}}}},
+	{"azurerm_user_assigned_identity", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.ManagedIdentity", Types: []string{"userAssignedIdentities"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.ManagedIdentity/userAssignedIdentities"}}}},
+	{"azurerm_video_indexer_account", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.VideoIndexer", Types: []string{"accounts"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.VideoIndexer/accounts"}}}},
+	{"azurerm_virtual_desktop_application", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.DesktopVirtualization", Types: []string{"applicationGroups", "applications"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.DesktopVirtualization/applicationGroups/applications"}}}},
+	{"azurerm_virtual_desktop_application_group", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.DesktopVirtualization", Types: []string{"applicationGroups"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.DesktopVirtualization/applicationGroups"}}}},
+	{"azurerm_virtual_desktop_host_pool", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.DesktopVirtualization", Types: []string{"hostPools"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.DesktopVirtualization/hostPools"}}}},
+	{"azurerm_virtual_desktop_host_pool_registration_info", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.DesktopVirtualization", Types: []string{"hostPools", "registrationInfo"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.DesktopVirtualization/hostPools/registrationInfo"}}}},
+	{"azurerm_virtual_desktop_scaling_plan", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.DesktopVirtualization", Types: []string{"scalingPlans"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.DesktopVirtualization/scalingPlans"}}}},
+	{"azurerm_virtual_desktop_workspace", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.DesktopVirtualization", Types: []string{"workspaces"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.DesktopVirtualization/workspaces"}}}},
+	{"azurerm_virtual_desktop_workspace_application_group_association", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.DesktopVirtualization", Types: []string{"workspaces", "applicationGroups"}}}},
+	{"azurerm_virtual_hub", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Network", Types: []string{"virtualHubs"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Network/virtualHubs"}}}},
+	{"azurerm_virtual_hub_bgp_connection", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Network", Types: []string{"virtualHubs", "bgpConnections"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Network/virtualHubs/bgpConnections"}}}},
+	{"azurerm_virtual_hub_connection", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Network", Types: []string{"virtualHubs", "hubVirtualNetworkConnections"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Network/virtualHubs/hubVirtualNetworkConnections"}}}},
+	{"azurerm_virtual_hub_ip", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Network", Types: []string{"virtualHubs", "ipConfigurations"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Network/virtualHubs/ipConfigurations"}}}},
+	{"azurerm_virtual_hub_route_table", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Network", Types: []string{"virtualHubs", "hubRouteTables"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Network/virtualHubs/hubRouteTables"}}}},
+	{"azurerm_virtual_hub_route_table_route", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Network", Types: []string{"virtualHubs", "hubRouteTables", "routes"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Network/virtualHubs/hubRouteTables/routes"}}}},
+	{"azurerm_virtual_hub_routing_intent", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Network", Types: []string{"virtualHubs", "routingIntent"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Network/virtualHubs/routingIntent"}}}},
+	{"azurerm_virtual_hub_security_partner_provider", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Network", Types: []string{"securityPartnerProviders"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Network/securityPartnerProviders"}}}},
+	{"azurerm_virtual_machine", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Compute", Types: []string{"virtualMachines"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Compute/virtualMachines"}}}},
+	{"azurerm_virtual_machine_automanage_configuration_assignment", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups/Microsoft.Compute/virtualMachines"}, Provider: "Microsoft.AutoManage", Types: []string{"configurationProfileAssignments"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Compute/virtualMachines/Microsoft.AutoManage/configurationProfileAssignments"}}}},
+	{"azurerm_virtual_machine_data_disk_attachment", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Compute", Types: []string{"virtualMachines", "dataDisks"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Compute/virtualMachines/dataDisks"}}}},
+	{"azurerm_virtual_machine_extension", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Compute", Types: []string{"virtualMachines", "extensions"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Compute/virtualMachines/extensions"}}}},
+	{"azurerm_virtual_machine_implicit_data_disk_from_source", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Compute", Types: []string{"virtualMachines", "dataDisks"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Compute/virtualMachines/dataDisks"}}}},
+	{"azurerm_virtual_machine_packet_capture", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Network", Types: []string{"networkWatchers", "packetCaptures"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Network/networkWatchers/packetCaptures"}}}},
+	{"azurerm_virtual_machine_restore_point", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Compute", Types: []string{"restorePointCollections", "restorePoints"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Compute/restorePointCollections/restorePoints"}}}},
+	{"azurerm_virtual_machine_restore_point_collection", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Compute", Types: []string{"restorePointCollections"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Compute/restorePointCollections"}}}},
+	{"azurerm_virtual_machine_run_command", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Compute", Types: []string{"virtualMachines", "runCommands"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Compute/virtualMachines/runCommands"}}}},
+	{"azurerm_virtual_machine_scale_set", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Compute", Types: []string{"virtualMachineScaleSets"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Compute/virtualMachineScaleSets"}}, IsRemoved: true, RemoveReason: "This is deprecated in favor of `azurerm_linux_virtual_machine_scale_set` and `azurerm_windows_virtual_machine_scale_set`", Deprecation: &Deprecation{Reason: "This is deprecated in favor of `azurerm_linux_virtual_machine_scale_set` and `azurerm_windows_virtual_machine_scale_set`", Successors: []string{"azurerm_linux_virtual_machine_scale_set", "azurerm_windows_virtual_machine_scale_set"}}}},
+	{"azurerm_virtual_machine_scale_set_extension", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Compute", Types: []string{"virtualMachineScaleSets", "extensions"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Compute/virtualMachineScaleSets/extensions"}}}},
+	{"azurerm_virtual_machine_scale_set_packet_capture", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Network", Types: []string{"networkWatchers", "packetCaptures"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Network/networkWatchers/packetCaptures"}}}},
+	{"azurerm_virtual_machine_scale_set_standby_pool", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.StandbyPool", Types: []string{"standbyVirtualMachinePools"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.StandbyPool/standbyVirtualMachinePools"}}}},
+	{"azurerm_virtual_network", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Network", Types: []string{"virtualNetworks"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Network/virtualNetworks"}}}},
+	{"azurerm_virtual_network_dns_servers", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Network", Types: []string{"virtualNetworks", "dnsServers"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Network/virtualNetworks/dnsServers"}}}},
+	{"azurerm_virtual_network_gateway", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Network", Types: []string{"virtualNetworkGateways"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Network/virtualNetworkGateways"}}}},
+	{"azurerm_virtual_network_gateway_connection", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Network", Types: []string{"connections"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Network/connections"}}}},
+	{"azurerm_virtual_network_gateway_nat_rule", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Network", Types: []string{"virtualNetworkGateways", "natRules"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Network/virtualNetworkGateways/natRules"}}}},
+	{"azurerm_virtual_network_peering", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Network", Types: []string{"virtualNetworks", "virtualNetworkPeerings"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Network/virtualNetworks/virtualNetworkPeerings"}}}},
+	{"azurerm_virtual_wan", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Network", Types: []string{"virtualWans"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Network/virtualWans"}}}},
+	{"azurerm_vmware_cluster", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.AVS", Types: []string{"privateClouds", "clusters"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.AVS/privateClouds/clusters"}}}},
+	{"azurerm_vmware_express_route_authorization", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.AVS", Types: []string{"privateClouds", "authorizations"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.AVS/privateClouds/authorizations"}}}},
+	{"azurerm_vmware_netapp_volume_attachment", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.AVS", Types: []string{"privateClouds", "clusters", "dataStores"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.AVS/privateClouds/clusters/dataStores"}}}},
+	{"azurerm_vmware_private_cloud", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.AVS", Types: []string{"privateClouds"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.AVS/privateClouds"}}}},
+	{"azurerm_vpn_gateway", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Network", Types: []string{"vpnGateways"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Network/vpnGateways"}}}},
+	{"azurerm_vpn_gateway_connection", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Network", Types: []string{"vpnGateways", "vpnConnections"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Network/vpnGateways/vpnConnections"}}}},
+	{"azurerm_vpn_gateway_nat_rule", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Network", Types: []string{"vpnGateways", "natRules"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Network/vpnGateways/natRules"}}}},
+	{"azurerm_vpn_server_configuration", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Network", Types: []string{"vpnServerConfigurations"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Network/vpnServerConfigurations"}}}},
+	{"azurerm_vpn_server_configuration_policy_group", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Network", Types: []string{"vpnServerConfigurations", "configurationPolicyGroups"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Network/vpnServerConfigurations/configurationPolicyGroups"}}}},
+	{"azurerm_vpn_site", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Network", Types: []string{"vpnSites"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Network/vpnSites"}}}},
+	{"azurerm_web_app_active_slot", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Web", Types: []string{"sites"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Web/sites"}}, IsRemoved: true, RemoveReason: "This is a property rather than a resource", Deprecation: &Deprecation{Reason: "This is a property rather than a resource", Successors: nil}}},
+	{"azurerm_web_app_hybrid_connection", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Web", Types: []string{"sites", "hybridConnectionNamespaces", "relays"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Web/sites/hybridConnectionNamespaces/relays"}}}},
+	{"azurerm_web_application_firewall_policy", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Network", Types: []string{"applicationGatewayWebApplicationFirewallPolicies"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Network/applicationGatewayWebApplicationFirewallPolicies"}}}},
+	{"azurerm_web_pubsub", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.SignalRService", Types: []string{"webPubSub"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.SignalRService/webPubSub"}}}},
+	{"azurerm_web_pubsub_custom_certificate", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.SignalRService", Types: []string{"webPubSub", "customCertificates"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.SignalRService/webPubSub/customCertificates"}}}},
+	{"azurerm_web_pubsub_custom_domain", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.SignalRService", Types: []string{"webPubSub", "customDomains"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.SignalRService/webPubSub/customDomains"}}}},
+	{"azurerm_web_pubsub_hub", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.SignalRService", Types: []string{"webPubSub", "hubs"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.SignalRService/webPubSub/hubs"}}}},
+	{"azurerm_web_pubsub_network_acl", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.SignalRService", Types: []string{"webPubSub"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.SignalRService/webPubSub"}}, IsRemoved: true, RemoveReason: "This is a property rather than a resource", Deprecation: &Deprecation{Reason: "This is a property rather than a resource", Successors: nil}}},
+	{"azurerm_web_pubsub_shared_private_link_resource", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.SignalRService", Types: []string{"webPubSub", "sharedPrivateLinkResources"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.SignalRService/webPubSub/sharedPrivateLinkResources"}}}},
+	{"azurerm_web_pubsub_socketio", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.SignalRService", Types: []string{"webPubSub"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.SignalRService/webPubSub"}}}},
+	{"azurerm_windows_function_app", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Web", Types: []string{"sites"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Web/sites"}}}},
+	{"azurerm_windows_function_app_slot", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Web", Types: []string{"sites", "slots"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Web/sites/slots"}}}},
+	{"azurerm_windows_virtual_machine", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Compute", Types: []string{"virtualMachines"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Compute/virtualMachines"}}}},
+	{"azurerm_windows_virtual_machine_scale_set", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Compute", Types: []string{"virtualMachineScaleSets"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Compute/virtualMachineScaleSets"}}}},
+	{"azurerm_windows_web_app", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Web", Types: []string{"sites"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Web/sites"}}}},
+	{"azurerm_windows_web_app_slot", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Web", Types: []string{"sites", "slots"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Web/sites/slots"}}}},
+	{"azurerm_workloads_sap_discovery_virtual_instance", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Workloads", Types: []string{"sapVirtualInstances"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Workloads/sapVirtualInstances"}}}},
+	{"azurerm_workloads_sap_single_node_virtual_instance", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Workloads", Types: []string{"sapVirtualInstances"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Workloads/sapVirtualInstances"}}}},
+	{"azurerm_workloads_sap_three_tier_virtual_instance", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Workloads", Types: []string{"sapVirtualInstances"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Workloads/sapVirtualInstances"}}}},
+	{"fake_azurerm_application_gateway_backend_address_pool", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Network", Types: []string{"applicationGateways", "backendAddressPools"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Network/applicationGateways/backendAddressPools"}}}},
+	{"fake_azurerm_network_interface_ipconfig", TF2ARMIdMapItem{ManagementPlane: &MapManagementPlane{ParentScopes: []string{"/subscriptions/resourceGroups"}, Provider: "Microsoft.Network", Types: []string{"networkInterfaces", "ipConfigurations"}, ImportSpecs: []string{"/subscriptions/resourceGroups/Microsoft.Network/networkInterfaces/ipConfigurations"}}}},
+}
+
+var armId2TFTable = []armId2TFEntry{
+	{"/DYNATRACE.OBSERVABILITY/MONITORS/TAGRULES", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_dynatrace_tag_rules", "/subscriptions/resourceGroups/Dynatrace.Observability/monitors/tagRules"}}},
+	{"/MICROSOFT.AAD/DOMAINSERVICES", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_active_directory_domain_service", "/subscriptions/resourceGroups/Microsoft.AAD/domainServices/initialReplicaSetId"}}},
+	{"/MICROSOFT.AAD/DOMAINSERVICES/REPLICASETS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_active_directory_domain_service_replica_set", "/subscriptions/resourceGroups/Microsoft.AAD/domainServices/replicaSets"}}},
+	{"/MICROSOFT.AADIAM/DIAGNOSTICSETTINGS", "/", []ARMId2TFMapItem{{"azurerm_monitor_aad_diagnostic_setting", "/Microsoft.AADIAM/diagnosticSettings"}}},
+	{"/MICROSOFT.ADVISOR/RECOMMENDATIONS/SUPPRESSIONS", "/SUBSCRIPTIONS", []ARMId2TFMapItem{{"azurerm_advisor_suppression", "/subscriptions/Microsoft.Advisor/recommendations/suppressions"}}},
+	{"/MICROSOFT.ALERTSMANAGEMENT/ACTIONRULES", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_monitor_alert_processing_rule_action_group", "/subscriptions/resourceGroups/Microsoft.AlertsManagement/actionRules"}, {"azurerm_monitor_alert_processing_rule_suppression", "/subscriptions/resourceGroups/Microsoft.AlertsManagement/actionRules"}}},
+	{"/MICROSOFT.ALERTSMANAGEMENT/PROMETHEUSRULEGROUPS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_monitor_alert_prometheus_rule_group", "/subscriptions/resourceGroups/Microsoft.AlertsManagement/prometheusRuleGroups"}}},
+	{"/MICROSOFT.ALERTSMANAGEMENT/SMARTDETECTORALERTRULES", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_monitor_smart_detector_alert_rule", "/subscriptions/resourceGroups/Microsoft.AlertsManagement/smartDetectorAlertRules"}}},
+	{"/MICROSOFT.ANALYSISSERVICES/SERVERS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_analysis_services_server", "/subscriptions/resourceGroups/Microsoft.AnalysisServices/servers"}}},
+	{"/MICROSOFT.APIMANAGEMENT/GATEWAYS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_api_management_standalone_gateway", "/subscriptions/resourceGroups/Microsoft.ApiManagement/gateways"}}},
+	{"/MICROSOFT.APIMANAGEMENT/SERVICE", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_api_management", "/subscriptions/resourceGroups/Microsoft.ApiManagement/service"}}},
+	{"/MICROSOFT.APIMANAGEMENT/SERVICE/APIS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_api_management_api", "/subscriptions/resourceGroups/Microsoft.ApiManagement/service/apis"}}},
+	{"/MICROSOFT.APIMANAGEMENT/SERVICE/APIS/DIAGNOSTICS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_api_management_api_diagnostic", "/subscriptions/resourceGroups/Microsoft.ApiManagement/service/apis/diagnostics"}}},
+	{"/MICROSOFT.APIMANAGEMENT/SERVICE/APIS/OPERATIONS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_api_management_api_operation", "/subscriptions/resourceGroups/Microsoft.ApiManagement/service/apis/operations"}}},
+	{"/MICROSOFT.APIMANAGEMENT/SERVICE/APIS/OPERATIONS/POLICIES", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_api_management_api_operation_policy", "/subscriptions/resourceGroups/Microsoft.ApiManagement/service/apis/operations"}}},
+	{"/MICROSOFT.APIMANAGEMENT/SERVICE/APIS/OPERATIONS/TAGS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_api_management_api_operation_tag", "/subscriptions/resourceGroups/Microsoft.ApiManagement/service/apis/operations/tags"}}},
+	{"/MICROSOFT.APIMANAGEMENT/SERVICE/APIS/POLICIES", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_api_management_api_policy", "/subscriptions/resourceGroups/Microsoft.ApiManagement/service/apis"}}},
+	{"/MICROSOFT.APIMANAGEMENT/SERVICE/APIS/RELEASES", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_api_management_api_release", "/subscriptions/resourceGroups/Microsoft.ApiManagement/service/apis/releases"}}},
+	{"/MICROSOFT.APIMANAGEMENT/SERVICE/APIS/SCHEMAS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_api_management_api_schema", "/subscriptions/resourceGroups/Microsoft.ApiManagement/service/apis/schemas"}}},
+	{"/MICROSOFT.APIMANAGEMENT/SERVICE/APIS/TAGDESCRIPTIONS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_api_management_api_tag_description", "/subscriptions/resourceGroups/Microsoft.ApiManagement/service/apis/tagDescriptions"}}},
+	{"/MICROSOFT.APIMANAGEMENT/SERVICE/APIS/TAGS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_api_management_api_tag", "/subscriptions/resourceGroups/Microsoft.ApiManagement/service/apis/tags"}}},
+	{"/MICROSOFT.APIMANAGEMENT/SERVICE/APIVERSIONSETS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_api_management_api_version_set", "/subscriptions/resourceGroups/Microsoft.ApiManagement/service/apiVersionSets"}}},
+	{"/MICROSOFT.APIMANAGEMENT/SERVICE/AUTHORIZATIONSERVERS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_api_management_authorization_server", "/subscriptions/resourceGroups/Microsoft.ApiManagement/service/authorizationServers"}}},
+	{"/MICROSOFT.APIMANAGEMENT/SERVICE/BACKENDS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_api_management_backend", "/subscriptions/resourceGroups/Microsoft.ApiManagement/service/backends"}}},
+	{"/MICROSOFT.APIMANAGEMENT/SERVICE/CACHES", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_api_management_redis_cache", "/subscriptions/resourceGroups/Microsoft.ApiManagement/service/caches"}}},
+	{"/MICROSOFT.APIMANAGEMENT/SERVICE/CERTIFICATES", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_api_management_certificate", "/subscriptions/resourceGroups/Microsoft.ApiManagement/service/certificates"}}},
+	{"/MICROSOFT.APIMANAGEMENT/SERVICE/CUSTOMDOMAINS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_api_management_custom_domain", "/subscriptions/resourceGroups/Microsoft.ApiManagement/service/customDomains"}}},
+	{"/MICROSOFT.APIMANAGEMENT/SERVICE/DIAGNOSTICS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_api_management_diagnostic", "/subscriptions/resourceGroups/Microsoft.ApiManagement/service/diagnostics"}}},
+	{"/MICROSOFT.APIMANAGEMENT/SERVICE/GATEWAYS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_api_management_gateway", "/subscriptions/resourceGroups/Microsoft.ApiManagement/service/gateways"}}},
+	{"/MICROSOFT.APIMANAGEMENT/SERVICE/GATEWAYS/APIS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_api_management_gateway_api", "/subscriptions/resourceGroups/Microsoft.ApiManagement/service/gateways/apis"}}},
+	{"/MICROSOFT.APIMANAGEMENT/SERVICE/GATEWAYS/CERTIFICATEAUTHORITIES", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_api_management_gateway_certificate_authority", "/subscriptions/resourceGroups/Microsoft.ApiManagement/service/gateways/certificateAuthorities"}}},
+	{"/MICROSOFT.APIMANAGEMENT/SERVICE/GATEWAYS/HOSTNAMECONFIGURATIONS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_api_management_gateway_host_name_configuration", "/subscriptions/resourceGroups/Microsoft.ApiManagement/service/gateways/hostnameConfigurations"}}},
+	{"/MICROSOFT.APIMANAGEMENT/SERVICE/GROUPS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_api_management_group", "/subscriptions/resourceGroups/Microsoft.ApiManagement/service/groups"}}},
+	{"/MICROSOFT.APIMANAGEMENT/SERVICE/GROUPS/USERS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_api_management_group_user", "/subscriptions/resourceGroups/Microsoft.ApiManagement/service/groups/users"}}},
+	{"/MICROSOFT.APIMANAGEMENT/SERVICE/IDENTITYPROVIDERS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_api_management_identity_provider_aad", "/subscriptions/resourceGroups/Microsoft.ApiManagement/service/identityProviders"}, {"azurerm_api_management_identity_provider_aadb2c", "/subscriptions/resourceGroups/Microsoft.ApiManagement/service/identityProviders"}, {"azurerm_api_management_identity_provider_facebook", "/subscriptions/resourceGroups/Microsoft.ApiManagement/service/identityProviders"}, {"azurerm_api_management_identity_provider_google", "/subscriptions/resourceGroups/Microsoft.ApiManagement/service/identityProviders"}, {"azurerm_api_management_identity_provider_microsoft", "/subscriptions/resourceGroups/Microsoft.ApiManagement/service/identityProviders"}, {"azurerm_api_management_identity_provider_twitter", "/subscriptions/resourceGroups/Microsoft.ApiManagement/service/identityProviders"}}},
+	{"/MICROSOFT.APIMANAGEMENT/SERVICE/LOGGERS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_api_management_logger", "/subscriptions/resourceGroups/Microsoft.ApiManagement/service/loggers"}}},
+	{"/MICROSOFT.APIMANAGEMENT/SERVICE/NAMEDVALUES", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_api_management_named_value", "/subscriptions/resourceGroups/Microsoft.ApiManagement/service/namedValues"}}},
+	{"/MICROSOFT.APIMANAGEMENT/SERVICE/NOTIFICATIONS/RECIPIENTEMAILS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_api_management_notification_recipient_email", "/subscriptions/resourceGroups/Microsoft.ApiManagement/service/notifications/recipientEmails"}}},
+	{"/MICROSOFT.APIMANAGEMENT/SERVICE/NOTIFICATIONS/RECIPIENTUSERS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_api_management_notification_recipient_user", "/subscriptions/resourceGroups/Microsoft.ApiManagement/service/notifications/recipientUsers"}}},
+	{"/MICROSOFT.APIMANAGEMENT/SERVICE/OPENIDCONNECTPROVIDERS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_api_management_openid_connect_provider", "/subscriptions/resourceGroups/Microsoft.ApiManagement/service/openidConnectProviders"}}},
+	{"/MICROSOFT.APIMANAGEMENT/SERVICE/POLICIES", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_api_management_policy", "/subscriptions/resourceGroups/Microsoft.ApiManagement/service"}}},
+	{"/MICROSOFT.APIMANAGEMENT/SERVICE/POLICYFRAGMENTS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_api_management_policy_fragment", "/subscriptions/resourceGroups/Microsoft.ApiManagement/service/policyFragments"}}},
+	{"/MICROSOFT.APIMANAGEMENT/SERVICE/PRODUCTS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_api_management_product", "/subscriptions/resourceGroups/Microsoft.ApiManagement/service/products"}}},
+	{"/MICROSOFT.APIMANAGEMENT/SERVICE/PRODUCTS/APIS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_api_management_product_api", "/subscriptions/resourceGroups/Microsoft.ApiManagement/service/products/apis"}}},
+	{"/MICROSOFT.APIMANAGEMENT/SERVICE/PRODUCTS/GROUPS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_api_management_product_group", "/subscriptions/resourceGroups/Microsoft.ApiManagement/service/products/groups"}}},
+	{"/MICROSOFT.APIMANAGEMENT/SERVICE/PRODUCTS/POLICIES", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_api_management_product_policy", "/subscriptions/resourceGroups/Microsoft.ApiManagement/service/products"}}},
+	{"/MICROSOFT.APIMANAGEMENT/SERVICE/PRODUCTS/TAGS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_api_management_product_tag", "/subscriptions/resourceGroups/Microsoft.ApiManagement/service/products/tags"}}},
+	{"/MICROSOFT.APIMANAGEMENT/SERVICE/SCHEMAS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_api_management_global_schema", "/subscriptions/resourceGroups/Microsoft.ApiManagement/service/schemas"}}},
+	{"/MICROSOFT.APIMANAGEMENT/SERVICE/SUBSCRIPTIONS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_api_management_subscription", "/subscriptions/resourceGroups/Microsoft.ApiManagement/service/subscriptions"}}},
+	{"/MICROSOFT.APIMANAGEMENT/SERVICE/TAGS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_api_management_tag", "/subscriptions/resourceGroups/Microsoft.ApiManagement/service/tags"}}},
+	{"/MICROSOFT.APIMANAGEMENT/SERVICE/TEMPLATES", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_api_management_email_template", "/subscriptions/resourceGroups/Microsoft.ApiManagement/service/templates"}}},
+	{"/MICROSOFT.APIMANAGEMENT/SERVICE/USERS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_api_management_user", "/subscriptions/resourceGroups/Microsoft.ApiManagement/service/users"}}},
+	{"/MICROSOFT.APIMANAGEMENT/SERVICE/WORKSPACES", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_api_management_workspace", "/subscriptions/resourceGroups/Microsoft.ApiManagement/service/workspaces"}}},
+	{"/MICROSOFT.APIMANAGEMENT/SERVICE/WORKSPACES/APIVERSIONSETS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_api_management_workspace_api_version_set", "/subscriptions/resourceGroups/Microsoft.ApiManagement/service/workspaces/apiVersionSets"}}},
+	{"/MICROSOFT.APIMANAGEMENT/SERVICE/WORKSPACES/CERTIFICATES", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_api_management_workspace_certificate", "/subscriptions/resourceGroups/Microsoft.ApiManagement/service/workspaces/certificates"}}},
+	{"/MICROSOFT.APIMANAGEMENT/SERVICE/WORKSPACES/NAMEDVALUES", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_api_management_workspace_named_value", "/subscriptions/resourceGroups/Microsoft.ApiManagement/service/workspaces/namedValues"}}},
+	{"/MICROSOFT.APIMANAGEMENT/SERVICE/WORKSPACES/POLICIES", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_api_management_workspace_policy", "/subscriptions/resourceGroups/Microsoft.ApiManagement/service/workspaces"}}},
+	{"/MICROSOFT.APIMANAGEMENT/SERVICE/WORKSPACES/POLICYFRAGMENTS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_api_management_workspace_policy_fragment", "/subscriptions/resourceGroups/Microsoft.ApiManagement/service/workspaces/policyFragments"}}},
+	{"/MICROSOFT.APP/CONTAINERAPPS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_container_app", "/subscriptions/resourceGroups/Microsoft.App/containerApps"}}},
+	{"/MICROSOFT.APP/CONTAINERAPPS/CUSTOMDOMAINNAME", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_container_app_custom_domain", "/subscriptions/resourceGroups/Microsoft.App/containerApps/customDomainName"}}},
+	{"/MICROSOFT.APP/JOBS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_container_app_job", "/subscriptions/resourceGroups/Microsoft.App/jobs"}}},
+	{"/MICROSOFT.APP/MANAGEDENVIRONMENTS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_container_app_environment", "/subscriptions/resourceGroups/Microsoft.App/managedEnvironments"}}},
+	{"/MICROSOFT.APP/MANAGEDENVIRONMENTS/CERTIFICATES", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_container_app_environment_certificate", "/subscriptions/resourceGroups/Microsoft.App/managedEnvironments/certificates"}}},
+	{"/MICROSOFT.APP/MANAGEDENVIRONMENTS/CUSTOMDOMAINS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_container_app_environment_custom_domain", "/subscriptions/resourceGroups/Microsoft.App/managedEnvironments"}}},
+	{"/MICROSOFT.APP/MANAGEDENVIRONMENTS/DAPRCOMPONENTS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_container_app_environment_dapr_component", "/subscriptions/resourceGroups/Microsoft.App/managedEnvironments/daprComponents"}}},
+	{"/MICROSOFT.APP/MANAGEDENVIRONMENTS/MANAGEDCERTIFICATES", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_container_app_environment_managed_certificate", "/subscriptions/resourceGroups/Microsoft.App/managedEnvironments/managedCertificates"}}},
+	{"/MICROSOFT.APP/MANAGEDENVIRONMENTS/STORAGES", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_container_app_environment_storage", "/subscriptions/resourceGroups/Microsoft.App/managedEnvironments/storages"}}},
+	{"/MICROSOFT.APPCONFIGURATION/CONFIGURATIONSTORES", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_app_configuration", "/subscriptions/resourceGroups/Microsoft.AppConfiguration/configurationStores"}}},
+	{"/MICROSOFT.APPCONFIGURATION/CONFIGURATIONSTORES/APPCONFIGURATIONFEATURE/LABEL", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_app_configuration_feature", "/subscriptions/resourceGroups/Microsoft.AppConfiguration/configurationStores/AppConfigurationFeature/Label"}}},
+	{"/MICROSOFT.APPCONFIGURATION/CONFIGURATIONSTORES/APPCONFIGURATIONKEY/LABEL", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_app_configuration_key", "/subscriptions/resourceGroups/Microsoft.AppConfiguration/configurationStores/AppConfigurationKey/Label"}}},
+	{"/MICROSOFT.APPPLATFORM/SPRING", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_spring_cloud_service", "/subscriptions/resourceGroups/Microsoft.AppPlatform/spring"}}},
+	{"/MICROSOFT.APPPLATFORM/SPRING/APIPORTALS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_spring_cloud_api_portal", "/subscriptions/resourceGroups/Microsoft.AppPlatform/spring/apiPortals"}}},
+	{"/MICROSOFT.APPPLATFORM/SPRING/APIPORTALS/DOMAINS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_spring_cloud_api_portal_custom_domain", "/subscriptions/resourceGroups/Microsoft.AppPlatform/spring/apiPortals/domains"}}},
+	{"/MICROSOFT.APPPLATFORM/SPRING/APMS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_spring_cloud_app_dynamics_application_performance_monitoring", "/subscriptions/resourceGroups/Microsoft.AppPlatform/spring/apms"}, {"azurerm_spring_cloud_application_insights_application_performance_monitoring", "/subscriptions/resourceGroups/Microsoft.AppPlatform/spring/apms"}, {"azurerm_spring_cloud_dynatrace_application_performance_monitoring", "/subscriptions/resourceGroups/Microsoft.AppPlatform/spring/apms"}, {"azurerm_spring_cloud_elastic_application_performance_monitoring", "/subscriptions/resourceGroups/Microsoft.AppPlatform/spring/apms"}, {"azurerm_spring_cloud_new_relic_application_performance_monitoring", "/subscriptions/resourceGroups/Microsoft.AppPlatform/spring/apms"}}},
+	{"/MICROSOFT.APPPLATFORM/SPRING/APPLICATIONACCELERATORS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_spring_cloud_accelerator", "/subscriptions/resourceGroups/Microsoft.AppPlatform/spring/applicationAccelerators"}}},
+	{"/MICROSOFT.APPPLATFORM/SPRING/APPLICATIONACCELERATORS/CUSTOMIZEDACCELERATORS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_spring_cloud_customized_accelerator", "/subscriptions/resourceGroups/Microsoft.AppPlatform/spring/applicationAccelerators/customizedAccelerators"}}},
+	{"/MICROSOFT.APPPLATFORM/SPRING/APPLICATIONLIVEVIEWS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_spring_cloud_application_live_view", "/subscriptions/resourceGroups/Microsoft.AppPlatform/spring/applicationLiveViews"}}},
+	{"/MICROSOFT.APPPLATFORM/SPRING/APPS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_spring_cloud_app", "/subscriptions/resourceGroups/Microsoft.AppPlatform/spring/apps"}}},
+	{"/MICROSOFT.APPPLATFORM/SPRING/APPS/BINDINGS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_spring_cloud_app_cosmosdb_association", "/subscriptions/resourceGroups/Microsoft.AppPlatform/spring/apps/bindings"}, {"azurerm_spring_cloud_app_mysql_association", "/subscriptions/resourceGroups/Microsoft.AppPlatform/spring/apps/bindings"}, {"azurerm_spring_cloud_app_redis_association", "/subscriptions/resourceGroups/Microsoft.AppPlatform/spring/apps/bindings"}}},
+	{"/MICROSOFT.APPPLATFORM/SPRING/APPS/DEPLOYMENTS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_spring_cloud_build_deployment", "/subscriptions/resourceGroups/Microsoft.AppPlatform/spring/apps/deployments"}, {"azurerm_spring_cloud_container_deployment", "/subscriptions/resourceGroups/Microsoft.AppPlatform/spring/apps/deployments"}, {"azurerm_spring_cloud_java_deployment", "/subscriptions/resourceGroups/Microsoft.AppPlatform/spring/apps/deployments"}}},
+	{"/MICROSOFT.APPPLATFORM/SPRING/APPS/DOMAINS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_spring_cloud_custom_domain", "/subscriptions/resourceGroups/Microsoft.AppPlatform/spring/apps/domains"}}},
+	{"/MICROSOFT.APPPLATFORM/SPRING/BUILDSERVICES/BUILDERS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_spring_cloud_builder", "/subscriptions/resourceGroups/Microsoft.AppPlatform/spring/buildServices/builders"}}},
+	{"/MICROSOFT.APPPLATFORM/SPRING/BUILDSERVICES/BUILDERS/BUILDPACKBINDINGS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_spring_cloud_build_pack_binding", "/subscriptions/resourceGroups/Microsoft.AppPlatform/spring/buildServices/builders/buildPackBindings"}}},
+	{"/MICROSOFT.APPPLATFORM/SPRING/CERTIFICATES", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_spring_cloud_certificate", "/subscriptions/resourceGroups/Microsoft.AppPlatform/spring/certificates"}}},
+	{"/MICROSOFT.APPPLATFORM/SPRING/CONFIGURATIONSERVICES", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_spring_cloud_configuration_service", "/subscriptions/resourceGroups/Microsoft.AppPlatform/spring/configurationServices"}}},
+	{"/MICROSOFT.APPPLATFORM/SPRING/DEVTOOLPORTALS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_spring_cloud_dev_tool_portal", "/subscriptions/resourceGroups/Microsoft.AppPlatform/Spring/DevToolPortals"}}},
+	{"/MICROSOFT.APPPLATFORM/SPRING/GATEWAYS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_spring_cloud_gateway", "/subscriptions/resourceGroups/Microsoft.AppPlatform/spring/gateways"}}},
+	{"/MICROSOFT.APPPLATFORM/SPRING/GATEWAYS/DOMAINS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_spring_cloud_gateway_custom_domain", "/subscriptions/resourceGroups/Microsoft.AppPlatform/spring/gateways/domains"}}},
+	{"/MICROSOFT.APPPLATFORM/SPRING/GATEWAYS/ROUTECONFIGS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_spring_cloud_gateway_route_config", "/subscriptions/resourceGroups/Microsoft.AppPlatform/spring/gateways/routeConfigs"}}},
+	{"/MICROSOFT.APPPLATFORM/SPRING/STORAGES", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_spring_cloud_storage", "/subscriptions/resourceGroups/Microsoft.AppPlatform/spring/storages"}}},
+	{"/MICROSOFT.ATTESTATION/ATTESTATIONPROVIDERS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_attestation_provider", "/subscriptions/resourceGroups/Microsoft.Attestation/attestationProviders"}}},
+	{"/MICROSOFT.AUTHORIZATION/LOCKS", "ANY", []ARMId2TFMapItem{{"azurerm_management_lock", ""}}},
+	{"/MICROSOFT.AUTHORIZATION/POLICYASSIGNMENTS", "/MICROSOFT.MANAGEMENT/MANAGEMENTGROUPS", []ARMId2TFMapItem{{"azurerm_management_group_policy_assignment", "/Microsoft.Management/managementGroups/Microsoft.Authorization/policyAssignments"}}},
+	{"/MICROSOFT.AUTHORIZATION/POLICYASSIGNMENTS", "/SUBSCRIPTIONS", []ARMId2TFMapItem{{"azurerm_subscription_policy_assignment", "/subscriptions/Microsoft.Authorization/policyAssignments"}}},
+	{"/MICROSOFT.AUTHORIZATION/POLICYASSIGNMENTS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_resource_group_policy_assignment", "/subscriptions/resourceGroups/Microsoft.Authorization/policyAssignments"}}},
+	{"/MICROSOFT.AUTHORIZATION/POLICYASSIGNMENTS", "ANY", []ARMId2TFMapItem{{"azurerm_resource_policy_assignment", ""}}},
+	{"/MICROSOFT.AUTHORIZATION/POLICYDEFINITIONS", "/MICROSOFT.MANAGEMENT/MANAGEMENTGROUPS", []ARMId2TFMapItem{{"azurerm_policy_definition", "/Microsoft.Management/managementgroups/Microsoft.Authorization/policyDefinitions"}}},
+	{"/MICROSOFT.AUTHORIZATION/POLICYDEFINITIONS", "/SUBSCRIPTIONS", []ARMId2TFMapItem{{"azurerm_policy_definition", "/subscriptions/Microsoft.Authorization/policyDefinitions"}}},
+	{"/MICROSOFT.AUTHORIZATION/POLICYEXEMPTIONS", "/MICROSOFT.MANAGEMENT/MANAGEMENTGROUPS", []ARMId2TFMapItem{{"azurerm_management_group_policy_exemption", "/Microsoft.Management/managementGroups/Microsoft.Authorization/policyExemptions"}}},
+	{"/MICROSOFT.AUTHORIZATION/POLICYEXEMPTIONS", "/SUBSCRIPTIONS", []ARMId2TFMapItem{{"azurerm_subscription_policy_exemption", "/subscriptions/Microsoft.Authorization/policyExemptions"}}},
+	{"/MICROSOFT.AUTHORIZATION/POLICYEXEMPTIONS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_resource_group_policy_exemption", "/subscriptions/resourceGroups/Microsoft.Authorization/policyExemptions"}}},
+	{"/MICROSOFT.AUTHORIZATION/POLICYEXEMPTIONS", "ANY", []ARMId2TFMapItem{{"azurerm_resource_policy_exemption", ""}}},
+	{"/MICROSOFT.AUTHORIZATION/POLICYSETDEFINITIONS", "/MICROSOFT.MANAGEMENT/MANAGEMENTGROUPS", []ARMId2TFMapItem{{"azurerm_management_group_policy_set_definition", "/Microsoft.Management/managementGroups/Microsoft.Authorization/policySetDefinitions"}}},
+	{"/MICROSOFT.AUTHORIZATION/POLICYSETDEFINITIONS", "/SUBSCRIPTIONS", []ARMId2TFMapItem{{"azurerm_policy_set_definition", "/subscriptions/Microsoft.Authorization/policySetDefinitions"}}},
+	{"/MICROSOFT.AUTHORIZATION/PRIVATELINKASSOCIATIONS", "/MICROSOFT.MANAGEMENT/MANAGEMENTGROUPS", []ARMId2TFMapItem{{"azurerm_resource_management_private_link_association", "/Microsoft.Management/managementGroups/Microsoft.Authorization/privateLinkAssociations"}}},
+	{"/MICROSOFT.AUTHORIZATION/RESOURCEMANAGEMENTPRIVATELINKS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_resource_management_private_link", "/subscriptions/resourceGroups/Microsoft.Authorization/resourceManagementPrivateLinks"}}},
+	{"/MICROSOFT.AUTHORIZATION/ROLEASSIGNMENTS", "/MICROSOFT.MARKETPLACE", []ARMId2TFMapItem{{"azurerm_marketplace_role_assignment", "/Microsoft.Marketplace/Microsoft.Authorization/roleAssignments"}}},
+	{"/MICROSOFT.AUTHORIZATION/ROLEASSIGNMENTS", "ANY", []ARMId2TFMapItem{{"azurerm_role_assignment", ""}}},
+	{"/MICROSOFT.AUTHORIZATION/ROLEDEFINITIONS", "ANY", []ARMId2TFMapItem{{"azurerm_role_definition", ""}}},
+	{"/MICROSOFT.AUTHORIZATION/ROLEMANAGEMENTPOLICIES", "ANY", []ARMId2TFMapItem{{"azurerm_role_management_policy", ""}}},
+	{"/MICROSOFT.AUTOMANAGE/CONFIGURATIONPROFILEASSIGNMENTS", "/SUBSCRIPTIONS/RESOURCEGROUPS/MICROSOFT.COMPUTE/VIRTUALMACHINES", []ARMId2TFMapItem{{"azurerm_virtual_machine_automanage_configuration_assignment", "/subscriptions/resourceGroups/Microsoft.Compute/virtualMachines/Microsoft.AutoManage/configurationProfileAssignments"}}},
+	{"/MICROSOFT.AUTOMANAGE/CONFIGURATIONPROFILEASSIGNMENTS", "/SUBSCRIPTIONS/RESOURCEGROUPS/MICROSOFT.HYBRIDCOMPUTE/MACHINES", []ARMId2TFMapItem{{"azurerm_arc_machine_automanage_configuration_assignment", "/subscriptions/resourceGroups/Microsoft.HybridCompute/machines/Microsoft.AutoManage/configurationProfileAssignments"}}},
+	{"/MICROSOFT.AUTOMANAGE/CONFIGURATIONPROFILES", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_automanage_configuration", "/subscriptions/resourceGroups/Microsoft.AutoManage/configurationProfiles"}}},
+	{"/MICROSOFT.AUTOMATION/AUTOMATIONACCOUNTS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_automation_account", "/subscriptions/resourceGroups/Microsoft.Automation/automationAccounts"}}},
+	{"/MICROSOFT.AUTOMATION/AUTOMATIONACCOUNTS/CERTIFICATES", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_automation_certificate", "/subscriptions/resourceGroups/Microsoft.Automation/automationAccounts/certificates"}}},
+	{"/MICROSOFT.AUTOMATION/AUTOMATIONACCOUNTS/CONFIGURATIONS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_automation_dsc_configuration", "/subscriptions/resourceGroups/Microsoft.Automation/automationAccounts/configurations"}}},
+	{"/MICROSOFT.AUTOMATION/AUTOMATIONACCOUNTS/CONNECTIONS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_automation_connection", "/subscriptions/resourceGroups/Microsoft.Automation/automationAccounts/connections"}, {"azurerm_automation_connection_certificate", "/subscriptions/resourceGroups/Microsoft.Automation/automationAccounts/connections"}, {"azurerm_automation_connection_classic_certificate", "/subscriptions/resourceGroups/Microsoft.Automation/automationAccounts/connections"}, {"azurerm_automation_connection_service_principal", "/subscriptions/resourceGroups/Microsoft.Automation/automationAccounts/connections"}}},
+	{"/MICROSOFT.AUTOMATION/AUTOMATIONACCOUNTS/CONNECTIONTYPES", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_automation_connection_type", "/subscriptions/resourceGroups/Microsoft.Automation/automationAccounts/connectionTypes"}}},
+	{"/MICROSOFT.AUTOMATION/AUTOMATIONACCOUNTS/CREDENTIALS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_automation_credential", "/subscriptions/resourceGroups/Microsoft.Automation/automationAccounts/credentials"}}},
+	{"/MICROSOFT.AUTOMATION/AUTOMATIONACCOUNTS/HYBRIDRUNBOOKWORKERGROUPS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_automation_hybrid_runbook_worker_group", "/subscriptions/resourceGroups/Microsoft.Automation/automationAccounts/hybridRunbookWorkerGroups"}}},
+	{"/MICROSOFT.AUTOMATION/AUTOMATIONACCOUNTS/HYBRIDRUNBOOKWORKERGROUPS/HYBRIDRUNBOOKWORKERS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_automation_hybrid_runbook_worker", "/subscriptions/resourceGroups/Microsoft.Automation/automationAccounts/hybridRunbookWorkerGroups/hybridRunbookWorkers"}}},
+	{"/MICROSOFT.AUTOMATION/AUTOMATIONACCOUNTS/JOBSCHEDULES", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_automation_job_schedule", "/subscriptions/resourceGroups/Microsoft.Automation/automationAccounts/jobSchedules"}}},
+	{"/MICROSOFT.AUTOMATION/AUTOMATIONACCOUNTS/MODULES", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_automation_module", "/subscriptions/resourceGroups/Microsoft.Automation/automationAccounts/modules"}}},
+	{"/MICROSOFT.AUTOMATION/AUTOMATIONACCOUNTS/NODECONFIGURATIONS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_automation_dsc_nodeconfiguration", "/subscriptions/resourceGroups/Microsoft.Automation/automationAccounts/nodeConfigurations"}}},
+	{"/MICROSOFT.AUTOMATION/AUTOMATIONACCOUNTS/POWERSHELL72MODULES", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_automation_powershell72_module", "/subscriptions/resourceGroups/Microsoft.Automation/automationAccounts/powerShell72Modules"}}},
+	{"/MICROSOFT.AUTOMATION/AUTOMATIONACCOUNTS/PYTHON3PACKAGES", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_automation_python3_package", "/subscriptions/resourceGroups/Microsoft.Automation/automationAccounts/python3Packages"}}},
+	{"/MICROSOFT.AUTOMATION/AUTOMATIONACCOUNTS/RUNBOOKS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_automation_runbook", "/subscriptions/resourceGroups/Microsoft.Automation/automationAccounts/runbooks"}}},
+	{"/MICROSOFT.AUTOMATION/AUTOMATIONACCOUNTS/RUNTIMEENVIRONMENTS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_automation_runtime_environment", "/subscriptions/resourceGroups/Microsoft.Automation/automationAccounts/runtimeEnvironments"}}},
+	{"/MICROSOFT.AUTOMATION/AUTOMATIONACCOUNTS/RUNTIMEENVIRONMENTS/PACKAGES", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_automation_runtime_environment_package", "/subscriptions/resourceGroups/Microsoft.Automation/automationAccounts/runtimeEnvironments/packages"}}},
+	{"/MICROSOFT.AUTOMATION/AUTOMATIONACCOUNTS/SCHEDULES", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_automation_schedule", "/subscriptions/resourceGroups/Microsoft.Automation/automationAccounts/schedules"}}},
+	{"/MICROSOFT.AUTOMATION/AUTOMATIONACCOUNTS/SOFTWAREUPDATECONFIGURATIONS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_automation_software_update_configuration", "/subscriptions/resourceGroups/Microsoft.Automation/automationAccounts/softwareUpdateConfigurations"}}},
+	{"/MICROSOFT.AUTOMATION/AUTOMATIONACCOUNTS/SOURCECONTROLS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_automation_source_control", "/subscriptions/resourceGroups/Microsoft.Automation/automationAccounts/sourceControls"}}},
+	{"/MICROSOFT.AUTOMATION/AUTOMATIONACCOUNTS/VARIABLES", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_automation_variable_bool", "/subscriptions/resourceGroups/Microsoft.Automation/automationAccounts/variables"}, {"azurerm_automation_variable_datetime", "/subscriptions/resourceGroups/Microsoft.Automation/automationAccounts/variables"}, {"azurerm_automation_variable_int", "/subscriptions/resourceGroups/Microsoft.Automation/automationAccounts/variables"}, {"azurerm_automation_variable_object", "/subscriptions/resourceGroups/Microsoft.Automation/automationAccounts/variables"}, {"azurerm_automation_variable_string", "/subscriptions/resourceGroups/Microsoft.Automation/automationAccounts/variables"}}},
+	{"/MICROSOFT.AUTOMATION/AUTOMATIONACCOUNTS/WATCHERS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_automation_watcher", "/subscriptions/resourceGroups/Microsoft.Automation/automationAccounts/watchers"}}},
+	{"/MICROSOFT.AUTOMATION/AUTOMATIONACCOUNTS/WEBHOOKS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_automation_webhook", "/subscriptions/resourceGroups/Microsoft.Automation/automationAccounts/webHooks"}}},
+	{"/MICROSOFT.AVS/PRIVATECLOUDS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_vmware_private_cloud", "/subscriptions/resourceGroups/Microsoft.AVS/privateClouds"}}},
+	{"/MICROSOFT.AVS/PRIVATECLOUDS/AUTHORIZATIONS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_vmware_express_route_authorization", "/subscriptions/resourceGroups/Microsoft.AVS/privateClouds/authorizations"}}},
+	{"/MICROSOFT.AVS/PRIVATECLOUDS/CLUSTERS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_vmware_cluster", "/subscriptions/resourceGroups/Microsoft.AVS/privateClouds/clusters"}}},
+	{"/MICROSOFT.AVS/PRIVATECLOUDS/CLUSTERS/DATASTORES", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_vmware_netapp_volume_attachment", "/subscriptions/resourceGroups/Microsoft.AVS/privateClouds/clusters/dataStores"}}},
+	{"/MICROSOFT.AZUREACTIVEDIRECTORY/B2CDIRECTORIES", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_aadb2c_directory", "/subscriptions/resourceGroups/Microsoft.AzureActiveDirectory/b2cDirectories"}}},
+	{"/MICROSOFT.AZURESTACKHCI/CLUSTERS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_stack_hci_cluster", "/subscriptions/resourceGroups/Microsoft.AzureStackHCI/clusters"}}},
+	{"/MICROSOFT.AZURESTACKHCI/CLUSTERS/ARCSETTINGS/EXTENSIONS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_stack_hci_extension", "/subscriptions/resourceGroups/Microsoft.AzureStackHCI/clusters/arcSettings/extensions"}}},
+	{"/MICROSOFT.AZURESTACKHCI/CLUSTERS/DEPLOYMENTSETTINGS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_stack_hci_deployment_setting", "/subscriptions/resourceGroups/Microsoft.AzureStackHCI/clusters/deploymentSettings"}}},
+	{"/MICROSOFT.AZURESTACKHCI/LOGICALNETWORKS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_stack_hci_logical_network", "/subscriptions/resourceGroups/Microsoft.AzureStackHCI/logicalNetworks"}}},
+	{"/MICROSOFT.AZURESTACKHCI/MARKETPLACEGALLERYIMAGES", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_stack_hci_marketplace_gallery_image", "/subscriptions/resourceGroups/Microsoft.AzureStackHCI/marketplaceGalleryImages"}}},
+	{"/MICROSOFT.AZURESTACKHCI/NETWORKINTERFACES", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_stack_hci_network_interface", "/subscriptions/resourceGroups/Microsoft.AzureStackHCI/networkInterfaces"}}},
+	{"/MICROSOFT.AZURESTACKHCI/STORAGECONTAINERS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_stack_hci_storage_path", "/subscriptions/resourceGroups/Microsoft.AzureStackHCI/storageContainers"}}},
+	{"/MICROSOFT.AZURESTACKHCI/VIRTUALHARDDISKS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_stack_hci_virtual_hard_disk", "/subscriptions/resourceGroups/Microsoft.AzureStackHCI/virtualHardDisks"}}},
+	{"/MICROSOFT.BATCH/BATCHACCOUNTS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_batch_account", "/subscriptions/resourceGroups/Microsoft.Batch/batchAccounts"}}},
+	{"/MICROSOFT.BATCH/BATCHACCOUNTS/APPLICATIONS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_batch_application", "/subscriptions/resourceGroups/Microsoft.Batch/batchAccounts/applications"}}},
+	{"/MICROSOFT.BATCH/BATCHACCOUNTS/CERTIFICATES", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_batch_certificate", "/subscriptions/resourceGroups/Microsoft.Batch/batchAccounts/certificates"}}},
+	{"/MICROSOFT.BATCH/BATCHACCOUNTS/POOLS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_batch_pool", "/subscriptions/resourceGroups/Microsoft.Batch/batchAccounts/pools"}}},
+	{"/MICROSOFT.BATCH/BATCHACCOUNTS/POOLS/JOBS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_batch_job", "/subscriptions/resourceGroups/Microsoft.Batch/batchAccounts/pools/jobs"}}},
+	{"/MICROSOFT.BLUEPRINT/BLUEPRINTASSIGNMENTS", "/MICROSOFT.MANAGEMENT/MANAGEMENTGROUPS", []ARMId2TFMapItem{{"azurerm_blueprint_assignment", "/Microsoft.Management/managementGroups/Microsoft.Blueprint/blueprintAssignments"}}},
+	{"/MICROSOFT.BLUEPRINT/BLUEPRINTASSIGNMENTS", "/SUBSCRIPTIONS", []ARMId2TFMapItem{{"azurerm_blueprint_assignment", "/subscriptions/Microsoft.Blueprint/blueprintAssignments"}}},
+	{"/MICROSOFT.BOTSERVICE/BOTSERVICES", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_bot_channels_registration", "/subscriptions/resourceGroups/Microsoft.BotService/botServices"}, {"azurerm_bot_service_azure_bot", "/subscriptions/resourceGroups/Microsoft.BotService/botServices"}, {"azurerm_bot_web_app", "/subscriptions/resourceGroups/Microsoft.BotService/botServices"}}},
+	{"/MICROSOFT.BOTSERVICE/BOTSERVICES/CHANNELS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_bot_channel_alexa", "/subscriptions/resourceGroups/Microsoft.BotService/botServices/channels"}, {"azurerm_bot_channel_direct_line_speech", "/subscriptions/resourceGroups/Microsoft.BotService/botServices/channels"}, {"azurerm_bot_channel_directline", "/subscriptions/resourceGroups/Microsoft.BotService/botServices/channels"}, {"azurerm_bot_channel_email", "/subscriptions/resourceGroups/Microsoft.BotService/botServices/channels"}, {"azurerm_bot_channel_facebook", "/subscriptions/resourceGroups/Microsoft.BotService/botServices/channels"}, {"azurerm_bot_channel_line", "/subscriptions/resourceGroups/Microsoft.BotService/botServices/channels"}, {"azurerm_bot_channel_ms_teams", "/subscriptions/resourceGroups/Microsoft.BotService/botServices/channels"}, {"azurerm_bot_channel_slack", "/subscriptions/resourceGroups/Microsoft.BotService/botServices/channels"}, {"azurerm_bot_channel_sms", "/subscriptions/resourceGroups/Microsoft.BotService/botServices/channels"}, {"azurerm_bot_channel_web_chat", "/subscriptions/resourceGroups/Microsoft.BotService/botServices/channels"}}},
+	{"/MICROSOFT.BOTSERVICE/BOTSERVICES/CONNECTIONS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_bot_connection", "/subscriptions/resourceGroups/Microsoft.BotService/botServices/connections"}}},
+	{"/MICROSOFT.CACHE/REDIS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_redis_cache", "/subscriptions/resourceGroups/Microsoft.Cache/redis"}}},
+	{"/MICROSOFT.CACHE/REDIS/ACCESSPOLICIES", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_redis_cache_access_policy", "/subscriptions/resourceGroups/Microsoft.Cache/redis/accessPolicies"}}},
+	{"/MICROSOFT.CACHE/REDIS/ACCESSPOLICYASSIGNMENTS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_redis_cache_access_policy_assignment", "/subscriptions/resourceGroups/Microsoft.Cache/redis/accessPolicyAssignments"}}},
+	{"/MICROSOFT.CACHE/REDIS/FIREWALLRULES", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_redis_firewall_rule", "/subscriptions/resourceGroups/Microsoft.Cache/redis/firewallRules"}}},
+	{"/MICROSOFT.CACHE/REDIS/LINKEDSERVERS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_redis_linked_server", "/subscriptions/resourceGroups/Microsoft.Cache/redis/linkedServers"}}},
+	{"/MICROSOFT.CACHE/REDISENTERPRISE/DATABASES/ACCESSPOLICYASSIGNMENTS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_managed_redis_access_policy_assignment", "/subscriptions/resourceGroups/Microsoft.Cache/redisEnterprise/databases/accessPolicyAssignments"}}},
+	{"/MICROSOFT.CACHE/REDISENTERPRISE/DATABASESE", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_managed_redis", "/subscriptions/resourceGroups/Microsoft.Cache/redisEnterprise"}}},
+	{"/MICROSOFT.CACHE/REDISENTERPRISE/DATABASESE/REPLICATIONS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_managed_redis_geo_replication", "/subscriptions/resourceGroups/Microsoft.Cache/redisEnterprise"}}},
+	{"/MICROSOFT.CDN/PROFILES", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_cdn_frontdoor_profile", "/subscriptions/resourceGroups/Microsoft.Cdn/profiles"}, {"azurerm_cdn_profile", "/subscriptions/resourceGroups/Microsoft.Cdn/profiles"}}},
+	{"/MICROSOFT.CDN/PROFILES/AFDENDPOINTS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_cdn_frontdoor_endpoint", "/subscriptions/resourceGroups/Microsoft.Cdn/profiles/afdEndpoints"}}},
+	{"/MICROSOFT.CDN/PROFILES/AFDENDPOINTS/ROUTES", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_cdn_frontdoor_route", "/subscriptions/resourceGroups/Microsoft.Cdn/profiles/afdEndpoints/routes"}}},
+	{"/MICROSOFT.CDN/PROFILES/ASSOCIATIONS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_cdn_frontdoor_custom_domain_association", "/subscriptions/resourceGroups/Microsoft.Cdn/profiles/associations"}}},
+	{"/MICROSOFT.CDN/PROFILES/CUSTOMDOMAINS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_cdn_frontdoor_custom_domain", "/subscriptions/resourceGroups/Microsoft.Cdn/profiles/customDomains"}}},
+	{"/MICROSOFT.CDN/PROFILES/ENDPOINTS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_cdn_endpoint", "/subscriptions/resourceGroups/Microsoft.Cdn/profiles/endpoints"}}},
+	{"/MICROSOFT.CDN/PROFILES/ENDPOINTS/CUSTOMDOMAINS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_cdn_endpoint_custom_domain", "/subscriptions/resourceGroups/Microsoft.Cdn/profiles/endpoints/customDomains"}}},
+	{"/MICROSOFT.CDN/PROFILES/ORIGINGROUPS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_cdn_frontdoor_origin_group", "/subscriptions/resourceGroups/Microsoft.Cdn/profiles/originGroups"}}},
+	{"/MICROSOFT.CDN/PROFILES/ORIGINGROUPS/ORIGINS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_cdn_frontdoor_origin", "/subscriptions/resourceGroups/Microsoft.Cdn/profiles/originGroups/origins"}}},
+	{"/MICROSOFT.CDN/PROFILES/RULESETS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_cdn_frontdoor_batch_rule_set", "/subscriptions/resourceGroups/Microsoft.Cdn/profiles/ruleSets"}, {"azurerm_cdn_frontdoor_rule_set", "/subscriptions/resourceGroups/Microsoft.Cdn/profiles/ruleSets"}}},
+	{"/MICROSOFT.CDN/PROFILES/RULESETS/RULES", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_cdn_frontdoor_rule", "/subscriptions/resourceGroups/Microsoft.Cdn/profiles/ruleSets/rules"}}},
+	{"/MICROSOFT.CDN/PROFILES/SECRETS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_cdn_frontdoor_secret", "/subscriptions/resourceGroups/Microsoft.Cdn/profiles/secrets"}}},
+	{"/MICROSOFT.CDN/PROFILES/SECURITYPOLICIES", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_cdn_frontdoor_security_policy", "/subscriptions/resourceGroups/Microsoft.Cdn/profiles/securityPolicies"}}},
+	{"/MICROSOFT.CERTIFICATEREGISTRATION/CERTIFICATEORDERS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_app_service_certificate_order", "/subscriptions/resourceGroups/Microsoft.CertificateRegistration/certificateOrders"}}},
+	{"/MICROSOFT.CHAOS/EXPERIMENTS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_chaos_studio_experiment", "/subscriptions/resourceGroups/Microsoft.Chaos/experiments"}}},
+	{"/MICROSOFT.CHAOS/TARGETS", "ANY", []ARMId2TFMapItem{{"azurerm_chaos_studio_target", ""}}},
+	{"/MICROSOFT.CHAOS/TARGETS/CAPABILITIES", "ANY", []ARMId2TFMapItem{{"azurerm_chaos_studio_capability", ""}}},
+	{"/MICROSOFT.CODESIGNING/CODESIGNINGACCOUNTS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_trusted_signing_account", "/subscriptions/resourceGroups/Microsoft.CodeSigning/codeSigningAccounts"}}},
+	{"/MICROSOFT.COGNITIVESERVICES/ACCOUNTS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_cognitive_account", "/subscriptions/resourceGroups/Microsoft.CognitiveServices/accounts"}}},
+	{"/MICROSOFT.COGNITIVESERVICES/ACCOUNTS/CONNECTIONS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_cognitive_account_connection_account_key", "/subscriptions/resourceGroups/Microsoft.CognitiveServices/accounts/connections"}, {"azurerm_cognitive_account_connection_account_managed_identity", "/subscriptions/resourceGroups/Microsoft.CognitiveServices/accounts/connections"}, {"azurerm_cognitive_account_connection_api_key", "/subscriptions/resourceGroups/Microsoft.CognitiveServices/accounts/connections"}, {"azurerm_cognitive_account_connection_custom_keys", "/subscriptions/resourceGroups/Microsoft.CognitiveServices/accounts/connections"}, {"azurerm_cognitive_account_connection_entra_id", "/subscriptions/resourceGroups/Microsoft.CognitiveServices/accounts/connections"}}},
+	{"/MICROSOFT.COGNITIVESERVICES/ACCOUNTS/DEPLOYMENTS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_cognitive_deployment", "/subscriptions/resourceGroups/Microsoft.CognitiveServices/accounts/deployments"}}},
+	{"/MICROSOFT.COGNITIVESERVICES/ACCOUNTS/PROJECTS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_cognitive_account_project", "/subscriptions/resourceGroups/Microsoft.CognitiveServices/accounts/projects"}}},
+	{"/MICROSOFT.COGNITIVESERVICES/ACCOUNTS/RAIBLOCKLISTS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_cognitive_account_rai_blocklist", "/subscriptions/resourceGroups/Microsoft.CognitiveServices/accounts/raiBlocklists"}}},
+	{"/MICROSOFT.COGNITIVESERVICES/ACCOUNTS/RAIPOLICIES", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_cognitive_account_rai_policy", "/subscriptions/resourceGroups/Microsoft.CognitiveServices/accounts/raiPolicies"}}},
+	{"/MICROSOFT.COMMUNICATION/COMMUNICATIONSERVICES", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_communication_service", "/subscriptions/resourceGroups/Microsoft.Communication/communicationServices"}}},
+	{"/MICROSOFT.COMMUNICATION/EMAILSERVICES", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_email_communication_service", "/subscriptions/resourceGroups/Microsoft.Communication/emailServices"}}},
+	{"/MICROSOFT.COMMUNICATION/EMAILSERVICES/DOMAINS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_email_communication_service_domain", "/subscriptions/resourceGroups/Microsoft.Communication/emailServices/domains"}}},
+	{"/MICROSOFT.COMMUNICATION/EMAILSERVICES/DOMAINS/SENDERUSERNAMES", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_email_communication_service_domain_sender_username", "/subscriptions/resourceGroups/Microsoft.Communication/emailServices/domains/senderUsernames"}}},
+	{"/MICROSOFT.COMPUTE/AVAILABILITYSETS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_availability_set", "/subscriptions/resourceGroups/Microsoft.Compute/availabilitySets"}}},
+	{"/MICROSOFT.COMPUTE/CAPACITYRESERVATIONGROUPS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_capacity_reservation_group", "/subscriptions/resourceGroups/Microsoft.Compute/capacityReservationGroups"}}},
+	{"/MICROSOFT.COMPUTE/CAPACITYRESERVATIONGROUPS/CAPACITYRESERVATIONS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_capacity_reservation", "/subscriptions/resourceGroups/Microsoft.Compute/capacityReservationGroups/capacityReservations"}}},
+	{"/MICROSOFT.COMPUTE/DISKACCESSES", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_disk_access", "/subscriptions/resourceGroups/Microsoft.Compute/diskAccesses"}}},
+	{"/MICROSOFT.COMPUTE/DISKENCRYPTIONSETS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_disk_encryption_set", "/subscriptions/resourceGroups/Microsoft.Compute/diskEncryptionSets"}}},
+	{"/MICROSOFT.COMPUTE/DISKS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_managed_disk", "/subscriptions/resourceGroups/Microsoft.Compute/disks"}}},
+	{"/MICROSOFT.COMPUTE/GALLERIES", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_shared_image_gallery", "/subscriptions/resourceGroups/Microsoft.Compute/galleries"}}},
+	{"/MICROSOFT.COMPUTE/GALLERIES/APPLICATIONS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_gallery_application", "/subscriptions/resourceGroups/Microsoft.Compute/galleries/applications"}}},
+	{"/MICROSOFT.COMPUTE/GALLERIES/APPLICATIONS/VERSIONS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_gallery_application_version", "/subscriptions/resourceGroups/Microsoft.Compute/galleries/applications/versions"}}},
+	{"/MICROSOFT.COMPUTE/GALLERIES/IMAGES", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_shared_image", "/subscriptions/resourceGroups/Microsoft.Compute/galleries/images"}}},
+	{"/MICROSOFT.COMPUTE/GALLERIES/IMAGES/VERSIONS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_shared_image_version", "/subscriptions/resourceGroups/Microsoft.Compute/galleries/images/versions"}}},
+	{"/MICROSOFT.COMPUTE/HOSTGROUPS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_dedicated_host_group", "/subscriptions/resourceGroups/Microsoft.Compute/hostGroups"}}},
+	{"/MICROSOFT.COMPUTE/HOSTGROUPS/HOSTS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_dedicated_host", "/subscriptions/resourceGroups/Microsoft.Compute/hostGroups/hosts"}}},
+	{"/MICROSOFT.COMPUTE/IMAGES", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_image", "/subscriptions/resourceGroups/Microsoft.Compute/images"}}},
+	{"/MICROSOFT.COMPUTE/PROXIMITYPLACEMENTGROUPS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_proximity_placement_group", "/subscriptions/resourceGroups/Microsoft.Compute/proximityPlacementGroups"}}},
+	{"/MICROSOFT.COMPUTE/RESTOREPOINTCOLLECTIONS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_virtual_machine_restore_point_collection", "/subscriptions/resourceGroups/Microsoft.Compute/restorePointCollections"}}},
+	{"/MICROSOFT.COMPUTE/RESTOREPOINTCOLLECTIONS/RESTOREPOINTS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_virtual_machine_restore_point", "/subscriptions/resourceGroups/Microsoft.Compute/restorePointCollections/restorePoints"}}},
+	{"/MICROSOFT.COMPUTE/SNAPSHOTS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_snapshot", "/subscriptions/resourceGroups/Microsoft.Compute/snapshots"}}},
+	{"/MICROSOFT.COMPUTE/SSHPUBLICKEYS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_ssh_public_key", "/subscriptions/resourceGroups/Microsoft.Compute/sshPublicKeys"}}},
+	{"/MICROSOFT.COMPUTE/VIRTUALMACHINES", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_linux_virtual_machine", "/subscriptions/resourceGroups/Microsoft.Compute/virtualMachines"}, {"azurerm_virtual_machine", "/subscriptions/resourceGroups/Microsoft.Compute/virtualMachines"}, {"azurerm_windows_virtual_machine", "/subscriptions/resourceGroups/Microsoft.Compute/virtualMachines"}}},
+	{"/MICROSOFT.COMPUTE/VIRTUALMACHINES/DATADISKS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_virtual_machine_data_disk_attachment", "/subscriptions/resourceGroups/Microsoft.Compute/virtualMachines/dataDisks"}, {"azurerm_virtual_machine_implicit_data_disk_from_source", "/subscriptions/resourceGroups/Microsoft.Compute/virtualMachines/dataDisks"}}},
+	{"/MICROSOFT.COMPUTE/VIRTUALMACHINES/EXTENSIONS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_virtual_machine_extension", "/subscriptions/resourceGroups/Microsoft.Compute/virtualMachines/extensions"}}},
+	{"/MICROSOFT.COMPUTE/VIRTUALMACHINES/RUNCOMMANDS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_virtual_machine_run_command", "/subscriptions/resourceGroups/Microsoft.Compute/virtualMachines/runCommands"}}},
+	{"/MICROSOFT.COMPUTE/VIRTUALMACHINESCALESETS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_linux_virtual_machine_scale_set", "/subscriptions/resourceGroups/Microsoft.Compute/virtualMachineScaleSets"}, {"azurerm_orchestrated_virtual_machine_scale_set", "/subscriptions/resourceGroups/Microsoft.Compute/virtualMachineScaleSets"}, {"azurerm_windows_virtual_machine_scale_set", "/subscriptions/resourceGroups/Microsoft.Compute/virtualMachineScaleSets"}}},
+	{"/MICROSOFT.COMPUTE/VIRTUALMACHINESCALESETS/EXTENSIONS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_virtual_machine_scale_set_extension", "/subscriptions/resourceGroups/Microsoft.Compute/virtualMachineScaleSets/extensions"}}},
+	{"/MICROSOFT.CONFIDENTIALLEDGER/LEDGERS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_confidential_ledger", "/subscriptions/resourceGroups/Microsoft.ConfidentialLedger/ledgers"}}},
+	{"/MICROSOFT.CONSUMPTION/BUDGETS", "/MICROSOFT.MANAGEMENT/MANAGEMENTGROUPS", []ARMId2TFMapItem{{"azurerm_consumption_budget_management_group", "/Microsoft.Management/managementGroups/Microsoft.Consumption/budgets"}}},
+	{"/MICROSOFT.CONSUMPTION/BUDGETS", "/SUBSCRIPTIONS", []ARMId2TFMapItem{{"azurerm_consumption_budget_subscription", "/subscriptions/Microsoft.Consumption/budgets"}}},
+	{"/MICROSOFT.CONSUMPTION/BUDGETS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_consumption_budget_resource_group", "/subscriptions/resourceGroups/Microsoft.Consumption/budgets"}}},
+	{"/MICROSOFT.CONTAINERINSTANCE/CONTAINERGROUPS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_container_group", "/subscriptions/resourceGroups/Microsoft.ContainerInstance/containerGroups"}}},
+	{"/MICROSOFT.CONTAINERREGISTRY/REGISTRIES", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_container_registry", "/subscriptions/resourceGroups/Microsoft.ContainerRegistry/registries"}}},
+	{"/MICROSOFT.CONTAINERREGISTRY/REGISTRIES/AGENTPOOLS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_container_registry_agent_pool", "/subscriptions/resourceGroups/Microsoft.ContainerRegistry/registries/agentPools"}}},
+	{"/MICROSOFT.CONTAINERREGISTRY/REGISTRIES/CACHERULES", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_container_registry_cache_rule", "/subscriptions/resourceGroups/Microsoft.ContainerRegistry/registries/cacheRules"}}},
+	{"/MICROSOFT.CONTAINERREGISTRY/REGISTRIES/CONNECTEDREGISTRIES", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_container_connected_registry", "/subscriptions/resourceGroups/Microsoft.ContainerRegistry/registries/connectedRegistries"}}},
+	{"/MICROSOFT.CONTAINERREGISTRY/REGISTRIES/CREDENTIALSETS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_container_registry_credential_set", "/subscriptions/resourceGroups/Microsoft.ContainerRegistry/registries/credentialSets"}}},
+	{"/MICROSOFT.CONTAINERREGISTRY/REGISTRIES/SCOPEMAPS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_container_registry_scope_map", "/subscriptions/resourceGroups/Microsoft.ContainerRegistry/registries/scopeMaps"}}},
+	{"/MICROSOFT.CONTAINERREGISTRY/REGISTRIES/TASKS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_container_registry_task", "/subscriptions/resourceGroups/Microsoft.ContainerRegistry/registries/tasks"}}},
+	{"/MICROSOFT.CONTAINERREGISTRY/REGISTRIES/TOKENS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_container_registry_token", "/subscriptions/resourceGroups/Microsoft.ContainerRegistry/registries/tokens"}}},
+	{"/MICROSOFT.CONTAINERREGISTRY/REGISTRIES/TOKENS/PASSWORDS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_container_registry_token_password", "/subscriptions/resourceGroups/Microsoft.ContainerRegistry/registries/tokens/passwords"}}},
+	{"/MICROSOFT.CONTAINERREGISTRY/REGISTRIES/WEBHOOKS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_container_registry_webhook", "/subscriptions/resourceGroups/Microsoft.ContainerRegistry/registries/webHooks"}}},
+	{"/MICROSOFT.CONTAINERSERVICE/DEPLOYMENTSAFEGUARDS", "/SUBSCRIPTIONS/RESOURCEGROUPS/MICROSOFT.CONTAINERSERVICE/MANAGEDCLUSTERS", []ARMId2TFMapItem{{"azurerm_kubernetes_cluster_deployment_safeguard", "/subscriptions/resourceGroups/Microsoft.ContainerService/managedClusters"}}},
+	{"/MICROSOFT.CONTAINERSERVICE/FLEETS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_kubernetes_fleet_manager", "/subscriptions/resourceGroups/Microsoft.ContainerService/fleets"}}},
+	{"/MICROSOFT.CONTAINERSERVICE/FLEETS/MEMBERS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_kubernetes_fleet_member", "/subscriptions/resourceGroups/Microsoft.ContainerService/fleets/members"}}},
+	{"/MICROSOFT.CONTAINERSERVICE/FLEETS/UPDATERUNS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_kubernetes_fleet_update_run", "/subscriptions/resourceGroups/Microsoft.ContainerService/fleets/updateRuns"}}},
+	{"/MICROSOFT.CONTAINERSERVICE/FLEETS/UPDATESTRATEGIES", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_kubernetes_fleet_update_strategy", "/subscriptions/resourceGroups/Microsoft.ContainerService/fleets/updateStrategies"}}},
+	{"/MICROSOFT.CONTAINERSERVICE/MANAGEDCLUSTERS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_kubernetes_automatic_cluster", "/subscriptions/resourceGroups/Microsoft.ContainerService/managedClusters"}, {"azurerm_kubernetes_cluster", "/subscriptions/resourceGroups/Microsoft.ContainerService/managedClusters"}}},
+	{"/MICROSOFT.CONTAINERSERVICE/MANAGEDCLUSTERS/AGENTPOOLS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_kubernetes_cluster_node_pool", "/subscriptions/resourceGroups/Microsoft.ContainerService/managedClusters/agentPools"}}},
+	{"/MICROSOFT.CONTAINERSERVICE/MANAGEDCLUSTERS/TRUSTEDACCESSROLEBINDINGS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_kubernetes_cluster_trusted_access_role_binding", "/subscriptions/resourceGroups/Microsoft.ContainerService/managedClusters/trustedAccessRoleBindings"}}},
+	{"/MICROSOFT.COSTMANAGEMENT/EXPORTS", "/MICROSOFT.BILLING/BILLINGACCOUNTS", []ARMId2TFMapItem{{"azurerm_billing_account_cost_management_export", "/Microsoft.Billing/billingAccounts/Microsoft.CostManagement/exports"}}},
+	{"/MICROSOFT.COSTMANAGEMENT/EXPORTS", "/SUBSCRIPTIONS", []ARMId2TFMapItem{{"azurerm_subscription_cost_management_export", "/subscriptions/Microsoft.CostManagement/exports"}}},
+	{"/MICROSOFT.COSTMANAGEMENT/EXPORTS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_resource_group_cost_management_export", "/subscriptions/resourceGroups/Microsoft.CostManagement/exports"}}},
+	{"/MICROSOFT.COSTMANAGEMENT/SCHEDULEDACTIONS", "/SUBSCRIPTIONS", []ARMId2TFMapItem{{"azurerm_cost_anomaly_alert", "/subscriptions/Microsoft.CostManagement/scheduledActions"}, {"azurerm_cost_management_scheduled_action", "/subscriptions/Microsoft.CostManagement/scheduledActions"}}},
+	{"/MICROSOFT.COSTMANAGEMENT/VIEWS", "/SUBSCRIPTIONS", []ARMId2TFMapItem{{"azurerm_subscription_cost_management_view", "/subscriptions/Microsoft.CostManagement/views"}}},
+	{"/MICROSOFT.COSTMANAGEMENT/VIEWS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_resource_group_cost_management_view", "/subscriptions/resourceGroups/Microsoft.CostManagement/views"}}},
+	{"/MICROSOFT.CUSTOMPROVIDERS/RESOURCEPROVIDERS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_custom_provider", "/subscriptions/resourceGroups/Microsoft.CustomProviders/resourceProviders"}}},
+	{"/MICROSOFT.DASHBOARD/GRAFANA", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_dashboard_grafana", "/subscriptions/resourceGroups/Microsoft.Dashboard/grafana"}}},
+	{"/MICROSOFT.DASHBOARD/GRAFANA/MANAGEDPRIVATEENDPOINTS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_dashboard_grafana_managed_private_endpoint", "/subscriptions/resourceGroups/Microsoft.Dashboard/grafana/managedPrivateEndpoints"}}},
+	{"/MICROSOFT.DATABOXEDGE/DATABOXEDGEDEVICES", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_databox_edge_device", "/subscriptions/resourceGroups/Microsoft.DataBoxEdge/dataBoxEdgeDevices"}}},
+	{"/MICROSOFT.DATABRICKS/ACCESSCONNECTORS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_databricks_access_connector", "/subscriptions/resourceGroups/Microsoft.Databricks/accessConnectors"}}},
+	{"/MICROSOFT.DATABRICKS/WORKSPACES", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_databricks_workspace", "/subscriptions/resourceGroups/Microsoft.Databricks/workspaces"}}},
+	{"/MICROSOFT.DATABRICKS/WORKSPACES/VIRTUALNETWORKPEERINGS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_databricks_virtual_network_peering", "/subscriptions/resourceGroups/Microsoft.Databricks/workspaces/virtualNetworkPeerings"}}},
+	{"/MICROSOFT.DATADOG/MONITORS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_datadog_monitor", "/subscriptions/resourceGroups/Microsoft.Datadog/monitors"}}},
+	{"/MICROSOFT.DATADOG/MONITORS/SINGLESIGNONCONFIGURATIONS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_datadog_monitor_sso_configuration", "/subscriptions/resourceGroups/Microsoft.Datadog/monitors/singleSignOnConfigurations"}}},
+	{"/MICROSOFT.DATADOG/MONITORS/TAGRULES", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_datadog_monitor_tag_rule", "/subscriptions/resourceGroups/Microsoft.Datadog/monitors/tagRules"}}},
+	{"/MICROSOFT.DATAFACTORY/FACTORIES", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_data_factory", "/subscriptions/resourceGroups/Microsoft.DataFactory/factories"}}},
+	{"/MICROSOFT.DATAFACTORY/FACTORIES/CREDENTIALS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_data_factory_credential_service_principal", "/subscriptions/resourceGroups/Microsoft.DataFactory/factories/credentials"}, {"azurerm_data_factory_credential_user_managed_identity", "/subscriptions/resourceGroups/Microsoft.DataFactory/factories/credentials"}}},
+	{"/MICROSOFT.DATAFACTORY/FACTORIES/DATAFLOWS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_data_factory_data_flow", "/subscriptions/resourceGroups/Microsoft.DataFactory/factories/dataflows"}, {"azurerm_data_factory_flowlet_data_flow", "/subscriptions/resourceGroups/Microsoft.DataFactory/factories/dataflows"}}},
+	{"/MICROSOFT.DATAFACTORY/FACTORIES/DATASETS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_data_factory_custom_dataset", "/subscriptions/resourceGroups/Microsoft.DataFactory/factories/datasets"}, {"azurerm_data_factory_dataset_azure_blob", "/subscriptions/resourceGroups/Microsoft.DataFactory/factories/datasets"}, {"azurerm_data_factory_dataset_azure_sql_table", "/subscriptions/resourceGroups/Microsoft.DataFactory/factories/datasets"}, {"azurerm_data_factory_dataset_binary", "/subscriptions/resourceGroups/Microsoft.DataFactory/factories/datasets"}, {"azurerm_data_factory_dataset_cosmosdb_sqlapi", "/subscriptions/resourceGroups/Microsoft.DataFactory/factories/datasets"}, {"azurerm_data_factory_dataset_delimited_text", "/subscriptions/resourceGroups/Microsoft.DataFactory/factories/datasets"}, {"azurerm_data_factory_dataset_http", "/subscriptions/resourceGroups/Microsoft.DataFactory/factories/datasets"}, {"azurerm_data_factory_dataset_json", "/subscriptions/resourceGroups/Microsoft.DataFactory/factories/datasets"}, {"azurerm_data_factory_dataset_mysql", "/subscriptions/resourceGroups/Microsoft.DataFactory/factories/datasets"}, {"azurerm_data_factory_dataset_parquet", "/subscriptions/resourceGroups/Microsoft.DataFactory/factories/datasets"}, {"azurerm_data_factory_dataset_postgresql", "/subscriptions/resourceGroups/Microsoft.DataFactory/factories/datasets"}, {"azurerm_data_factory_dataset_snowflake", "/subscriptions/resourceGroups/Microsoft.DataFactory/factories/datasets"}, {"azurerm_data_factory_dataset_sql_server_table", "/subscriptions/resourceGroups/Microsoft.DataFactory/factories/datasets"}}},
+	{"/MICROSOFT.DATAFACTORY/FACTORIES/INTEGRATIONRUNTIMES", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_data_factory_integration_runtime_azure", "/subscriptions/resourceGroups/Microsoft.DataFactory/factories/integrationRuntimes"}, {"azurerm_data_factory_integration_runtime_azure_ssis", "/subscriptions/resourceGroups/Microsoft.DataFactory/factories/integrationRuntimes"}, {"azurerm_data_factory_integration_runtime_self_hosted", "/subscriptions/resourceGroups/Microsoft.DataFactory/factories/integrationRuntimes"}}},
+	{"/MICROSOFT.DATAFACTORY/FACTORIES/LINKEDSERVICES", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_data_factory_linked_custom_service", "/subscriptions/resourceGroups/Microsoft.DataFactory/factories/linkedservices"}, {"azurerm_data_factory_linked_service_azure_blob_storage", "/subscriptions/resourceGroups/Microsoft.DataFactory/factories/linkedservices"}, {"azurerm_data_factory_linked_service_azure_databricks", "/subscriptions/resourceGroups/Microsoft.DataFactory/factories/linkedservices"}, {"azurerm_data_factory_linked_service_azure_file_storage", "/subscriptions/resourceGroups/Microsoft.DataFactory/factories/linkedservices"}, {"azurerm_data_factory_linked_service_azure_function", "/subscriptions/resourceGroups/Microsoft.DataFactory/factories/linkedservices"}, {"azurerm_data_factory_linked_service_azure_search", "/subscriptions/resourceGroups/Microsoft.DataFactory/factories/linkedservices"}, {"azurerm_data_factory_linked_service_azure_sql_database", "/subscriptions/resourceGroups/Microsoft.DataFactory/factories/linkedservices"}, {"azurerm_data_factory_linked_service_azure_table_storage", "/subscriptions/resourceGroups/Microsoft.DataFactory/factories/linkedservices"}, {"azurerm_data_factory_linked_service_cosmosdb", "/subscriptions/resourceGroups/Microsoft.DataFactory/factories/linkedservices"}, {"azurerm_data_factory_linked_service_cosmosdb_mongoapi", "/subscriptions/resourceGroups/Microsoft.DataFactory/factories/linkedservices"}, {"azurerm_data_factory_linked_service_data_lake_storage_gen2", "/subscriptions/resourceGroups/Microsoft.DataFactory/factories/linkedservices"}, {"azurerm_data_factory_linked_service_key_vault", "/subscriptions/resourceGroups/Microsoft.DataFactory/factories/linkedservices"}, {"azurerm_data_factory_linked_service_kusto", "/subscriptions/resourceGroups/Microsoft.DataFactory/factories/linkedservices"}, {"azurerm_data_factory_linked_service_mysql", "/subscriptions/resourceGroups/Microsoft.DataFactory/factories/linkedservices"}, {"azurerm_data_factory_linked_service_odata", "/subscriptions/resourceGroups/Microsoft.DataFactory/factories/linkedservices"}, {"azurerm_data_factory_linked_service_odbc", "/subscriptions/resourceGroups/Microsoft.DataFactory/factories/linkedservices"}, {"azurerm_data_factory_linked_service_postgresql", "/subscriptions/resourceGroups/Microsoft.DataFactory/factories/linkedservices"}, {"azurerm_data_factory_linked_service_sftp", "/subscriptions/resourceGroups/Microsoft.DataFactory/factories/linkedservices"}, {"azurerm_data_factory_linked_service_snowflake", "/subscriptions/resourceGroups/Microsoft.DataFactory/factories/linkedservices"}, {"azurerm_data_factory_linked_service_sql_managed_instance", "/subscriptions/resourceGroups/Microsoft.DataFactory/factories/linkedservices"}, {"azurerm_data_factory_linked_service_sql_server", "/subscriptions/resourceGroups/Microsoft.DataFactory/factories/linkedservices"}, {"azurerm_data_factory_linked_service_synapse", "/subscriptions/resourceGroups/Microsoft.DataFactory/factories/linkedservices"}, {"azurerm_data_factory_linked_service_web", "/subscriptions/resourceGroups/Microsoft.DataFactory/factories/linkedservices"}}},
+	{"/MICROSOFT.DATAFACTORY/FACTORIES/MANAGEDVIRTUALNETWORKS/MANAGEDPRIVATEENDPOINTS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_data_factory_managed_private_endpoint", "/subscriptions/resourceGroups/Microsoft.DataFactory/factories/managedVirtualNetworks/managedPrivateEndpoints"}}},
+	{"/MICROSOFT.DATAFACTORY/FACTORIES/PIPELINES", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_data_factory_pipeline", "/subscriptions/resourceGroups/Microsoft.DataFactory/factories/pipelines"}}},
+	{"/MICROSOFT.DATAFACTORY/FACTORIES/TRIGGERS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_data_factory_trigger_blob_event", "/subscriptions/resourceGroups/Microsoft.DataFactory/factories/triggers"}, {"azurerm_data_factory_trigger_custom_event", "/subscriptions/resourceGroups/Microsoft.DataFactory/factories/triggers"}, {"azurerm_data_factory_trigger_schedule", "/subscriptions/resourceGroups/Microsoft.DataFactory/factories/triggers"}, {"azurerm_data_factory_trigger_tumbling_window", "/subscriptions/resourceGroups/Microsoft.DataFactory/factories/triggers"}}},
+	{"/MICROSOFT.DATAMIGRATION/SERVICES", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_database_migration_service", "/subscriptions/resourceGroups/Microsoft.DataMigration/services"}}},
+	{"/MICROSOFT.DATAMIGRATION/SERVICES/PROJECTS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_database_migration_project", "/subscriptions/resourceGroups/Microsoft.DataMigration/services/projects"}}},
+	{"/MICROSOFT.DATAPROTECTION/BACKUPVAULTS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_data_protection_backup_vault", "/subscriptions/resourceGroups/Microsoft.DataProtection/backupVaults"}}},
+	{"/MICROSOFT.DATAPROTECTION/BACKUPVAULTS/BACKUPINSTANCES", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_data_protection_backup_instance_blob_storage", "/subscriptions/resourceGroups/Microsoft.DataProtection/backupVaults/backupInstances"}, {"azurerm_data_protection_backup_instance_data_lake_storage", "/subscriptions/resourceGroups/Microsoft.DataProtection/backupVaults/backupInstances"}, {"azurerm_data_protection_backup_instance_disk", "/subscriptions/resourceGroups/Microsoft.DataProtection/backupVaults/backupInstances"}, {"azurerm_data_protection_backup_instance_kubernetes_cluster", "/subscriptions/resourceGroups/Microsoft.DataProtection/backupVaults/backupInstances"}, {"azurerm_data_protection_backup_instance_mysql_flexible_server", "/subscriptions/resourceGroups/Microsoft.DataProtection/backupVaults/backupInstances"}, {"azurerm_data_protection_backup_instance_postgresql", "/subscriptions/resourceGroups/Microsoft.DataProtection/backupVaults/backupInstances"}, {"azurerm_data_protection_backup_instance_postgresql_flexible_server", "/subscriptions/resourceGroups/Microsoft.DataProtection/backupVaults/backupInstances"}}},
+	{"/MICROSOFT.DATAPROTECTION/BACKUPVAULTS/BACKUPPOLICIES", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_data_protection_backup_policy_blob_storage", "/subscriptions/resourceGroups/Microsoft.DataProtection/backupVaults/backupPolicies"}, {"azurerm_data_protection_backup_policy_data_lake_storage", "/subscriptions/resourceGroups/Microsoft.DataProtection/backupVaults/backupPolicies"}, {"azurerm_data_protection_backup_policy_disk", "/subscriptions/resourceGroups/Microsoft.DataProtection/backupVaults/backupPolicies"}, {"azurerm_data_protection_backup_policy_kubernetes_cluster", "/subscriptions/resourceGroups/Microsoft.DataProtection/backupVaults/backupPolicies"}, {"azurerm_data_protection_backup_policy_mysql_flexible_server", "/subscriptions/resourceGroups/Microsoft.DataProtection/backupVaults/backupPolicies"}, {"azurerm_data_protection_backup_policy_postgresql", "/subscriptions/resourceGroups/Microsoft.DataProtection/backupVaults/backupPolicies"}, {"azurerm_data_protection_backup_policy_postgresql_flexible_server", "/subscriptions/resourceGroups/Microsoft.DataProtection/backupVaults/backupPolicies"}}},
+	{"/MICROSOFT.DATAPROTECTION/RESOURCEGUARDS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_data_protection_resource_guard", "/subscriptions/resourceGroups/Microsoft.DataProtection/resourceGuards"}}},
+	{"/MICROSOFT.DATASHARE/ACCOUNTS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_data_share_account", "/subscriptions/resourceGroups/Microsoft.DataShare/accounts"}}},
+	{"/MICROSOFT.DATASHARE/ACCOUNTS/SHARES", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_data_share", "/subscriptions/resourceGroups/Microsoft.DataShare/accounts/shares"}}},
+	{"/MICROSOFT.DATASHARE/ACCOUNTS/SHARES/DATASETS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_data_share_dataset_blob_storage", "/subscriptions/resourceGroups/Microsoft.DataShare/accounts/shares/dataSets"}, {"azurerm_data_share_dataset_data_lake_gen2", "/subscriptions/resourceGroups/Microsoft.DataShare/accounts/shares/dataSets"}, {"azurerm_data_share_dataset_kusto_cluster", "/subscriptions/resourceGroups/Microsoft.DataShare/accounts/shares/dataSets"}, {"azurerm_data_share_dataset_kusto_database", "/subscriptions/resourceGroups/Microsoft.DataShare/accounts/shares/dataSets"}}},
+	{"/MICROSOFT.DBFORMYSQL/FLEXIBLESERVERS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_mysql_flexible_server", "/subscriptions/resourceGroups/Microsoft.DBforMySQL/flexibleServers"}}},
+	{"/MICROSOFT.DBFORMYSQL/FLEXIBLESERVERS/ADMINISTRATORS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_mysql_flexible_server_active_directory_administrator", "/subscriptions/resourceGroups/Microsoft.DBforMySQL/flexibleServers/administrators"}}},
+	{"/MICROSOFT.DBFORMYSQL/FLEXIBLESERVERS/CONFIGURATIONS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_mysql_flexible_server_configuration", "/subscriptions/resourceGroups/Microsoft.DBforMySQL/flexibleServers/configurations"}}},
+	{"/MICROSOFT.DBFORMYSQL/FLEXIBLESERVERS/DATABASES", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_mysql_flexible_database", "/subscriptions/resourceGroups/Microsoft.DBforMySQL/flexibleServers/databases"}}},
+	{"/MICROSOFT.DBFORMYSQL/FLEXIBLESERVERS/FIREWALLRULES", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_mysql_flexible_server_firewall_rule", "/subscriptions/resourceGroups/Microsoft.DBforMySQL/flexibleServers/firewallRules"}}},
+	{"/MICROSOFT.DBFORPOSTGRESQL/FLEXIBLESERVERS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_postgresql_flexible_server", "/subscriptions/resourceGroups/Microsoft.DBforPostgreSQL/flexibleServers"}}},
+	{"/MICROSOFT.DBFORPOSTGRESQL/FLEXIBLESERVERS/ADMINISTRATORS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_postgresql_flexible_server_active_directory_administrator", "/subscriptions/resourceGroups/Microsoft.DBforPostgreSQL/flexibleServers/administrators"}}},
+	{"/MICROSOFT.DBFORPOSTGRESQL/FLEXIBLESERVERS/BACKUPS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_postgresql_flexible_server_backup", "/subscriptions/resourceGroups/Microsoft.DBforPostgreSQL/flexibleServers/backups"}}},
+	{"/MICROSOFT.DBFORPOSTGRESQL/FLEXIBLESERVERS/CONFIGURATIONS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_postgresql_flexible_server_configuration", "/subscriptions/resourceGroups/Microsoft.DBforPostgreSQL/flexibleServers/configurations"}}},
+	{"/MICROSOFT.DBFORPOSTGRESQL/FLEXIBLESERVERS/DATABASES", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_postgresql_flexible_server_database", "/subscriptions/resourceGroups/Microsoft.DBforPostgreSQL/flexibleServers/databases"}}},
+	{"/MICROSOFT.DBFORPOSTGRESQL/FLEXIBLESERVERS/FIREWALLRULES", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_postgresql_flexible_server_firewall_rule", "/subscriptions/resourceGroups/Microsoft.DBforPostgreSQL/flexibleServers/firewallRules"}}},
+	{"/MICROSOFT.DBFORPOSTGRESQL/FLEXIBLESERVERS/VIRTUALENDPOINTS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_postgresql_flexible_server_virtual_endpoint", "/subscriptions/resourceGroups/Microsoft.DBforPostgreSQL/flexibleServers/virtualEndpoints"}}},
+	{"/MICROSOFT.DBFORPOSTGRESQL/SERVERGROUPSV2", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_cosmosdb_postgresql_cluster", "/subscriptions/resourceGroups/Microsoft.DBforPostgreSQL/serverGroupsv2"}}},
+	{"/MICROSOFT.DBFORPOSTGRESQL/SERVERGROUPSV2/COORDINATORCONFIGURATIONS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_cosmosdb_postgresql_coordinator_configuration", "/subscriptions/resourceGroups/Microsoft.DBforPostgreSQL/serverGroupsv2/coordinatorConfigurations"}}},
+	{"/MICROSOFT.DBFORPOSTGRESQL/SERVERGROUPSV2/FIREWALLRULES", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_cosmosdb_postgresql_firewall_rule", "/subscriptions/resourceGroups/Microsoft.DBforPostgreSQL/serverGroupsv2/firewallRules"}}},
+	{"/MICROSOFT.DBFORPOSTGRESQL/SERVERGROUPSV2/NODECONFIGURATIONS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_cosmosdb_postgresql_node_configuration", "/subscriptions/resourceGroups/Microsoft.DBforPostgreSQL/serverGroupsv2/nodeConfigurations"}}},
+	{"/MICROSOFT.DBFORPOSTGRESQL/SERVERGROUPSV2/ROLES", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_cosmosdb_postgresql_role", "/subscriptions/resourceGroups/Microsoft.DBforPostgreSQL/serverGroupsv2/roles"}}},
+	{"/MICROSOFT.DBFORPOSTGRESQL/SERVERS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_postgresql_server", "/subscriptions/resourceGroups/Microsoft.DBforPostgreSQL/servers"}}},
+	{"/MICROSOFT.DBFORPOSTGRESQL/SERVERS/ADMINISTRATORS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_postgresql_active_directory_administrator", "/subscriptions/resourceGroups/Microsoft.DBforPostgreSQL/servers"}}},
+	{"/MICROSOFT.DBFORPOSTGRESQL/SERVERS/CONFIGURATIONS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_postgresql_configuration", "/subscriptions/resourceGroups/Microsoft.DBforPostgreSQL/servers/configurations"}}},
+	{"/MICROSOFT.DBFORPOSTGRESQL/SERVERS/DATABASES", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_postgresql_database", "/subscriptions/resourceGroups/Microsoft.DBforPostgreSQL/servers/databases"}}},
+	{"/MICROSOFT.DBFORPOSTGRESQL/SERVERS/FIREWALLRULES", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_postgresql_firewall_rule", "/subscriptions/resourceGroups/Microsoft.DBforPostgreSQL/servers/firewallRules"}}},
+	{"/MICROSOFT.DBFORPOSTGRESQL/SERVERS/KEYS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_postgresql_server_key", "/subscriptions/resourceGroups/Microsoft.DBforPostgreSQL/servers/keys"}}},
+	{"/MICROSOFT.DBFORPOSTGRESQL/SERVERS/VIRTUALNETWORKRULES", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_postgresql_virtual_network_rule", "/subscriptions/resourceGroups/Microsoft.DBforPostgreSQL/servers/virtualNetworkRules"}}},
+	{"/MICROSOFT.DESKTOPVIRTUALIZATION/APPLICATIONGROUPS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_virtual_desktop_application_group", "/subscriptions/resourceGroups/Microsoft.DesktopVirtualization/applicationGroups"}}},
+	{"/MICROSOFT.DESKTOPVIRTUALIZATION/APPLICATIONGROUPS/APPLICATIONS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_virtual_desktop_application", "/subscriptions/resourceGroups/Microsoft.DesktopVirtualization/applicationGroups/applications"}}},
+	{"/MICROSOFT.DESKTOPVIRTUALIZATION/HOSTPOOLS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_virtual_desktop_host_pool", "/subscriptions/resourceGroups/Microsoft.DesktopVirtualization/hostPools"}}},
+	{"/MICROSOFT.DESKTOPVIRTUALIZATION/HOSTPOOLS/REGISTRATIONINFO", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_virtual_desktop_host_pool_registration_info", "/subscriptions/resourceGroups/Microsoft.DesktopVirtualization/hostPools/registrationInfo"}}},
+	{"/MICROSOFT.DESKTOPVIRTUALIZATION/SCALINGPLANS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_virtual_desktop_scaling_plan", "/subscriptions/resourceGroups/Microsoft.DesktopVirtualization/scalingPlans"}}},
+	{"/MICROSOFT.DESKTOPVIRTUALIZATION/WORKSPACES", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_virtual_desktop_workspace", "/subscriptions/resourceGroups/Microsoft.DesktopVirtualization/workspaces"}}},
+	{"/MICROSOFT.DESKTOPVIRTUALIZATION/WORKSPACES/APPLICATIONGROUPS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_virtual_desktop_workspace_application_group_association", ""}}},
+	{"/MICROSOFT.DEVCENTER/DEVCENTERS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_dev_center", "/subscriptions/resourceGroups/Microsoft.DevCenter/devCenters"}}},
+	{"/MICROSOFT.DEVCENTER/DEVCENTERS/ATTACHEDNETWORKS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_dev_center_attached_network", "/subscriptions/resourceGroups/Microsoft.DevCenter/devCenters/attachedNetworks"}}},
+	{"/MICROSOFT.DEVCENTER/DEVCENTERS/CATALOGS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_dev_center_catalog", "/subscriptions/resourceGroups/Microsoft.DevCenter/devCenters/catalogs"}}},
+	{"/MICROSOFT.DEVCENTER/DEVCENTERS/DEVBOXDEFINITIONS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_dev_center_dev_box_definition", "/subscriptions/resourceGroups/Microsoft.DevCenter/devCenters/devBoxDefinitions"}}},
+	{"/MICROSOFT.DEVCENTER/DEVCENTERS/ENVIRONMENTTYPES", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_dev_center_environment_type", "/subscriptions/resourceGroups/Microsoft.DevCenter/devCenters/environmentTypes"}}},
+	{"/MICROSOFT.DEVCENTER/DEVCENTERS/GALLERIES", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_dev_center_gallery", "/subscriptions/resourceGroups/Microsoft.DevCenter/devCenters/galleries"}}},
+	{"/MICROSOFT.DEVCENTER/NETWORKCONNECTIONS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_dev_center_network_connection", "/subscriptions/resourceGroups/Microsoft.DevCenter/networkConnections"}}},
+	{"/MICROSOFT.DEVCENTER/PROJECTS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_dev_center_project", "/subscriptions/resourceGroups/Microsoft.DevCenter/projects"}}},
+	{"/MICROSOFT.DEVCENTER/PROJECTS/ENVIRONMENTTYPES", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_dev_center_project_environment_type", "/subscriptions/resourceGroups/Microsoft.DevCenter/projects/environmentTypes"}}},
+	{"/MICROSOFT.DEVCENTER/PROJECTS/POOLS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_dev_center_project_pool", "/subscriptions/resourceGroups/Microsoft.DevCenter/projects/pools"}}},
+	{"/MICROSOFT.DEVICES/IOTHUBS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_iothub", "/subscriptions/resourceGroups/Microsoft.Devices/iotHubs"}}},
+	{"/MICROSOFT.DEVICES/IOTHUBS/CERTIFICATES", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_iothub_certificate", "/subscriptions/resourceGroups/Microsoft.Devices/iotHubs/certificates"}}},
+	{"/MICROSOFT.DEVICES/IOTHUBS/ENDPOINTSCOSMOSDBACCOUNT", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_iothub_endpoint_cosmosdb_account", "/subscriptions/resourceGroups/Microsoft.Devices/iotHubs/endpoints"}}},
+	{"/MICROSOFT.DEVICES/IOTHUBS/ENDPOINTSEVENTHUB", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_iothub_endpoint_eventhub", "/subscriptions/resourceGroups/Microsoft.Devices/iotHubs/endpoints"}}},
+	{"/MICROSOFT.DEVICES/IOTHUBS/ENDPOINTSSERVICEBUSQUEUE", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_iothub_endpoint_servicebus_queue", "/subscriptions/resourceGroups/Microsoft.Devices/iotHubs/endpoints"}}},
+	{"/MICROSOFT.DEVICES/IOTHUBS/ENDPOINTSSERVICEBUSTOPIC", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_iothub_endpoint_servicebus_topic", "/subscriptions/resourceGroups/Microsoft.Devices/iotHubs/endpoints"}}},
+	{"/MICROSOFT.DEVICES/IOTHUBS/ENDPOINTSSTORAGECONTAINER", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_iothub_endpoint_storage_container", "/subscriptions/resourceGroups/Microsoft.Devices/iotHubs/endpoints"}}},
+	{"/MICROSOFT.DEVICES/IOTHUBS/ENRICHMENTS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_iothub_enrichment", "/subscriptions/resourceGroups/Microsoft.Devices/iotHubs/enrichments"}}},
+	{"/MICROSOFT.DEVICES/IOTHUBS/EVENTHUBENDPOINTS/CONSUMERGROUPS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_iothub_consumer_group", "/subscriptions/resourceGroups/Microsoft.Devices/iotHubs/eventHubEndpoints/consumerGroups"}}},
+	{"/MICROSOFT.DEVICES/IOTHUBS/FALLBACKROUTE", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_iothub_fallback_route", "/subscriptions/resourceGroups/Microsoft.Devices/iotHubs/fallbackRoute"}}},
+	{"/MICROSOFT.DEVICES/IOTHUBS/IOTHUBKEYS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_iothub_shared_access_policy", "/subscriptions/resourceGroups/Microsoft.Devices/iotHubs/iotHubKeys"}}},
+	{"/MICROSOFT.DEVICES/IOTHUBS/ROUTES", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_iothub_route", "/subscriptions/resourceGroups/Microsoft.Devices/iotHubs/routes"}}},
+	{"/MICROSOFT.DEVICES/PROVISIONINGSERVICES", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_iothub_dps", "/subscriptions/resourceGroups/Microsoft.Devices/provisioningServices"}}},
+	{"/MICROSOFT.DEVICES/PROVISIONINGSERVICES/CERTIFICATES", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_iothub_dps_certificate", "/subscriptions/resourceGroups/Microsoft.Devices/provisioningServices/certificates"}}},
+	{"/MICROSOFT.DEVICES/PROVISIONINGSERVICES/KEYS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_iothub_dps_shared_access_policy", "/subscriptions/resourceGroups/Microsoft.Devices/provisioningServices/keys"}}},
+	{"/MICROSOFT.DEVICEUPDATE/ACCOUNTS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_iothub_device_update_account", "/subscriptions/resourceGroups/Microsoft.DeviceUpdate/accounts"}}},
+	{"/MICROSOFT.DEVICEUPDATE/ACCOUNTS/INSTANCES", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_iothub_device_update_instance", "/subscriptions/resourceGroups/Microsoft.DeviceUpdate/accounts/instances"}}},
+	{"/MICROSOFT.DEVOPSINFRASTRUCTURE/POOLS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_managed_devops_pool", "/subscriptions/resourceGroups/Microsoft.DevOpsInfrastructure/pools"}}},
+	{"/MICROSOFT.DEVTESTLAB/LABS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_dev_test_lab", "/subscriptions/resourceGroups/Microsoft.DevTestLab/labs"}}},
+	{"/MICROSOFT.DEVTESTLAB/LABS/POLICYSETS/POLICIES", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_dev_test_policy", "/subscriptions/resourceGroups/Microsoft.DevTestLab/labs/policySets/policies"}}},
+	{"/MICROSOFT.DEVTESTLAB/LABS/SCHEDULES", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_dev_test_schedule", "/subscriptions/resourceGroups/Microsoft.DevTestLab/labs/schedules"}}},
+	{"/MICROSOFT.DEVTESTLAB/LABS/VIRTUALMACHINES", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_dev_test_linux_virtual_machine", "/subscriptions/resourceGroups/Microsoft.DevTestLab/labs/virtualMachines"}, {"azurerm_dev_test_windows_virtual_machine", "/subscriptions/resourceGroups/Microsoft.DevTestLab/labs/virtualMachines"}}},
+	{"/MICROSOFT.DEVTESTLAB/LABS/VIRTUALNETWORKS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_dev_test_virtual_network", "/subscriptions/resourceGroups/Microsoft.DevTestLab/labs/virtualNetworks"}}},
+	{"/MICROSOFT.DEVTESTLAB/SCHEDULES", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_dev_test_global_vm_shutdown_schedule", "/subscriptions/resourceGroups/Microsoft.DevTestLab/schedules"}}},
+	{"/MICROSOFT.DIGITALTWINS/DIGITALTWINSINSTANCES", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_digital_twins_instance", "/subscriptions/resourceGroups/Microsoft.DigitalTwins/digitalTwinsInstances"}}},
+	{"/MICROSOFT.DIGITALTWINS/DIGITALTWINSINSTANCES/ENDPOINTS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_digital_twins_endpoint_eventgrid", "/subscriptions/resourceGroups/Microsoft.DigitalTwins/digitalTwinsInstances/endpoints"}, {"azurerm_digital_twins_endpoint_eventhub", "/subscriptions/resourceGroups/Microsoft.DigitalTwins/digitalTwinsInstances/endpoints"}, {"azurerm_digital_twins_endpoint_servicebus", "/subscriptions/resourceGroups/Microsoft.DigitalTwins/digitalTwinsInstances/endpoints"}}},
+	{"/MICROSOFT.DIGITALTWINS/DIGITALTWINSINSTANCES/TIMESERIESDATABASECONNECTIONS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_digital_twins_time_series_database_connection", "/subscriptions/resourceGroups/Microsoft.DigitalTwins/digitalTwinsInstances/timeSeriesDatabaseConnections"}}},
+	{"/MICROSOFT.DOCUMENTDB/CASSANDRACLUSTERS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_cosmosdb_cassandra_cluster", "/subscriptions/resourceGroups/Microsoft.DocumentDB/cassandraClusters"}}},
+	{"/MICROSOFT.DOCUMENTDB/CASSANDRACLUSTERS/DATACENTERS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_cosmosdb_cassandra_datacenter", "/subscriptions/resourceGroups/Microsoft.DocumentDB/cassandraClusters/dataCenters"}}},
+	{"/MICROSOFT.DOCUMENTDB/DATABASEACCOUNTS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_cosmosdb_account", "/subscriptions/resourceGroups/Microsoft.DocumentDB/databaseAccounts"}}},
+	{"/MICROSOFT.DOCUMENTDB/DATABASEACCOUNTS/CASSANDRAKEYSPACES", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_cosmosdb_cassandra_keyspace", "/subscriptions/resourceGroups/Microsoft.DocumentDB/databaseAccounts/cassandraKeyspaces"}}},
+	{"/MICROSOFT.DOCUMENTDB/DATABASEACCOUNTS/CASSANDRAKEYSPACES/TABLES", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_cosmosdb_cassandra_table", "/subscriptions/resourceGroups/Microsoft.DocumentDB/databaseAccounts/cassandraKeyspaces/tables"}}},
+	{"/MICROSOFT.DOCUMENTDB/DATABASEACCOUNTS/GREMLINDATABASES", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_cosmosdb_gremlin_database", "/subscriptions/resourceGroups/Microsoft.DocumentDB/databaseAccounts/gremlinDatabases"}}},
+	{"/MICROSOFT.DOCUMENTDB/DATABASEACCOUNTS/GREMLINDATABASES/GRAPHS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_cosmosdb_gremlin_graph", "/subscriptions/resourceGroups/Microsoft.DocumentDB/databaseAccounts/gremlinDatabases/graphs"}}},
+	{"/MICROSOFT.DOCUMENTDB/DATABASEACCOUNTS/MONGODBDATABASES", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_cosmosdb_mongo_database", "/subscriptions/resourceGroups/Microsoft.DocumentDB/databaseAccounts/mongodbDatabases"}}},
+	{"/MICROSOFT.DOCUMENTDB/DATABASEACCOUNTS/MONGODBDATABASES/COLLECTIONS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_cosmosdb_mongo_collection", "/subscriptions/resourceGroups/Microsoft.DocumentDB/databaseAccounts/mongodbDatabases/collections"}}},
+	{"/MICROSOFT.DOCUMENTDB/DATABASEACCOUNTS/MONGODBROLEDEFINITIONS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_cosmosdb_mongo_role_definition", "/subscriptions/resourceGroups/Microsoft.DocumentDB/databaseAccounts/mongodbRoleDefinitions"}}},
+	{"/MICROSOFT.DOCUMENTDB/DATABASEACCOUNTS/MONGODBUSERDEFINITIONS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_cosmosdb_mongo_user_definition", "/subscriptions/resourceGroups/Microsoft.DocumentDB/databaseAccounts/mongodbUserDefinitions"}}},
+	{"/MICROSOFT.DOCUMENTDB/DATABASEACCOUNTS/SERVICES", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_cosmosdb_sql_dedicated_gateway", "/subscriptions/resourceGroups/Microsoft.DocumentDB/databaseAccounts/services"}}},
+	{"/MICROSOFT.DOCUMENTDB/DATABASEACCOUNTS/SQLDATABASES", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_cosmosdb_sql_database", "/subscriptions/resourceGroups/Microsoft.DocumentDB/databaseAccounts/sqlDatabases"}}},
+	{"/MICROSOFT.DOCUMENTDB/DATABASEACCOUNTS/SQLDATABASES/CONTAINERS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_cosmosdb_sql_container", "/subscriptions/resourceGroups/Microsoft.DocumentDB/databaseAccounts/sqlDatabases/containers"}}},
+	{"/MICROSOFT.DOCUMENTDB/DATABASEACCOUNTS/SQLDATABASES/CONTAINERS/STOREDPROCEDURES", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_cosmosdb_sql_stored_procedure", "/subscriptions/resourceGroups/Microsoft.DocumentDB/databaseAccounts/sqlDatabases/containers/storedProcedures"}}},
+	{"/MICROSOFT.DOCUMENTDB/DATABASEACCOUNTS/SQLDATABASES/CONTAINERS/TRIGGERS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_cosmosdb_sql_trigger", "/subscriptions/resourceGroups/Microsoft.DocumentDB/databaseAccounts/sqlDatabases/containers/triggers"}}},
+	{"/MICROSOFT.DOCUMENTDB/DATABASEACCOUNTS/SQLDATABASES/CONTAINERS/USERDEFINEDFUNCTIONS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_cosmosdb_sql_function", "/subscriptions/resourceGroups/Microsoft.DocumentDB/databaseAccounts/sqlDatabases/containers/userDefinedFunctions"}}},
+	{"/MICROSOFT.DOCUMENTDB/DATABASEACCOUNTS/SQLROLEASSIGNMENTS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_cosmosdb_sql_role_assignment", "/subscriptions/resourceGroups/Microsoft.DocumentDB/databaseAccounts/sqlRoleAssignments"}}},
+	{"/MICROSOFT.DOCUMENTDB/DATABASEACCOUNTS/SQLROLEDEFINITIONS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_cosmosdb_sql_role_definition", "/subscriptions/resourceGroups/Microsoft.DocumentDB/databaseAccounts/sqlRoleDefinitions"}}},
+	{"/MICROSOFT.DOCUMENTDB/DATABASEACCOUNTS/TABLES", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_cosmosdb_table", "/subscriptions/resourceGroups/Microsoft.DocumentDB/databaseAccounts/tables"}}},
+	{"/MICROSOFT.DOCUMENTDB/MONGOCLUSTERS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_mongo_cluster", "/subscriptions/resourceGroups/Microsoft.DocumentDB/mongoClusters"}}},
+	{"/MICROSOFT.DOCUMENTDB/MONGOCLUSTERS/FIREWALLRULES", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_mongo_cluster_firewall_rule", "/subscriptions/resourceGroups/Microsoft.DocumentDB/mongoClusters/firewallRules"}}},
+	{"/MICROSOFT.DOCUMENTDB/MONGOCLUSTERS/USERS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_mongo_cluster_user", "/subscriptions/resourceGroups/Microsoft.DocumentDB/mongoClusters/users"}}},
+	{"/MICROSOFT.ELASTIC/MONITORS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_elastic_cloud_elasticsearch", "/subscriptions/resourceGroups/Microsoft.Elastic/monitors"}}},
+	{"/MICROSOFT.ELASTICSAN/ELASTICSANS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_elastic_san", "/subscriptions/resourceGroups/Microsoft.ElasticSan/elasticSans"}}},
+	{"/MICROSOFT.ELASTICSAN/ELASTICSANS/VOLUMEGROUPS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_elastic_san_volume_group", "/subscriptions/resourceGroups/Microsoft.ElasticSan/elasticSans/volumeGroups"}}},
+	{"/MICROSOFT.ELASTICSAN/ELASTICSANS/VOLUMEGROUPS/VOLUMES", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_elastic_san_volume", "/subscriptions/resourceGroups/Microsoft.ElasticSan/elasticSans/volumeGroups/volumes"}}},
+	{"/MICROSOFT.EVENTGRID/DOMAINS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_eventgrid_domain", "/subscriptions/resourceGroups/Microsoft.EventGrid/domains"}}},
+	{"/MICROSOFT.EVENTGRID/DOMAINS/TOPICS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_eventgrid_domain_topic", "/subscriptions/resourceGroups/Microsoft.EventGrid/domains/topics"}}},
+	{"/MICROSOFT.EVENTGRID/EVENTSUBSCRIPTIONS", "ANY", []ARMId2TFMapItem{{"azurerm_eventgrid_event_subscription", ""}}},
+	{"/MICROSOFT.EVENTGRID/NAMESPACES", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_eventgrid_namespace", "/subscriptions/resourceGroups/Microsoft.EventGrid/namespaces"}}},
+	{"/MICROSOFT.EVENTGRID/NAMESPACES/TOPICS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_eventgrid_namespace_topic", "/subscriptions/resourceGroups/Microsoft.EventGrid/namespaces/topics"}}},
+	{"/MICROSOFT.EVENTGRID/PARTNERCONFIGURATIONS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_eventgrid_partner_configuration", "/subscriptions/resourceGroups"}}},
+	{"/MICROSOFT.EVENTGRID/PARTNERNAMESPACES", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_eventgrid_partner_namespace", "/subscriptions/resourceGroups/Microsoft.EventGrid/partnerNamespaces"}}},
+	{"/MICROSOFT.EVENTGRID/PARTNERREGISTRATIONS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_eventgrid_partner_registration", "/subscriptions/resourceGroups/Microsoft.EventGrid/partnerRegistrations"}}},
+	{"/MICROSOFT.EVENTGRID/SYSTEMTOPICS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_eventgrid_system_topic", "/subscriptions/resourceGroups/Microsoft.EventGrid/systemTopics"}}},
+	{"/MICROSOFT.EVENTGRID/SYSTEMTOPICS/EVENTSUBSCRIPTIONS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_eventgrid_system_topic_event_subscription", "/subscriptions/resourceGroups/Microsoft.EventGrid/systemTopics/eventSubscriptions"}}},
+	{"/MICROSOFT.EVENTGRID/TOPICS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_eventgrid_topic", "/subscriptions/resourceGroups/Microsoft.EventGrid/topics"}}},
+	{"/MICROSOFT.EVENTHUB/CLUSTERS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_eventhub_cluster", "/subscriptions/resourceGroups/Microsoft.EventHub/clusters"}}},
+	{"/MICROSOFT.EVENTHUB/NAMESPACES", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_eventhub_namespace", "/subscriptions/resourceGroups/Microsoft.EventHub/namespaces"}}},
+	{"/MICROSOFT.EVENTHUB/NAMESPACES/AUTHORIZATIONRULES", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_eventhub_namespace_authorization_rule", "/subscriptions/resourceGroups/Microsoft.EventHub/namespaces/authorizationRules"}}},
+	{"/MICROSOFT.EVENTHUB/NAMESPACES/DISASTERRECOVERYCONFIGS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_eventhub_namespace_disaster_recovery_config", "/subscriptions/resourceGroups/Microsoft.EventHub/namespaces/disasterRecoveryConfigs"}}},
+	{"/MICROSOFT.EVENTHUB/NAMESPACES/EVENTHUBS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_eventhub", "/subscriptions/resourceGroups/Microsoft.EventHub/namespaces/eventhubs"}}},
+	{"/MICROSOFT.EVENTHUB/NAMESPACES/EVENTHUBS/AUTHORIZATIONRULES", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_eventhub_authorization_rule", "/subscriptions/resourceGroups/Microsoft.EventHub/namespaces/eventhubs/authorizationRules"}}},
+	{"/MICROSOFT.EVENTHUB/NAMESPACES/EVENTHUBS/CONSUMERGROUPS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_eventhub_consumer_group", "/subscriptions/resourceGroups/Microsoft.EventHub/namespaces/eventhubs/consumerGroups"}}},
+	{"/MICROSOFT.EVENTHUB/NAMESPACES/SCHEMAGROUPS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_eventhub_namespace_schema_group", "/subscriptions/resourceGroups/Microsoft.EventHub/namespaces/schemaGroups"}}},
+	{"/MICROSOFT.EXTENDEDLOCATION/CUSTOMLOCATIONS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_extended_location_custom_location", "/subscriptions/resourceGroups/Microsoft.ExtendedLocation/customLocations"}}},
+	{"/MICROSOFT.FABRIC/CAPACITIES", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_fabric_capacity", "/subscriptions/resourceGroups/Microsoft.Fabric/capacities"}}},
+	{"/MICROSOFT.FLUIDRELAY/FLUIDRELAYSERVERS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_fluid_relay_server", "/subscriptions/resourceGroups/Microsoft.FluidRelay/fluidRelayServers"}}},
+	{"/MICROSOFT.GRAPHSERVICES/ACCOUNTS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_graph_services_account", "/subscriptions/resourceGroups/Microsoft.GraphServices/accounts"}}},
+	{"/MICROSOFT.GUESTCONFIGURATION/GUESTCONFIGURATIONASSIGNMENTS", "/SUBSCRIPTIONS/RESOURCEGROUPS/MICROSOFT.COMPUTE/VIRTUALMACHINES", []ARMId2TFMapItem{{"azurerm_policy_virtual_machine_configuration_assignment", "/subscriptions/resourceGroups/Microsoft.Compute/virtualMachines/Microsoft.GuestConfiguration/guestConfigurationAssignments"}}},
+	{"/MICROSOFT.HARDWARESECURITYMODULES/DEDICATEDHSMS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_dedicated_hardware_security_module", "/subscriptions/resourceGroups/Microsoft.HardwareSecurityModules/dedicatedHSMs"}}},
+	{"/MICROSOFT.HDINSIGHT/CLUSTERS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_hdinsight_hadoop_cluster", "/subscriptions/resourceGroups/Microsoft.HDInsight/clusters"}, {"azurerm_hdinsight_hbase_cluster", "/subscriptions/resourceGroups/Microsoft.HDInsight/clusters"}, {"azurerm_hdinsight_interactive_query_cluster", "/subscriptions/resourceGroups/Microsoft.HDInsight/clusters"}, {"azurerm_hdinsight_kafka_cluster", "/subscriptions/resourceGroups/Microsoft.HDInsight/clusters"}, {"azurerm_hdinsight_spark_cluster", "/subscriptions/resourceGroups/Microsoft.HDInsight/clusters"}}},
+	{"/MICROSOFT.HEALTHBOT/HEALTHBOTS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_healthbot", "/subscriptions/resourceGroups/Microsoft.HealthBot/healthBots"}}},
+	{"/MICROSOFT.HEALTHCAREAPIS/SERVICES", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_healthcare_service", "/subscriptions/resourceGroups/Microsoft.HealthcareApis/services"}}},
+	{"/MICROSOFT.HEALTHCAREAPIS/WORKSPACES", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_healthcare_workspace", "/subscriptions/resourceGroups/Microsoft.HealthcareApis/workspaces"}}},
+	{"/MICROSOFT.HEALTHCAREAPIS/WORKSPACES/DICOMSERVICES", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_healthcare_dicom_service", "/subscriptions/resourceGroups/Microsoft.HealthcareApis/workspaces/dicomServices"}}},
+	{"/MICROSOFT.HEALTHCAREAPIS/WORKSPACES/FHIRSERVICES", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_healthcare_fhir_service", "/subscriptions/resourceGroups/Microsoft.HealthcareApis/workspaces/fhirServices"}}},
+	{"/MICROSOFT.HEALTHCAREAPIS/WORKSPACES/IOTCONNECTORS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_healthcare_medtech_service", "/subscriptions/resourceGroups/Microsoft.HealthcareApis/workspaces/iotConnectors"}}},
+	{"/MICROSOFT.HEALTHCAREAPIS/WORKSPACES/IOTCONNECTORS/FHIRDESTINATIONS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_healthcare_medtech_service_fhir_destination", "/subscriptions/resourceGroups/Microsoft.HealthcareApis/workspaces/iotConnectors/fhirDestinations"}}},
+	{"/MICROSOFT.HYBRIDCOMPUTE/MACHINES", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_arc_machine", "/subscriptions/resourceGroups/Microsoft.HybridCompute/machines"}}},
+	{"/MICROSOFT.HYBRIDCOMPUTE/MACHINES/EXTENSIONS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_arc_machine_extension", "/subscriptions/resourceGroups/Microsoft.HybridCompute/machines/extensions"}}},
+	{"/MICROSOFT.HYBRIDCOMPUTE/PRIVATELINKSCOPES", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_arc_private_link_scope", "/subscriptions/resourceGroups/Microsoft.HybridCompute/privateLinkScopes"}}},
+	{"/MICROSOFT.INSIGHTS/ACTIONGROUPS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_monitor_action_group", "/subscriptions/resourceGroups/Microsoft.Insights/actionGroups"}}},
+	{"/MICROSOFT.INSIGHTS/ACTIVITYLOGALERTS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_monitor_activity_log_alert", "/subscriptions/resourceGroups/Microsoft.Insights/activityLogAlerts"}}},
+	{"/MICROSOFT.INSIGHTS/AUTOSCALESETTINGS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_monitor_autoscale_setting", "/subscriptions/resourceGroups/Microsoft.Insights/autoScaleSettings"}}},
+	{"/MICROSOFT.INSIGHTS/COMPONENTS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_application_insights", "/subscriptions/resourceGroups/Microsoft.Insights/components"}}},
+	{"/MICROSOFT.INSIGHTS/COMPONENTS/ANALYTICSITEMS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_application_insights_analytics_item", "/subscriptions/resourceGroups/Microsoft.Insights/components/analyticsItems"}}},
+	{"/MICROSOFT.INSIGHTS/COMPONENTS/APIKEYS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_application_insights_api_key", "/subscriptions/resourceGroups/Microsoft.Insights/components/apiKeys"}}},
+	{"/MICROSOFT.INSIGHTS/COMPONENTS/PROACTIVEDETECTIONCONFIGS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_application_insights_smart_detection_rule", "/subscriptions/resourceGroups/Microsoft.Insights/components/proactiveDetectionConfigs"}}},
+	{"/MICROSOFT.INSIGHTS/DATACOLLECTIONENDPOINTS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_monitor_data_collection_endpoint", "/subscriptions/resourceGroups/Microsoft.Insights/dataCollectionEndpoints"}}},
+	{"/MICROSOFT.INSIGHTS/DATACOLLECTIONRULEASSOCIATIONS", "ANY", []ARMId2TFMapItem{{"azurerm_monitor_data_collection_rule_association", ""}}},
+	{"/MICROSOFT.INSIGHTS/DATACOLLECTIONRULES", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_monitor_data_collection_rule", "/subscriptions/resourceGroups/Microsoft.Insights/dataCollectionRules"}}},
+	{"/MICROSOFT.INSIGHTS/DIAGNOSTICSETTINGS", "ANY", []ARMId2TFMapItem{{"azurerm_monitor_diagnostic_setting", ""}}},
+	{"/MICROSOFT.INSIGHTS/METRICALERTS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_monitor_metric_alert", "/subscriptions/resourceGroups/Microsoft.Insights/metricAlerts"}}},
+	{"/MICROSOFT.INSIGHTS/PRIVATELINKSCOPES", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_monitor_private_link_scope", "/subscriptions/resourceGroups/Microsoft.Insights/privateLinkScopes"}}},
+	{"/MICROSOFT.INSIGHTS/PRIVATELINKSCOPES/SCOPEDRESOURCES", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_monitor_private_link_scoped_service", "/subscriptions/resourceGroups/Microsoft.Insights/privateLinkScopes/scopedResources"}}},
+	{"/MICROSOFT.INSIGHTS/SCHEDULEDQUERYRULES", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_monitor_scheduled_query_rules_alert_v2", "/subscriptions/resourceGroups/Microsoft.Insights/scheduledQueryRules"}}},
+	{"/MICROSOFT.INSIGHTS/WEBTESTS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_application_insights_standard_web_test", "/subscriptions/resourceGroups/Microsoft.Insights/webTests"}, {"azurerm_application_insights_web_test", "/subscriptions/resourceGroups/Microsoft.Insights/webTests"}}},
+	{"/MICROSOFT.INSIGHTS/WORKBOOKS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_application_insights_workbook", "/subscriptions/resourceGroups/Microsoft.Insights/workbooks"}}},
+	{"/MICROSOFT.INSIGHTS/WORKBOOKTEMPLATES", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_application_insights_workbook_template", "/subscriptions/resourceGroups/Microsoft.Insights/workbookTemplates"}}},
+	{"/MICROSOFT.IOTCENTRAL/IOTAPPS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_iotcentral_application", "/subscriptions/resourceGroups/Microsoft.IoTCentral/iotApps"}}},
+	{"/MICROSOFT.IOTCENTRAL/IOTAPPS/NETWORKRULESETS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_iotcentral_application_network_rule_set", "/subscriptions/resourceGroups/Microsoft.IoTCentral/iotApps"}}},
+	{"/MICROSOFT.IOTCENTRAL/IOTAPPS/ORGANIZATIONS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_iotcentral_organization", "/subscriptions/resourceGroups/Microsoft.IoTCentral/iotApps/organizations"}}},
+	{"/MICROSOFT.KEYVAULT/MANAGEDHSMS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_key_vault_managed_hardware_security_module", "/subscriptions/resourceGroups/Microsoft.KeyVault/managedHSMs"}}},
+	{"/MICROSOFT.KEYVAULT/VAULTS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_key_vault", "/subscriptions/resourceGroups/Microsoft.KeyVault/vaults"}}},
+	{"/MICROSOFT.KEYVAULT/VAULTS/CERTIFICATES", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_key_vault_certificate", ""}}},
+	{"/MICROSOFT.KEYVAULT/VAULTS/CERTIFICATES/CONTACTS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_key_vault_certificate_contacts", ""}}},
+	{"/MICROSOFT.KEYVAULT/VAULTS/CERTIFICATES/ISSUERS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_key_vault_certificate_issuer", ""}}},
+	{"/MICROSOFT.KEYVAULT/VAULTS/KEYS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_key_vault_key", ""}}},
+	{"/MICROSOFT.KEYVAULT/VAULTS/OBJECTID", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_key_vault_access_policy", "/subscriptions/resourceGroups/Microsoft.KeyVault/vaults/objectId"}}},
+	{"/MICROSOFT.KEYVAULT/VAULTS/SECRETS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_key_vault_secret", ""}}},
+	{"/MICROSOFT.KEYVAULT/VAULTS/STORAGE", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_key_vault_managed_storage_account", ""}}},
+	{"/MICROSOFT.KEYVAULT/VAULTS/STORAGE/SAS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_key_vault_managed_storage_account_sas_token_definition", ""}}},
+	{"/MICROSOFT.KUBERNETES/CONNECTEDCLUSTERS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_arc_kubernetes_cluster", "/subscriptions/resourceGroups/Microsoft.Kubernetes/connectedClusters"}, {"azurerm_arc_kubernetes_provisioned_cluster", "/subscriptions/resourceGroups/Microsoft.Kubernetes/connectedClusters"}}},
+	{"/MICROSOFT.KUBERNETESCONFIGURATION/EXTENSIONS", "/SUBSCRIPTIONS/RESOURCEGROUPS/MICROSOFT.CONTAINERSERVICE/MANAGEDCLUSTERS", []ARMId2TFMapItem{{"azurerm_kubernetes_cluster_extension", "/subscriptions/resourceGroups/Microsoft.ContainerService/managedClusters/Microsoft.KubernetesConfiguration/extensions"}}},
+	{"/MICROSOFT.KUBERNETESCONFIGURATION/EXTENSIONS", "/SUBSCRIPTIONS/RESOURCEGROUPS/MICROSOFT.KUBERNETES/CONNECTEDCLUSTERS", []ARMId2TFMapItem{{"azurerm_arc_kubernetes_cluster_extension", "/subscriptions/resourceGroups/Microsoft.Kubernetes/connectedClusters/Microsoft.KubernetesConfiguration/extensions"}}},
+	{"/MICROSOFT.KUBERNETESCONFIGURATION/FLUXCONFIGURATIONS", "/SUBSCRIPTIONS/RESOURCEGROUPS/MICROSOFT.CONTAINERSERVICE/MANAGEDCLUSTERS", []ARMId2TFMapItem{{"azurerm_kubernetes_flux_configuration", "/subscriptions/resourceGroups/Microsoft.ContainerService/managedClusters/Microsoft.KubernetesConfiguration/fluxConfigurations"}}},
+	{"/MICROSOFT.KUBERNETESCONFIGURATION/FLUXCONFIGURATIONS", "/SUBSCRIPTIONS/RESOURCEGROUPS/MICROSOFT.KUBERNETES/CONNECTEDCLUSTERS", []ARMId2TFMapItem{{"azurerm_arc_kubernetes_flux_configuration", "/subscriptions/resourceGroups/Microsoft.Kubernetes/connectedClusters/Microsoft.KubernetesConfiguration/fluxConfigurations"}}},
+	{"/MICROSOFT.KUSTO/CLUSTERS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_kusto_cluster", "/subscriptions/resourceGroups/Microsoft.Kusto/clusters"}}},
+	{"/MICROSOFT.KUSTO/CLUSTERS/ATTACHEDDATABASECONFIGURATIONS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_kusto_attached_database_configuration", "/subscriptions/resourceGroups/Microsoft.Kusto/clusters/attachedDatabaseConfigurations"}}},
+	{"/MICROSOFT.KUSTO/CLUSTERS/DATABASES", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_kusto_database", "/subscriptions/resourceGroups/Microsoft.Kusto/clusters/databases"}}},
+	{"/MICROSOFT.KUSTO/CLUSTERS/DATABASES/DATACONNECTIONS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_kusto_cosmosdb_data_connection", "/subscriptions/resourceGroups/Microsoft.Kusto/clusters/databases/dataConnections"}, {"azurerm_kusto_eventgrid_data_connection", "/subscriptions/resourceGroups/Microsoft.Kusto/clusters/databases/dataConnections"}, {"azurerm_kusto_eventhub_data_connection", "/subscriptions/resourceGroups/Microsoft.Kusto/clusters/databases/dataConnections"}, {"azurerm_kusto_iothub_data_connection", "/subscriptions/resourceGroups/Microsoft.Kusto/clusters/databases/dataConnections"}}},
+	{"/MICROSOFT.KUSTO/CLUSTERS/DATABASES/PRINCIPALASSIGNMENTS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_kusto_database_principal_assignment", "/subscriptions/resourceGroups/Microsoft.Kusto/clusters/databases/principalAssignments"}}},
+	{"/MICROSOFT.KUSTO/CLUSTERS/DATABASES/SCRIPTS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_kusto_script", "/subscriptions/resourceGroups/Microsoft.Kusto/clusters/databases/scripts"}}},
+	{"/MICROSOFT.KUSTO/CLUSTERS/MANAGEDPRIVATEENDPOINTS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_kusto_cluster_managed_private_endpoint", "/subscriptions/resourceGroups/Microsoft.Kusto/clusters/managedPrivateEndpoints"}}},
+	{"/MICROSOFT.KUSTO/CLUSTERS/PRINCIPALASSIGNMENTS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_kusto_cluster_principal_assignment", "/subscriptions/resourceGroups/Microsoft.Kusto/clusters/principalAssignments"}}},
+	{"/MICROSOFT.LOADTESTSERVICE/LOADTESTS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_load_test", "/subscriptions/resourceGroups/Microsoft.LoadTestService/loadTests"}}},
+	{"/MICROSOFT.LOGIC/INTEGRATIONACCOUNTS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_logic_app_integration_account", "/subscriptions/resourceGroups/Microsoft.Logic/integrationAccounts"}}},
+	{"/MICROSOFT.LOGIC/INTEGRATIONACCOUNTS/AGREEMENTS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_logic_app_integration_account_agreement", "/subscriptions/resourceGroups/Microsoft.Logic/integrationAccounts/agreements"}}},
+	{"/MICROSOFT.LOGIC/INTEGRATIONACCOUNTS/ASSEMBLIES", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_logic_app_integration_account_assembly", "/subscriptions/resourceGroups/Microsoft.Logic/integrationAccounts/assemblies"}}},
+	{"/MICROSOFT.LOGIC/INTEGRATIONACCOUNTS/BATCHCONFIGURATIONS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_logic_app_integration_account_batch_configuration", "/subscriptions/resourceGroups/Microsoft.Logic/integrationAccounts/batchConfigurations"}}},
+	{"/MICROSOFT.LOGIC/INTEGRATIONACCOUNTS/CERTIFICATES", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_logic_app_integration_account_certificate", "/subscriptions/resourceGroups/Microsoft.Logic/integrationAccounts/certificates"}}},
+	{"/MICROSOFT.LOGIC/INTEGRATIONACCOUNTS/MAPS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_logic_app_integration_account_map", "/subscriptions/resourceGroups/Microsoft.Logic/integrationAccounts/maps"}}},
+	{"/MICROSOFT.LOGIC/INTEGRATIONACCOUNTS/PARTNERS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_logic_app_integration_account_partner", "/subscriptions/resourceGroups/Microsoft.Logic/integrationAccounts/partners"}}},
+	{"/MICROSOFT.LOGIC/INTEGRATIONACCOUNTS/SCHEMAS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_logic_app_integration_account_schema", "/subscriptions/resourceGroups/Microsoft.Logic/integrationAccounts/schemas"}}},
+	{"/MICROSOFT.LOGIC/INTEGRATIONACCOUNTS/SESSIONS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_logic_app_integration_account_session", "/subscriptions/resourceGroups/Microsoft.Logic/integrationAccounts/sessions"}}},
+	{"/MICROSOFT.LOGIC/WORKFLOWS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_logic_app_workflow", "/subscriptions/resourceGroups/Microsoft.Logic/workflows"}}},
+	{"/MICROSOFT.LOGIC/WORKFLOWS/ACTIONS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_logic_app_action_custom", "/subscriptions/resourceGroups/Microsoft.Logic/workflows/actions"}, {"azurerm_logic_app_action_http", "/subscriptions/resourceGroups/Microsoft.Logic/workflows/actions"}}},
+	{"/MICROSOFT.LOGIC/WORKFLOWS/TRIGGERS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_logic_app_trigger_custom", "/subscriptions/resourceGroups/Microsoft.Logic/workflows/triggers"}, {"azurerm_logic_app_trigger_http_request", "/subscriptions/resourceGroups/Microsoft.Logic/workflows/triggers"}, {"azurerm_logic_app_trigger_recurrence", "/subscriptions/resourceGroups/Microsoft.Logic/workflows/triggers"}}},
+	{"/MICROSOFT.MACHINELEARNINGSERVICES/WORKSPACES", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_ai_foundry", "/subscriptions/resourceGroups/Microsoft.MachineLearningServices/workspaces"}, {"azurerm_ai_foundry_project", "/subscriptions/resourceGroups/Microsoft.MachineLearningServices/workspaces"}, {"azurerm_machine_learning_workspace", "/subscriptions/resourceGroups/Microsoft.MachineLearningServices/workspaces"}}},
+	{"/MICROSOFT.MACHINELEARNINGSERVICES/WORKSPACES/COMPUTES", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_machine_learning_compute_cluster", "/subscriptions/resourceGroups/Microsoft.MachineLearningServices/workspaces/computes"}, {"azurerm_machine_learning_compute_instance", "/subscriptions/resourceGroups/Microsoft.MachineLearningServices/workspaces/computes"}, {"azurerm_machine_learning_inference_cluster", "/subscriptions/resourceGroups/Microsoft.MachineLearningServices/workspaces/computes"}, {"azurerm_machine_learning_synapse_spark", "/subscriptions/resourceGroups/Microsoft.MachineLearningServices/workspaces/computes"}}},
+	{"/MICROSOFT.MACHINELEARNINGSERVICES/WORKSPACES/DATASTORES", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_machine_learning_datastore_blobstorage", "/subscriptions/resourceGroups/Microsoft.MachineLearningServices/workspaces/dataStores"}, {"azurerm_machine_learning_datastore_datalake_gen2", "/subscriptions/resourceGroups/Microsoft.MachineLearningServices/workspaces/dataStores"}, {"azurerm_machine_learning_datastore_fileshare", "/subscriptions/resourceGroups/Microsoft.MachineLearningServices/workspaces/dataStores"}}},
+	{"/MICROSOFT.MACHINELEARNINGSERVICES/WORKSPACES/OUTBOUNDRULES", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_machine_learning_workspace_network_outbound_rule_fqdn", "/subscriptions/resourceGroups/Microsoft.MachineLearningServices/workspaces/outboundRules"}, {"azurerm_machine_learning_workspace_network_outbound_rule_private_endpoint", "/subscriptions/resourceGroups/Microsoft.MachineLearningServices/workspaces/outboundRules"}, {"azurerm_machine_learning_workspace_network_outbound_rule_service_tag", "/subscriptions/resourceGroups/Microsoft.MachineLearningServices/workspaces/outboundRules"}}},
+	{"/MICROSOFT.MAINTENANCE/CONFIGURATIONASSIGNMENTS", "/SUBSCRIPTIONS", []ARMId2TFMapItem{{"azurerm_maintenance_assignment_dynamic_scope", "/subscriptions/Microsoft.Maintenance/configurationAssignments"}}},
+	{"/MICROSOFT.MAINTENANCE/CONFIGURATIONASSIGNMENTS", "/SUBSCRIPTIONS/RESOURCEGROUPS/MICROSOFT.COMPUTE/HOSTGROUPS/HOSTS", []ARMId2TFMapItem{{"azurerm_maintenance_assignment_dedicated_host", "/subscriptions/resourceGroups/Microsoft.Compute/hostGroups/hosts/Microsoft.Maintenance/configurationAssignments"}}},
+	{"/MICROSOFT.MAINTENANCE/CONFIGURATIONASSIGNMENTS", "/SUBSCRIPTIONS/RESOURCEGROUPS/MICROSOFT.COMPUTE/VIRTUALMACHINES", []ARMId2TFMapItem{{"azurerm_maintenance_assignment_virtual_machine", "/subscriptions/resourceGroups/Microsoft.Compute/virtualMachines/Microsoft.Maintenance/configurationAssignments"}}},
+	{"/MICROSOFT.MAINTENANCE/CONFIGURATIONASSIGNMENTS", "/SUBSCRIPTIONS/RESOURCEGROUPS/MICROSOFT.COMPUTE/VIRTUALMACHINESCALESETS", []ARMId2TFMapItem{{"azurerm_maintenance_assignment_virtual_machine_scale_set", "/subscriptions/resourceGroups/Microsoft.Compute/virtualMachineScaleSets/Microsoft.Maintenance/configurationAssignments"}}},
+	{"/MICROSOFT.MAINTENANCE/MAINTENANCECONFIGURATIONS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_maintenance_configuration", "/subscriptions/resourceGroups/Microsoft.Maintenance/maintenanceConfigurations"}}},
+	{"/MICROSOFT.MANAGEDIDENTITY/USERASSIGNEDIDENTITIES", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_user_assigned_identity", "/subscriptions/resourceGroups/Microsoft.ManagedIdentity/userAssignedIdentities"}}},
+	{"/MICROSOFT.MANAGEDIDENTITY/USERASSIGNEDIDENTITIES/FEDERATEDIDENTITYCREDENTIALS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_federated_identity_credential", "/subscriptions/resourceGroups/Microsoft.ManagedIdentity/userAssignedIdentities/federatedIdentityCredentials"}}},
+	{"/MICROSOFT.MANAGEDSERVICES/REGISTRATIONASSIGNMENTS", "/SUBSCRIPTIONS", []ARMId2TFMapItem{{"azurerm_lighthouse_assignment", "/subscriptions/Microsoft.ManagedServices/registrationAssignments"}}},
+	{"/MICROSOFT.MANAGEDSERVICES/REGISTRATIONASSIGNMENTS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_lighthouse_assignment", "/subscriptions/resourceGroups/Microsoft.ManagedServices/registrationAssignments"}}},
+	{"/MICROSOFT.MANAGEDSERVICES/REGISTRATIONDEFINITIONS", "/SUBSCRIPTIONS", []ARMId2TFMapItem{{"azurerm_lighthouse_definition", "/subscriptions/Microsoft.ManagedServices/registrationDefinitions"}}},
+	{"/MICROSOFT.MANAGEMENT/MANAGEMENTGROUPS", "", []ARMId2TFMapItem{{"azurerm_management_group", ""}}},
+	{"/MICROSOFT.MAPS/ACCOUNTS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_maps_account", "/subscriptions/resourceGroups/Microsoft.Maps/accounts"}}},
+	{"/MICROSOFT.MAPS/ACCOUNTS/CREATORS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_maps_creator", "/subscriptions/resourceGroups/Microsoft.Maps/accounts/creators"}}},
+	{"/MICROSOFT.MARKETPLACEORDERING/AGREEMENTS/OFFERS/PLANS", "/SUBSCRIPTIONS", []ARMId2TFMapItem{{"azurerm_marketplace_agreement", "/subscriptions/Microsoft.MarketplaceOrdering/agreements/offers/plans"}}},
+	{"/MICROSOFT.MONITOR/ACCOUNTS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_monitor_workspace", "/subscriptions/resourceGroups/Microsoft.Monitor/accounts"}}},
+	{"/MICROSOFT.NETAPP/NETAPPACCOUNTS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_netapp_account", "/subscriptions/resourceGroups/Microsoft.NetApp/netAppAccounts"}}},
+	{"/MICROSOFT.NETAPP/NETAPPACCOUNTS/BACKUPPOLICIES", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_netapp_backup_policy", "/subscriptions/resourceGroups/Microsoft.NetApp/netAppAccounts/backupPolicies"}}},
+	{"/MICROSOFT.NETAPP/NETAPPACCOUNTS/BACKUPVAULTS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_netapp_backup_vault", "/subscriptions/resourceGroups/Microsoft.NetApp/netAppAccounts/backupVaults"}}},
+	{"/MICROSOFT.NETAPP/NETAPPACCOUNTS/CAPACITYPOOLS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_netapp_pool", "/subscriptions/resourceGroups/Microsoft.NetApp/netAppAccounts/capacityPools"}}},
+	{"/MICROSOFT.NETAPP/NETAPPACCOUNTS/CAPACITYPOOLS/VOLUMES", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_netapp_volume", "/subscriptions/resourceGroups/Microsoft.NetApp/netAppAccounts/capacityPools/volumes"}}},
+	{"/MICROSOFT.NETAPP/NETAPPACCOUNTS/CAPACITYPOOLS/VOLUMES/BUCKETS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_netapp_volume_bucket", "/subscriptions/resourceGroups/Microsoft.NetApp/netAppAccounts/capacityPools/volumes/buckets"}, {"azurerm_netapp_volume_bucket_with_server", "/subscriptions/resourceGroups/Microsoft.NetApp/netAppAccounts/capacityPools/volumes/buckets"}}},
+	{"/MICROSOFT.NETAPP/NETAPPACCOUNTS/CAPACITYPOOLS/VOLUMES/SNAPSHOTS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_netapp_snapshot", "/subscriptions/resourceGroups/Microsoft.NetApp/netAppAccounts/capacityPools/volumes/snapshots"}}},
+	{"/MICROSOFT.NETAPP/NETAPPACCOUNTS/CAPACITYPOOLS/VOLUMES/VOLUMEQUOTARULES", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_netapp_volume_quota_rule", "/subscriptions/resourceGroups/Microsoft.NetApp/netAppAccounts/capacityPools/volumes/volumeQuotaRules"}}},
+	{"/MICROSOFT.NETAPP/NETAPPACCOUNTS/ENCRYPTIONS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_netapp_account_encryption", "/subscriptions/resourceGroups/Microsoft.NetApp/netAppAccounts"}}},
+	{"/MICROSOFT.NETAPP/NETAPPACCOUNTS/SNAPSHOTPOLICIES", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_netapp_snapshot_policy", "/subscriptions/resourceGroups/Microsoft.NetApp/netAppAccounts/snapshotPolicies"}}},
+	{"/MICROSOFT.NETAPP/NETAPPACCOUNTS/VOLUMEGROUPS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_netapp_volume_group_oracle", "/subscriptions/resourceGroups/Microsoft.NetApp/netAppAccounts/volumeGroups"}, {"azurerm_netapp_volume_group_sap_hana", "/subscriptions/resourceGroups/Microsoft.NetApp/netAppAccounts/volumeGroups"}}},
+	{"/MICROSOFT.NETWORK/APPLICATIONGATEWAYS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_application_gateway", "/subscriptions/resourceGroups/Microsoft.Network/applicationGateways"}}},
+	{"/MICROSOFT.NETWORK/APPLICATIONGATEWAYS/BACKENDADDRESSPOOLS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"fake_azurerm_application_gateway_backend_address_pool", "/subscriptions/resourceGroups/Microsoft.Network/applicationGateways/backendAddressPools"}}},
+	{"/MICROSOFT.NETWORK/APPLICATIONGATEWAYWEBAPPLICATIONFIREWALLPOLICIES", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_web_application_firewall_policy", "/subscriptions/resourceGroups/Microsoft.Network/applicationGatewayWebApplicationFirewallPolicies"}}},
+	{"/MICROSOFT.NETWORK/APPLICATIONSECURITYGROUPS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_application_security_group", "/subscriptions/resourceGroups/Microsoft.Network/applicationSecurityGroups"}}},
+	{"/MICROSOFT.NETWORK/AZUREFIREWALLS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_firewall", "/subscriptions/resourceGroups/Microsoft.Network/azureFirewalls"}}},
+	{"/MICROSOFT.NETWORK/AZUREFIREWALLS/APPLICATIONRULECOLLECTIONS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_firewall_application_rule_collection", "/subscriptions/resourceGroups/Microsoft.Network/azureFirewalls/applicationRuleCollections"}}},
+	{"/MICROSOFT.NETWORK/AZUREFIREWALLS/NATRULECOLLECTIONS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_firewall_nat_rule_collection", "/subscriptions/resourceGroups/Microsoft.Network/azureFirewalls/natRuleCollections"}}},
+	{"/MICROSOFT.NETWORK/AZUREFIREWALLS/NETWORKRULECOLLECTIONS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_firewall_network_rule_collection", "/subscriptions/resourceGroups/Microsoft.Network/azureFirewalls/networkRuleCollections"}}},
+	{"/MICROSOFT.NETWORK/BASTIONHOSTS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_bastion_host", "/subscriptions/resourceGroups/Microsoft.Network/bastionHosts"}}},
+	{"/MICROSOFT.NETWORK/CONNECTIONS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_virtual_network_gateway_connection", "/subscriptions/resourceGroups/Microsoft.Network/connections"}}},
+	{"/MICROSOFT.NETWORK/CUSTOMIPPREFIXES", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_custom_ip_prefix", "/subscriptions/resourceGroups/Microsoft.Network/customIPPrefixes"}}},
+	{"/MICROSOFT.NETWORK/DDOSPROTECTIONPLANS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_network_ddos_protection_plan", "/subscriptions/resourceGroups/Microsoft.Network/ddosProtectionPlans"}}},
+	{"/MICROSOFT.NETWORK/DNSFORWARDINGRULESETS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_private_dns_resolver_dns_forwarding_ruleset", "/subscriptions/resourceGroups/Microsoft.Network/dnsForwardingRulesets"}}},
+	{"/MICROSOFT.NETWORK/DNSFORWARDINGRULESETS/FORWARDINGRULES", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_private_dns_resolver_forwarding_rule", "/subscriptions/resourceGroups/Microsoft.Network/dnsForwardingRulesets/forwardingRules"}}},
+	{"/MICROSOFT.NETWORK/DNSFORWARDINGRULESETS/VIRTUALNETWORKLINKS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_private_dns_resolver_virtual_network_link", "/subscriptions/resourceGroups/Microsoft.Network/dnsForwardingRulesets/virtualNetworkLinks"}}},
+	{"/MICROSOFT.NETWORK/DNSRESOLVERS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_private_dns_resolver", "/subscriptions/resourceGroups/Microsoft.Network/dnsResolvers"}}},
+	{"/MICROSOFT.NETWORK/DNSRESOLVERS/INBOUNDENDPOINTS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_private_dns_resolver_inbound_endpoint", "/subscriptions/resourceGroups/Microsoft.Network/dnsResolvers/inboundEndpoints"}}},
+	{"/MICROSOFT.NETWORK/DNSRESOLVERS/OUTBOUNDENDPOINTS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_private_dns_resolver_outbound_endpoint", "/subscriptions/resourceGroups/Microsoft.Network/dnsResolvers/outboundEndpoints"}}},
+	{"/MICROSOFT.NETWORK/DNSZONES", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_dns_zone", "/subscriptions/resourceGroups/Microsoft.Network/dnsZones"}}},
+	{"/MICROSOFT.NETWORK/DNSZONES/A", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_dns_a_record", "/subscriptions/resourceGroups/Microsoft.Network/dnsZones/A"}}},
+	{"/MICROSOFT.NETWORK/DNSZONES/AAAA", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_dns_aaaa_record", "/subscriptions/resourceGroups/Microsoft.Network/dnsZones/AAAA"}}},
+	{"/MICROSOFT.NETWORK/DNSZONES/CAA", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_dns_caa_record", "/subscriptions/resourceGroups/Microsoft.Network/dnsZones/CAA"}}},
+	{"/MICROSOFT.NETWORK/DNSZONES/CNAME", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_dns_cname_record", "/subscriptions/resourceGroups/Microsoft.Network/dnsZones/CNAME"}}},
+	{"/MICROSOFT.NETWORK/DNSZONES/MX", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_dns_mx_record", "/subscriptions/resourceGroups/Microsoft.Network/dnsZones/MX"}}},
+	{"/MICROSOFT.NETWORK/DNSZONES/NS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_dns_ns_record", "/subscriptions/resourceGroups/Microsoft.Network/dnsZones/NS"}}},
+	{"/MICROSOFT.NETWORK/DNSZONES/PTR", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_dns_ptr_record", "/subscriptions/resourceGroups/Microsoft.Network/dnsZones/PTR"}}},
+	{"/MICROSOFT.NETWORK/DNSZONES/SRV", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_dns_srv_record", "/subscriptions/resourceGroups/Microsoft.Network/dnsZones/SRV"}}},
+	{"/MICROSOFT.NETWORK/DNSZONES/TXT", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_dns_txt_record", "/subscriptions/resourceGroups/Microsoft.Network/dnsZones/TXT"}}},
+	{"/MICROSOFT.NETWORK/EXPRESSROUTECIRCUITS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_express_route_circuit", "/subscriptions/resourceGroups/Microsoft.Network/expressRouteCircuits"}}},
+	{"/MICROSOFT.NETWORK/EXPRESSROUTECIRCUITS/AUTHORIZATIONS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_express_route_circuit_authorization", "/subscriptions/resourceGroups/Microsoft.Network/expressRouteCircuits/authorizations"}}},
+	{"/MICROSOFT.NETWORK/EXPRESSROUTECIRCUITS/PEERINGS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_express_route_circuit_peering", "/subscriptions/resourceGroups/Microsoft.Network/expressRouteCircuits/peerings"}}},
+	{"/MICROSOFT.NETWORK/EXPRESSROUTECIRCUITS/PEERINGS/CONNECTIONS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_express_route_circuit_connection", "/subscriptions/resourceGroups/Microsoft.Network/expressRouteCircuits/peerings/connections"}}},
+	{"/MICROSOFT.NETWORK/EXPRESSROUTEGATEWAYS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_express_route_gateway", "/subscriptions/resourceGroups/Microsoft.Network/expressRouteGateways"}}},
+	{"/MICROSOFT.NETWORK/EXPRESSROUTEGATEWAYS/EXPRESSROUTECONNECTIONS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_express_route_connection", "/subscriptions/resourceGroups/Microsoft.Network/expressRouteGateways/expressRouteConnections"}}},
+	{"/MICROSOFT.NETWORK/EXPRESSROUTEPORTS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_express_route_port", "/subscriptions/resourceGroups/Microsoft.Network/expressRoutePorts"}}},
+	{"/MICROSOFT.NETWORK/EXPRESSROUTEPORTS/AUTHORIZATIONS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_express_route_port_authorization", "/subscriptions/resourceGroups/Microsoft.Network/expressRoutePorts/authorizations"}}},
+	{"/MICROSOFT.NETWORK/FIREWALLPOLICIES", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_firewall_policy", "/subscriptions/resourceGroups/Microsoft.Network/firewallPolicies"}}},
+	{"/MICROSOFT.NETWORK/FIREWALLPOLICIES/RULECOLLECTIONGROUPS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_firewall_policy_rule_collection_group", "/subscriptions/resourceGroups/Microsoft.Network/firewallPolicies/ruleCollectionGroups"}}},
+	{"/MICROSOFT.NETWORK/FRONTDOORS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_frontdoor", "/subscriptions/resourceGroups/Microsoft.Network/frontDoors"}}},
+	{"/MICROSOFT.NETWORK/FRONTDOORS/CUSTOMHTTPSCONFIGURATION", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_frontdoor_custom_https_configuration", "/subscriptions/resourceGroups/Microsoft.Network/frontDoors/customHttpsConfiguration"}}},
+	{"/MICROSOFT.NETWORK/FRONTDOORS/RULESENGINES", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_frontdoor_rules_engine", "/subscriptions/resourceGroups/Microsoft.Network/frontdoors/rulesEngines"}}},
+	{"/MICROSOFT.NETWORK/FRONTDOORWEBAPPLICATIONFIREWALLPOLICIES", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_cdn_frontdoor_firewall_policy", "/subscriptions/resourceGroups/Microsoft.Network/frontDoorWebApplicationFirewallPolicies"}, {"azurerm_frontdoor_firewall_policy", "/subscriptions/resourceGroups/Microsoft.Network/frontDoorWebApplicationFirewallPolicies"}}},
+	{"/MICROSOFT.NETWORK/IPGROUPS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_ip_group", "/subscriptions/resourceGroups/Microsoft.Network/ipGroups"}}},
+	{"/MICROSOFT.NETWORK/IPGROUPS/CIDRS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_ip_group_cidr", "/subscriptions/resourceGroups/Microsoft.Network/ipGroups/cidrs"}}},
+	{"/MICROSOFT.NETWORK/LOADBALANCERS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_lb", "/subscriptions/resourceGroups/Microsoft.Network/loadBalancers"}}},
+	{"/MICROSOFT.NETWORK/LOADBALANCERS/BACKENDADDRESSPOOLS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_lb_backend_address_pool", "/subscriptions/resourceGroups/Microsoft.Network/loadBalancers/backendAddressPools"}}},
+	{"/MICROSOFT.NETWORK/LOADBALANCERS/BACKENDADDRESSPOOLS/ADDRESSES", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_lb_backend_address_pool_address", "/subscriptions/resourceGroups/Microsoft.Network/loadBalancers/backendAddressPools/addresses"}}},
+	{"/MICROSOFT.NETWORK/LOADBALANCERS/INBOUNDNATPOOLS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_lb_nat_pool", "/subscriptions/resourceGroups/Microsoft.Network/loadBalancers/inboundNatPools"}}},
+	{"/MICROSOFT.NETWORK/LOADBALANCERS/INBOUNDNATRULES", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_lb_nat_rule", "/subscriptions/resourceGroups/Microsoft.Network/loadBalancers/inboundNatRules"}}},
+	{"/MICROSOFT.NETWORK/LOADBALANCERS/LOADBALANCINGRULES", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_lb_rule", "/subscriptions/resourceGroups/Microsoft.Network/loadBalancers/loadBalancingRules"}}},
+	{"/MICROSOFT.NETWORK/LOADBALANCERS/OUTBOUNDRULES", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_lb_outbound_rule", "/subscriptions/resourceGroups/Microsoft.Network/loadBalancers/outboundRules"}}},
+	{"/MICROSOFT.NETWORK/LOADBALANCERS/PROBES", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_lb_probe", "/subscriptions/resourceGroups/Microsoft.Network/loadBalancers/probes"}}},
+	{"/MICROSOFT.NETWORK/LOCALNETWORKGATEWAYS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_local_network_gateway", "/subscriptions/resourceGroups/Microsoft.Network/localNetworkGateways"}}},
+	{"/MICROSOFT.NETWORK/NATGATEWAYS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_nat_gateway", "/subscriptions/resourceGroups/Microsoft.Network/natGateways"}}},
+	{"/MICROSOFT.NETWORK/NATGATEWAYS/PUBLICIPADDRESSES", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_nat_gateway_public_ip_association", ""}}},
+	{"/MICROSOFT.NETWORK/NATGATEWAYS/PUBLICIPPREFIXES", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_nat_gateway_public_ip_prefix_association", ""}}},
+	{"/MICROSOFT.NETWORK/NETWORKINTERFACES", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_network_interface", "/subscriptions/resourceGroups/Microsoft.Network/networkInterfaces"}}},
+	{"/MICROSOFT.NETWORK/NETWORKINTERFACES/IPCONFIGURATIONS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"fake_azurerm_network_interface_ipconfig", "/subscriptions/resourceGroups/Microsoft.Network/networkInterfaces/ipConfigurations"}}},
+	{"/MICROSOFT.NETWORK/NETWORKINTERFACES/IPCONFIGURATIONS/APPLICATIONGATEWAYBACKENDADDRESSPOOLS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_network_interface_application_gateway_backend_address_pool_association", ""}}},
+	{"/MICROSOFT.NETWORK/NETWORKINTERFACES/IPCONFIGURATIONS/APPLICATIONSECURITYGROUPS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_network_interface_application_security_group_association", ""}}},
+	{"/MICROSOFT.NETWORK/NETWORKINTERFACES/IPCONFIGURATIONS/LOADBALANCERBACKENDADDRESSPOOLS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_network_interface_backend_address_pool_association", ""}}},
+	{"/MICROSOFT.NETWORK/NETWORKINTERFACES/IPCONFIGURATIONS/LOADBALANCERINBOUNDNATRULES", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_network_interface_nat_rule_association", ""}}},
+	{"/MICROSOFT.NETWORK/NETWORKINTERFACES/NETWORKSECURITYGROUPS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_network_interface_security_group_association", ""}}},
+	{"/MICROSOFT.NETWORK/NETWORKMANAGERCONNECTIONS", "/MICROSOFT.MANAGEMENT/MANAGEMENTGROUPS", []ARMId2TFMapItem{{"azurerm_network_manager_management_group_connection", "/Microsoft.Management/managementGroups/Microsoft.Network/networkManagerConnections"}}},
+	{"/MICROSOFT.NETWORK/NETWORKMANAGERCONNECTIONS", "/SUBSCRIPTIONS", []ARMId2TFMapItem{{"azurerm_network_manager_subscription_connection", "/subscriptions/Microsoft.Network/networkManagerConnections"}}},
+	{"/MICROSOFT.NETWORK/NETWORKMANAGERS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_network_manager", "/subscriptions/resourceGroups/Microsoft.Network/networkManagers"}}},
+	{"/MICROSOFT.NETWORK/NETWORKMANAGERS/CONNECTIVITYCONFIGURATIONS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_network_manager_connectivity_configuration", "/subscriptions/resourceGroups/Microsoft.Network/networkManagers/connectivityConfigurations"}}},
+	{"/MICROSOFT.NETWORK/NETWORKMANAGERS/IPAMPOOLS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_network_manager_ipam_pool", "/subscriptions/resourceGroups/Microsoft.Network/networkManagers/ipamPools"}}},
+	{"/MICROSOFT.NETWORK/NETWORKMANAGERS/IPAMPOOLS/STATICCIDRS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_network_manager_ipam_pool_static_cidr", "/subscriptions/resourceGroups/Microsoft.Network/networkManagers/ipamPools/staticCidrs"}}},
+	{"/MICROSOFT.NETWORK/NETWORKMANAGERS/LOCATIONS/TYPES", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_network_manager_deployment", "/subscriptions/resourceGroups/Microsoft.Network/networkManagers"}}},
+	{"/MICROSOFT.NETWORK/NETWORKMANAGERS/NETWORKGROUPS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_network_manager_network_group", "/subscriptions/resourceGroups/Microsoft.Network/networkManagers/networkGroups"}}},
+	{"/MICROSOFT.NETWORK/NETWORKMANAGERS/NETWORKGROUPS/STATICMEMBERS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_network_manager_static_member", "/subscriptions/resourceGroups/Microsoft.Network/networkManagers/networkGroups/staticMembers"}}},
+	{"/MICROSOFT.NETWORK/NETWORKMANAGERS/ROUTINGCONFIGURATIONS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_network_manager_routing_configuration", "/subscriptions/resourceGroups/Microsoft.Network/networkManagers/routingConfigurations"}}},
+	{"/MICROSOFT.NETWORK/NETWORKMANAGERS/ROUTINGCONFIGURATIONS/RULECOLLECTIONS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_network_manager_routing_rule_collection", "/subscriptions/resourceGroups/Microsoft.Network/networkManagers/routingConfigurations/ruleCollections"}}},
+	{"/MICROSOFT.NETWORK/NETWORKMANAGERS/ROUTINGCONFIGURATIONS/RULECOLLECTIONS/RULES", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_network_manager_routing_rule", "/subscriptions/resourceGroups/Microsoft.Network/networkManagers/routingConfigurations/ruleCollections/rules"}}},
+	{"/MICROSOFT.NETWORK/NETWORKMANAGERS/SCOPECONNECTIONS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_network_manager_scope_connection", "/subscriptions/resourceGroups/Microsoft.Network/networkManagers/scopeConnections"}}},
+	{"/MICROSOFT.NETWORK/NETWORKMANAGERS/SECURITYADMINCONFIGURATIONS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_network_manager_security_admin_configuration", "/subscriptions/resourceGroups/Microsoft.Network/networkManagers/securityAdminConfigurations"}}},
+	{"/MICROSOFT.NETWORK/NETWORKMANAGERS/SECURITYADMINCONFIGURATIONS/RULECOLLECTIONS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_network_manager_admin_rule_collection", "/subscriptions/resourceGroups/Microsoft.Network/networkManagers/securityAdminConfigurations/ruleCollections"}}},
+	{"/MICROSOFT.NETWORK/NETWORKMANAGERS/SECURITYADMINCONFIGURATIONS/RULECOLLECTIONS/RULES", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_network_manager_admin_rule", "/subscriptions/resourceGroups/Microsoft.Network/networkManagers/securityAdminConfigurations/ruleCollections/rules"}}},
+	{"/MICROSOFT.NETWORK/NETWORKMANAGERS/VERIFIERWORKSPACES", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_network_manager_verifier_workspace", "/subscriptions/resourceGroups/Microsoft.Network/networkManagers/verifierWorkspaces"}}},
+	{"/MICROSOFT.NETWORK/NETWORKMANAGERS/VERIFIERWORKSPACES/REACHABILITYANALYSISINTENTS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_network_manager_verifier_workspace_reachability_analysis_intent", "/subscriptions/resourceGroups/Microsoft.Network/networkManagers/verifierWorkspaces/reachabilityAnalysisIntents"}}},
+	{"/MICROSOFT.NETWORK/NETWORKPROFILES", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_network_profile", "/subscriptions/resourceGroups/Microsoft.Network/networkProfiles"}}},
+	{"/MICROSOFT.NETWORK/NETWORKSECURITYGROUPS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_network_security_group", "/subscriptions/resourceGroups/Microsoft.Network/networkSecurityGroups"}}},
+	{"/MICROSOFT.NETWORK/NETWORKSECURITYGROUPS/SECURITYRULES", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_network_security_rule", "/subscriptions/resourceGroups/Microsoft.Network/networkSecurityGroups/securityRules"}}},
+	{"/MICROSOFT.NETWORK/NETWORKSECURITYPERIMETERS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_network_security_perimeter", "/subscriptions/resourceGroups/Microsoft.Network/networkSecurityPerimeters"}}},
+	{"/MICROSOFT.NETWORK/NETWORKSECURITYPERIMETERS/PROFILES", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_network_security_perimeter_profile", "/subscriptions/resourceGroups/Microsoft.Network/networkSecurityPerimeters/profiles"}}},
+	{"/MICROSOFT.NETWORK/NETWORKSECURITYPERIMETERS/PROFILES/ACCESSRULES", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_network_security_perimeter_access_rule", "/subscriptions/resourceGroups/Microsoft.Network/networkSecurityPerimeters/profiles/accessRules"}}},
+	{"/MICROSOFT.NETWORK/NETWORKSECURITYPERIMETERS/RESOURCEASSOCIATIONS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_network_security_perimeter_association", "/subscriptions/resourceGroups/Microsoft.Network/networkSecurityPerimeters/resourceAssociations"}}},
+	{"/MICROSOFT.NETWORK/NETWORKVIRTUALAPPLIANCES", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_palo_alto_virtual_network_appliance", "/subscriptions/resourceGroups/Microsoft.Network/networkVirtualAppliances"}}},
+	{"/MICROSOFT.NETWORK/NETWORKWATCHERS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_network_watcher", "/subscriptions/resourceGroups/Microsoft.Network/networkWatchers"}}},
+	{"/MICROSOFT.NETWORK/NETWORKWATCHERS/CONNECTIONMONITORS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_network_connection_monitor", "/subscriptions/resourceGroups/Microsoft.Network/networkWatchers/connectionMonitors"}}},
+	{"/MICROSOFT.NETWORK/NETWORKWATCHERS/FLOWLOGS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_network_watcher_flow_log", "/subscriptions/resourceGroups/Microsoft.Network/networkWatchers/flowLogs"}}},
+	{"/MICROSOFT.NETWORK/NETWORKWATCHERS/PACKETCAPTURES", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_virtual_machine_packet_capture", "/subscriptions/resourceGroups/Microsoft.Network/networkWatchers/packetCaptures"}, {"azurerm_virtual_machine_scale_set_packet_capture", "/subscriptions/resourceGroups/Microsoft.Network/networkWatchers/packetCaptures"}}},
+	{"/MICROSOFT.NETWORK/P2SVPNGATEWAYS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_point_to_site_vpn_gateway", "/subscriptions/resourceGroups/Microsoft.Network/p2sVpnGateways"}}},
+	{"/MICROSOFT.NETWORK/PRIVATEDNSZONES", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_private_dns_zone", "/subscriptions/resourceGroups/Microsoft.Network/privateDnsZones"}}},
+	{"/MICROSOFT.NETWORK/PRIVATEDNSZONES/A", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_private_dns_a_record", "/subscriptions/resourceGroups/Microsoft.Network/privateDnsZones/A"}}},
+	{"/MICROSOFT.NETWORK/PRIVATEDNSZONES/AAAA", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_private_dns_aaaa_record", "/subscriptions/resourceGroups/Microsoft.Network/privateDnsZones/AAAA"}}},
+	{"/MICROSOFT.NETWORK/PRIVATEDNSZONES/CNAME", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_private_dns_cname_record", "/subscriptions/resourceGroups/Microsoft.Network/privateDnsZones/CNAME"}}},
+	{"/MICROSOFT.NETWORK/PRIVATEDNSZONES/MX", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_private_dns_mx_record", "/subscriptions/resourceGroups/Microsoft.Network/privateDnsZones/MX"}}},
+	{"/MICROSOFT.NETWORK/PRIVATEDNSZONES/PTR", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_private_dns_ptr_record", "/subscriptions/resourceGroups/Microsoft.Network/privateDnsZones/PTR"}}},
+	{"/MICROSOFT.NETWORK/PRIVATEDNSZONES/SRV", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_private_dns_srv_record", "/subscriptions/resourceGroups/Microsoft.Network/privateDnsZones/SRV"}}},
+	{"/MICROSOFT.NETWORK/PRIVATEDNSZONES/TXT", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_private_dns_txt_record", "/subscriptions/resourceGroups/Microsoft.Network/privateDnsZones/TXT"}}},
+	{"/MICROSOFT.NETWORK/PRIVATEDNSZONES/VIRTUALNETWORKLINKS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_private_dns_zone_virtual_network_link", "/subscriptions/resourceGroups/Microsoft.Network/privateDnsZones/virtualNetworkLinks"}}},
+	{"/MICROSOFT.NETWORK/PRIVATEENDPOINTS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_private_endpoint", "/subscriptions/resourceGroups/Microsoft.Network/privateEndpoints"}}},
+	{"/MICROSOFT.NETWORK/PRIVATELINKSERVICES", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_private_link_service", "/subscriptions/resourceGroups/Microsoft.Network/privateLinkServices"}}},
+	{"/MICROSOFT.NETWORK/PUBLICIPADDRESSES", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_public_ip", "/subscriptions/resourceGroups/Microsoft.Network/publicIPAddresses"}}},
+	{"/MICROSOFT.NETWORK/PUBLICIPPREFIXES", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_public_ip_prefix", "/subscriptions/resourceGroups/Microsoft.Network/publicIPPrefixes"}}},
+	{"/MICROSOFT.NETWORK/ROUTEFILTERS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_route_filter", "/subscriptions/resourceGroups/Microsoft.Network/routeFilters"}}},
+	{"/MICROSOFT.NETWORK/ROUTETABLES", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_route_table", "/subscriptions/resourceGroups/Microsoft.Network/routeTables"}}},
+	{"/MICROSOFT.NETWORK/ROUTETABLES/ROUTES", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_route", "/subscriptions/resourceGroups/Microsoft.Network/routeTables/routes"}}},
+	{"/MICROSOFT.NETWORK/SECURITYPARTNERPROVIDERS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_virtual_hub_security_partner_provider", "/subscriptions/resourceGroups/Microsoft.Network/securityPartnerProviders"}}},
+	{"/MICROSOFT.NETWORK/SERVICEENDPOINTPOLICIES", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_subnet_service_endpoint_storage_policy", "/subscriptions/resourceGroups/Microsoft.Network/serviceEndpointPolicies"}}},
+	{"/MICROSOFT.NETWORK/TRAFFICMANAGERPROFILES", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_traffic_manager_profile", "/subscriptions/resourceGroups/Microsoft.Network/trafficManagerProfiles"}}},
+	{"/MICROSOFT.NETWORK/TRAFFICMANAGERPROFILES/AZUREENDPOINTS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_traffic_manager_azure_endpoint", "/subscriptions/resourceGroups/Microsoft.Network/trafficManagerProfiles/AzureEndpoints"}}},
+	{"/MICROSOFT.NETWORK/TRAFFICMANAGERPROFILES/EXTERNALENDPOINTS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_traffic_manager_external_endpoint", "/subscriptions/resourceGroups/Microsoft.Network/trafficManagerProfiles/ExternalEndpoints"}}},
+	{"/MICROSOFT.NETWORK/TRAFFICMANAGERPROFILES/NESTEDENDPOINTS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_traffic_manager_nested_endpoint", "/subscriptions/resourceGroups/Microsoft.Network/trafficManagerProfiles/NestedEndpoints"}}},
+	{"/MICROSOFT.NETWORK/VIRTUALHUBS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_route_server", "/subscriptions/resourceGroups/Microsoft.Network/virtualHubs"}, {"azurerm_virtual_hub", "/subscriptions/resourceGroups/Microsoft.Network/virtualHubs"}}},
+	{"/MICROSOFT.NETWORK/VIRTUALHUBS/BGPCONNECTIONS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_route_server_bgp_connection", "/subscriptions/resourceGroups/Microsoft.Network/virtualHubs/bgpConnections"}, {"azurerm_virtual_hub_bgp_connection", "/subscriptions/resourceGroups/Microsoft.Network/virtualHubs/bgpConnections"}}},
+	{"/MICROSOFT.NETWORK/VIRTUALHUBS/HUBROUTETABLES", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_virtual_hub_route_table", "/subscriptions/resourceGroups/Microsoft.Network/virtualHubs/hubRouteTables"}}},
+	{"/MICROSOFT.NETWORK/VIRTUALHUBS/HUBROUTETABLES/ROUTES", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_virtual_hub_route_table_route", "/subscriptions/resourceGroups/Microsoft.Network/virtualHubs/hubRouteTables/routes"}}},
+	{"/MICROSOFT.NETWORK/VIRTUALHUBS/HUBVIRTUALNETWORKCONNECTIONS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_virtual_hub_connection", "/subscriptions/resourceGroups/Microsoft.Network/virtualHubs/hubVirtualNetworkConnections"}}},
+	{"/MICROSOFT.NETWORK/VIRTUALHUBS/IPCONFIGURATIONS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_virtual_hub_ip", "/subscriptions/resourceGroups/Microsoft.Network/virtualHubs/ipConfigurations"}}},
+	{"/MICROSOFT.NETWORK/VIRTUALHUBS/ROUTEMAPS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_route_map", "/subscriptions/resourceGroups/Microsoft.Network/virtualHubs/routeMaps"}}},
+	{"/MICROSOFT.NETWORK/VIRTUALHUBS/ROUTINGINTENT", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_virtual_hub_routing_intent", "/subscriptions/resourceGroups/Microsoft.Network/virtualHubs/routingIntent"}}},
+	{"/MICROSOFT.NETWORK/VIRTUALNETWORKGATEWAYS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_virtual_network_gateway", "/subscriptions/resourceGroups/Microsoft.Network/virtualNetworkGateways"}}},
+	{"/MICROSOFT.NETWORK/VIRTUALNETWORKGATEWAYS/NATRULES", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_virtual_network_gateway_nat_rule", "/subscriptions/resourceGroups/Microsoft.Network/virtualNetworkGateways/natRules"}}},
+	{"/MICROSOFT.NETWORK/VIRTUALNETWORKS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_virtual_network", "/subscriptions/resourceGroups/Microsoft.Network/virtualNetworks"}}},
+	{"/MICROSOFT.NETWORK/VIRTUALNETWORKS/DNSSERVERS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_virtual_network_dns_servers", "/subscriptions/resourceGroups/Microsoft.Network/virtualNetworks/dnsServers"}}},
+	{"/MICROSOFT.NETWORK/VIRTUALNETWORKS/SUBNETS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_subnet", "/subscriptions/resourceGroups/Microsoft.Network/virtualNetworks/subnets"}}},
+	{"/MICROSOFT.NETWORK/VIRTUALNETWORKS/SUBNETS/NATGATEWAYS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_subnet_nat_gateway_association", ""}}},
+	{"/MICROSOFT.NETWORK/VIRTUALNETWORKS/SUBNETS/NETWORKSECURITYGROUPS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_subnet_network_security_group_association", ""}}},
+	{"/MICROSOFT.NETWORK/VIRTUALNETWORKS/SUBNETS/ROUTETABLES", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_subnet_route_table_association", ""}}},
+	{"/MICROSOFT.NETWORK/VIRTUALNETWORKS/VIRTUALNETWORKPEERINGS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_virtual_network_peering", "/subscriptions/resourceGroups/Microsoft.Network/virtualNetworks/virtualNetworkPeerings"}}},
+	{"/MICROSOFT.NETWORK/VIRTUALWANS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_virtual_wan", "/subscriptions/resourceGroups/Microsoft.Network/virtualWans"}}},
+	{"/MICROSOFT.NETWORK/VPNGATEWAYS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_vpn_gateway", "/subscriptions/resourceGroups/Microsoft.Network/vpnGateways"}}},
+	{"/MICROSOFT.NETWORK/VPNGATEWAYS/NATRULES", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_vpn_gateway_nat_rule", "/subscriptions/resourceGroups/Microsoft.Network/vpnGateways/natRules"}}},
+	{"/MICROSOFT.NETWORK/VPNGATEWAYS/VPNCONNECTIONS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_vpn_gateway_connection", "/subscriptions/resourceGroups/Microsoft.Network/vpnGateways/vpnConnections"}}},
+	{"/MICROSOFT.NETWORK/VPNSERVERCONFIGURATIONS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_vpn_server_configuration", "/subscriptions/resourceGroups/Microsoft.Network/vpnServerConfigurations"}}},
+	{"/MICROSOFT.NETWORK/VPNSERVERCONFIGURATIONS/CONFIGURATIONPOLICYGROUPS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_vpn_server_configuration_policy_group", "/subscriptions/resourceGroups/Microsoft.Network/vpnServerConfigurations/configurationPolicyGroups"}}},
+	{"/MICROSOFT.NETWORK/VPNSITES", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_vpn_site", "/subscriptions/resourceGroups/Microsoft.Network/vpnSites"}}},
+	{"/MICROSOFT.NETWORKFUNCTION/AZURETRAFFICCOLLECTORS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_network_function_azure_traffic_collector", "/subscriptions/resourceGroups/Microsoft.NetworkFunction/azureTrafficCollectors"}}},
+	{"/MICROSOFT.NETWORKFUNCTION/AZURETRAFFICCOLLECTORS/COLLECTORPOLICIES", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_network_function_collector_policy", "/subscriptions/resourceGroups/Microsoft.NetworkFunction/azureTrafficCollectors/collectorPolicies"}}},
+	{"/MICROSOFT.NOTIFICATIONHUBS/NAMESPACES", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_notification_hub_namespace", "/subscriptions/resourceGroups/Microsoft.NotificationHubs/namespaces"}}},
+	{"/MICROSOFT.NOTIFICATIONHUBS/NAMESPACES/NOTIFICATIONHUBS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_notification_hub", "/subscriptions/resourceGroups/Microsoft.NotificationHubs/namespaces/notificationHubs"}}},
+	{"/MICROSOFT.NOTIFICATIONHUBS/NAMESPACES/NOTIFICATIONHUBS/AUTHORIZATIONRULES", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_notification_hub_authorization_rule", "/subscriptions/resourceGroups/Microsoft.NotificationHubs/namespaces/notificationHubs/authorizationRules"}}},
+	{"/MICROSOFT.OPERATIONALINSIGHTS/CLUSTERS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_log_analytics_cluster", "/subscriptions/resourceGroups/Microsoft.OperationalInsights/clusters"}}},
+	{"/MICROSOFT.OPERATIONALINSIGHTS/QUERYPACKS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_log_analytics_query_pack", "/subscriptions/resourceGroups/Microsoft.OperationalInsights/queryPacks"}}},
+	{"/MICROSOFT.OPERATIONALINSIGHTS/QUERYPACKS/QUERIES", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_log_analytics_query_pack_query", "/subscriptions/resourceGroups/Microsoft.OperationalInsights/queryPacks/queries"}}},
+	{"/MICROSOFT.OPERATIONALINSIGHTS/WORKSPACES", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_log_analytics_workspace", "/subscriptions/resourceGroups/Microsoft.OperationalInsights/workspaces"}}},
+	{"/MICROSOFT.OPERATIONALINSIGHTS/WORKSPACES/DATAEXPORTS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_log_analytics_data_export_rule", "/subscriptions/resourceGroups/Microsoft.OperationalInsights/workspaces/dataExports"}}},
+	{"/MICROSOFT.OPERATIONALINSIGHTS/WORKSPACES/DATASOURCES", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_log_analytics_datasource_windows_event", "/subscriptions/resourceGroups/Microsoft.OperationalInsights/workspaces/dataSources"}, {"azurerm_log_analytics_datasource_windows_performance_counter", "/subscriptions/resourceGroups/Microsoft.OperationalInsights/workspaces/dataSources"}}},
+	{"/MICROSOFT.OPERATIONALINSIGHTS/WORKSPACES/LINKEDSERVICES", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_log_analytics_linked_service", "/subscriptions/resourceGroups/Microsoft.OperationalInsights/workspaces/linkedServices"}}},
+	{"/MICROSOFT.OPERATIONALINSIGHTS/WORKSPACES/LINKEDSTORAGEACCOUNTS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_log_analytics_linked_storage_account", "/subscriptions/resourceGroups/Microsoft.OperationalInsights/workspaces/linkedStorageAccounts"}}},
+	{"/MICROSOFT.OPERATIONALINSIGHTS/WORKSPACES/SAVEDSEARCHES", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_log_analytics_saved_search", "/subscriptions/resourceGroups/Microsoft.OperationalInsights/workspaces/savedSearches"}}},
+	{"/MICROSOFT.OPERATIONALINSIGHTS/WORKSPACES/STORAGEINSIGHTCONFIGS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_log_analytics_storage_insights", "/subscriptions/resourceGroups/Microsoft.OperationalInsights/workspaces/storageInsightConfigs"}}},
+	{"/MICROSOFT.OPERATIONALINSIGHTS/WORKSPACES/TABLES", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_log_analytics_workspace_table_custom_log", "/subscriptions/resourceGroups/Microsoft.OperationalInsights/workspaces/tables"}, {"azurerm_log_analytics_workspace_table_microsoft", "/subscriptions/resourceGroups/Microsoft.OperationalInsights/workspaces/tables"}}},
+	{"/MICROSOFT.OPERATIONSMANAGEMENT/SOLUTIONS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_log_analytics_solution", "/subscriptions/resourceGroups/Microsoft.OperationsManagement/solutions"}}},
+	{"/MICROSOFT.ORBITAL/CONTACTPROFILES", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_orbital_contact_profile", "/subscriptions/resourceGroups/Microsoft.Orbital/contactProfiles"}}},
+	{"/MICROSOFT.ORBITAL/SPACECRAFTS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_orbital_spacecraft", "/subscriptions/resourceGroups/Microsoft.Orbital/spacecrafts"}}},
+	{"/MICROSOFT.ORBITAL/SPACECRAFTS/CONTACTS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_orbital_contact", "/subscriptions/resourceGroups/Microsoft.Orbital/spacecrafts/contacts"}}},
+	{"/MICROSOFT.POLICYINSIGHTS", "/SUBSCRIPTIONS", []ARMId2TFMapItem{{"azurerm_resource_provider_registration", "/subscriptions/Microsoft.PolicyInsights"}}},
+	{"/MICROSOFT.POLICYINSIGHTS/REMEDIATIONS", "/MICROSOFT.MANAGEMENT/MANAGEMENTGROUPS", []ARMId2TFMapItem{{"azurerm_management_group_policy_remediation", "/Microsoft.Management/managementGroups/Microsoft.PolicyInsights/remediations"}}},
+	{"/MICROSOFT.POLICYINSIGHTS/REMEDIATIONS", "/SUBSCRIPTIONS", []ARMId2TFMapItem{{"azurerm_subscription_policy_remediation", "/subscriptions/Microsoft.PolicyInsights/remediations"}}},
+	{"/MICROSOFT.POLICYINSIGHTS/REMEDIATIONS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_resource_group_policy_remediation", "/subscriptions/resourceGroups/Microsoft.PolicyInsights/remediations"}}},
+	{"/MICROSOFT.POLICYINSIGHTS/REMEDIATIONS", "ANY", []ARMId2TFMapItem{{"azurerm_resource_policy_remediation", ""}}},
+	{"/MICROSOFT.PORTAL/DASHBOARDS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_portal_dashboard", "/subscriptions/resourceGroups/Microsoft.Portal/dashboards"}}},
+	{"/MICROSOFT.PORTAL/TENANTCONFIGURATIONS", "/", []ARMId2TFMapItem{{"azurerm_portal_tenant_configuration", "/Microsoft.Portal/tenantConfigurations"}}},
+	{"/MICROSOFT.POWERBIDEDICATED/CAPACITIES", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_powerbi_embedded", "/subscriptions/resourceGroups/Microsoft.PowerBIDedicated/capacities"}}},
+	{"/MICROSOFT.PURVIEW/ACCOUNTS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_purview_account", "/subscriptions/resourceGroups/Microsoft.Purview/accounts"}}},
+	{"/MICROSOFT.RECOVERYSERVICES/VAULTS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_recovery_services_vault", "/subscriptions/resourceGroups/Microsoft.RecoveryServices/vaults"}}},
+	{"/MICROSOFT.RECOVERYSERVICES/VAULTS/BACKUPFABRICS/PROTECTIONCONTAINERS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_backup_container_storage_account", "/subscriptions/resourceGroups/Microsoft.RecoveryServices/vaults/backupFabrics/protectionContainers"}}},
+	{"/MICROSOFT.RECOVERYSERVICES/VAULTS/BACKUPFABRICS/PROTECTIONCONTAINERS/PROTECTEDITEMS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_backup_protected_file_share", "/subscriptions/resourceGroups/Microsoft.RecoveryServices/vaults/backupFabrics/protectionContainers/protectedItems"}, {"azurerm_backup_protected_vm", "/subscriptions/resourceGroups/Microsoft.RecoveryServices/vaults/backupFabrics/protectionContainers/protectedItems"}}},
+	{"/MICROSOFT.RECOVERYSERVICES/VAULTS/BACKUPPOLICIES", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_backup_policy_file_share", "/subscriptions/resourceGroups/Microsoft.RecoveryServices/vaults/backupPolicies"}, {"azurerm_backup_policy_vm", "/subscriptions/resourceGroups/Microsoft.RecoveryServices/vaults/backupPolicies"}, {"azurerm_backup_policy_vm_workload", "/subscriptions/resourceGroups/Microsoft.RecoveryServices/vaults/backupPolicies"}}},
+	{"/MICROSOFT.RECOVERYSERVICES/VAULTS/BACKUPRESOURCEGUARDPROXIES", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_recovery_services_vault_resource_guard_association", "/subscriptions/resourceGroups/Microsoft.RecoveryServices/vaults/backupResourceGuardProxies"}}},
+	{"/MICROSOFT.RECOVERYSERVICES/VAULTS/REPLICATIONFABRICS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_site_recovery_fabric", "/subscriptions/resourceGroups/Microsoft.RecoveryServices/vaults/replicationFabrics"}, {"azurerm_site_recovery_services_vault_hyperv_site", "/subscriptions/resourceGroups/Microsoft.RecoveryServices/vaults/replicationFabrics"}}},
+	{"/MICROSOFT.RECOVERYSERVICES/VAULTS/REPLICATIONFABRICS/REPLICATIONNETWORKS/REPLICATIONNETWORKMAPPINGS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_site_recovery_hyperv_network_mapping", "/subscriptions/resourceGroups/Microsoft.RecoveryServices/vaults/replicationFabrics/replicationNetworks/replicationNetworkMappings"}, {"azurerm_site_recovery_network_mapping", "/subscriptions/resourceGroups/Microsoft.RecoveryServices/vaults/replicationFabrics/replicationNetworks/replicationNetworkMappings"}}},
+	{"/MICROSOFT.RECOVERYSERVICES/VAULTS/REPLICATIONFABRICS/REPLICATIONPROTECTIONCONTAINERS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_site_recovery_protection_container", "/subscriptions/resourceGroups/Microsoft.RecoveryServices/vaults/replicationFabrics/replicationProtectionContainers"}}},
+	{"/MICROSOFT.RECOVERYSERVICES/VAULTS/REPLICATIONFABRICS/REPLICATIONPROTECTIONCONTAINERS/REPLICATIONPROTECTEDITEMS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_site_recovery_replicated_vm", "/subscriptions/resourceGroups/Microsoft.RecoveryServices/vaults/replicationFabrics/replicationProtectionContainers/replicationProtectedItems"}, {"azurerm_site_recovery_vmware_replicated_vm", "/subscriptions/resourceGroups/Microsoft.RecoveryServices/vaults/replicationFabrics/replicationProtectionContainers/replicationProtectedItems"}}},
+	{"/MICROSOFT.RECOVERYSERVICES/VAULTS/REPLICATIONFABRICS/REPLICATIONPROTECTIONCONTAINERS/REPLICATIONPROTECTIONCONTAINERMAPPINGS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_site_recovery_hyperv_replication_policy_association", "/subscriptions/resourceGroups/Microsoft.RecoveryServices/vaults/replicationFabrics/replicationProtectionContainers/replicationProtectionContainerMappings"}, {"azurerm_site_recovery_protection_container_mapping", "/subscriptions/resourceGroups/Microsoft.RecoveryServices/vaults/replicationFabrics/replicationProtectionContainers/replicationProtectionContainerMappings"}, {"azurerm_site_recovery_vmware_replication_policy_association", "/subscriptions/resourceGroups/Microsoft.RecoveryServices/vaults/replicationFabrics/replicationProtectionContainers/replicationProtectionContainerMappings"}}},
+	{"/MICROSOFT.RECOVERYSERVICES/VAULTS/REPLICATIONPOLICIES", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_site_recovery_hyperv_replication_policy", "/subscriptions/resourceGroups/Microsoft.RecoveryServices/vaults/replicationPolicies"}, {"azurerm_site_recovery_replication_policy", "/subscriptions/resourceGroups/Microsoft.RecoveryServices/vaults/replicationPolicies"}, {"azurerm_site_recovery_vmware_replication_policy", "/subscriptions/resourceGroups/Microsoft.RecoveryServices/vaults/replicationPolicies"}}},
+	{"/MICROSOFT.RECOVERYSERVICES/VAULTS/REPLICATIONRECOVERYPLANS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_site_recovery_replication_recovery_plan", "/subscriptions/resourceGroups/Microsoft.RecoveryServices/vaults/replicationRecoveryPlans"}}},
+	{"/MICROSOFT.REDHATOPENSHIFT/OPENSHIFTCLUSTERS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_redhat_openshift_cluster", "/subscriptions/resourceGroups/Microsoft.RedHatOpenShift/openShiftClusters"}}},
+	{"/MICROSOFT.RELAY/NAMESPACES", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_relay_namespace", "/subscriptions/resourceGroups/Microsoft.Relay/namespaces"}}},
+	{"/MICROSOFT.RELAY/NAMESPACES/AUTHORIZATIONRULES", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_relay_namespace_authorization_rule", "/subscriptions/resourceGroups/Microsoft.Relay/namespaces/authorizationRules"}}},
+	{"/MICROSOFT.RELAY/NAMESPACES/HYBRIDCONNECTIONS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_relay_hybrid_connection", "/subscriptions/resourceGroups/Microsoft.Relay/namespaces/hybridConnections"}}},
+	{"/MICROSOFT.RELAY/NAMESPACES/HYBRIDCONNECTIONS/AUTHORIZATIONRULES", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_relay_hybrid_connection_authorization_rule", "/subscriptions/resourceGroups/Microsoft.Relay/namespaces/hybridConnections/authorizationRules"}}},
+	{"/MICROSOFT.RESOURCECONNECTOR/APPLIANCES", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_arc_resource_bridge_appliance", "/subscriptions/resourceGroups/Microsoft.ResourceConnector/appliances"}}},
+	{"/MICROSOFT.RESOURCES/DEPLOYMENTS", "/", []ARMId2TFMapItem{{"azurerm_tenant_template_deployment", "/Microsoft.Resources/deployments"}}},
+	{"/MICROSOFT.RESOURCES/DEPLOYMENTS", "/MICROSOFT.MANAGEMENT/MANAGEMENTGROUPS", []ARMId2TFMapItem{{"azurerm_management_group_template_deployment", "/Microsoft.Management/managementGroups/Microsoft.Resources/deployments"}}},
+	{"/MICROSOFT.RESOURCES/DEPLOYMENTS", "/SUBSCRIPTIONS", []ARMId2TFMapItem{{"azurerm_subscription_template_deployment", "/subscriptions/Microsoft.Resources/deployments"}}},
+	{"/MICROSOFT.RESOURCES/DEPLOYMENTS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_resource_group_template_deployment", "/subscriptions/resourceGroups/Microsoft.Resources/deployments"}}},
+	{"/MICROSOFT.RESOURCES/DEPLOYMENTSCRIPTS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_resource_deployment_script_azure_cli", "/subscriptions/resourceGroups/Microsoft.Resources/deploymentScripts"}, {"azurerm_resource_deployment_script_azure_power_shell", "/subscriptions/resourceGroups/Microsoft.Resources/deploymentScripts"}}},
+	{"/MICROSOFT.SCVMM/AVAILABILITYSETS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_system_center_virtual_machine_manager_availability_set", "/subscriptions/resourceGroups/Microsoft.ScVmm/availabilitySets"}}},
+	{"/MICROSOFT.SCVMM/CLOUDS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_system_center_virtual_machine_manager_cloud", "/subscriptions/resourceGroups/Microsoft.ScVmm/clouds"}}},
+	{"/MICROSOFT.SCVMM/VIRTUALMACHINEINSTANCES", "/SUBSCRIPTIONS/RESOURCEGROUPS/MICROSOFT.HYBRIDCOMPUTE/MACHINES", []ARMId2TFMapItem{{"azurerm_system_center_virtual_machine_manager_virtual_machine_instance", "/subscriptions/resourceGroups/Microsoft.HybridCompute/machines/Microsoft.ScVmm/virtualMachineInstances"}}},
+	{"/MICROSOFT.SCVMM/VIRTUALMACHINEINSTANCES/GUESTAGENTS", "/SUBSCRIPTIONS/RESOURCEGROUPS/MICROSOFT.HYBRIDCOMPUTE/MACHINES", []ARMId2TFMapItem{{"azurerm_system_center_virtual_machine_manager_virtual_machine_instance_guest_agent", "/subscriptions/resourceGroups/Microsoft.HybridCompute/machines/Microsoft.ScVmm/virtualMachineInstances/guestAgents"}}},
+	{"/MICROSOFT.SCVMM/VIRTUALMACHINETEMPLATES", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_system_center_virtual_machine_manager_virtual_machine_template", "/subscriptions/resourceGroups/Microsoft.ScVmm/virtualMachineTemplates"}}},
+	{"/MICROSOFT.SCVMM/VIRTUALNETWORKS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_system_center_virtual_machine_manager_virtual_network", "/subscriptions/resourceGroups/Microsoft.ScVmm/virtualNetworks"}}},
+	{"/MICROSOFT.SCVMM/VMMSERVERS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_system_center_virtual_machine_manager_server", "/subscriptions/resourceGroups/Microsoft.ScVmm/vmmServers"}}},
+	{"/MICROSOFT.SEARCH/SEARCHSERVICES", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_search_service", "/subscriptions/resourceGroups/Microsoft.Search/searchServices"}}},
+	{"/MICROSOFT.SEARCH/SEARCHSERVICES/SHAREDPRIVATELINKRESOURCES", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_search_shared_private_link_service", "/subscriptions/resourceGroups/Microsoft.Search/searchServices/sharedPrivateLinkResources"}}},
+	{"/MICROSOFT.SECURITY/ADVANCEDTHREATPROTECTIONSETTINGS", "/SUBSCRIPTIONS/RESOURCEGROUPS/MICROSOFT.STORAGE/STORAGEACCOUNTS", []ARMId2TFMapItem{{"azurerm_advanced_threat_protection", "/subscriptions/resourceGroups/Microsoft.Storage/storageAccounts/Microsoft.Security/advancedThreatProtectionSettings"}}},
+	{"/MICROSOFT.SECURITY/ASSESSMENTMETADATA", "/SUBSCRIPTIONS", []ARMId2TFMapItem{{"azurerm_security_center_assessment_policy", "/subscriptions/Microsoft.Security/assessmentMetadata"}}},
+	{"/MICROSOFT.SECURITY/ASSESSMENTS", "ANY", []ARMId2TFMapItem{{"azurerm_security_center_assessment", ""}}},
+	{"/MICROSOFT.SECURITY/AUTOMATIONS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_security_center_automation", "/subscriptions/resourceGroups/Microsoft.Security/automations"}}},
+	{"/MICROSOFT.SECURITY/AUTOPROVISIONINGSETTINGS", "/SUBSCRIPTIONS", []ARMId2TFMapItem{{"azurerm_security_center_auto_provisioning", "/subscriptions/Microsoft.Security/autoProvisioningSettings"}}},
+	{"/MICROSOFT.SECURITY/DEFENDERFORSTORAGESETTINGS", "/SUBSCRIPTIONS/RESOURCEGROUPS/MICROSOFT.STORAGE/STORAGEACCOUNTS", []ARMId2TFMapItem{{"azurerm_security_center_storage_defender", "/subscriptions/resourceGroups/Microsoft.Storage/storageAccounts/Microsoft.Security/defenderForStorageSettings"}}},
+	{"/MICROSOFT.SECURITY/DEVICESECURITYGROUPS", "ANY", []ARMId2TFMapItem{{"azurerm_iot_security_device_group", ""}}},
+	{"/MICROSOFT.SECURITY/IOTSECURITYSOLUTIONS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_iot_security_solution", "/subscriptions/resourceGroups/Microsoft.Security/iotSecuritySolutions"}}},
+	{"/MICROSOFT.SECURITY/PRICINGS", "/SUBSCRIPTIONS", []ARMId2TFMapItem{{"azurerm_security_center_subscription_pricing", "/subscriptions/Microsoft.Security/pricings"}}},
+	{"/MICROSOFT.SECURITY/SECURITYCONTACTS", "/SUBSCRIPTIONS", []ARMId2TFMapItem{{"azurerm_security_center_contact", "/subscriptions/Microsoft.Security/securityContacts"}}},
+	{"/MICROSOFT.SECURITY/SERVERVULNERABILITYASSESSMENTS", "/SUBSCRIPTIONS/RESOURCEGROUPS/MICROSOFT.COMPUTE/VIRTUALMACHINES", []ARMId2TFMapItem{{"azurerm_security_center_server_vulnerability_assessment_virtual_machine", "/subscriptions/resourceGroups/Microsoft.Compute/virtualMachines/Microsoft.Security/serverVulnerabilityAssessments"}}},
+	{"/MICROSOFT.SECURITY/SERVERVULNERABILITYASSESSMENTSSETTINGS", "/SUBSCRIPTIONS", []ARMId2TFMapItem{{"azurerm_security_center_server_vulnerability_assessments_setting", "/subscriptions/Microsoft.Security/serverVulnerabilityAssessmentsSettings"}}},
+	{"/MICROSOFT.SECURITY/SETTINGS", "/SUBSCRIPTIONS", []ARMId2TFMapItem{{"azurerm_security_center_setting", "/subscriptions/Microsoft.Security/settings"}}},
+	{"/MICROSOFT.SECURITY/WORKSPACESETTINGS", "/SUBSCRIPTIONS", []ARMId2TFMapItem{{"azurerm_security_center_workspace", "/subscriptions/Microsoft.Security/workspaceSettings"}}},
+	{"/MICROSOFT.SECURITYINSIGHTS/ALERTRULES", "/SUBSCRIPTIONS/RESOURCEGROUPS/MICROSOFT.OPERATIONALINSIGHTS/WORKSPACES", []ARMId2TFMapItem{{"azurerm_sentinel_alert_rule_fusion", "/subscriptions/resourceGroups/Microsoft.OperationalInsights/workspaces/Microsoft.SecurityInsights/alertRules"}, {"azurerm_sentinel_alert_rule_machine_learning_behavior_analytics", "/subscriptions/resourceGroups/Microsoft.OperationalInsights/workspaces/Microsoft.SecurityInsights/alertRules"}, {"azurerm_sentinel_alert_rule_ms_security_incident", "/subscriptions/resourceGroups/Microsoft.OperationalInsights/workspaces/Microsoft.SecurityInsights/alertRules"}, {"azurerm_sentinel_alert_rule_nrt", "/subscriptions/resourceGroups/Microsoft.OperationalInsights/workspaces/Microsoft.SecurityInsights/alertRules"}, {"azurerm_sentinel_alert_rule_scheduled", "/subscriptions/resourceGroups/Microsoft.OperationalInsights/workspaces/Microsoft.SecurityInsights/alertRules"}, {"azurerm_sentinel_alert_rule_threat_intelligence", "/subscriptions/resourceGroups/Microsoft.OperationalInsights/workspaces/Microsoft.SecurityInsights/alertRules"}}},
+	{"/MICROSOFT.SECURITYINSIGHTS/AUTOMATIONRULES", "/SUBSCRIPTIONS/RESOURCEGROUPS/MICROSOFT.OPERATIONALINSIGHTS/WORKSPACES", []ARMId2TFMapItem{{"azurerm_sentinel_automation_rule", "/subscriptions/resourceGroups/Microsoft.OperationalInsights/workspaces/Microsoft.SecurityInsights/automationRules"}}},
+	{"/MICROSOFT.SECURITYINSIGHTS/DATACONNECTORS", "/SUBSCRIPTIONS/RESOURCEGROUPS/MICROSOFT.OPERATIONALINSIGHTS/WORKSPACES", []ARMId2TFMapItem{{"azurerm_sentinel_data_connector_aws_cloud_trail", "/subscriptions/resourceGroups/Microsoft.OperationalInsights/workspaces/Microsoft.SecurityInsights/dataConnectors"}, {"azurerm_sentinel_data_connector_aws_s3", "/subscriptions/resourceGroups/Microsoft.OperationalInsights/workspaces/Microsoft.SecurityInsights/dataConnectors"}, {"azurerm_sentinel_data_connector_azure_active_directory", "/subscriptions/resourceGroups/Microsoft.OperationalInsights/workspaces/Microsoft.SecurityInsights/dataConnectors"}, {"azurerm_sentinel_data_connector_azure_advanced_threat_protection", "/subscriptions/resourceGroups/Microsoft.OperationalInsights/workspaces/Microsoft.SecurityInsights/dataConnectors"}, {"azurerm_sentinel_data_connector_azure_security_center", "/subscriptions/resourceGroups/Microsoft.OperationalInsights/workspaces/Microsoft.SecurityInsights/dataConnectors"}, {"azurerm_sentinel_data_connector_dynamics_365", "/subscriptions/resourceGroups/Microsoft.OperationalInsights/workspaces/Microsoft.SecurityInsights/dataConnectors"}, {"azurerm_sentinel_data_connector_iot", "/subscriptions/resourceGroups/Microsoft.OperationalInsights/workspaces/Microsoft.SecurityInsights/dataConnectors"}, {"azurerm_sentinel_data_connector_microsoft_cloud_app_security", "/subscriptions/resourceGroups/Microsoft.OperationalInsights/workspaces/Microsoft.SecurityInsights/dataConnectors"}, {"azurerm_sentinel_data_connector_microsoft_defender_advanced_threat_protection", "/subscriptions/resourceGroups/Microsoft.OperationalInsights/workspaces/Microsoft.SecurityInsights/dataConnectors"}, {"azurerm_sentinel_data_connector_microsoft_threat_intelligence", "/subscriptions/resourceGroups/Microsoft.OperationalInsights/workspaces/Microsoft.SecurityInsights/dataConnectors"}, {"azurerm_sentinel_data_connector_microsoft_threat_protection", "/subscriptions/resourceGroups/Microsoft.OperationalInsights/workspaces/Microsoft.SecurityInsights/dataConnectors"}, {"azurerm_sentinel_data_connector_office_365", "/subscriptions/resourceGroups/Microsoft.OperationalInsights/workspaces/Microsoft.SecurityInsights/dataConnectors"}, {"azurerm_sentinel_data_connector_office_365_project", "/subscriptions/resourceGroups/Microsoft.OperationalInsights/workspaces/Microsoft.SecurityInsights/dataConnectors"}, {"azurerm_sentinel_data_connector_office_atp", "/subscriptions/resourceGroups/Microsoft.OperationalInsights/workspaces/Microsoft.SecurityInsights/dataConnectors"}, {"azurerm_sentinel_data_connector_office_irm", "/subscriptions/resourceGroups/Microsoft.OperationalInsights/workspaces/Microsoft.SecurityInsights/dataConnectors"}, {"azurerm_sentinel_data_connector_office_power_bi", "/subscriptions/resourceGroups/Microsoft.OperationalInsights/workspaces/Microsoft.SecurityInsights/dataConnectors"}, {"azurerm_sentinel_data_connector_threat_intelligence", "/subscriptions/resourceGroups/Microsoft.OperationalInsights/workspaces/Microsoft.SecurityInsights/dataConnectors"}, {"azurerm_sentinel_data_connector_threat_intelligence_taxii", "/subscriptions/resourceGroups/Microsoft.OperationalInsights/workspaces/Microsoft.SecurityInsights/dataConnectors"}}},
+	{"/MICROSOFT.SECURITYINSIGHTS/METADATA", "/SUBSCRIPTIONS/RESOURCEGROUPS/MICROSOFT.OPERATIONALINSIGHTS/WORKSPACES", []ARMId2TFMapItem{{"azurerm_sentinel_metadata", "/subscriptions/resourceGroups/Microsoft.OperationalInsights/workspaces/Microsoft.SecurityInsights/metadata"}}},
+	{"/MICROSOFT.SECURITYINSIGHTS/ONBOARDINGSTATES", "/SUBSCRIPTIONS/RESOURCEGROUPS/MICROSOFT.OPERATIONALINSIGHTS/WORKSPACES", []ARMId2TFMapItem{{"azurerm_sentinel_log_analytics_workspace_onboarding", "/subscriptions/resourceGroups/Microsoft.OperationalInsights/workspaces/Microsoft.SecurityInsights/onboardingStates"}}},
+	{"/MICROSOFT.SECURITYINSIGHTS/SECURITYMLANALYTICSSETTINGS", "/SUBSCRIPTIONS/RESOURCEGROUPS/MICROSOFT.OPERATIONALINSIGHTS/WORKSPACES", []ARMId2TFMapItem{{"azurerm_sentinel_alert_rule_anomaly_built_in", "/subscriptions/resourceGroups/Microsoft.OperationalInsights/workspaces/Microsoft.SecurityInsights/securityMLAnalyticsSettings"}, {"azurerm_sentinel_alert_rule_anomaly_duplicate", "/subscriptions/resourceGroups/Microsoft.OperationalInsights/workspaces/Microsoft.SecurityInsights/securityMLAnalyticsSettings"}}},
+	{"/MICROSOFT.SECURITYINSIGHTS/THREATINTELLIGENCE/INDICATORS", "/SUBSCRIPTIONS/RESOURCEGROUPS/MICROSOFT.OPERATIONALINSIGHTS/WORKSPACES", []ARMId2TFMapItem{{"azurerm_sentinel_threat_intelligence_indicator", "/subscriptions/resourceGroups/Microsoft.OperationalInsights/workspaces/Microsoft.SecurityInsights/threatIntelligence/indicators"}}},
+	{"/MICROSOFT.SECURITYINSIGHTS/WATCHLISTS", "/SUBSCRIPTIONS/RESOURCEGROUPS/MICROSOFT.OPERATIONALINSIGHTS/WORKSPACES", []ARMId2TFMapItem{{"azurerm_sentinel_watchlist", "/subscriptions/resourceGroups/Microsoft.OperationalInsights/workspaces/Microsoft.SecurityInsights/watchlists"}}},
+	{"/MICROSOFT.SECURITYINSIGHTS/WATCHLISTS/WATCHLISTITEMS", "/SUBSCRIPTIONS/RESOURCEGROUPS/MICROSOFT.OPERATIONALINSIGHTS/WORKSPACES", []ARMId2TFMapItem{{"azurerm_sentinel_watchlist_item", "/subscriptions/resourceGroups/Microsoft.OperationalInsights/workspaces/Microsoft.SecurityInsights/watchlists/watchlistItems"}}},
+	{"/MICROSOFT.SERVICEBUS/NAMESPACES", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_servicebus_namespace", "/subscriptions/resourceGroups/Microsoft.ServiceBus/namespaces"}}},
+	{"/MICROSOFT.SERVICEBUS/NAMESPACES/AUTHORIZATIONRULES", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_servicebus_namespace_authorization_rule", "/subscriptions/resourceGroups/Microsoft.ServiceBus/namespaces/authorizationRules"}}},
+	{"/MICROSOFT.SERVICEBUS/NAMESPACES/DISASTERRECOVERYCONFIGS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_servicebus_namespace_disaster_recovery_config", "/subscriptions/resourceGroups/Microsoft.ServiceBus/namespaces/disasterRecoveryConfigs"}}},
+	{"/MICROSOFT.SERVICEBUS/NAMESPACES/QUEUES", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_servicebus_queue", "/subscriptions/resourceGroups/Microsoft.ServiceBus/namespaces/queues"}}},
+	{"/MICROSOFT.SERVICEBUS/NAMESPACES/QUEUES/AUTHORIZATIONRULES", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_servicebus_queue_authorization_rule", "/subscriptions/resourceGroups/Microsoft.ServiceBus/namespaces/queues/authorizationRules"}}},
+	{"/MICROSOFT.SERVICEBUS/NAMESPACES/TOPICS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_servicebus_topic", "/subscriptions/resourceGroups/Microsoft.ServiceBus/namespaces/topics"}}},
+	{"/MICROSOFT.SERVICEBUS/NAMESPACES/TOPICS/AUTHORIZATIONRULES", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_servicebus_topic_authorization_rule", "/subscriptions/resourceGroups/Microsoft.ServiceBus/namespaces/topics/authorizationRules"}}},
+	{"/MICROSOFT.SERVICEBUS/NAMESPACES/TOPICS/SUBSCRIPTIONS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_servicebus_subscription", "/subscriptions/resourceGroups/Microsoft.ServiceBus/namespaces/topics/subscriptions"}}},
+	{"/MICROSOFT.SERVICEBUS/NAMESPACES/TOPICS/SUBSCRIPTIONS/RULES", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_servicebus_subscription_rule", "/subscriptions/resourceGroups/Microsoft.ServiceBus/namespaces/topics/subscriptions/rules"}}},
+	{"/MICROSOFT.SERVICEFABRIC/CLUSTERS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_service_fabric_cluster", "/subscriptions/resourceGroups/Microsoft.ServiceFabric/clusters"}}},
+	{"/MICROSOFT.SERVICEFABRIC/MANAGEDCLUSTERS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_service_fabric_managed_cluster", "/subscriptions/resourceGroups/Microsoft.ServiceFabric/managedClusters"}}},
+	{"/MICROSOFT.SERVICELINKER/LINKERS", "/SUBSCRIPTIONS/RESOURCEGROUPS/MICROSOFT.APPPLATFORM/SPRING/APPS/DEPLOYMENTS", []ARMId2TFMapItem{{"azurerm_spring_cloud_connection", "/subscriptions/resourceGroups/Microsoft.AppPlatform/Spring/apps/deployments/Microsoft.ServiceLinker/linkers"}}},
+	{"/MICROSOFT.SERVICELINKER/LINKERS", "/SUBSCRIPTIONS/RESOURCEGROUPS/MICROSOFT.WEB/SITES", []ARMId2TFMapItem{{"azurerm_app_service_connection", "/subscriptions/resourceGroups/Microsoft.Web/sites/Microsoft.ServiceLinker/linkers"}, {"azurerm_function_app_connection", "/subscriptions/resourceGroups/Microsoft.Web/sites/Microsoft.ServiceLinker/linkers"}}},
+	{"/MICROSOFT.SERVICENETWORKING/TRAFFICCONTROLLERS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_application_load_balancer", "/subscriptions/resourceGroups/Microsoft.ServiceNetworking/trafficControllers"}}},
+	{"/MICROSOFT.SERVICENETWORKING/TRAFFICCONTROLLERS/ASSOCIATIONS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_application_load_balancer_subnet_association", "/subscriptions/resourceGroups/Microsoft.ServiceNetworking/trafficControllers/associations"}}},
+	{"/MICROSOFT.SERVICENETWORKING/TRAFFICCONTROLLERS/FRONTENDS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_application_load_balancer_frontend", "/subscriptions/resourceGroups/Microsoft.ServiceNetworking/trafficControllers/frontends"}}},
+	{"/MICROSOFT.SERVICENETWORKING/TRAFFICCONTROLLERS/SECURITYPOLICIES", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_application_load_balancer_security_policy", "/subscriptions/resourceGroups/Microsoft.ServiceNetworking/trafficControllers/securityPolicies"}}},
+	{"/MICROSOFT.SIGNALRSERVICE/SIGNALR", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_signalr_service", "/subscriptions/resourceGroups/Microsoft.SignalRService/signalR"}}},
+	{"/MICROSOFT.SIGNALRSERVICE/SIGNALR/CUSTOMCERTIFICATES", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_signalr_service_custom_certificate", "/subscriptions/resourceGroups/Microsoft.SignalRService/signalR/customCertificates"}}},
+	{"/MICROSOFT.SIGNALRSERVICE/SIGNALR/CUSTOMDOMAINS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_signalr_service_custom_domain", "/subscriptions/resourceGroups/Microsoft.SignalRService/signalR/customDomains"}}},
+	{"/MICROSOFT.SIGNALRSERVICE/SIGNALR/SHAREDPRIVATELINKRESOURCES", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_signalr_shared_private_link_resource", "/subscriptions/resourceGroups/Microsoft.SignalRService/signalR/sharedPrivateLinkResources"}}},
+	{"/MICROSOFT.SIGNALRSERVICE/WEBPUBSUB", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_web_pubsub", "/subscriptions/resourceGroups/Microsoft.SignalRService/webPubSub"}, {"azurerm_web_pubsub_socketio", "/subscriptions/resourceGroups/Microsoft.SignalRService/webPubSub"}}},
+	{"/MICROSOFT.SIGNALRSERVICE/WEBPUBSUB/CUSTOMCERTIFICATES", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_web_pubsub_custom_certificate", "/subscriptions/resourceGroups/Microsoft.SignalRService/webPubSub/customCertificates"}}},
+	{"/MICROSOFT.SIGNALRSERVICE/WEBPUBSUB/CUSTOMDOMAINS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_web_pubsub_custom_domain", "/subscriptions/resourceGroups/Microsoft.SignalRService/webPubSub/customDomains"}}},
+	{"/MICROSOFT.SIGNALRSERVICE/WEBPUBSUB/HUBS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_web_pubsub_hub", "/subscriptions/resourceGroups/Microsoft.SignalRService/webPubSub/hubs"}}},
+	{"/MICROSOFT.SIGNALRSERVICE/WEBPUBSUB/SHAREDPRIVATELINKRESOURCES", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_web_pubsub_shared_private_link_resource", "/subscriptions/resourceGroups/Microsoft.SignalRService/webPubSub/sharedPrivateLinkResources"}}},
+	{"/MICROSOFT.SOLUTIONS/APPLICATIONDEFINITIONS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_managed_application_definition", "/subscriptions/resourceGroups/Microsoft.Solutions/applicationDefinitions"}}},
+	{"/MICROSOFT.SOLUTIONS/APPLICATIONS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_managed_application", "/subscriptions/resourceGroups/Microsoft.Solutions/applications"}}},
+	{"/MICROSOFT.SQL/LOCATIONS/INSTANCEFAILOVERGROUPS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_mssql_managed_instance_failover_group", "/subscriptions/resourceGroups/Microsoft.Sql/locations/instanceFailoverGroups"}}},
+	{"/MICROSOFT.SQL/MANAGEDINSTANCES", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_mssql_managed_instance", "/subscriptions/resourceGroups/Microsoft.Sql/managedInstances"}}},
+	{"/MICROSOFT.SQL/MANAGEDINSTANCES/ADMINISTRATORS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_mssql_managed_instance_active_directory_administrator", "/subscriptions/resourceGroups/Microsoft.Sql/managedInstances/administrators"}}},
+	{"/MICROSOFT.SQL/MANAGEDINSTANCES/DATABASES", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_mssql_managed_database", "/subscriptions/resourceGroups/Microsoft.Sql/managedInstances/databases"}}},
+	{"/MICROSOFT.SQL/MANAGEDINSTANCES/ENCRYPTIONPROTECTOR", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_mssql_managed_instance_transparent_data_encryption", "/subscriptions/resourceGroups/Microsoft.Sql/managedInstances/encryptionProtector"}}},
+	{"/MICROSOFT.SQL/MANAGEDINSTANCES/SECURITYALERTPOLICIES", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_mssql_managed_instance_security_alert_policy", "/subscriptions/resourceGroups/Microsoft.Sql/managedInstances/securityAlertPolicies"}}},
+	{"/MICROSOFT.SQL/MANAGEDINSTANCES/STARTSTOPSCHEDULES", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_mssql_managed_instance_start_stop_schedule", "/subscriptions/resourceGroups/Microsoft.Sql/managedInstances/startStopSchedules"}}},
+	{"/MICROSOFT.SQL/MANAGEDINSTANCES/VULNERABILITYASSESSMENTS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_mssql_managed_instance_vulnerability_assessment", "/subscriptions/resourceGroups/Microsoft.Sql/managedInstances/vulnerabilityAssessments"}}},
+	{"/MICROSOFT.SQL/SERVERS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_mssql_server", "/subscriptions/resourceGroups/Microsoft.Sql/servers"}}},
+	{"/MICROSOFT.SQL/SERVERS/DATABASES", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_mssql_database", "/subscriptions/resourceGroups/Microsoft.Sql/servers/databases"}}},
+	{"/MICROSOFT.SQL/SERVERS/DATABASES/EXTENDEDAUDITINGSETTINGS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_mssql_database_extended_auditing_policy", "/subscriptions/resourceGroups/Microsoft.Sql/servers/databases/extendedAuditingSettings"}}},
+	{"/MICROSOFT.SQL/SERVERS/DATABASES/VULNERABILITYASSESSMENTS/RULES/BASELINES", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_mssql_database_vulnerability_assessment_rule_baseline", "/subscriptions/resourceGroups/Microsoft.Sql/servers/databases/vulnerabilityAssessments/rules/baselines"}}},
+	{"/MICROSOFT.SQL/SERVERS/DEVOPSAUDITINGSETTINGS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_mssql_server_microsoft_support_auditing_policy", "/subscriptions/resourceGroups/Microsoft.Sql/servers/devOpsAuditingSettings"}}},
+	{"/MICROSOFT.SQL/SERVERS/DNSALIASES", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_mssql_server_dns_alias", "/subscriptions/resourceGroups/Microsoft.Sql/servers/dnsAliases"}}},
+	{"/MICROSOFT.SQL/SERVERS/ELASTICPOOLS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_mssql_elasticpool", "/subscriptions/resourceGroups/Microsoft.Sql/servers/elasticPools"}}},
+	{"/MICROSOFT.SQL/SERVERS/ENCRYPTIONPROTECTOR", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_mssql_server_transparent_data_encryption", "/subscriptions/resourceGroups/Microsoft.Sql/servers/encryptionProtector"}}},
+	{"/MICROSOFT.SQL/SERVERS/EXTENDEDAUDITINGSETTINGS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_mssql_server_extended_auditing_policy", "/subscriptions/resourceGroups/Microsoft.Sql/servers/extendedAuditingSettings"}}},
+	{"/MICROSOFT.SQL/SERVERS/FAILOVERGROUPS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_mssql_failover_group", "/subscriptions/resourceGroups/Microsoft.Sql/servers/failoverGroups"}}},
+	{"/MICROSOFT.SQL/SERVERS/FIREWALLRULES", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_mssql_firewall_rule", "/subscriptions/resourceGroups/Microsoft.Sql/servers/firewallRules"}}},
+	{"/MICROSOFT.SQL/SERVERS/JOBAGENTS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_mssql_job_agent", "/subscriptions/resourceGroups/Microsoft.Sql/servers/jobAgents"}}},
+	{"/MICROSOFT.SQL/SERVERS/JOBAGENTS/CREDENTIALS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_mssql_job_credential", "/subscriptions/resourceGroups/Microsoft.Sql/servers/jobAgents/credentials"}}},
+	{"/MICROSOFT.SQL/SERVERS/JOBAGENTS/JOBS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_mssql_job", "/subscriptions/resourceGroups/Microsoft.Sql/servers/jobAgents/jobs"}}},
+	{"/MICROSOFT.SQL/SERVERS/JOBAGENTS/JOBS/SCHEDULES", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_mssql_job_schedule", "/subscriptions/resourceGroups/Microsoft.Sql/servers/jobAgents/jobs"}}},
+	{"/MICROSOFT.SQL/SERVERS/JOBAGENTS/JOBS/STEPS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_mssql_job_step", "/subscriptions/resourceGroups/Microsoft.Sql/servers/jobAgents/jobs/steps"}}},
+	{"/MICROSOFT.SQL/SERVERS/JOBAGENTS/TARGETGROUPS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_mssql_job_target_group", "/subscriptions/resourceGroups/Microsoft.Sql/servers/jobAgents/targetGroups"}}},
+	{"/MICROSOFT.SQL/SERVERS/OUTBOUNDFIREWALLRULES", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_mssql_outbound_firewall_rule", "/subscriptions/resourceGroups/Microsoft.Sql/servers/outboundFirewallRules"}}},
+	{"/MICROSOFT.SQL/SERVERS/SECURITYALERTPOLICIES", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_mssql_server_security_alert_policy", "/subscriptions/resourceGroups/Microsoft.Sql/servers/securityAlertPolicies"}}},
+	{"/MICROSOFT.SQL/SERVERS/VIRTUALNETWORKRULES", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_mssql_virtual_network_rule", "/subscriptions/resourceGroups/Microsoft.Sql/servers/virtualNetworkRules"}}},
+	{"/MICROSOFT.SQL/SERVERS/VULNERABILITYASSESSMENTS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_mssql_server_vulnerability_assessment", "/subscriptions/resourceGroups/Microsoft.Sql/servers/vulnerabilityAssessments"}}},
+	{"/MICROSOFT.SQLVIRTUALMACHINE/SQLVIRTUALMACHINEGROUPS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_mssql_virtual_machine_group", "/subscriptions/resourceGroups/Microsoft.SqlVirtualMachine/sqlVirtualMachineGroups"}}},
+	{"/MICROSOFT.SQLVIRTUALMACHINE/SQLVIRTUALMACHINEGROUPS/AVAILABILITYGROUPLISTENERS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_mssql_virtual_machine_availability_group_listener", "/subscriptions/resourceGroups/Microsoft.SqlVirtualMachine/sqlVirtualMachineGroups/availabilityGroupListeners"}}},
+	{"/MICROSOFT.SQLVIRTUALMACHINE/SQLVIRTUALMACHINES", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_mssql_virtual_machine", "/subscriptions/resourceGroups/Microsoft.SqlVirtualMachine/sqlVirtualMachines"}}},
+	{"/MICROSOFT.STANDBYPOOL/STANDBYVIRTUALMACHINEPOOLS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_virtual_machine_scale_set_standby_pool", "/subscriptions/resourceGroups/Microsoft.StandbyPool/standbyVirtualMachinePools"}}},
+	{"/MICROSOFT.STORAGE/STORAGEACCOUNTS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_storage_account", "/subscriptions/resourceGroups/Microsoft.Storage/storageAccounts"}}},
+	{"/MICROSOFT.STORAGE/STORAGEACCOUNTS/BLOBSERVICES/CONTAINERS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_storage_container", "/subscriptions/resourceGroups/Microsoft.Storage/storageAccounts/blobServices/containers"}}},
+	{"/MICROSOFT.STORAGE/STORAGEACCOUNTS/BLOBSERVICES/CONTAINERS/BLOBS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_storage_blob", ""}}},
+	{"/MICROSOFT.STORAGE/STORAGEACCOUNTS/BLOBSERVICES/CONTAINERS/IMMUTABILITYPOLICIES", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_storage_container_immutability_policy", "/subscriptions/resourceGroups/Microsoft.Storage/storageAccounts/blobServices/containers/immutabilityPolicies"}}},
+	{"/MICROSOFT.STORAGE/STORAGEACCOUNTS/DFS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_storage_data_lake_gen2_filesystem", ""}}},
+	{"/MICROSOFT.STORAGE/STORAGEACCOUNTS/DFS/PATHS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_storage_data_lake_gen2_path", ""}}},
+	{"/MICROSOFT.STORAGE/STORAGEACCOUNTS/ENCRYPTIONSCOPES", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_storage_encryption_scope", "/subscriptions/resourceGroups/Microsoft.Storage/storageAccounts/encryptionScopes"}}},
+	{"/MICROSOFT.STORAGE/STORAGEACCOUNTS/FILESERVICES/SHARES", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_storage_share", "/subscriptions/resourceGroups/Microsoft.Storage/storageAccounts/fileServices/shares"}}},
+	{"/MICROSOFT.STORAGE/STORAGEACCOUNTS/FILESERVICES/SHARES/DIRECTORIES", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_storage_share_directory", ""}}},
+	{"/MICROSOFT.STORAGE/STORAGEACCOUNTS/FILESERVICES/SHARES/FILES", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_storage_share_file", ""}}},
+	{"/MICROSOFT.STORAGE/STORAGEACCOUNTS/INVETORYPOLICIES", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_storage_blob_inventory_policy", "/subscriptions/resourceGroups/Microsoft.Storage/storageAccounts"}}},
+	{"/MICROSOFT.STORAGE/STORAGEACCOUNTS/LOCALUSERS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_storage_account_local_user", "/subscriptions/resourceGroups/Microsoft.Storage/storageAccounts/localUsers"}}},
+	{"/MICROSOFT.STORAGE/STORAGEACCOUNTS/MANAGEMENTPOLICIES", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_storage_management_policy", "/subscriptions/resourceGroups/Microsoft.Storage/storageAccounts/managementPolicies"}}},
+	{"/MICROSOFT.STORAGE/STORAGEACCOUNTS/OBJECTREPLICATIONPOLICIES", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_storage_object_replication", ""}}},
+	{"/MICROSOFT.STORAGE/STORAGEACCOUNTS/QUEUESERVICES", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_storage_account_queue_properties", "/subscriptions/resourceGroups/Microsoft.Storage/storageAccounts"}}},
+	{"/MICROSOFT.STORAGE/STORAGEACCOUNTS/QUEUESERVICES/QUEUES", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_storage_queue", ""}}},
+	{"/MICROSOFT.STORAGE/STORAGEACCOUNTS/STATICWEBSITES", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_storage_account_static_website", "/subscriptions/resourceGroups/Microsoft.Storage/storageAccounts"}}},
+	{"/MICROSOFT.STORAGE/STORAGEACCOUNTS/TABLESERVICES", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_storage_account_table_properties", "/subscriptions/resourceGroups/Microsoft.Storage/storageAccounts"}}},
+	{"/MICROSOFT.STORAGE/STORAGEACCOUNTS/TABLESERVICES/TABLES", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_storage_table", ""}}},
+	{"/MICROSOFT.STORAGE/STORAGEACCOUNTS/TABLESERVICES/TABLES/PARTITIONKEYS/ROWKEYS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_storage_table_entity", ""}}},
+	{"/MICROSOFT.STORAGECACHE/AMLFILESYSTEMS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_managed_lustre_file_system", "/subscriptions/resourceGroups/Microsoft.StorageCache/amlFilesystems"}}},
+	{"/MICROSOFT.STORAGECACHE/CACHES", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_hpc_cache", "/subscriptions/resourceGroups/Microsoft.StorageCache/caches"}}},
+	{"/MICROSOFT.STORAGECACHE/CACHES/CACHEACCESSPOLICIES", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_hpc_cache_access_policy", "/subscriptions/resourceGroups/Microsoft.StorageCache/caches/cacheAccessPolicies"}}},
+	{"/MICROSOFT.STORAGECACHE/CACHES/STORAGETARGETS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_hpc_cache_blob_nfs_target", "/subscriptions/resourceGroups/Microsoft.StorageCache/caches/storageTargets"}, {"azurerm_hpc_cache_blob_target", "/subscriptions/resourceGroups/Microsoft.StorageCache/caches/storageTargets"}, {"azurerm_hpc_cache_nfs_target", "/subscriptions/resourceGroups/Microsoft.StorageCache/caches/storageTargets"}}},
+	{"/MICROSOFT.STORAGEMOVER/STORAGEMOVERS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_storage_mover", "/subscriptions/resourceGroups/Microsoft.StorageMover/storageMovers"}}},
+	{"/MICROSOFT.STORAGEMOVER/STORAGEMOVERS/AGENTS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_storage_mover_agent", "/subscriptions/resourceGroups/Microsoft.StorageMover/storageMovers/agents"}}},
+	{"/MICROSOFT.STORAGEMOVER/STORAGEMOVERS/ENDPOINTS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_storage_mover_source_endpoint", "/subscriptions/resourceGroups/Microsoft.StorageMover/storageMovers/endpoints"}, {"azurerm_storage_mover_target_endpoint", "/subscriptions/resourceGroups/Microsoft.StorageMover/storageMovers/endpoints"}}},
+	{"/MICROSOFT.STORAGEMOVER/STORAGEMOVERS/PROJECTS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_storage_mover_project", "/subscriptions/resourceGroups/Microsoft.StorageMover/storageMovers/projects"}}},
+	{"/MICROSOFT.STORAGEMOVER/STORAGEMOVERS/PROJECTS/JOBDEFINITIONS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_storage_mover_job_definition", "/subscriptions/resourceGroups/Microsoft.StorageMover/storageMovers/projects/jobDefinitions"}}},
+	{"/MICROSOFT.STORAGESYNC/STORAGESYNCSERVICES", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_storage_sync", "/subscriptions/resourceGroups/Microsoft.StorageSync/storageSyncServices"}}},
+	{"/MICROSOFT.STORAGESYNC/STORAGESYNCSERVICES/SYNCGROUPS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_storage_sync_group", "/subscriptions/resourceGroups/Microsoft.StorageSync/storageSyncServices/syncGroups"}}},
+	{"/MICROSOFT.STORAGESYNC/STORAGESYNCSERVICES/SYNCGROUPS/CLOUDENDPOINTS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_storage_sync_cloud_endpoint", "/subscriptions/resourceGroups/Microsoft.StorageSync/storageSyncServices/syncGroups/cloudEndpoints"}}},
+	{"/MICROSOFT.STORAGESYNC/STORAGESYNCSERVICES/SYNCGROUPS/SERVERENDPOINTS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_storage_sync_server_endpoint", "/subscriptions/resourceGroups/Microsoft.StorageSync/storageSyncServices/syncGroups/serverEndpoints"}}},
+	{"/MICROSOFT.STREAMANALYTICS/CLUSTERS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_stream_analytics_cluster", "/subscriptions/resourceGroups/Microsoft.StreamAnalytics/clusters"}}},
+	{"/MICROSOFT.STREAMANALYTICS/CLUSTERS/PRIVATEENDPOINTS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_stream_analytics_managed_private_endpoint", "/subscriptions/resourceGroups/Microsoft.StreamAnalytics/clusters/privateEndpoints"}}},
+	{"/MICROSOFT.STREAMANALYTICS/STREAMINGJOBS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_stream_analytics_job", "/subscriptions/resourceGroups/Microsoft.StreamAnalytics/streamingJobs"}}},
+	{"/MICROSOFT.STREAMANALYTICS/STREAMINGJOBS/FUNCTIONS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_stream_analytics_function_javascript_uda", "/subscriptions/resourceGroups/Microsoft.StreamAnalytics/streamingJobs/functions"}, {"azurerm_stream_analytics_function_javascript_udf", "/subscriptions/resourceGroups/Microsoft.StreamAnalytics/streamingJobs/functions"}}},
+	{"/MICROSOFT.STREAMANALYTICS/STREAMINGJOBS/INPUTS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_stream_analytics_reference_input_blob", "/subscriptions/resourceGroups/Microsoft.StreamAnalytics/streamingJobs/inputs"}, {"azurerm_stream_analytics_reference_input_mssql", "/subscriptions/resourceGroups/Microsoft.StreamAnalytics/streamingJobs/inputs"}, {"azurerm_stream_analytics_stream_input_blob", "/subscriptions/resourceGroups/Microsoft.StreamAnalytics/streamingJobs/inputs"}, {"azurerm_stream_analytics_stream_input_eventhub", "/subscriptions/resourceGroups/Microsoft.StreamAnalytics/streamingJobs/inputs"}, {"azurerm_stream_analytics_stream_input_eventhub_v2", "/subscriptions/resourceGroups/Microsoft.StreamAnalytics/streamingJobs/inputs"}, {"azurerm_stream_analytics_stream_input_iothub", "/subscriptions/resourceGroups/Microsoft.StreamAnalytics/streamingJobs/inputs"}}},
+	{"/MICROSOFT.STREAMANALYTICS/STREAMINGJOBS/OUTPUTS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_stream_analytics_output_blob", "/subscriptions/resourceGroups/Microsoft.StreamAnalytics/streamingJobs/outputs"}, {"azurerm_stream_analytics_output_cosmosdb", "/subscriptions/resourceGroups/Microsoft.StreamAnalytics/streamingJobs/outputs"}, {"azurerm_stream_analytics_output_eventhub", "/subscriptions/resourceGroups/Microsoft.StreamAnalytics/streamingJobs/outputs"}, {"azurerm_stream_analytics_output_function", "/subscriptions/resourceGroups/Microsoft.StreamAnalytics/streamingJobs/outputs"}, {"azurerm_stream_analytics_output_mssql", "/subscriptions/resourceGroups/Microsoft.StreamAnalytics/streamingJobs/outputs"}, {"azurerm_stream_analytics_output_powerbi", "/subscriptions/resourceGroups/Microsoft.StreamAnalytics/streamingJobs/outputs"}, {"azurerm_stream_analytics_output_servicebus_queue", "/subscriptions/resourceGroups/Microsoft.StreamAnalytics/streamingJobs/outputs"}, {"azurerm_stream_analytics_output_servicebus_topic", "/subscriptions/resourceGroups/Microsoft.StreamAnalytics/streamingJobs/outputs"}, {"azurerm_stream_analytics_output_synapse", "/subscriptions/resourceGroups/Microsoft.StreamAnalytics/streamingJobs/outputs"}, {"azurerm_stream_analytics_output_table", "/subscriptions/resourceGroups/Microsoft.StreamAnalytics/streamingJobs/outputs"}}},
+	{"/MICROSOFT.STREAMANALYTICS/STREAMINGJOBS/SCHEDULE", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_stream_analytics_job_schedule", "/subscriptions/resourceGroups/Microsoft.StreamAnalytics/streamingJobs/schedule"}}},
+	{"/MICROSOFT.STREAMANALYTICS/STREAMINGJOBS/STORAGEACCOUNTS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_stream_analytics_job_storage_account", "/subscriptions/resourceGroups/Microsoft.StreamAnalytics/streamingJobs"}}},
+	{"/MICROSOFT.SUBSCRIPTION/ALIASES", "/", []ARMId2TFMapItem{{"azurerm_subscription", "/Microsoft.Subscription/aliases"}}},
+	{"/MICROSOFT.SYNAPSE/PRIVATELINKHUBS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_synapse_private_link_hub", "/subscriptions/resourceGroups/Microsoft.Synapse/privateLinkHubs"}}},
+	{"/MICROSOFT.SYNAPSE/WORKSPACES", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_synapse_workspace", "/subscriptions/resourceGroups/Microsoft.Synapse/workspaces"}}},
+	{"/MICROSOFT.SYNAPSE/WORKSPACES/ADMINISTRATORS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_synapse_workspace_aad_admin", "/subscriptions/resourceGroups/Microsoft.Synapse/workspaces/administrators"}}},
+	{"/MICROSOFT.SYNAPSE/WORKSPACES/BIGDATAPOOLS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_synapse_spark_pool", "/subscriptions/resourceGroups/Microsoft.Synapse/workspaces/bigDataPools"}}},
+	{"/MICROSOFT.SYNAPSE/WORKSPACES/EXTENDEDAUDITINGSETTINGS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_synapse_workspace_extended_auditing_policy", "/subscriptions/resourceGroups/Microsoft.Synapse/workspaces/extendedAuditingSettings"}}},
+	{"/MICROSOFT.SYNAPSE/WORKSPACES/FIREWALLRULES", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_synapse_firewall_rule", "/subscriptions/resourceGroups/Microsoft.Synapse/workspaces/firewallRules"}}},
+	{"/MICROSOFT.SYNAPSE/WORKSPACES/INTEGRATIONRUNTIMES", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_synapse_integration_runtime_azure", "/subscriptions/resourceGroups/Microsoft.Synapse/workspaces/integrationRuntimes"}, {"azurerm_synapse_integration_runtime_self_hosted", "/subscriptions/resourceGroups/Microsoft.Synapse/workspaces/integrationRuntimes"}}},
+	{"/MICROSOFT.SYNAPSE/WORKSPACES/KEYS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_synapse_workspace_key", "/subscriptions/resourceGroups/Microsoft.Synapse/workspaces/keys"}}},
+	{"/MICROSOFT.SYNAPSE/WORKSPACES/LINKEDSERVICES", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_synapse_linked_service", "/subscriptions/resourceGroups/Microsoft.Synapse/workspaces/linkedServices"}}},
+	{"/MICROSOFT.SYNAPSE/WORKSPACES/MANAGEDVIRTUALNETWORKS/MANAGEDPRIVATEENDPOINTS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_synapse_managed_private_endpoint", "/subscriptions/resourceGroups/Microsoft.Synapse/workspaces/managedVirtualNetworks/managedPrivateEndpoints"}}},
+	{"/MICROSOFT.SYNAPSE/WORKSPACES/ROLEASSIGNMENTS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_synapse_role_assignment", "/subscriptions/resourceGroups/Microsoft.Synapse/workspaces"}}},
+	{"/MICROSOFT.SYNAPSE/WORKSPACES/SECURITYALERTPOLICIES", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_synapse_workspace_security_alert_policy", "/subscriptions/resourceGroups/Microsoft.Synapse/workspaces/securityAlertPolicies"}}},
+	{"/MICROSOFT.SYNAPSE/WORKSPACES/SQLPOOLS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_synapse_sql_pool", "/subscriptions/resourceGroups/Microsoft.Synapse/workspaces/sqlPools"}}},
+	{"/MICROSOFT.SYNAPSE/WORKSPACES/SQLPOOLS/EXTENDEDAUDITINGSETTINGS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_synapse_sql_pool_extended_auditing_policy", "/subscriptions/resourceGroups/Microsoft.Synapse/workspaces/sqlPools/extendedAuditingSettings"}}},
+	{"/MICROSOFT.SYNAPSE/WORKSPACES/SQLPOOLS/SECURITYALERTPOLICIES", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_synapse_sql_pool_security_alert_policy", "/subscriptions/resourceGroups/Microsoft.Synapse/workspaces/sqlPools/securityAlertPolicies"}}},
+	{"/MICROSOFT.SYNAPSE/WORKSPACES/SQLPOOLS/VULNERABILITYASSESSMENTS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_synapse_sql_pool_vulnerability_assessment", "/subscriptions/resourceGroups/Microsoft.Synapse/workspaces/sqlPools/vulnerabilityAssessments"}}},
+	{"/MICROSOFT.SYNAPSE/WORKSPACES/SQLPOOLS/VULNERABILITYASSESSMENTS/RULES/BASELINES", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_synapse_sql_pool_vulnerability_assessment_baseline", "/subscriptions/resourceGroups/Microsoft.Synapse/workspaces/sqlPools/vulnerabilityAssessments/rules/baselines"}}},
+	{"/MICROSOFT.SYNAPSE/WORKSPACES/SQLPOOLS/WORKLOADGROUPS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_synapse_sql_pool_workload_group", "/subscriptions/resourceGroups/Microsoft.Synapse/workspaces/sqlPools/workloadGroups"}}},
+	{"/MICROSOFT.SYNAPSE/WORKSPACES/SQLPOOLS/WORKLOADGROUPS/WORKLOADCLASSIFIERS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_synapse_sql_pool_workload_classifier", "/subscriptions/resourceGroups/Microsoft.Synapse/workspaces/sqlPools/workloadGroups/workloadClassifiers"}}},
+	{"/MICROSOFT.SYNAPSE/WORKSPACES/VULNERABILITYASSESSMENTS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_synapse_workspace_vulnerability_assessment", "/subscriptions/resourceGroups/Microsoft.Synapse/workspaces/vulnerabilityAssessments"}}},
+	{"/MICROSOFT.VIDEOINDEXER/ACCOUNTS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_video_indexer_account", "/subscriptions/resourceGroups/Microsoft.VideoIndexer/accounts"}}},
+	{"/MICROSOFT.WEB/CERTIFICATES", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_app_service_certificate", "/subscriptions/resourceGroups/Microsoft.Web/certificates"}, {"azurerm_app_service_managed_certificate", "/subscriptions/resourceGroups/Microsoft.Web/certificates"}}},
+	{"/MICROSOFT.WEB/CONNECTIONS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_api_connection", "/subscriptions/resourceGroups/Microsoft.Web/connections"}}},
+	{"/MICROSOFT.WEB/HOSTINGENVIRONMENTS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_app_service_environment_v3", "/subscriptions/resourceGroups/Microsoft.Web/hostingEnvironments"}}},
+	{"/MICROSOFT.WEB/SERVERFARMS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_service_plan", "/subscriptions/resourceGroups/Microsoft.Web/serverFarms"}}},
+	{"/MICROSOFT.WEB/SITES", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_function_app_flex_consumption", "/subscriptions/resourceGroups/Microsoft.Web/sites"}, {"azurerm_linux_function_app", "/subscriptions/resourceGroups/Microsoft.Web/sites"}, {"azurerm_linux_web_app", "/subscriptions/resourceGroups/Microsoft.Web/sites"}, {"azurerm_logic_app_standard", "/subscriptions/resourceGroups/Microsoft.Web/sites"}, {"azurerm_windows_function_app", "/subscriptions/resourceGroups/Microsoft.Web/sites"}, {"azurerm_windows_web_app", "/subscriptions/resourceGroups/Microsoft.Web/sites"}}},
+	{"/MICROSOFT.WEB/SITES/FUNCTIONS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_function_app_function", "/subscriptions/resourceGroups/Microsoft.Web/sites/functions"}}},
+	{"/MICROSOFT.WEB/SITES/HOSTNAMEBINDINGS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_app_service_custom_hostname_binding", "/subscriptions/resourceGroups/Microsoft.Web/sites/hostNameBindings"}}},
+	{"/MICROSOFT.WEB/SITES/HYBRIDCONNECTIONNAMESPACES/RELAYS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_function_app_hybrid_connection", "/subscriptions/resourceGroups/Microsoft.Web/sites/hybridConnectionNamespaces/relays"}, {"azurerm_web_app_hybrid_connection", "/subscriptions/resourceGroups/Microsoft.Web/sites/hybridConnectionNamespaces/relays"}}},
+	{"/MICROSOFT.WEB/SITES/NETWORKCONFIG", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_app_service_virtual_network_swift_connection", "/subscriptions/resourceGroups/Microsoft.Web/sites/config"}}},
+	{"/MICROSOFT.WEB/SITES/PUBLICCERTIFICATES", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_app_service_public_certificate", "/subscriptions/resourceGroups/Microsoft.Web/sites/publicCertificates"}}},
+	{"/MICROSOFT.WEB/SITES/SLOTS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_linux_function_app_slot", "/subscriptions/resourceGroups/Microsoft.Web/sites/slots"}, {"azurerm_linux_web_app_slot", "/subscriptions/resourceGroups/Microsoft.Web/sites/slots"}, {"azurerm_windows_function_app_slot", "/subscriptions/resourceGroups/Microsoft.Web/sites/slots"}, {"azurerm_windows_web_app_slot", "/subscriptions/resourceGroups/Microsoft.Web/sites/slots"}}},
+	{"/MICROSOFT.WEB/SITES/SLOTS/HOSTNAMEBINDINGS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_app_service_slot_custom_hostname_binding", "/subscriptions/resourceGroups/Microsoft.Web/sites/slots/hostNameBindings"}}},
+	{"/MICROSOFT.WEB/SITES/SLOTS/NETWORKCONFIG", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_app_service_slot_virtual_network_swift_connection", "/subscriptions/resourceGroups/Microsoft.Web/sites/slots/config"}}},
+	{"/MICROSOFT.WEB/SOURCECONTROLS", "/", []ARMId2TFMapItem{{"azurerm_source_control_token", "/Microsoft.Web/sourceControls"}}},
+	{"/MICROSOFT.WEB/STATICSITES", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_static_web_app", "/subscriptions/resourceGroups/Microsoft.Web/staticSites"}}},
+	{"/MICROSOFT.WEB/STATICSITES/CUSTOMDOMAINS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_static_web_app_custom_domain", "/subscriptions/resourceGroups/Microsoft.Web/staticSites/customDomains"}}},
+	{"/MICROSOFT.WEB/STATICSITES/USERPROVIDEDFUNCTIONAPPS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_static_web_app_function_app_registration", "/subscriptions/resourceGroups/Microsoft.Web/staticSites/userProvidedFunctionApps"}}},
+	{"/MICROSOFT.WORKLOADS/SAPVIRTUALINSTANCES", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_workloads_sap_discovery_virtual_instance", "/subscriptions/resourceGroups/Microsoft.Workloads/sapVirtualInstances"}, {"azurerm_workloads_sap_single_node_virtual_instance", "/subscriptions/resourceGroups/Microsoft.Workloads/sapVirtualInstances"}, {"azurerm_workloads_sap_three_tier_virtual_instance", "/subscriptions/resourceGroups/Microsoft.Workloads/sapVirtualInstances"}}},
+	{"/NEWRELIC.OBSERVABILITY/MONITORS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_new_relic_monitor", "/subscriptions/resourceGroups/NewRelic.Observability/monitors"}}},
+	{"/NEWRELIC.OBSERVABILITY/MONITORS/TAGRULES", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_new_relic_tag_rule", "/subscriptions/resourceGroups/NewRelic.Observability/monitors/tagRules"}}},
+	{"/NGINX.NGINXPLUS/NGINXDEPLOYMENTS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_nginx_deployment", "/subscriptions/resourceGroups/Nginx.NginxPlus/nginxDeployments"}}},
+	{"/NGINX.NGINXPLUS/NGINXDEPLOYMENTS/APIKEYS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_nginx_api_key", "/subscriptions/resourceGroups/Nginx.NginxPlus/nginxDeployments/apiKeys"}}},
+	{"/NGINX.NGINXPLUS/NGINXDEPLOYMENTS/CERTIFICATES", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_nginx_certificate", "/subscriptions/resourceGroups/Nginx.NginxPlus/nginxDeployments/certificates"}}},
+	{"/NGINX.NGINXPLUS/NGINXDEPLOYMENTS/CONFIGURATIONS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_nginx_configuration", "/subscriptions/resourceGroups/Nginx.NginxPlus/nginxDeployments/configurations"}}},
+	{"/ORACLE.DATABASE/AUTONOMOUSDATABASES", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_oracle_autonomous_database", "/subscriptions/resourceGroups/Oracle.Database/autonomousDatabases"}, {"azurerm_oracle_autonomous_database_clone_from_backup", "/subscriptions/resourceGroups/Oracle.Database/autonomousDatabases"}, {"azurerm_oracle_autonomous_database_clone_from_database", "/subscriptions/resourceGroups/Oracle.Database/autonomousDatabases"}}},
+	{"/ORACLE.DATABASE/AUTONOMOUSDATABASES/AUTONOMOUSDATABASEBACKUPS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_oracle_autonomous_database_backup", "/subscriptions/resourceGroups/Oracle.Database/autonomousDatabases/autonomousDatabaseBackups"}}},
+	{"/ORACLE.DATABASE/CLOUDEXADATAINFRASTRUCTURES", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_oracle_exadata_infrastructure", "/subscriptions/resourceGroups/Oracle.Database/cloudExadataInfrastructures"}}},
+	{"/ORACLE.DATABASE/CLOUDVMCLUSTERS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_oracle_cloud_vm_cluster", "/subscriptions/resourceGroups/Oracle.Database/cloudVmClusters"}}},
+	{"/ORACLE.DATABASE/EXASCALEDBSTORAGEVAULTS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_oracle_exascale_database_storage_vault", "/subscriptions/resourceGroups/Oracle.Database/exascaleDbStorageVaults"}}},
+	{"/ORACLE.DATABASE/RESOURCEANCHORS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_oracle_resource_anchor", "/subscriptions/resourceGroups/Oracle.Database/resourceAnchors"}}},
+	{"/PALOALTONETWORKS.CLOUDNGFW/FIREWALLS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_palo_alto_next_generation_firewall_virtual_hub_local_rulestack", "/subscriptions/resourceGroups/PaloAltoNetworks.Cloudngfw/firewalls"}, {"azurerm_palo_alto_next_generation_firewall_virtual_hub_panorama", "/subscriptions/resourceGroups/PaloAltoNetworks.Cloudngfw/firewalls"}, {"azurerm_palo_alto_next_generation_firewall_virtual_hub_strata_cloud_manager", "/subscriptions/resourceGroups/PaloAltoNetworks.Cloudngfw/firewalls"}, {"azurerm_palo_alto_next_generation_firewall_virtual_network_local_rulestack", "/subscriptions/resourceGroups/PaloAltoNetworks.Cloudngfw/firewalls"}, {"azurerm_palo_alto_next_generation_firewall_virtual_network_panorama", "/subscriptions/resourceGroups/PaloAltoNetworks.Cloudngfw/firewalls"}, {"azurerm_palo_alto_next_generation_firewall_virtual_network_strata_cloud_manager", "/subscriptions/resourceGroups/PaloAltoNetworks.Cloudngfw/firewalls"}}},
+	{"/PALOALTONETWORKS.CLOUDNGFW/LOCALRULESTACKS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_palo_alto_local_rulestack", "/subscriptions/resourceGroups/PaloAltoNetworks.Cloudngfw/localRulestacks"}}},
+	{"/PALOALTONETWORKS.CLOUDNGFW/LOCALRULESTACKS/CERTIFICATES", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_palo_alto_local_rulestack_certificate", "/subscriptions/resourceGroups/PaloAltoNetworks.Cloudngfw/localRulestacks/certificates"}}},
+	{"/PALOALTONETWORKS.CLOUDNGFW/LOCALRULESTACKS/FQDNLISTS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_palo_alto_local_rulestack_fqdn_list", "/subscriptions/resourceGroups/PaloAltoNetworks.Cloudngfw/localRulestacks/fqdnLists"}}},
+	{"/PALOALTONETWORKS.CLOUDNGFW/LOCALRULESTACKS/LOCALRULES", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_palo_alto_local_rulestack_rule", "/subscriptions/resourceGroups/PaloAltoNetworks.Cloudngfw/localRulestacks/localRules"}}},
+	{"/PALOALTONETWORKS.CLOUDNGFW/LOCALRULESTACKS/PREFIXLISTS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_palo_alto_local_rulestack_prefix_list", "/subscriptions/resourceGroups/PaloAltoNetworks.Cloudngfw/localRulestacks/prefixLists"}}},
+	{"/QUMULO.STORAGE/FILESYSTEMS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_qumulo_file_system", "/subscriptions/resourceGroups/Qumulo.Storage/fileSystems"}}},
+	{"/SUBSCRIPTIONS/RESOURCEGROUPS", "", []ARMId2TFMapItem{{"azurerm_resource_group", ""}}},
+	{"/{RESOURCEPROVIDERNAME}/FEATURES", "/SUBSCRIPTIONS/MICROSOFT.FEATURES", []ARMId2TFMapItem{{"azurerm_resource_provider_feature_registration", "/subscriptions/Microsoft.Features/{ResourceProviderName}/features"}}},
+}
+
+var removedARMId2TFTable = []armId2TFEntry{
+	{"/MICROSOFT.AAD/DOMAINSERVICES/TRUSTS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_active_directory_domain_service_trust", "/subscriptions/resourceGroups/Microsoft.AAD/domainServices/trusts"}}},
+	{"/MICROSOFT.APPPLATFORM/SPRING/APPS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_spring_cloud_active_deployment", "/subscriptions/resourceGroups/Microsoft.AppPlatform/spring/apps"}}},
+	{"/MICROSOFT.CACHE/REDISENTERPRISE", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_redis_enterprise_cluster", "/subscriptions/resourceGroups/Microsoft.Cache/redisEnterprise"}}},
+	{"/MICROSOFT.CACHE/REDISENTERPRISE/DATABASES", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_redis_enterprise_database", "/subscriptions/resourceGroups/Microsoft.Cache/redisEnterprise/databases"}}},
+	{"/MICROSOFT.COGNITIVESERVICES/ACCOUNTS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_ai_services", "/subscriptions/resourceGroups/Microsoft.CognitiveServices/accounts"}, {"azurerm_cognitive_account_customer_managed_key", "/subscriptions/resourceGroups/Microsoft.CognitiveServices/accounts"}}},
+	{"/MICROSOFT.COMPUTE/DISKS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_managed_disk_sas_token", "/subscriptions/resourceGroups/Microsoft.Compute/disks"}}},
+	{"/MICROSOFT.COMPUTE/VIRTUALMACHINESCALESETS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_virtual_machine_scale_set", "/subscriptions/resourceGroups/Microsoft.Compute/virtualMachineScaleSets"}}},
+	{"/MICROSOFT.DATABRICKS/WORKSPACES", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_databricks_workspace_root_dbfs_customer_managed_key", "/subscriptions/resourceGroups/Microsoft.Databricks/workspaces"}}},
+	{"/MICROSOFT.DATAFACTORY/FACTORIES", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_data_factory_customer_managed_key", "/subscriptions/resourceGroups/Microsoft.DataFactory/factories"}}},
+	{"/MICROSOFT.DATAPROTECTION/BACKUPVAULTS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_data_protection_backup_vault_customer_managed_key", "/subscriptions/resourceGroups/Microsoft.DataProtection/backupVaults"}}},
+	{"/MICROSOFT.DEVICES/IOTHUBS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_iothub_file_upload", "/subscriptions/resourceGroups/Microsoft.Devices/iotHubs"}}},
+	{"/MICROSOFT.EVENTHUB/NAMESPACES", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_eventhub_namespace_customer_managed_key", "/subscriptions/resourceGroups/Microsoft.EventHub/namespaces"}}},
+	{"/MICROSOFT.INSIGHTS/SCHEDULEDQUERYRULES", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_monitor_scheduled_query_rules_alert", "/subscriptions/resourceGroups/Microsoft.Insights/scheduledQueryRules"}, {"azurerm_monitor_scheduled_query_rules_log", "/subscriptions/resourceGroups/Microsoft.Insights/scheduledQueryRules"}}},
+	{"/MICROSOFT.KUSTO/CLUSTERS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_kusto_cluster_customer_managed_key", "/subscriptions/resourceGroups/Microsoft.Kusto/clusters"}}},
+	{"/MICROSOFT.OPERATIONALINSIGHTS/CLUSTERS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_log_analytics_cluster_customer_managed_key", "/subscriptions/resourceGroups/Microsoft.OperationalInsights/clusters"}}},
+	{"/MICROSOFT.SERVICEBUS/NAMESPACES", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_servicebus_namespace_customer_managed_key", "/subscriptions/resourceGroups/Microsoft.ServiceBus/namespaces"}}},
+	{"/MICROSOFT.SIGNALRSERVICE/SIGNALR", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_signalr_service_network_acl", "/subscriptions/resourceGroups/Microsoft.SignalRService/signalR"}}},
+	{"/MICROSOFT.SIGNALRSERVICE/WEBPUBSUB", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_web_pubsub_network_acl", "/subscriptions/resourceGroups/Microsoft.SignalRService/webPubSub"}}},
+	{"/MICROSOFT.STORAGE/STORAGEACCOUNTS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_storage_account_customer_managed_key", "/subscriptions/resourceGroups/Microsoft.Storage/storageAccounts"}, {"azurerm_storage_account_network_rules", "/subscriptions/resourceGroups/Microsoft.Storage/storageAccounts"}}},
+	{"/MICROSOFT.SYNAPSE/WORKSPACES/ADMINISTRATORS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_synapse_workspace_sql_aad_admin", "/subscriptions/resourceGroups/Microsoft.Synapse/workspaces/sqlAdministrators"}}},
+	{"/MICROSOFT.WEB/SERVERFARMS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_app_service_plan", "/subscriptions/resourceGroups/Microsoft.Web/serverFarms"}}},
+	{"/MICROSOFT.WEB/SITES", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_app_service", "/subscriptions/resourceGroups/Microsoft.Web/sites"}, {"azurerm_app_service_source_control", "/subscriptions/resourceGroups/Microsoft.Web/sites"}, {"azurerm_function_app", "/subscriptions/resourceGroups/Microsoft.Web/sites"}, {"azurerm_function_app_active_slot", "/subscriptions/resourceGroups/Microsoft.Web/sites"}, {"azurerm_web_app_active_slot", "/subscriptions/resourceGroups/Microsoft.Web/sites"}}},
+	{"/MICROSOFT.WEB/SITES/HYBRIDCONNECTIONNAMESPACES/RELAYS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_app_service_hybrid_connection", "/subscriptions/resourceGroups/Microsoft.Web/sites/hybridConnectionNamespaces/relays"}}},
+	{"/MICROSOFT.WEB/SITES/SLOTS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_app_service_slot", "/subscriptions/resourceGroups/Microsoft.Web/sites/slots"}, {"azurerm_app_service_source_control_slot", "/subscriptions/resourceGroups/Microsoft.Web/sites/slots"}, {"azurerm_function_app_slot", "/subscriptions/resourceGroups/Microsoft.Web/sites/slots"}}},
+	{"/MICROSOFT.WEB/STATICSITES", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_static_site", "/subscriptions/resourceGroups/Microsoft.Web/staticSites"}}},
+	{"/MICROSOFT.WEB/STATICSITES/CUSTOMDOMAINS", "/SUBSCRIPTIONS/RESOURCEGROUPS", []ARMId2TFMapItem{{"azurerm_static_site_custom_domain", "/subscriptions/resourceGroups/Microsoft.Web/staticSites/customDomains"}}},
+}
+
+var latestResourceTypes = []string{"azurerm_aadb2c_directory", "azurerm_active_directory_domain_service", "azurerm_active_directory_domain_service_replica_set", "azurerm_advanced_threat_protection", "azurerm_advisor_suppression", "azurerm_ai_foundry", "azurerm_ai_foundry_project", "azurerm_analysis_services_server", "azurerm_api_connection", "azurerm_api_management", "azurerm_api_management_api", "azurerm_api_management_api_diagnostic", "azurerm_api_management_api_operation", "azurerm_api_management_api_operation_policy", "azurerm_api_management_api_operation_tag", "azurerm_api_management_api_policy", "azurerm_api_management_api_release", "azurerm_api_management_api_schema", "azurerm_api_management_api_tag", "azurerm_api_management_api_tag_description", "azurerm_api_management_api_version_set", "azurerm_api_management_authorization_server", "azurerm_api_management_backend", "azurerm_api_management_certificate", "azurerm_api_management_custom_domain", "azurerm_api_management_diagnostic", "azurerm_api_management_email_template", "azurerm_api_management_gateway", "azurerm_api_management_gateway_api", "azurerm_api_management_gateway_certificate_authority", "azurerm_api_management_gateway_host_name_configuration", "azurerm_api_management_global_schema", "azurerm_api_management_group", "azurerm_api_management_group_user", "azurerm_api_management_identity_provider_aad", "azurerm_api_management_identity_provider_aadb2c", "azurerm_api_management_identity_provider_facebook", "azurerm_api_management_identity_provider_google", "azurerm_api_management_identity_provider_microsoft", "azurerm_api_management_identity_provider_twitter", "azurerm_api_management_logger", "azurerm_api_management_named_value", "azurerm_api_management_notification_recipient_email", "azurerm_api_management_notification_recipient_user", "azurerm_api_management_openid_connect_provider", "azurerm_api_management_policy", "azurerm_api_management_policy_fragment", "azurerm_api_management_product", "azurerm_api_management_product_api", "azurerm_api_management_product_group", "azurerm_api_management_product_policy", "azurerm_api_management_product_tag", "azurerm_api_management_redis_cache", "azurerm_api_management_standalone_gateway", "azurerm_api_management_subscription", "azurerm_api_management_tag", "azurerm_api_management_user", "azurerm_api_management_workspace", "azurerm_api_management_workspace_api_version_set", "azurerm_api_management_workspace_certificate", "azurerm_api_management_workspace_named_value", "azurerm_api_management_workspace_policy", "azurerm_api_management_workspace_policy_fragment", "azurerm_app_configuration", "azurerm_app_configuration_feature", "azurerm_app_configuration_key", "azurerm_app_service_certificate", "azurerm_app_service_certificate_order", "azurerm_app_service_connection", "azurerm_app_service_custom_hostname_binding", "azurerm_app_service_environment_v3", "azurerm_app_service_managed_certificate", "azurerm_app_service_public_certificate", "azurerm_app_service_slot_custom_hostname_binding", "azurerm_app_service_slot_virtual_network_swift_connection", "azurerm_app_service_virtual_network_swift_connection", "azurerm_application_gateway", "azurerm_application_insights", "azurerm_application_insights_analytics_item", "azurerm_application_insights_api_key", "azurerm_application_insights_smart_detection_rule", "azurerm_application_insights_standard_web_test", "azurerm_application_insights_web_test", "azurerm_application_insights_workbook", "azurerm_application_insights_workbook_template", "azurerm_application_load_balancer", "azurerm_application_load_balancer_frontend", "azurerm_application_load_balancer_security_policy", "azurerm_application_load_balancer_subnet_association", "azurerm_application_security_group", "azurerm_arc_kubernetes_cluster", "azurerm_arc_kubernetes_cluster_extension", "azurerm_arc_kubernetes_flux_configuration", "azurerm_arc_kubernetes_provisioned_cluster", "azurerm_arc_machine", "azurerm_arc_machine_automanage_configuration_assignment", "azurerm_arc_machine_extension", "azurerm_arc_private_link_scope", "azurerm_arc_resource_bridge_appliance", "azurerm_attestation_provider", "azurerm_automanage_configuration", "azurerm_automation_account", "azurerm_automation_certificate", "azurerm_automation_connection", "azurerm_automation_connection_certificate", "azurerm_automation_connection_classic_certificate", "azurerm_automation_connection_service_principal", "azurerm_automation_connection_type", "azurerm_automation_credential", "azurerm_automation_dsc_configuration", "azurerm_automation_dsc_nodeconfiguration", "azurerm_automation_hybrid_runbook_worker", "azurerm_automation_hybrid_runbook_worker_group", "azurerm_automation_job_schedule", "azurerm_automation_module", "azurerm_automation_powershell72_module", "azurerm_automation_python3_package", "azurerm_automation_runbook", "azurerm_automation_runtime_environment", "azurerm_automation_runtime_environment_package", "azurerm_automation_schedule", "azurerm_automation_software_update_configuration", "azurerm_automation_source_control", "azurerm_automation_variable_bool", "azurerm_automation_variable_datetime", "azurerm_automation_variable_int", "azurerm_automation_variable_object", "azurerm_automation_variable_string", "azurerm_automation_watcher", "azurerm_automation_webhook", "azurerm_availability_set", "azurerm_backup_container_storage_account", "azurerm_backup_policy_file_share", "azurerm_backup_policy_vm", "azurerm_backup_policy_vm_workload", "azurerm_backup_protected_file_share", "azurerm_backup_protected_vm", "azurerm_bastion_host", "azurerm_batch_account", "azurerm_batch_application", "azurerm_batch_certificate", "azurerm_batch_job", "azurerm_batch_pool", "azurerm_billing_account_cost_management_export", "azurerm_blueprint_assignment", "azurerm_bot_channel_alexa", "azurerm_bot_channel_direct_line_speech", "azurerm_bot_channel_directline", "azurerm_bot_channel_email", "azurerm_bot_channel_facebook", "azurerm_bot_channel_line", "azurerm_bot_channel_ms_teams", "azurerm_bot_channel_slack", "azurerm_bot_channel_sms", "azurerm_bot_channel_web_chat", "azurerm_bot_channels_registration", "azurerm_bot_connection", "azurerm_bot_service_azure_bot", "azurerm_bot_web_app", "azurerm_capacity_reservation", "azurerm_capacity_reservation_group", "azurerm_cdn_endpoint", "azurerm_cdn_endpoint_custom_domain", "azurerm_cdn_frontdoor_batch_rule_set", "azurerm_cdn_frontdoor_custom_domain", "azurerm_cdn_frontdoor_custom_domain_association", "azurerm_cdn_frontdoor_endpoint", "azurerm_cdn_frontdoor_firewall_policy", "azurerm_cdn_frontdoor_origin", "azurerm_cdn_frontdoor_origin_group", "azurerm_cdn_frontdoor_profile", "azurerm_cdn_frontdoor_route", "azurerm_cdn_frontdoor_rule", "azurerm_cdn_frontdoor_rule_set", "azurerm_cdn_frontdoor_secret", "azurerm_cdn_frontdoor_security_policy", "azurerm_cdn_profile", "azurerm_chaos_studio_capability", "azurerm_chaos_studio_experiment", "azurerm_chaos_studio_target", "azurerm_cognitive_account", "azurerm_cognitive_account_connection_account_key", "azurerm_cognitive_account_connection_account_managed_identity", "azurerm_cognitive_account_connection_api_key", "azurerm_cognitive_account_connection_custom_keys", "azurerm_cognitive_account_connection_entra_id", "azurerm_cognitive_account_project", "azurerm_cognitive_account_rai_blocklist", "azurerm_cognitive_account_rai_policy", "azurerm_cognitive_deployment", "azurerm_communication_service", "azurerm_confidential_ledger", "azurerm_consumption_budget_management_group", "azurerm_consumption_budget_resource_group", "azurerm_consumption_budget_subscription", "azurerm_container_app", "azurerm_container_app_custom_domain", "azurerm_container_app_environment", "azurerm_container_app_environment_certificate", "azurerm_container_app_environment_custom_domain", "azurerm_container_app_environment_dapr_component", "azurerm_container_app_environment_managed_certificate", "azurerm_container_app_environment_storage", "azurerm_container_app_job", "azurerm_container_connected_registry", "azurerm_container_group", "azurerm_container_registry", "azurerm_container_registry_agent_pool", "azurerm_container_registry_cache_rule", "azurerm_container_registry_credential_set", "azurerm_container_registry_scope_map", "azurerm_container_registry_task", "azurerm_container_registry_token", "azurerm_container_registry_token_password", "azurerm_container_registry_webhook", "azurerm_cosmosdb_account", "azurerm_cosmosdb_cassandra_cluster", "azurerm_cosmosdb_cassandra_datacenter", "azurerm_cosmosdb_cassandra_keyspace", "azurerm_cosmosdb_cassandra_table", "azurerm_cosmosdb_gremlin_database", "azurerm_cosmosdb_gremlin_graph", "azurerm_cosmosdb_mongo_collection", "azurerm_cosmosdb_mongo_database", "azurerm_cosmosdb_mongo_role_definition", "azurerm_cosmosdb_mongo_user_definition", "azurerm_cosmosdb_postgresql_cluster", "azurerm_cosmosdb_postgresql_coordinator_configuration", "azurerm_cosmosdb_postgresql_firewall_rule", "azurerm_cosmosdb_postgresql_node_configuration", "azurerm_cosmosdb_postgresql_role", "azurerm_cosmosdb_sql_container", "azurerm_cosmosdb_sql_database", "azurerm_cosmosdb_sql_dedicated_gateway", "azurerm_cosmosdb_sql_function", "azurerm_cosmosdb_sql_role_assignment", "azurerm_cosmosdb_sql_role_definition", "azurerm_cosmosdb_sql_stored_procedure", "azurerm_cosmosdb_sql_trigger", "azurerm_cosmosdb_table", "azurerm_cost_anomaly_alert", "azurerm_cost_management_scheduled_action", "azurerm_custom_ip_prefix", "azurerm_custom_provider", "azurerm_dashboard_grafana", "azurerm_dashboard_grafana_managed_private_endpoint", "azurerm_data_factory", "azurerm_data_factory_credential_service_principal", "azurerm_data_factory_credential_user_managed_identity", "azurerm_data_factory_custom_dataset", "azurerm_data_factory_data_flow", "azurerm_data_factory_dataset_azure_blob", "azurerm_data_factory_dataset_azure_sql_table", "azurerm_data_factory_dataset_binary", "azurerm_data_factory_dataset_cosmosdb_sqlapi", "azurerm_data_factory_dataset_delimited_text", "azurerm_data_factory_dataset_http", "azurerm_data_factory_dataset_json", "azurerm_data_factory_dataset_mysql", "azurerm_data_factory_dataset_parquet", "azurerm_data_factory_dataset_postgresql", "azurerm_data_factory_dataset_snowflake", "azurerm_data_factory_dataset_sql_server_table", "azurerm_data_factory_flowlet_data_flow", "azurerm_data_factory_integration_runtime_azure", "azurerm_data_factory_integration_runtime_azure_ssis", "azurerm_data_factory_integration_runtime_self_hosted", "azurerm_data_factory_linked_custom_service", "azurerm_data_factory_linked_service_azure_blob_storage", "azurerm_data_factory_linked_service_azure_databricks", "azurerm_data_factory_linked_service_azure_file_storage", "azurerm_data_factory_linked_service_azure_function", "azurerm_data_factory_linked_service_azure_search", "azurerm_data_factory_linked_service_azure_sql_database", "azurerm_data_factory_linked_service_azure_table_storage", "azurerm_data_factory_linked_service_cosmosdb", "azurerm_data_factory_linked_service_cosmosdb_mongoapi", "azurerm_data_factory_linked_service_data_lake_storage_gen2", "azurerm_data_factory_linked_service_key_vault", "azurerm_data_factory_linked_service_kusto", "azurerm_data_factory_linked_service_mysql", "azurerm_data_factory_linked_service_odata", "azurerm_data_factory_linked_service_odbc", "azurerm_data_factory_linked_service_postgresql", "azurerm_data_factory_linked_service_sftp", "azurerm_data_factory_linked_service_snowflake", "azurerm_data_factory_linked_service_sql_managed_instance", "azurerm_data_factory_linked_service_sql_server", "azurerm_data_factory_linked_service_synapse", "azurerm_data_factory_linked_service_web", "azurerm_data_factory_managed_private_endpoint", "azurerm_data_factory_pipeline", "azurerm_data_factory_trigger_blob_event", "azurerm_data_factory_trigger_custom_event", "azurerm_data_factory_trigger_schedule", "azurerm_data_factory_trigger_tumbling_window", "azurerm_data_protection_backup_instance_blob_storage", "azurerm_data_protection_backup_instance_data_lake_storage", "azurerm_data_protection_backup_instance_disk", "azurerm_data_protection_backup_instance_kubernetes_cluster", "azurerm_data_protection_backup_instance_mysql_flexible_server", "azurerm_data_protection_backup_instance_postgresql", "azurerm_data_protection_backup_instance_postgresql_flexible_server", "azurerm_data_protection_backup_policy_blob_storage", "azurerm_data_protection_backup_policy_data_lake_storage", "azurerm_data_protection_backup_policy_disk", "azurerm_data_protection_backup_policy_kubernetes_cluster", "azurerm_data_protection_backup_policy_mysql_flexible_server", "azurerm_data_protection_backup_policy_postgresql", "azurerm_data_protection_backup_policy_postgresql_flexible_server", "azurerm_data_protection_backup_vault", "azurerm_data_protection_resource_guard", "azurerm_data_share", "azurerm_data_share_account", "azurerm_data_share_dataset_blob_storage", "azurerm_data_share_dataset_data_lake_gen2", "azurerm_data_share_dataset_kusto_cluster", "azurerm_data_share_dataset_kusto_database", "azurerm_database_migration_project", "azurerm_database_migration_service", "azurerm_databox_edge_device", "azurerm_databricks_access_connector", "azurerm_databricks_virtual_network_peering", "azurerm_databricks_workspace", "azurerm_datadog_monitor", "azurerm_datadog_monitor_sso_configuration", "azurerm_datadog_monitor_tag_rule", "azurerm_dedicated_hardware_security_module", "azurerm_dedicated_host", "azurerm_dedicated_host_group", "azurerm_dev_center", "azurerm_dev_center_attached_network", "azurerm_dev_center_catalog", "azurerm_dev_center_dev_box_definition", "azurerm_dev_center_environment_type", "azurerm_dev_center_gallery", "azurerm_dev_center_network_connection", "azurerm_dev_center_project", "azurerm_dev_center_project_environment_type", "azurerm_dev_center_project_pool", "azurerm_dev_test_global_vm_shutdown_schedule", "azurerm_dev_test_lab", "azurerm_dev_test_linux_virtual_machine", "azurerm_dev_test_policy", "azurerm_dev_test_schedule", "azurerm_dev_test_virtual_network", "azurerm_dev_test_windows_virtual_machine", "azurerm_digital_twins_endpoint_eventgrid", "azurerm_digital_twins_endpoint_eventhub", "azurerm_digital_twins_endpoint_servicebus", "azurerm_digital_twins_instance", "azurerm_digital_twins_time_series_database_connection", "azurerm_disk_access", "azurerm_disk_encryption_set", "azurerm_dns_a_record", "azurerm_dns_aaaa_record", "azurerm_dns_caa_record", "azurerm_dns_cname_record", "azurerm_dns_mx_record", "azurerm_dns_ns_record", "azurerm_dns_ptr_record", "azurerm_dns_srv_record", "azurerm_dns_txt_record", "azurerm_dns_zone", "azurerm_dynatrace_tag_rules", "azurerm_elastic_cloud_elasticsearch", "azurerm_elastic_san", "azurerm_elastic_san_volume", "azurerm_elastic_san_volume_group", "azurerm_email_communication_service", "azurerm_email_communication_service_domain", "azurerm_email_communication_service_domain_sender_username", "azurerm_eventgrid_domain", "azurerm_eventgrid_domain_topic", "azurerm_eventgrid_event_subscription", "azurerm_eventgrid_namespace", "azurerm_eventgrid_namespace_topic", "azurerm_eventgrid_partner_configuration", "azurerm_eventgrid_partner_namespace", "azurerm_eventgrid_partner_registration", "azurerm_eventgrid_system_topic", "azurerm_eventgrid_system_topic_event_subscription", "azurerm_eventgrid_topic", "azurerm_eventhub", "azurerm_eventhub_authorization_rule", "azurerm_eventhub_cluster", "azurerm_eventhub_consumer_group", "azurerm_eventhub_namespace", "azurerm_eventhub_namespace_authorization_rule", "azurerm_eventhub_namespace_disaster_recovery_config", "azurerm_eventhub_namespace_schema_group", "azurerm_express_route_circuit", "azurerm_express_route_circuit_authorization", "azurerm_express_route_circuit_connection", "azurerm_express_route_circuit_peering", "azurerm_express_route_connection", "azurerm_express_route_gateway", "azurerm_express_route_port", "azurerm_express_route_port_authorization", "azurerm_extended_location_custom_location", "azurerm_fabric_capacity", "azurerm_federated_identity_credential", "azurerm_firewall", "azurerm_firewall_application_rule_collection", "azurerm_firewall_nat_rule_collection", "azurerm_firewall_network_rule_collection", "azurerm_firewall_policy", "azurerm_firewall_policy_rule_collection_group", "azurerm_fluid_relay_server", "azurerm_frontdoor", "azurerm_frontdoor_custom_https_configuration", "azurerm_frontdoor_firewall_policy", "azurerm_frontdoor_rules_engine", "azurerm_function_app_connection", "azurerm_function_app_flex_consumption", "azurerm_function_app_function", "azurerm_function_app_hybrid_connection", "azurerm_gallery_application", "azurerm_gallery_application_version", "azurerm_graph_services_account", "azurerm_hdinsight_hadoop_cluster", "azurerm_hdinsight_hbase_cluster", "azurerm_hdinsight_interactive_query_cluster", "azurerm_hdinsight_kafka_cluster", "azurerm_hdinsight_spark_cluster", "azurerm_healthbot", "azurerm_healthcare_dicom_service", "azurerm_healthcare_fhir_service", "azurerm_healthcare_medtech_service", "azurerm_healthcare_medtech_service_fhir_destination", "azurerm_healthcare_service", "azurerm_healthcare_workspace", "azurerm_hpc_cache", "azurerm_hpc_cache_access_policy", "azurerm_hpc_cache_blob_nfs_target", "azurerm_hpc_cache_blob_target", "azurerm_hpc_cache_nfs_target", "azurerm_image", "azurerm_iot_security_device_group", "azurerm_iot_security_solution", "azurerm_iotcentral_application", "azurerm_iotcentral_application_network_rule_set", "azurerm_iotcentral_organization", "azurerm_iothub", "azurerm_iothub_certificate", "azurerm_iothub_consumer_group", "azurerm_iothub_device_update_account", "azurerm_iothub_device_update_instance", "azurerm_iothub_dps", "azurerm_iothub_dps_certificate", "azurerm_iothub_dps_shared_access_policy", "azurerm_iothub_endpoint_cosmosdb_account", "azurerm_iothub_endpoint_eventhub", "azurerm_iothub_endpoint_servicebus_queue", "azurerm_iothub_endpoint_servicebus_topic", "azurerm_iothub_endpoint_storage_container", "azurerm_iothub_enrichment", "azurerm_iothub_fallback_route", "azurerm_iothub_route", "azurerm_iothub_shared_access_policy", "azurerm_ip_group", "azurerm_ip_group_cidr", "azurerm_key_vault", "azurerm_key_vault_access_policy", "azurerm_key_vault_certificate", "azurerm_key_vault_certificate_contacts", "azurerm_key_vault_certificate_issuer", "azurerm_key_vault_key", "azurerm_key_vault_managed_hardware_security_module", "azurerm_key_vault_managed_storage_account", "azurerm_key_vault_managed_storage_account_sas_token_definition", "azurerm_key_vault_secret", "azurerm_kubernetes_automatic_cluster", "azurerm_kubernetes_cluster", "azurerm_kubernetes_cluster_deployment_safeguard", "azurerm_kubernetes_cluster_extension", "azurerm_kubernetes_cluster_node_pool", "azurerm_kubernetes_cluster_trusted_access_role_binding", "azurerm_kubernetes_fleet_manager", "azurerm_kubernetes_fleet_member", "azurerm_kubernetes_fleet_update_run", "azurerm_kubernetes_fleet_update_strategy", "azurerm_kubernetes_flux_configuration", "azurerm_kusto_attached_database_configuration", "azurerm_kusto_cluster", "azurerm_kusto_cluster_managed_private_endpoint", "azurerm_kusto_cluster_principal_assignment", "azurerm_kusto_cosmosdb_data_connection", "azurerm_kusto_database", "azurerm_kusto_database_principal_assignment", "azurerm_kusto_eventgrid_data_connection", "azurerm_kusto_eventhub_data_connection", "azurerm_kusto_iothub_data_connection", "azurerm_kusto_script", "azurerm_lb", "azurerm_lb_backend_address_pool", "azurerm_lb_backend_address_pool_address", "azurerm_lb_nat_pool", "azurerm_lb_nat_rule", "azurerm_lb_outbound_rule", "azurerm_lb_probe", "azurerm_lb_rule", "azurerm_lighthouse_assignment", "azurerm_lighthouse_definition", "azurerm_linux_function_app", "azurerm_linux_function_app_slot", "azurerm_linux_virtual_machine", "azurerm_linux_virtual_machine_scale_set", "azurerm_linux_web_app", "azurerm_linux_web_app_slot", "azurerm_load_test", "azurerm_local_network_gateway", "azurerm_log_analytics_cluster", "azurerm_log_analytics_data_export_rule", "azurerm_log_analytics_datasource_windows_event", "azurerm_log_analytics_datasource_windows_performance_counter", "azurerm_log_analytics_linked_service", "azurerm_log_analytics_linked_storage_account", "azurerm_log_analytics_query_pack", "azurerm_log_analytics_query_pack_query", "azurerm_log_analytics_saved_search", "azurerm_log_analytics_solution", "azurerm_log_analytics_storage_insights", "azurerm_log_analytics_workspace", "azurerm_log_analytics_workspace_table_custom_log", "azurerm_log_analytics_workspace_table_microsoft", "azurerm_logic_app_action_custom", "azurerm_logic_app_action_http", "azurerm_logic_app_integration_account", "azurerm_logic_app_integration_account_agreement", "azurerm_logic_app_integration_account_assembly", "azurerm_logic_app_integration_account_batch_configuration", "azurerm_logic_app_integration_account_certificate", "azurerm_logic_app_integration_account_map", "azurerm_logic_app_integration_account_partner", "azurerm_logic_app_integration_account_schema", "azurerm_logic_app_integration_account_session", "azurerm_logic_app_standard", "azurerm_logic_app_trigger_custom", "azurerm_logic_app_trigger_http_request", "azurerm_logic_app_trigger_recurrence", "azurerm_logic_app_workflow", "azurerm_machine_learning_compute_cluster", "azurerm_machine_learning_compute_instance", "azurerm_machine_learning_datastore_blobstorage", "azurerm_machine_learning_datastore_datalake_gen2", "azurerm_machine_learning_datastore_fileshare", "azurerm_machine_learning_inference_cluster", "azurerm_machine_learning_synapse_spark", "azurerm_machine_learning_workspace", "azurerm_machine_learning_workspace_network_outbound_rule_fqdn", "azurerm_machine_learning_workspace_network_outbound_rule_private_endpoint", "azurerm_machine_learning_workspace_network_outbound_rule_service_tag", "azurerm_maintenance_assignment_dedicated_host", "azurerm_maintenance_assignment_dynamic_scope", "azurerm_maintenance_assignment_virtual_machine", "azurerm_maintenance_assignment_virtual_machine_scale_set", "azurerm_maintenance_configuration", "azurerm_managed_application", "azurerm_managed_application_definition", "azurerm_managed_devops_pool", "azurerm_managed_disk", "azurerm_managed_lustre_file_system", "azurerm_managed_redis", "azurerm_managed_redis_access_policy_assignment", "azurerm_managed_redis_geo_replication", "azurerm_management_group", "azurerm_management_group_policy_assignment", "azurerm_management_group_policy_exemption", "azurerm_management_group_policy_remediation", "azurerm_management_group_policy_set_definition", "azurerm_management_group_template_deployment", "azurerm_management_lock", "azurerm_maps_account", "azurerm_maps_creator", "azurerm_marketplace_agreement", "azurerm_marketplace_role_assignment", "azurerm_mongo_cluster", "azurerm_mongo_cluster_firewall_rule", "azurerm_mongo_cluster_user", "azurerm_monitor_aad_diagnostic_setting", "azurerm_monitor_action_group", "azurerm_monitor_activity_log_alert", "azurerm_monitor_alert_processing_rule_action_group", "azurerm_monitor_alert_processing_rule_suppression", "azurerm_monitor_alert_prometheus_rule_group", "azurerm_monitor_autoscale_setting", "azurerm_monitor_data_collection_endpoint", "azurerm_monitor_data_collection_rule", "azurerm_monitor_data_collection_rule_association", "azurerm_monitor_diagnostic_setting", "azurerm_monitor_metric_alert", "azurerm_monitor_private_link_scope", "azurerm_monitor_private_link_scoped_service", "azurerm_monitor_scheduled_query_rules_alert_v2", "azurerm_monitor_smart_detector_alert_rule", "azurerm_monitor_workspace", "azurerm_mssql_database", "azurerm_mssql_database_extended_auditing_policy", "azurerm_mssql_database_vulnerability_assessment_rule_baseline", "azurerm_mssql_elasticpool", "azurerm_mssql_failover_group", "azurerm_mssql_firewall_rule", "azurerm_mssql_job", "azurerm_mssql_job_agent", "azurerm_mssql_job_credential", "azurerm_mssql_job_schedule", "azurerm_mssql_job_step", "azurerm_mssql_job_target_group", "azurerm_mssql_managed_database", "azurerm_mssql_managed_instance", "azurerm_mssql_managed_instance_active_directory_administrator", "azurerm_mssql_managed_instance_failover_group", "azurerm_mssql_managed_instance_security_alert_policy", "azurerm_mssql_managed_instance_start_stop_schedule", "azurerm_mssql_managed_instance_transparent_data_encryption", "azurerm_mssql_managed_instance_vulnerability_assessment", "azurerm_mssql_outbound_firewall_rule", "azurerm_mssql_server", "azurerm_mssql_server_dns_alias", "azurerm_mssql_server_extended_auditing_policy", "azurerm_mssql_server_microsoft_support_auditing_policy", "azurerm_mssql_server_security_alert_policy", "azurerm_mssql_server_transparent_data_encryption", "azurerm_mssql_server_vulnerability_assessment", "azurerm_mssql_virtual_machine", "azurerm_mssql_virtual_machine_availability_group_listener", "azurerm_mssql_virtual_machine_group", "azurerm_mssql_virtual_network_rule", "azurerm_mysql_flexible_database", "azurerm_mysql_flexible_server", "azurerm_mysql_flexible_server_active_directory_administrator", "azurerm_mysql_flexible_server_configuration", "azurerm_mysql_flexible_server_firewall_rule", "azurerm_nat_gateway", "azurerm_nat_gateway_public_ip_association", "azurerm_nat_gateway_public_ip_prefix_association", "azurerm_netapp_account", "azurerm_netapp_account_encryption", "azurerm_netapp_backup_policy", "azurerm_netapp_backup_vault", "azurerm_netapp_pool", "azurerm_netapp_snapshot", "azurerm_netapp_snapshot_policy", "azurerm_netapp_volume", "azurerm_netapp_volume_bucket", "azurerm_netapp_volume_bucket_with_server", "azurerm_netapp_volume_group_oracle", "azurerm_netapp_volume_group_sap_hana", "azurerm_netapp_volume_quota_rule", "azurerm_network_connection_monitor", "azurerm_network_ddos_protection_plan", "azurerm_network_function_azure_traffic_collector", "azurerm_network_function_collector_policy", "azurerm_network_interface", "azurerm_network_interface_application_gateway_backend_address_pool_association", "azurerm_network_interface_application_security_group_association", "azurerm_network_interface_backend_address_pool_association", "azurerm_network_interface_nat_rule_association", "azurerm_network_interface_security_group_association", "azurerm_network_manager", "azurerm_network_manager_admin_rule", "azurerm_network_manager_admin_rule_collection", "azurerm_network_manager_connectivity_configuration", "azurerm_network_manager_deployment", "azurerm_network_manager_ipam_pool", "azurerm_network_manager_ipam_pool_static_cidr", "azurerm_network_manager_management_group_connection", "azurerm_network_manager_network_group", "azurerm_network_manager_routing_configuration", "azurerm_network_manager_routing_rule", "azurerm_network_manager_routing_rule_collection", "azurerm_network_manager_scope_connection", "azurerm_network_manager_security_admin_configuration", "azurerm_network_manager_static_member", "azurerm_network_manager_subscription_connection", "azurerm_network_manager_verifier_workspace", "azurerm_network_manager_verifier_workspace_reachability_analysis_intent", "azurerm_network_profile", "azurerm_network_security_group", "azurerm_network_security_perimeter", "azurerm_network_security_perimeter_access_rule", "azurerm_network_security_perimeter_association", "azurerm_network_security_perimeter_profile", "azurerm_network_security_rule", "azurerm_network_watcher", "azurerm_network_watcher_flow_log", "azurerm_new_relic_monitor", "azurerm_new_relic_tag_rule", "azurerm_nginx_api_key", "azurerm_nginx_certificate", "azurerm_nginx_configuration", "azurerm_nginx_deployment", "azurerm_notification_hub", "azurerm_notification_hub_authorization_rule", "azurerm_notification_hub_namespace", "azurerm_oracle_autonomous_database", "azurerm_oracle_autonomous_database_backup", "azurerm_oracle_autonomous_database_clone_from_backup", "azurerm_oracle_autonomous_database_clone_from_database", "azurerm_oracle_cloud_vm_cluster", "azurerm_oracle_exadata_infrastructure", "azurerm_oracle_exascale_database_storage_vault", "azurerm_oracle_resource_anchor", "azurerm_orbital_contact", "azurerm_orbital_contact_profile", "azurerm_orbital_spacecraft", "azurerm_orchestrated_virtual_machine_scale_set", "azurerm_palo_alto_local_rulestack", "azurerm_palo_alto_local_rulestack_certificate", "azurerm_palo_alto_local_rulestack_fqdn_list", "azurerm_palo_alto_local_rulestack_prefix_list", "azurerm_palo_alto_local_rulestack_rule", "azurerm_palo_alto_next_generation_firewall_virtual_hub_local_rulestack", "azurerm_palo_alto_next_generation_firewall_virtual_hub_panorama", "azurerm_palo_alto_next_generation_firewall_virtual_hub_strata_cloud_manager", "azurerm_palo_alto_next_generation_firewall_virtual_network_local_rulestack", "azurerm_palo_alto_next_generation_firewall_virtual_network_panorama", "azurerm_palo_alto_next_generation_firewall_virtual_network_strata_cloud_manager", "azurerm_palo_alto_virtual_network_appliance", "azurerm_point_to_site_vpn_gateway", "azurerm_policy_definition", "azurerm_policy_set_definition", "azurerm_policy_virtual_machine_configuration_assignment", "azurerm_portal_dashboard", "azurerm_portal_tenant_configuration", "azurerm_postgresql_active_directory_administrator", "azurerm_postgresql_configuration", "azurerm_postgresql_database", "azurerm_postgresql_firewall_rule", "azurerm_postgresql_flexible_server", "azurerm_postgresql_flexible_server_active_directory_administrator", "azurerm_postgresql_flexible_server_backup", "azurerm_postgresql_flexible_server_configuration", "azurerm_postgresql_flexible_server_database", "azurerm_postgresql_flexible_server_firewall_rule", "azurerm_postgresql_flexible_server_virtual_endpoint", "azurerm_postgresql_server", "azurerm_postgresql_server_key", "azurerm_postgresql_virtual_network_rule", "azurerm_powerbi_embedded", "azurerm_private_dns_a_record", "azurerm_private_dns_aaaa_record", "azurerm_private_dns_cname_record", "azurerm_private_dns_mx_record", "azurerm_private_dns_ptr_record", "azurerm_private_dns_resolver", "azurerm_private_dns_resolver_dns_forwarding_ruleset", "azurerm_private_dns_resolver_forwarding_rule", "azurerm_private_dns_resolver_inbound_endpoint", "azurerm_private_dns_resolver_outbound_endpoint", "azurerm_private_dns_resolver_virtual_network_link", "azurerm_private_dns_srv_record", "azurerm_private_dns_txt_record", "azurerm_private_dns_zone", "azurerm_private_dns_zone_virtual_network_link", "azurerm_private_endpoint", "azurerm_private_link_service", "azurerm_proximity_placement_group", "azurerm_public_ip", "azurerm_public_ip_prefix", "azurerm_purview_account", "azurerm_qumulo_file_system", "azurerm_recovery_services_vault", "azurerm_recovery_services_vault_resource_guard_association", "azurerm_redhat_openshift_cluster", "azurerm_redis_cache", "azurerm_redis_cache_access_policy", "azurerm_redis_cache_access_policy_assignment", "azurerm_redis_firewall_rule", "azurerm_redis_linked_server", "azurerm_relay_hybrid_connection", "azurerm_relay_hybrid_connection_authorization_rule", "azurerm_relay_namespace", "azurerm_relay_namespace_authorization_rule", "azurerm_resource_deployment_script_azure_cli", "azurerm_resource_deployment_script_azure_power_shell", "azurerm_resource_group", "azurerm_resource_group_cost_management_export", "azurerm_resource_group_cost_management_view", "azurerm_resource_group_policy_assignment", "azurerm_resource_group_policy_exemption", "azurerm_resource_group_policy_remediation", "azurerm_resource_group_template_deployment", "azurerm_resource_management_private_link", "azurerm_resource_management_private_link_association", "azurerm_resource_policy_assignment", "azurerm_resource_policy_exemption", "azurerm_resource_policy_remediation", "azurerm_resource_provider_feature_registration", "azurerm_resource_provider_registration", "azurerm_role_assignment", "azurerm_role_definition", "azurerm_role_management_policy", "azurerm_route", "azurerm_route_filter", "azurerm_route_map", "azurerm_route_server", "azurerm_route_server_bgp_connection", "azurerm_route_table", "azurerm_search_service", "azurerm_search_shared_private_link_service", "azurerm_security_center_assessment", "azurerm_security_center_assessment_policy", "azurerm_security_center_auto_provisioning", "azurerm_security_center_automation", "azurerm_security_center_contact", "azurerm_security_center_server_vulnerability_assessment_virtual_machine", "azurerm_security_center_server_vulnerability_assessments_setting", "azurerm_security_center_setting", "azurerm_security_center_storage_defender", "azurerm_security_center_subscription_pricing", "azurerm_security_center_workspace", "azurerm_sentinel_alert_rule_anomaly_built_in", "azurerm_sentinel_alert_rule_anomaly_duplicate", "azurerm_sentinel_alert_rule_fusion", "azurerm_sentinel_alert_rule_machine_learning_behavior_analytics", "azurerm_sentinel_alert_rule_ms_security_incident", "azurerm_sentinel_alert_rule_nrt", "azurerm_sentinel_alert_rule_scheduled", "azurerm_sentinel_alert_rule_threat_intelligence", "azurerm_sentinel_automation_rule", "azurerm_sentinel_data_connector_aws_cloud_trail", "azurerm_sentinel_data_connector_aws_s3", "azurerm_sentinel_data_connector_azure_active_directory", "azurerm_sentinel_data_connector_azure_advanced_threat_protection", "azurerm_sentinel_data_connector_azure_security_center", "azurerm_sentinel_data_connector_dynamics_365", "azurerm_sentinel_data_connector_iot", "azurerm_sentinel_data_connector_microsoft_cloud_app_security", "azurerm_sentinel_data_connector_microsoft_defender_advanced_threat_protection", "azurerm_sentinel_data_connector_microsoft_threat_intelligence", "azurerm_sentinel_data_connector_microsoft_threat_protection", "azurerm_sentinel_data_connector_office_365", "azurerm_sentinel_data_connector_office_365_project", "azurerm_sentinel_data_connector_office_atp", "azurerm_sentinel_data_connector_office_irm", "azurerm_sentinel_data_connector_office_power_bi", "azurerm_sentinel_data_connector_threat_intelligence", "azurerm_sentinel_data_connector_threat_intelligence_taxii", "azurerm_sentinel_log_analytics_workspace_onboarding", "azurerm_sentinel_metadata", "azurerm_sentinel_threat_intelligence_indicator", "azurerm_sentinel_watchlist", "azurerm_sentinel_watchlist_item", "azurerm_service_fabric_cluster", "azurerm_service_fabric_managed_cluster", "azurerm_service_plan", "azurerm_servicebus_namespace", "azurerm_servicebus_namespace_authorization_rule", "azurerm_servicebus_namespace_disaster_recovery_config", "azurerm_servicebus_queue", "azurerm_servicebus_queue_authorization_rule", "azurerm_servicebus_subscription", "azurerm_servicebus_subscription_rule", "azurerm_servicebus_topic", "azurerm_servicebus_topic_authorization_rule", "azurerm_shared_image", "azurerm_shared_image_gallery", "azurerm_shared_image_version", "azurerm_signalr_service", "azurerm_signalr_service_custom_certificate", "azurerm_signalr_service_custom_domain", "azurerm_signalr_shared_private_link_resource", "azurerm_site_recovery_fabric", "azurerm_site_recovery_hyperv_network_mapping", "azurerm_site_recovery_hyperv_replication_policy", "azurerm_site_recovery_hyperv_replication_policy_association", "azurerm_site_recovery_network_mapping", "azurerm_site_recovery_protection_container", "azurerm_site_recovery_protection_container_mapping", "azurerm_site_recovery_replicated_vm", "azurerm_site_recovery_replication_policy", "azurerm_site_recovery_replication_recovery_plan", "azurerm_site_recovery_services_vault_hyperv_site", "azurerm_site_recovery_vmware_replicated_vm", "azurerm_site_recovery_vmware_replication_policy", "azurerm_site_recovery_vmware_replication_policy_association", "azurerm_snapshot", "azurerm_source_control_token", "azurerm_spring_cloud_accelerator", "azurerm_spring_cloud_api_portal", "azurerm_spring_cloud_api_portal_custom_domain", "azurerm_spring_cloud_app", "azurerm_spring_cloud_app_cosmosdb_association", "azurerm_spring_cloud_app_dynamics_application_performance_monitoring", "azurerm_spring_cloud_app_mysql_association", "azurerm_spring_cloud_app_redis_association", "azurerm_spring_cloud_application_insights_application_performance_monitoring", "azurerm_spring_cloud_application_live_view", "azurerm_spring_cloud_build_deployment", "azurerm_spring_cloud_build_pack_binding", "azurerm_spring_cloud_builder", "azurerm_spring_cloud_certificate", "azurerm_spring_cloud_configuration_service", "azurerm_spring_cloud_connection", "azurerm_spring_cloud_container_deployment", "azurerm_spring_cloud_custom_domain", "azurerm_spring_cloud_customized_accelerator", "azurerm_spring_cloud_dev_tool_portal", "azurerm_spring_cloud_dynatrace_application_performance_monitoring", "azurerm_spring_cloud_elastic_application_performance_monitoring", "azurerm_spring_cloud_gateway", "azurerm_spring_cloud_gateway_custom_domain", "azurerm_spring_cloud_gateway_route_config", "azurerm_spring_cloud_java_deployment", "azurerm_spring_cloud_new_relic_application_performance_monitoring", "azurerm_spring_cloud_service", "azurerm_spring_cloud_storage", "azurerm_ssh_public_key", "azurerm_stack_hci_cluster", "azurerm_stack_hci_deployment_setting", "azurerm_stack_hci_extension", "azurerm_stack_hci_logical_network", "azurerm_stack_hci_marketplace_gallery_image", "azurerm_stack_hci_network_interface", "azurerm_stack_hci_storage_path", "azurerm_stack_hci_virtual_hard_disk", "azurerm_static_web_app", "azurerm_static_web_app_custom_domain", "azurerm_static_web_app_function_app_registration", "azurerm_storage_account", "azurerm_storage_account_local_user", "azurerm_storage_account_queue_properties", "azurerm_storage_account_static_website", "azurerm_storage_account_table_properties", "azurerm_storage_blob", "azurerm_storage_blob_inventory_policy", "azurerm_storage_container", "azurerm_storage_container_immutability_policy", "azurerm_storage_data_lake_gen2_filesystem", "azurerm_storage_data_lake_gen2_path", "azurerm_storage_encryption_scope", "azurerm_storage_management_policy", "azurerm_storage_mover", "azurerm_storage_mover_agent", "azurerm_storage_mover_job_definition", "azurerm_storage_mover_project", "azurerm_storage_mover_source_endpoint", "azurerm_storage_mover_target_endpoint", "azurerm_storage_object_replication", "azurerm_storage_queue", "azurerm_storage_share", "azurerm_storage_share_directory", "azurerm_storage_share_file", "azurerm_storage_sync", "azurerm_storage_sync_cloud_endpoint", "azurerm_storage_sync_group", "azurerm_storage_sync_server_endpoint", "azurerm_storage_table", "azurerm_storage_table_entity", "azurerm_stream_analytics_cluster", "azurerm_stream_analytics_function_javascript_uda", "azurerm_stream_analytics_function_javascript_udf", "azurerm_stream_analytics_job", "azurerm_stream_analytics_job_schedule", "azurerm_stream_analytics_job_storage_account", "azurerm_stream_analytics_managed_private_endpoint", "azurerm_stream_analytics_output_blob", "azurerm_stream_analytics_output_cosmosdb", "azurerm_stream_analytics_output_eventhub", "azurerm_stream_analytics_output_function", "azurerm_stream_analytics_output_mssql", "azurerm_stream_analytics_output_powerbi", "azurerm_stream_analytics_output_servicebus_queue", "azurerm_stream_analytics_output_servicebus_topic", "azurerm_stream_analytics_output_synapse", "azurerm_stream_analytics_output_table", "azurerm_stream_analytics_reference_input_blob", "azurerm_stream_analytics_reference_input_mssql", "azurerm_stream_analytics_stream_input_blob", "azurerm_stream_analytics_stream_input_eventhub", "azurerm_stream_analytics_stream_input_eventhub_v2", "azurerm_stream_analytics_stream_input_iothub", "azurerm_subnet", "azurerm_subnet_nat_gateway_association", "azurerm_subnet_network_security_group_association", "azurerm_subnet_route_table_association", "azurerm_subnet_service_endpoint_storage_policy", "azurerm_subscription", "azurerm_subscription_cost_management_export", "azurerm_subscription_cost_management_view", "azurerm_subscription_policy_assignment", "azurerm_subscription_policy_exemption", "azurerm_subscription_policy_remediation", "azurerm_subscription_template_deployment", "azurerm_synapse_firewall_rule", "azurerm_synapse_integration_runtime_azure", "azurerm_synapse_integration_runtime_self_hosted", "azurerm_synapse_linked_service", "azurerm_synapse_managed_private_endpoint", "azurerm_synapse_private_link_hub", "azurerm_synapse_role_assignment", "azurerm_synapse_spark_pool", "azurerm_synapse_sql_pool", "azurerm_synapse_sql_pool_extended_auditing_policy", "azurerm_synapse_sql_pool_security_alert_policy", "azurerm_synapse_sql_pool_vulnerability_assessment", "azurerm_synapse_sql_pool_vulnerability_assessment_baseline", "azurerm_synapse_sql_pool_workload_classifier", "azurerm_synapse_sql_pool_workload_group", "azurerm_synapse_workspace", "azurerm_synapse_workspace_aad_admin", "azurerm_synapse_workspace_extended_auditing_policy", "azurerm_synapse_workspace_key", "azurerm_synapse_workspace_security_alert_policy", "azurerm_synapse_workspace_vulnerability_assessment", "azurerm_system_center_virtual_machine_manager_availability_set", "azurerm_system_center_virtual_machine_manager_cloud", "azurerm_system_center_virtual_machine_manager_server", "azurerm_system_center_virtual_machine_manager_virtual_machine_instance", "azurerm_system_center_virtual_machine_manager_virtual_machine_instance_guest_agent", "azurerm_system_center_virtual_machine_manager_virtual_machine_template", "azurerm_system_center_virtual_machine_manager_virtual_network", "azurerm_tenant_template_deployment", "azurerm_traffic_manager_azure_endpoint", "azurerm_traffic_manager_external_endpoint", "azurerm_traffic_manager_nested_endpoint", "azurerm_traffic_manager_profile", "azurerm_trusted_signing_account", "azurerm_user_assigned_identity", "azurerm_video_indexer_account", "azurerm_virtual_desktop_application", "azurerm_virtual_desktop_application_group", "azurerm_virtual_desktop_host_pool", "azurerm_virtual_desktop_host_pool_registration_info", "azurerm_virtual_desktop_scaling_plan", "azurerm_virtual_desktop_workspace", "azurerm_virtual_desktop_workspace_application_group_association", "azurerm_virtual_hub", "azurerm_virtual_hub_bgp_connection", "azurerm_virtual_hub_connection", "azurerm_virtual_hub_ip", "azurerm_virtual_hub_route_table", "azurerm_virtual_hub_route_table_route", "azurerm_virtual_hub_routing_intent", "azurerm_virtual_hub_security_partner_provider", "azurerm_virtual_machine", "azurerm_virtual_machine_automanage_configuration_assignment", "azurerm_virtual_machine_data_disk_attachment", "azurerm_virtual_machine_extension", "azurerm_virtual_machine_implicit_data_disk_from_source", "azurerm_virtual_machine_packet_capture", "azurerm_virtual_machine_restore_point", "azurerm_virtual_machine_restore_point_collection", "azurerm_virtual_machine_run_command", "azurerm_virtual_machine_scale_set_extension", "azurerm_virtual_machine_scale_set_packet_capture", "azurerm_virtual_machine_scale_set_standby_pool", "azurerm_virtual_network", "azurerm_virtual_network_dns_servers", "azurerm_virtual_network_gateway", "azurerm_virtual_network_gateway_connection", "azurerm_virtual_network_gateway_nat_rule", "azurerm_virtual_network_peering", "azurerm_virtual_wan", "azurerm_vmware_cluster", "azurerm_vmware_express_route_authorization", "azurerm_vmware_netapp_volume_attachment", "azurerm_vmware_private_cloud", "azurerm_vpn_gateway", "azurerm_vpn_gateway_connection", "azurerm_vpn_gateway_nat_rule", "azurerm_vpn_server_configuration", "azurerm_vpn_server_configuration_policy_group", "azurerm_vpn_site", "azurerm_web_app_hybrid_connection", "azurerm_web_application_firewall_policy", "azurerm_web_pubsub", "azurerm_web_pubsub_custom_certificate", "azurerm_web_pubsub_custom_domain", "azurerm_web_pubsub_hub", "azurerm_web_pubsub_shared_private_link_resource", "azurerm_web_pubsub_socketio", "azurerm_windows_function_app", "azurerm_windows_function_app_slot", "azurerm_windows_virtual_machine", "azurerm_windows_virtual_machine_scale_set", "azurerm_windows_web_app", "azurerm_windows_web_app_slot", "azurerm_workloads_sap_discovery_virtual_instance", "azurerm_workloads_sap_single_node_virtual_instance", "azurerm_workloads_sap_three_tier_virtual_instance", "fake_azurerm_application_gateway_backend_address_pool", "fake_azurerm_network_interface_ipconfig"}
@@ -0,0 +1,113 @@
+package resolve
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/magodo/armid"
+	"github.com/magodo/aztft/internal/client"
+	"github.com/magodo/aztft/internal/errs"
+)
+
+// bodyResolver is a resolver that resolves from the resource body (i.e. the GET response) alone, which can also be a row of the Azure Resource Graph.
+// Rather than a single resource type, it explains how each of the resource types is ruled in or out by the body.
+type bodyResolver interface {
+	resolver
+
+	// Get retrieves the resource body.
+	Get(ctx context.Context, b *client.ClientBuilder, id armid.ResourceId) (interface{}, error)
+
+	// BodyKeys returns the top level properties of the resource body that the resolution depends on.
+	BodyKeys() []string
+
+	// Explain returns a candidate for each of the resource types, where at most one of them is matched by the body.
+	// The body has (at least) the top level properties returned by BodyKeys.
+	Explain(body interface{}) []Candidate
+}
+
+// Candidate is one of the TF resource types that a resolver chooses from, together with the evidence of the resource that it has checked.
+type Candidate struct {
+	ResourceType string
+
+	// Evidence is the checked resource properties, e.g. "kind=functionapp,linux".
+	Evidence []string
+
+	// Matched indicates whether the candidate is ruled in by the evidence.
+	Matched bool
+
+	// Reason tells why the candidate is ruled in or out.
+	Reason string
+}
+
+// NoMatchError is returned when none of the candidates is ruled in by the resource body.
+type NoMatchError struct {
+	Candidates []Candidate
+}
+
+func (e *NoMatchError) Error() string {
+	var l []string
+	for _, c := range e.Candidates {
+		l = append(l, fmt.Sprintf("%s (%s)", c.ResourceType, c.Reason))
+	}
+	return "no candidate matches the resource: " + strings.Join(l, ", ")
+}
+
+func (e *NoMatchError) Is(target error) bool { return target == errs.ErrUnknownKind }
+
+// resolveBody returns the resource type of the first matched candidate explained by the resolver, together with all the candidates.
+func resolveBody(r bodyResolver, body interface{}) (string, []Candidate, error) {
+	candidates := r.Explain(body)
+	for _, c := range candidates {
+		if c.Matched {
+			return c.ResourceType, candidates, nil
+		}
+	}
+	return "", candidates, &NoMatchError{Candidates: candidates}
+}
+
+// resolveById retrieves the resource body, then resolves it. This implements the Resolve of the body resolvers.
+func resolveById(ctx context.Context, b *client.ClientBuilder, id armid.ResourceId, r bodyResolver) (string, error) {
+	body, err := r.Get(ctx, b, id)
+	if err != nil {
+		return "", err
+	}
+	rt, _, err := resolveBody(r, body)
+	return rt, err
+}
+
+// getBody retrieves the resource body with the API version.
+func getBody(ctx context.Context, b *client.ClientBuilder, id armid.ResourceId, apiVersion string) (interface{}, error) {
+	c, err := b.NewRawClient()
+	if err != nil {
+		return nil, err
+	}
+	resp, err := c.Get(ctx, id.String(), apiVersion)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %q: %w", id, err)
+	}
+	return resp, nil
+}
+
+// bodyEvidence returns the evidence of the value at the member keys of the body, in form of "<keys>=<value>".
+// The object and array values are denoted as "<present>", and the absent (or null) values as "<absent>".
+func bodyEvidence(body interface{}, keys ...string) string {
+	v, ok := lookupKeys(body, keys...)
+	return strings.Join(keys, ".") + "=" + evidenceValue(v, ok)
+}
+
+func evidenceValue(v interface{}, ok bool) string {
+	if !ok || v == nil {
+		return "<absent>"
+	}
+	switch v := v.(type) {
+	case string:
+		return v
+	case map[string]interface{}, []interface{}:
+		return "<present>"
+	default:
+		b, _ := json.Marshal(v)
+		return string(b)
+	}
+}
@@ -0,0 +1,144 @@
+package resolve
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/arm"
+	"github.com/magodo/armid"
+	"github.com/magodo/aztft/internal/client"
+)
+
+// prefetchChunkSize is the max number of resource ids in a single Azure Resource Graph query, which keeps the query text within the size limit.
+const prefetchChunkSize = 200
+
+// resourceGraphColumns are the top level properties of a resource, which are also the columns of the Azure Resource Graph "resources" table.
+var resourceGraphColumns = map[string]bool{
+	"name":       true,
+	"type":       true,
+	"kind":       true,
+	"location":   true,
+	"sku":        true,
+	"identity":   true,
+	"tags":       true,
+	"properties": true,
+}
+
+// Prefetched is the resource bodies retrieved in bulk by Prefetch, keyed by the upper cased resource id.
+type Prefetched map[string]interface{}
+
+func (p Prefetched) body(id armid.ResourceId) (interface{}, bool) {
+	body, ok := p[strings.ToUpper(id.String())]
+	return body, ok
+}
+
+// Has tells whether the body of the resource is prefetched.
+func (p Prefetched) Has(id armid.ResourceId) bool {
+	_, ok := p.body(id)
+	return ok
+}
+
+// Prefetch retrieves the bodies of the resources among the ids in bulk via Azure Resource Graph queries (one per chunk of ids), instead of one GET per resource.
+// Only the resources that need the API to resolve, and whose resolver can resolve from the Azure Resource Graph row, are queried.
+// The resources that are not indexed by Azure Resource Graph are absent in the result, which are left to ResolvePrefetched to GET them one by one.
+func Prefetch(ctx context.Context, ids []armid.ResourceId, cred azcore.TokenCredential, clientOpt arm.ClientOptions) (Prefetched, error) {
+	var (
+		subs   []string
+		subSet = map[string]bool{}
+		keySet = map[string]bool{}
+		idSet  = map[string]bool{}
+		qids   []string
+	)
+	for _, id := range ids {
+		r, ok := getResolver(id)
+		if !ok {
+			continue
+		}
+		br, ok := r.(bodyResolver)
+		if !ok || !inResourceGraph(br) {
+			continue
+		}
+		var sub string
+		switch root := id.RootScope().(type) {
+		case *armid.SubscriptionId:
+			sub = root.Id
+		case *armid.ResourceGroup:
+			sub = root.SubscriptionId
+		default:
+			continue
+		}
+		if idSet[strings.ToUpper(id.String())] {
+			continue
+		}
+		idSet[strings.ToUpper(id.String())] = true
+		qids = append(qids, id.String())
+		if !subSet[strings.ToLower(sub)] {
+			subSet[strings.ToLower(sub)] = true
+			subs = append(subs, sub)
+		}
+		for _, k := range br.BodyKeys() {
+			keySet[k] = true
+		}
+	}
+
+	p := Prefetched{}
+	if len(qids) == 0 {
+		return p, nil
+	}
+
+	var keys []string
+	for k := range keySet {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	b := &client.ClientBuilder{Cred: cred, ClientOpt: clientOpt}
+	c, err := b.NewResourceGraphClient()
+	if err != nil {
+		return nil, err
+	}
+	for start := 0; start < len(qids); start += prefetchChunkSize {
+		end := start + prefetchChunkSize
+		if end > len(qids) {
+			end = len(qids)
+		}
+		rows, err := c.Query(ctx, subs, resourceGraphQuery(qids[start:end], keys))
+		if err != nil {
+			return nil, fmt.Errorf("querying Azure Resource Graph: %w", err)
+		}
+		for _, row := range rows {
+			m, ok := row.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			id, ok := m["id"].(string)
+			if !ok {
+				continue
+			}
+			p[strings.ToUpper(id)] = row
+		}
+	}
+	return p, nil
+}
+
+// inResourceGraph tells whether the body keys of the resolver are all available as the Azure Resource Graph columns.
+func inResourceGraph(r bodyResolver) bool {
+	for _, k := range r.BodyKeys() {
+		if !resourceGraphColumns[k] {
+			return false
+		}
+	}
+	return true
+}
+
+// resourceGraphQuery returns the KQL query that selects the resources by ids, together with the columns.
+func resourceGraphQuery(ids []string, columns []string) string {
+	quoted := make([]string, 0, len(ids))
+	for _, id := range ids {
+		quoted = append(quoted, "'"+strings.ReplaceAll(id, "'", `\'`)+"'")
+	}
+	return fmt.Sprintf("resources | where id in~ (%s) | project %s", strings.Join(quoted, ", "), strings.Join(append([]string{"id"}, columns...), ", "))
+}
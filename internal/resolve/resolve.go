@@ -1,6 +1,7 @@
 package resolve
 
 import (
+	"context"
 	"fmt"
 	"strings"
 
@@ -8,10 +9,11 @@ import (
 	"github.com/Azure/azure-sdk-for-go/sdk/azcore/arm"
 	"github.com/magodo/armid"
 	"github.com/magodo/aztft/internal/client"
+	"github.com/magodo/aztft/internal/errs"
 )
 
 type resolver interface {
-	Resolve(*client.ClientBuilder, armid.ResourceId) (string, error)
+	Resolve(context.Context, *client.ClientBuilder, armid.ResourceId) (string, error)
 	ResourceTypes() []string
 }
 
@@ -43,12 +45,6 @@ var Resolvers = map[string]map[string]resolver{
 	"/MICROSOFT.DATAPROTECTION/BACKUPVAULTS/BACKUPINSTANCES": {
 		"/SUBSCRIPTIONS/RESOURCEGROUPS": dataProtectionBackupInstancesResolver{},
 	},
-	"/MICROSOFT.SYNAPSE/WORKSPACES/INTEGRATIONRUNTIMES": {
-		"/SUBSCRIPTIONS/RESOURCEGROUPS": synapseIntegrationRuntimesResolver{},
-	},
-	"/MICROSOFT.DIGITALTWINS/DIGITALTWINSINSTANCES/ENDPOINTS": {
-		"/SUBSCRIPTIONS/RESOURCEGROUPS": digitalTwinsEndpointsResolver{},
-	},
 	"/MICROSOFT.DATAFACTORY/FACTORIES/TRIGGERS": {
 		"/SUBSCRIPTIONS/RESOURCEGROUPS": dataFactoryTriggersResolver{},
 	},
@@ -67,9 +63,6 @@ var Resolvers = map[string]map[string]resolver{
 	"/MICROSOFT.DATAFACTORY/FACTORIES/CREDENTIALS": {
 		"/SUBSCRIPTIONS/RESOURCEGROUPS": dataFactoryCredentialsResolver{},
 	},
-	"/MICROSOFT.KUSTO/CLUSTERS/DATABASES/DATACONNECTIONS": {
-		"/SUBSCRIPTIONS/RESOURCEGROUPS": kustoDataConnectionsResolver{},
-	},
 	"/MICROSOFT.MACHINELEARNINGSERVICES/WORKSPACES/COMPUTES": {
 		"/SUBSCRIPTIONS/RESOURCEGROUPS": machineLearningComputesResolver{},
 	},
@@ -79,21 +72,12 @@ var Resolvers = map[string]map[string]resolver{
 	"/MICROSOFT.MACHINELEARNINGSERVICES/WORKSPACES/OUTBOUNDRULES": {
 		"/SUBSCRIPTIONS/RESOURCEGROUPS": machineLearningOutboundRulesResolver{},
 	},
-	"/MICROSOFT.STORAGECACHE/CACHES/STORAGETARGETS": {
-		"/SUBSCRIPTIONS/RESOURCEGROUPS": storageCacheTargetsResolver{},
-	},
 	"/MICROSOFT.AUTOMATION/AUTOMATIONACCOUNTS/CONNECTIONS": {
 		"/SUBSCRIPTIONS/RESOURCEGROUPS": automationConnectionsResolver{},
 	},
 	"/MICROSOFT.AUTOMATION/AUTOMATIONACCOUNTS/VARIABLES": {
 		"/SUBSCRIPTIONS/RESOURCEGROUPS": automationVariablesResolver{},
 	},
-	"/MICROSOFT.BOTSERVICE/BOTSERVICES": {
-		"/SUBSCRIPTIONS/RESOURCEGROUPS": botServiceBotsResolver{},
-	},
-	"/MICROSOFT.BOTSERVICE/BOTSERVICES/CHANNELS": {
-		"/SUBSCRIPTIONS/RESOURCEGROUPS": botServiceChannelsResolver{},
-	},
 	"/MICROSOFT.SECURITYINSIGHTS/DATACONNECTORS": {
 		"/SUBSCRIPTIONS/RESOURCEGROUPS/MICROSOFT.OPERATIONALINSIGHTS/WORKSPACES": securityInsightsDataConnectorsResolver{},
 	},
@@ -112,9 +96,6 @@ var Resolvers = map[string]map[string]resolver{
 	"/MICROSOFT.APPPLATFORM/SPRING/APPS/DEPLOYMENTS": {
 		"/SUBSCRIPTIONS/RESOURCEGROUPS": appPlatformDeploymentsResolver{},
 	},
-	"/MICROSOFT.DATASHARE/ACCOUNTS/SHARES/DATASETS": {
-		"/SUBSCRIPTIONS/RESOURCEGROUPS": datashareDatasetsResolver{},
-	},
 	"/MICROSOFT.HDINSIGHT/CLUSTERS": {
 		"/SUBSCRIPTIONS/RESOURCEGROUPS": hdInsightClustersResolver{},
 	},
@@ -127,9 +108,6 @@ var Resolvers = map[string]map[string]resolver{
 	"/MICROSOFT.STREAMANALYTICS/STREAMINGJOBS/FUNCTIONS": {
 		"/SUBSCRIPTIONS/RESOURCEGROUPS": streamAnalyticsFunctionsResolver{},
 	},
-	"/MICROSOFT.CDN/PROFILES": {
-		"/SUBSCRIPTIONS/RESOURCEGROUPS": cdnProfilesResolver{},
-	},
 	"/MICROSOFT.WEB/CERTIFICATES": {
 		"/SUBSCRIPTIONS/RESOURCEGROUPS": appServiceCertificatesResolver{},
 	},
@@ -142,24 +120,15 @@ var Resolvers = map[string]map[string]resolver{
 	"/MICROSOFT.WEB/SITES/HYBRIDCONNECTIONNAMESPACES/RELAYS": {
 		"/SUBSCRIPTIONS/RESOURCEGROUPS": appServiceSiteHybridConnectionsResolver{},
 	},
-	"/MICROSOFT.ALERTSMANAGEMENT/ACTIONRULES": {
-		"/SUBSCRIPTIONS/RESOURCEGROUPS": alertsManagementProcessingRulesResolver{},
-	},
 	"/MICROSOFT.NETWORK/VIRTUALHUBS": {
 		"/SUBSCRIPTIONS/RESOURCEGROUPS": virtualHubsResolver{},
 	},
 	"/MICROSOFT.NETWORK/VIRTUALHUBS/BGPCONNECTIONS": {
 		"/SUBSCRIPTIONS/RESOURCEGROUPS": virtualHubBgpConnectionsResolver{},
 	},
-	"/MICROSOFT.NETWORK/FRONTDOORWEBAPPLICATIONFIREWALLPOLICIES": {
-		"/SUBSCRIPTIONS/RESOURCEGROUPS": frontdoorPoliciesResolver{},
-	},
 	"/MICROSOFT.NETWORK/NETWORKWATCHERS/PACKETCAPTURES": {
 		"/SUBSCRIPTIONS/RESOURCEGROUPS": networkPacketCaptureResolver{},
 	},
-	"/MICROSOFT.RESOURCES/DEPLOYMENTSCRIPTS": {
-		"/SUBSCRIPTIONS/RESOURCEGROUPS": deploymentScriptsResolver{},
-	},
 	"/MICROSOFT.RECOVERYSERVICES/VAULTS/REPLICATIONPOLICIES": {
 		"/SUBSCRIPTIONS/RESOURCEGROUPS": siteRecoveryReplicationPoliciesResolver{},
 	},
@@ -172,30 +141,15 @@ var Resolvers = map[string]map[string]resolver{
 	"/MICROSOFT.RECOVERYSERVICES/VAULTS/REPLICATIONFABRICS/REPLICATIONNETWORKS/REPLICATIONNETWORKMAPPINGS": {
 		"/SUBSCRIPTIONS/RESOURCEGROUPS": siteRecoveryReplicationNetworkMappingResolver{},
 	},
-	"/MICROSOFT.STORAGEMOVER/STORAGEMOVERS/ENDPOINTS": {
-		"/SUBSCRIPTIONS/RESOURCEGROUPS": storageMoverEndpointsResolver{},
-	},
-	"/MICROSOFT.COSTMANAGEMENT/SCHEDULEDACTIONS": {
-		"/SUBSCRIPTIONS": costmanagementScheduleActionsResolver{},
-	},
-	"/MICROSOFT.INSIGHTS/WEBTESTS": {
-		"/SUBSCRIPTIONS/RESOURCEGROUPS": applicationInsightsWebTestsResolver{},
-	},
 	"/MICROSOFT.LOGIC/WORKFLOWS/ACTIONS": {
 		"/SUBSCRIPTIONS/RESOURCEGROUPS": logicAppAction{},
 	},
 	"/MICROSOFT.LOGIC/WORKFLOWS/TRIGGERS": {
 		"/SUBSCRIPTIONS/RESOURCEGROUPS": logicAppTrigger{},
 	},
-	"/PALOALTONETWORKS.CLOUDNGFW/FIREWALLS": {
-		"/SUBSCRIPTIONS/RESOURCEGROUPS": paloalToNetworkFirewall{},
-	},
 	"/MICROSOFT.SERVICELINKER/LINKERS": {
 		"/SUBSCRIPTIONS/RESOURCEGROUPS/MICROSOFT.WEB/SITES": serviceConnectorAppServiceResolver{},
 	},
-	"/MICROSOFT.APPPLATFORM/SPRING/APMS": {
-		"/SUBSCRIPTIONS/RESOURCEGROUPS": springApmsResolver{},
-	},
 	"/MICROSOFT.WORKLOADS/SAPVIRTUALINSTANCES": {
 		"/SUBSCRIPTIONS/RESOURCEGROUPS": sapVirtualInstancesResolver{},
 	},
@@ -211,24 +165,9 @@ var Resolvers = map[string]map[string]resolver{
 	"/MICROSOFT.MACHINELEARNINGSERVICES/WORKSPACES": {
 		"/SUBSCRIPTIONS/RESOURCEGROUPS": machineLearningWorkspaceResolver{},
 	},
-	"/MICROSOFT.SIGNALRSERVICE/WEBPUBSUB": {
-		"/SUBSCRIPTIONS/RESOURCEGROUPS": webPubSubResolver{},
-	},
-	"/ORACLE.DATABASE/AUTONOMOUSDATABASES": {
-		"/SUBSCRIPTIONS/RESOURCEGROUPS": oracleAutonomousDatabaseResolver{},
-	},
 	"/MICROSOFT.NETAPP/NETAPPACCOUNTS/CAPACITYPOOLS/VOLUMES/BUCKETS": {
 		"/SUBSCRIPTIONS/RESOURCEGROUPS": netappBucketResolver{},
 	},
-	"/MICROSOFT.CONTAINERSERVICE/MANAGEDCLUSTERS": {
-		"/SUBSCRIPTIONS/RESOURCEGROUPS": kubernetesClusterResolver{},
-	},
-	"/MICROSOFT.CDN/PROFILES/RULESETS": {
-		"/SUBSCRIPTIONS/RESOURCEGROUPS": cdnRuleSetsResolver{},
-	},
-	"/MICROSOFT.COGNITIVESERVICES/ACCOUNTS/CONNECTIONS": {
-		"/SUBSCRIPTIONS/RESOURCEGROUPS": cognitiveAccountConnectionResolver{},
-	},
 	"/MICROSOFT.OPERATIONALINSIGHTS/WORKSPACES/TABLES": {
 		"/SUBSCRIPTIONS/RESOURCEGROUPS": operationalInsightsTableResolver{},
 	},
@@ -239,12 +178,17 @@ type ResolveError struct {
 	Err        error
 }
 
-func (e ResolveError) Error() string {
+func (e *ResolveError) Error() string {
 	return e.ResourceId.String() + ": " + e.Err.Error()
 }
 
 func (e *ResolveError) Unwrap() error { return e.Err }
 
+// unknownKindError returns the error that the resource has an unknown kind (or type, etc.) to the resolver, which is an ErrUnknownKind.
+func unknownKindError(format string, a ...interface{}) error {
+	return errs.Mark(errs.ErrUnknownKind, format, a...)
+}
+
 func getResolver(id armid.ResourceId) (resolver, bool) {
 	routeKey := strings.ToUpper(id.RouteScopeString())
 	var parentScopeKey string
@@ -259,23 +203,61 @@ func getResolver(id armid.ResourceId) (resolver, bool) {
 	return resolver, ok
 }
 
+// ResolverName returns the name of the resolver for the id, e.g. "virtualMachinesResolver", or "the resolver rules" for the rule based ones.
+func ResolverName(id armid.ResourceId) (string, bool) {
+	r, ok := getResolver(id)
+	if !ok {
+		return "", false
+	}
+	if rs, ok := r.(ruleSet); ok {
+		return fmt.Sprintf("the resolver rules (api-version %s)", rs.APIVersion), true
+	}
+	return strings.TrimPrefix(fmt.Sprintf("%T", r), "resolve."), true
+}
+
 func NeedsAPI(id armid.ResourceId) bool {
 	_, ok := getResolver(id)
 	return ok
 }
 
 // Resolve resolves a given resource id via Azure API to disambiguate and return a single matched TF resource type.
-func Resolve(id armid.ResourceId, cred azcore.TokenCredential, clientOpt arm.ClientOptions) (string, error) {
+func Resolve(ctx context.Context, id armid.ResourceId, cred azcore.TokenCredential, clientOpt arm.ClientOptions) (string, error) {
+	rt, _, err := ResolvePrefetched(ctx, id, nil, cred, clientOpt)
+	return rt, err
+}
+
+// ResolvePrefetched is similar to Resolve, except it firstly tries to resolve from the prefetched body of the resource (see Prefetch), if any.
+// It falls back to retrieve the resource via Azure API, if the resource is not prefetched, or the prefetched body can't be resolved.
+// For the resolvers that resolve from the resource body, it also returns the candidates explained by the resolver, even if the resolution fails.
+func ResolvePrefetched(ctx context.Context, id armid.ResourceId, prefetched Prefetched, cred azcore.TokenCredential, clientOpt arm.ClientOptions) (string, []Candidate, error) {
 	// Ensure the API client can be built.
 	b := &client.ClientBuilder{Cred: cred, ClientOpt: clientOpt}
 
 	resolver, ok := getResolver(id)
 	if !ok {
-		return "", ResolveError{ResourceId: id, Err: fmt.Errorf("no resolver found for %q", id)}
+		return "", nil, &ResolveError{ResourceId: id, Err: fmt.Errorf("no resolver found for %q", id)}
+	}
+	br, ok := resolver.(bodyResolver)
+	if !ok {
+		rt, err := resolver.Resolve(ctx, b, id)
+		if err != nil {
+			return "", nil, &ResolveError{ResourceId: id, Err: fmt.Errorf("resolving %q: %w", id, err)}
+		}
+		return rt, nil, nil
+	}
+
+	if body, ok := prefetched.body(id); ok {
+		if rt, candidates, err := resolveBody(br, body); err == nil {
+			return rt, candidates, nil
+		}
+	}
+	body, err := br.Get(ctx, b, id)
+	if err != nil {
+		return "", nil, &ResolveError{ResourceId: id, Err: fmt.Errorf("resolving %q: %w", id, err)}
 	}
-	rt, err := resolver.Resolve(b, id)
+	rt, candidates, err := resolveBody(br, body)
 	if err != nil {
-		return "", ResolveError{ResourceId: id, Err: fmt.Errorf("resolving %q: %v", id, err)}
+		return "", candidates, &ResolveError{ResourceId: id, Err: fmt.Errorf("resolving %q: %w", id, err)}
 	}
-	return rt, nil
+	return rt, candidates, nil
 }
@@ -1,7 +1,7 @@
 package resolve
 
 import (
-	"fmt"
+	"context"
 	"strings"
 
 	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/apimanagement/armapimanagement/v3"
@@ -22,7 +22,7 @@ func (apiManagementIdentitiesResolver) ResourceTypes() []string {
 	}
 }
 
-func (apiManagementIdentitiesResolver) Resolve(b *client.ClientBuilder, id armid.ResourceId) (string, error) {
+func (apiManagementIdentitiesResolver) Resolve(ctx context.Context, b *client.ClientBuilder, id armid.ResourceId) (string, error) {
 	it := id.Names()[1]
 	switch strings.ToUpper(it) {
 	case strings.ToUpper(string(armapimanagement.IdentityProviderTypeAAD)):
@@ -38,6 +38,6 @@ func (apiManagementIdentitiesResolver) Resolve(b *client.ClientBuilder, id armid
 	case strings.ToUpper(string(armapimanagement.IdentityProviderTypeTwitter)):
 		return "azurerm_api_management_identity_provider_twitter", nil
 	default:
-		return "", fmt.Errorf("unknown identity provider type: %s", it)
+		return "", unknownKindError("unknown identity provider type: %s", it)
 	}
 }
@@ -14,15 +14,15 @@ func (appPlatformBindingsResolver) ResourceTypes() []string {
 	return []string{"azurerm_spring_cloud_app_cosmosdb_association", "azurerm_spring_cloud_app_redis_association", "azurerm_spring_cloud_app_mysql_association"}
 }
 
-func (appPlatformBindingsResolver) Resolve(b *client.ClientBuilder, id armid.ResourceId) (string, error) {
+func (appPlatformBindingsResolver) Resolve(ctx context.Context, b *client.ClientBuilder, id armid.ResourceId) (string, error) {
 	resourceGroupId := id.RootScope().(*armid.ResourceGroup)
 	client, err := b.NewAppPlatformBindingsClient(resourceGroupId.SubscriptionId)
 	if err != nil {
 		return "", err
 	}
-	resp, err := client.Get(context.Background(), resourceGroupId.Name, id.Names()[0], id.Names()[1], id.Names()[2], nil)
+	resp, err := client.Get(ctx, resourceGroupId.Name, id.Names()[0], id.Names()[1], id.Names()[2], nil)
 	if err != nil {
-		return "", fmt.Errorf("retrieving %q: %v", id, err)
+		return "", fmt.Errorf("retrieving %q: %w", id, err)
 	}
 	props := resp.BindingResource.Properties
 	if props == nil {
@@ -41,6 +41,6 @@ func (appPlatformBindingsResolver) Resolve(b *client.ClientBuilder, id armid.Res
 	case params["databaseName"] != nil && params["username"] != nil:
 		return "azurerm_spring_cloud_app_mysql_association", nil
 	default:
-		return "", fmt.Errorf("unknown spring binding type")
+		return "", unknownKindError("unknown spring binding type")
 	}
 }
@@ -15,15 +15,15 @@ func (appPlatformDeploymentsResolver) ResourceTypes() []string {
 	return []string{"azurerm_spring_cloud_build_deployment", "azurerm_spring_cloud_java_deployment", "azurerm_spring_cloud_container_deployment"}
 }
 
-func (appPlatformDeploymentsResolver) Resolve(b *client.ClientBuilder, id armid.ResourceId) (string, error) {
+func (appPlatformDeploymentsResolver) Resolve(ctx context.Context, b *client.ClientBuilder, id armid.ResourceId) (string, error) {
 	resourceGroupId := id.RootScope().(*armid.ResourceGroup)
 	client, err := b.NewAppPlatformDeploymentsClient(resourceGroupId.SubscriptionId)
 	if err != nil {
 		return "", err
 	}
-	resp, err := client.Get(context.Background(), resourceGroupId.Name, id.Names()[0], id.Names()[1], id.Names()[2], nil)
+	resp, err := client.Get(ctx, resourceGroupId.Name, id.Names()[0], id.Names()[1], id.Names()[2], nil)
 	if err != nil {
-		return "", fmt.Errorf("retrieving %q: %v", id, err)
+		return "", fmt.Errorf("retrieving %q: %w", id, err)
 	}
 	props := resp.DeploymentResource.Properties
 	if props == nil {
@@ -42,6 +42,6 @@ func (appPlatformDeploymentsResolver) Resolve(b *client.ClientBuilder, id armid.
 	case *armappplatform.CustomContainerUserSourceInfo:
 		return "azurerm_spring_cloud_container_deployment", nil
 	default:
-		return "", fmt.Errorf("unknown spring cloud deployment source type: %T", source)
+		return "", unknownKindError("unknown spring cloud deployment source type: %T", source)
 	}
 }
@@ -14,15 +14,15 @@ func (appServiceCertificatesResolver) ResourceTypes() []string {
 	return []string{"azurerm_app_service_certificate", "azurerm_app_service_managed_certificate"}
 }
 
-func (appServiceCertificatesResolver) Resolve(b *client.ClientBuilder, id armid.ResourceId) (string, error) {
+func (appServiceCertificatesResolver) Resolve(ctx context.Context, b *client.ClientBuilder, id armid.ResourceId) (string, error) {
 	resourceGroupId := id.RootScope().(*armid.ResourceGroup)
 	client, err := b.NewAppServiceCertificatesClient(resourceGroupId.SubscriptionId)
 	if err != nil {
 		return "", err
 	}
-	resp, err := client.Get(context.Background(), resourceGroupId.Name, id.Names()[0], nil)
+	resp, err := client.Get(ctx, resourceGroupId.Name, id.Names()[0], nil)
 	if err != nil {
-		return "", fmt.Errorf("retrieving %q: %v", id, err)
+		return "", fmt.Errorf("retrieving %q: %w", id, err)
 	}
 	props := resp.AppCertificate.Properties
 	if props == nil {
@@ -1,7 +1,7 @@
 package resolve
 
 import (
-	"fmt"
+	"context"
 
 	"github.com/magodo/armid"
 	"github.com/magodo/aztft/internal/client"
@@ -13,9 +13,9 @@ func (appServiceSiteHybridConnectionsResolver) ResourceTypes() []string {
 	return []string{"azurerm_web_app_hybrid_connection", "azurerm_function_app_hybrid_connection"}
 }
 
-func (appServiceSiteHybridConnectionsResolver) Resolve(b *client.ClientBuilder, id armid.ResourceId) (string, error) {
+func (appServiceSiteHybridConnectionsResolver) Resolve(ctx context.Context, b *client.ClientBuilder, id armid.ResourceId) (string, error) {
 	// Resolve the resource type by resolving its parent resource, i.e. the sites.
-	rt, err := appServiceSitesResolver{}.Resolve(b, id.Parent().Parent())
+	rt, err := appServiceSitesResolver{}.Resolve(ctx, b, id.Parent().Parent())
 	if err != nil {
 		return "", err
 	}
@@ -26,6 +26,6 @@ func (appServiceSiteHybridConnectionsResolver) Resolve(b *client.ClientBuilder,
 	case "azurerm_windows_function_app", "azurerm_linux_function_app":
 		return "azurerm_function_app_hybrid_connection", nil
 	default:
-		return "", fmt.Errorf("unknown parent resource type: %s", rt)
+		return "", unknownKindError("unknown parent resource type: %s", rt)
 	}
 }
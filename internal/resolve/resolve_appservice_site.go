@@ -2,7 +2,6 @@ package resolve
 
 import (
 	"context"
-	"fmt"
 	"strings"
 
 	"github.com/magodo/armid"
@@ -22,20 +21,24 @@ func (appServiceSitesResolver) ResourceTypes() []string {
 	}
 }
 
-func (appServiceSitesResolver) Resolve(b *client.ClientBuilder, id armid.ResourceId) (string, error) {
-	resourceGroupId := id.RootScope().(*armid.ResourceGroup)
-	client, err := b.NewAppServiceWebAppsClient(resourceGroupId.SubscriptionId)
-	if err != nil {
-		return "", err
-	}
-	resp, err := client.Get(context.Background(), resourceGroupId.Name, id.Names()[0], nil)
-	if err != nil {
-		return "", fmt.Errorf("retrieving %q: %v", id, err)
-	}
-	kind := resp.Kind
-	if kind == nil {
-		return "", fmt.Errorf("unexpected nil kind in response")
+func (r appServiceSitesResolver) Resolve(ctx context.Context, b *client.ClientBuilder, id armid.ResourceId) (string, error) {
+	return resolveById(ctx, b, id, r)
+}
+
+func (appServiceSitesResolver) Get(ctx context.Context, b *client.ClientBuilder, id armid.ResourceId) (interface{}, error) {
+	return getBody(ctx, b, id, "2024-04-01")
+}
+
+func (appServiceSitesResolver) BodyKeys() []string {
+	return []string{"kind", "properties"}
+}
+
+func (appServiceSitesResolver) Explain(body interface{}) []Candidate {
+	evidence := []string{
+		bodyEvidence(body, "kind"),
+		bodyEvidence(body, "properties", "sku"),
 	}
+
 	// The value of kind for different resource are listed below:
 	//
 	// azurerm_logic_app_standard			: functionapp,workflowapp or functionapp,linux,container,workflowapp
@@ -44,36 +47,57 @@ func (appServiceSitesResolver) Resolve(b *client.ClientBuilder, id armid.Resourc
 	// azurerm_windows_web_app				: app,container,windows
 	// azurerm_function_app_flex_consumption: functionapp,linux
 	// azurerm_linux_function_app			: functionapp,linux
-
-	kinds := strings.Split(*kind, ",")
+	v, _ := lookupKeys(body, "kind")
+	kind, _ := v.(string)
 	m := map[string]bool{}
-	for _, k := range kinds {
+	for _, k := range strings.Split(kind, ",") {
 		m[strings.ToLower(k)] = true
 	}
+	v, _ = lookupKeys(body, "properties", "sku")
+	sku, _ := v.(string)
 
-	if m["workflowapp"] && m["functionapp"] {
-		return "azurerm_logic_app_standard", nil
-	}
+	logicApp := m["workflowapp"] && m["functionapp"]
+	functionApp := !logicApp && m["functionapp"]
+	// For "functionapp,linux" kind, it can be either azurerm_linux_function_app or azurerm_function_app_flex_consumption.
+	flex := functionApp && m["linux"] && strings.EqualFold(sku, "FlexConsumption")
+	webApp := !m["functionapp"] && m["app"]
 
-	if m["functionapp"] {
-		if _, ok := m["linux"]; !ok {
-			return "azurerm_windows_function_app", nil
-		}
-		// For "functionapp,linux" kind, it can be either azurerm_linux_function_app or azurerm_function_app_flex_consumption.
-		if props := resp.Properties; props != nil {
-			if sku := props.SKU; sku != nil && *sku == "FlexConsumption" {
-				return "azurerm_function_app_flex_consumption", nil
-			}
-		}
-		return "azurerm_linux_function_app", nil
+	return []Candidate{
+		{
+			ResourceType: "azurerm_logic_app_standard",
+			Evidence:     evidence,
+			Matched:      logicApp,
+			Reason:       `requires the kind to have both "functionapp" and "workflowapp"`,
+		},
+		{
+			ResourceType: "azurerm_function_app_flex_consumption",
+			Evidence:     evidence,
+			Matched:      flex,
+			Reason:       `requires the kind to have "functionapp" and "linux", and the "FlexConsumption" SKU`,
+		},
+		{
+			ResourceType: "azurerm_linux_function_app",
+			Evidence:     evidence,
+			Matched:      functionApp && m["linux"] && !flex,
+			Reason:       `requires the kind to have "functionapp" and "linux", but not the "FlexConsumption" SKU`,
+		},
+		{
+			ResourceType: "azurerm_windows_function_app",
+			Evidence:     evidence,
+			Matched:      functionApp && !m["linux"],
+			Reason:       `requires the kind to have "functionapp", but not "linux"`,
+		},
+		{
+			ResourceType: "azurerm_linux_web_app",
+			Evidence:     evidence,
+			Matched:      webApp && m["linux"],
+			Reason:       `requires the kind to have "app" and "linux"`,
+		},
+		{
+			ResourceType: "azurerm_windows_web_app",
+			Evidence:     evidence,
+			Matched:      webApp && !m["linux"],
+			Reason:       `requires the kind to have "app", but not "linux"`,
+		},
 	}
-
-	if m["app"] {
-		if m["linux"] {
-			return "azurerm_linux_web_app", nil
-		}
-		return "azurerm_windows_web_app", nil
-	}
-
-	return "", fmt.Errorf("unknown kind: %s", *kind)
 }
@@ -20,15 +20,15 @@ func (appServiceSiteSlotsResolver) ResourceTypes() []string {
 	}
 }
 
-func (appServiceSiteSlotsResolver) Resolve(b *client.ClientBuilder, id armid.ResourceId) (string, error) {
+func (appServiceSiteSlotsResolver) Resolve(ctx context.Context, b *client.ClientBuilder, id armid.ResourceId) (string, error) {
 	resourceGroupId := id.RootScope().(*armid.ResourceGroup)
 	client, err := b.NewAppServiceWebAppsClient(resourceGroupId.SubscriptionId)
 	if err != nil {
 		return "", err
 	}
-	resp, err := client.GetSlot(context.Background(), resourceGroupId.Name, id.Names()[0], id.Names()[1], nil)
+	resp, err := client.GetSlot(ctx, resourceGroupId.Name, id.Names()[0], id.Names()[1], nil)
 	if err != nil {
-		return "", fmt.Errorf("retrieving %q: %v", id, err)
+		return "", fmt.Errorf("retrieving %q: %w", id, err)
 	}
 	kind := resp.Kind
 	if kind == nil {
@@ -61,5 +61,5 @@ func (appServiceSiteSlotsResolver) Resolve(b *client.ClientBuilder, id armid.Res
 		return "azurerm_windows_web_app_slot", nil
 	}
 
-	return "", fmt.Errorf("unknown kind: %s", *kind)
+	return "", unknownKindError("unknown kind: %s", *kind)
 }
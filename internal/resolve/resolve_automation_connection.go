@@ -19,15 +19,15 @@ func (automationConnectionsResolver) ResourceTypes() []string {
 	}
 }
 
-func (automationConnectionsResolver) Resolve(b *client.ClientBuilder, id armid.ResourceId) (string, error) {
+func (automationConnectionsResolver) Resolve(ctx context.Context, b *client.ClientBuilder, id armid.ResourceId) (string, error) {
 	resourceGroupId := id.RootScope().(*armid.ResourceGroup)
 	client, err := b.NewAutomationConnectionClient(resourceGroupId.SubscriptionId)
 	if err != nil {
 		return "", err
 	}
-	resp, err := client.Get(context.Background(), resourceGroupId.Name, id.Names()[0], id.Names()[1], nil)
+	resp, err := client.Get(ctx, resourceGroupId.Name, id.Names()[0], id.Names()[1], nil)
 	if err != nil {
-		return "", fmt.Errorf("retrieving %q: %v", id, err)
+		return "", fmt.Errorf("retrieving %q: %w", id, err)
 	}
 	props := resp.Connection.Properties
 	if props == nil {
@@ -24,15 +24,15 @@ func (automationVariablesResolver) ResourceTypes() []string {
 	}
 }
 
-func (automationVariablesResolver) Resolve(b *client.ClientBuilder, id armid.ResourceId) (string, error) {
+func (automationVariablesResolver) Resolve(ctx context.Context, b *client.ClientBuilder, id armid.ResourceId) (string, error) {
 	resourceGroupId := id.RootScope().(*armid.ResourceGroup)
 	client, err := b.NewAutomationVariableClient(resourceGroupId.SubscriptionId)
 	if err != nil {
 		return "", err
 	}
-	resp, err := client.Get(context.Background(), resourceGroupId.Name, id.Names()[0], id.Names()[1], nil)
+	resp, err := client.Get(ctx, resourceGroupId.Name, id.Names()[0], id.Names()[1], nil)
 	if err != nil {
-		return "", fmt.Errorf("retrieving %q: %v", id, err)
+		return "", fmt.Errorf("retrieving %q: %w", id, err)
 	}
 	props := resp.Variable.Properties
 	if props == nil {
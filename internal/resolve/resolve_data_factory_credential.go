@@ -18,15 +18,15 @@ func (dataFactoryCredentialsResolver) ResourceTypes() []string {
 	}
 }
 
-func (dataFactoryCredentialsResolver) Resolve(b *client.ClientBuilder, id armid.ResourceId) (string, error) {
+func (dataFactoryCredentialsResolver) Resolve(ctx context.Context, b *client.ClientBuilder, id armid.ResourceId) (string, error) {
 	resourceGroupId := id.RootScope().(*armid.ResourceGroup)
 	client, err := b.NewDataFactoryCredentialsClient(resourceGroupId.SubscriptionId)
 	if err != nil {
 		return "", err
 	}
-	resp, err := client.Get(context.Background(), resourceGroupId.Name, id.Names()[0], id.Names()[1], nil)
+	resp, err := client.Get(ctx, resourceGroupId.Name, id.Names()[0], id.Names()[1], nil)
 	if err != nil {
-		return "", fmt.Errorf("retrieving %q: %v", id, err)
+		return "", fmt.Errorf("retrieving %q: %w", id, err)
 	}
 	props := resp.CredentialResource.Properties
 	if props == nil {
@@ -38,6 +38,6 @@ func (dataFactoryCredentialsResolver) Resolve(b *client.ClientBuilder, id armid.
 	case *armdatafactory.ServicePrincipalCredential:
 		return "azurerm_data_factory_credential_service_principal", nil
 	default:
-		return "", fmt.Errorf("unknown data flow type %T", props)
+		return "", unknownKindError("unknown data flow type %T", props)
 	}
 }
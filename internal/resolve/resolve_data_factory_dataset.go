@@ -29,15 +29,15 @@ func (dataFactoryDatasetsResolver) ResourceTypes() []string {
 	}
 }
 
-func (dataFactoryDatasetsResolver) Resolve(b *client.ClientBuilder, id armid.ResourceId) (string, error) {
+func (dataFactoryDatasetsResolver) Resolve(ctx context.Context, b *client.ClientBuilder, id armid.ResourceId) (string, error) {
 	resourceGroupId := id.RootScope().(*armid.ResourceGroup)
 	client, err := b.NewDataFactoryDatasetsClient(resourceGroupId.SubscriptionId)
 	if err != nil {
 		return "", err
 	}
-	resp, err := client.Get(context.Background(), resourceGroupId.Name, id.Names()[0], id.Names()[1], nil)
+	resp, err := client.Get(ctx, resourceGroupId.Name, id.Names()[0], id.Names()[1], nil)
 	if err != nil {
-		return "", fmt.Errorf("retrieving %q: %v", id, err)
+		return "", fmt.Errorf("retrieving %q: %w", id, err)
 	}
 	props := resp.DatasetResource.Properties
 	if props == nil {
@@ -71,6 +71,6 @@ func (dataFactoryDatasetsResolver) Resolve(b *client.ClientBuilder, id armid.Res
 	case *armdatafactory.AzureSQLTableDataset:
 		return "azurerm_data_factory_dataset_azure_sql_table", nil
 	default:
-		return "", fmt.Errorf("unknown dataset type %T", props)
+		return "", unknownKindError("unknown dataset type %T", props)
 	}
 }
@@ -15,15 +15,15 @@ func (dataFactoryIntegrationRuntimesResolver) ResourceTypes() []string {
 	return []string{"azurerm_data_factory_integration_runtime_azure_ssis", "azurerm_data_factory_integration_runtime_azure", "azurerm_data_factory_integration_runtime_self_hosted"}
 }
 
-func (dataFactoryIntegrationRuntimesResolver) Resolve(b *client.ClientBuilder, id armid.ResourceId) (string, error) {
+func (dataFactoryIntegrationRuntimesResolver) Resolve(ctx context.Context, b *client.ClientBuilder, id armid.ResourceId) (string, error) {
 	resourceGroupId := id.RootScope().(*armid.ResourceGroup)
 	client, err := b.NewDataFactoryIntegrationRuntimesClient(resourceGroupId.SubscriptionId)
 	if err != nil {
 		return "", err
 	}
-	resp, err := client.Get(context.Background(), resourceGroupId.Name, id.Names()[0], id.Names()[1], nil)
+	resp, err := client.Get(ctx, resourceGroupId.Name, id.Names()[0], id.Names()[1], nil)
 	if err != nil {
-		return "", fmt.Errorf("retrieving %q: %v", id, err)
+		return "", fmt.Errorf("retrieving %q: %w", id, err)
 	}
 	props := resp.IntegrationRuntimeResource.Properties
 	if props == nil {
@@ -42,6 +42,6 @@ func (dataFactoryIntegrationRuntimesResolver) Resolve(b *client.ClientBuilder, i
 	case *armdatafactory.SelfHostedIntegrationRuntime:
 		return "azurerm_data_factory_integration_runtime_self_hosted", nil
 	default:
-		return "", fmt.Errorf("unknown integration runtime type %T", props)
+		return "", unknownKindError("unknown integration runtime type %T", props)
 	}
 }
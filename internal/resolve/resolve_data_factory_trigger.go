@@ -20,15 +20,15 @@ func (dataFactoryTriggersResolver) ResourceTypes() []string {
 	}
 }
 
-func (dataFactoryTriggersResolver) Resolve(b *client.ClientBuilder, id armid.ResourceId) (string, error) {
+func (dataFactoryTriggersResolver) Resolve(ctx context.Context, b *client.ClientBuilder, id armid.ResourceId) (string, error) {
 	resourceGroupId := id.RootScope().(*armid.ResourceGroup)
 	client, err := b.NewDataFactoryTriggersClient(resourceGroupId.SubscriptionId)
 	if err != nil {
 		return "", err
 	}
-	resp, err := client.Get(context.Background(), resourceGroupId.Name, id.Names()[0], id.Names()[1], nil)
+	resp, err := client.Get(ctx, resourceGroupId.Name, id.Names()[0], id.Names()[1], nil)
 	if err != nil {
-		return "", fmt.Errorf("retrieving %q: %v", id, err)
+		return "", fmt.Errorf("retrieving %q: %w", id, err)
 	}
 	props := resp.TriggerResource.Properties
 	if props == nil {
@@ -44,6 +44,6 @@ func (dataFactoryTriggersResolver) Resolve(b *client.ClientBuilder, id armid.Res
 	case *armdatafactory.TumblingWindowTrigger:
 		return "azurerm_data_factory_trigger_tumbling_window", nil
 	default:
-		return "", fmt.Errorf("unknown trigger type %T", props)
+		return "", unknownKindError("unknown trigger type %T", props)
 	}
 }
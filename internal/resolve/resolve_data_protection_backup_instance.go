@@ -23,15 +23,15 @@ func (dataProtectionBackupInstancesResolver) ResourceTypes() []string {
 	}
 }
 
-func (dataProtectionBackupInstancesResolver) Resolve(b *client.ClientBuilder, id armid.ResourceId) (string, error) {
+func (dataProtectionBackupInstancesResolver) Resolve(ctx context.Context, b *client.ClientBuilder, id armid.ResourceId) (string, error) {
 	resourceGroupId := id.RootScope().(*armid.ResourceGroup)
 	client, err := b.NewDataProtectionBackupInstancesClient(resourceGroupId.SubscriptionId)
 	if err != nil {
 		return "", err
 	}
-	resp, err := client.Get(context.Background(), resourceGroupId.Name, id.Names()[0], id.Names()[1], nil)
+	resp, err := client.Get(ctx, resourceGroupId.Name, id.Names()[0], id.Names()[1], nil)
 	if err != nil {
-		return "", fmt.Errorf("retrieving %q: %v", id, err)
+		return "", fmt.Errorf("retrieving %q: %w", id, err)
 	}
 	props := resp.BackupInstanceResource.Properties
 	if props == nil {
@@ -61,6 +61,6 @@ func (dataProtectionBackupInstancesResolver) Resolve(b *client.ClientBuilder, id
 	case "MICROSOFT.STORAGE/STORAGEACCOUNTS/ADLSBLOBSERVICES":
 		return "azurerm_data_protection_backup_instance_data_lake_storage", nil
 	default:
-		return "", fmt.Errorf("unknown data source type: %s", *pdt)
+		return "", unknownKindError("unknown data source type: %s", *pdt)
 	}
 }
@@ -24,15 +24,15 @@ func (dataProtectionBackupPoliciesResolver) ResourceTypes() []string {
 	}
 }
 
-func (dataProtectionBackupPoliciesResolver) Resolve(b *client.ClientBuilder, id armid.ResourceId) (string, error) {
+func (dataProtectionBackupPoliciesResolver) Resolve(ctx context.Context, b *client.ClientBuilder, id armid.ResourceId) (string, error) {
 	resourceGroupId := id.RootScope().(*armid.ResourceGroup)
 	client, err := b.NewDataProtectionBackupPoliciesClient(resourceGroupId.SubscriptionId)
 	if err != nil {
 		return "", err
 	}
-	resp, err := client.Get(context.Background(), resourceGroupId.Name, id.Names()[0], id.Names()[1], nil)
+	resp, err := client.Get(ctx, resourceGroupId.Name, id.Names()[0], id.Names()[1], nil)
 	if err != nil {
-		return "", fmt.Errorf("retrieving %q: %v", id, err)
+		return "", fmt.Errorf("retrieving %q: %w", id, err)
 	}
 	props := resp.BaseBackupPolicyResource.Properties
 	if props == nil {
@@ -40,7 +40,7 @@ func (dataProtectionBackupPoliciesResolver) Resolve(b *client.ClientBuilder, id
 	}
 	policy, ok := props.(*armdataprotection.BackupPolicy)
 	if !ok {
-		return "", fmt.Errorf("unknown type of the property: %T", props)
+		return "", unknownKindError("unknown type of the property: %T", props)
 	}
 	if len(policy.DatasourceTypes) != 1 {
 		return "", fmt.Errorf("provider only support backup policy that has exactly one datasourceType specified, got=%d", len(policy.DatasourceTypes))
@@ -65,6 +65,6 @@ func (dataProtectionBackupPoliciesResolver) Resolve(b *client.ClientBuilder, id
 	case "MICROSOFT.STORAGE/STORAGEACCOUNTS/ADLSBLOBSERVICES":
 		return "azurerm_data_protection_backup_policy_data_lake_storage", nil
 	default:
-		return "", fmt.Errorf("unknown data source type: %s", *pdt)
+		return "", unknownKindError("unknown data source type: %s", *pdt)
 	}
 }
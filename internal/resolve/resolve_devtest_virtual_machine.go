@@ -14,15 +14,15 @@ func (devTestVirtualMachinesResolver) ResourceTypes() []string {
 	return []string{"azurerm_dev_test_linux_virtual_machine", "azurerm_dev_test_windows_virtual_machine"}
 }
 
-func (devTestVirtualMachinesResolver) Resolve(b *client.ClientBuilder, id armid.ResourceId) (string, error) {
+func (devTestVirtualMachinesResolver) Resolve(ctx context.Context, b *client.ClientBuilder, id armid.ResourceId) (string, error) {
 	resourceGroupId := id.RootScope().(*armid.ResourceGroup)
 	client, err := b.NewDevTestVirtualMachinesClient(resourceGroupId.SubscriptionId)
 	if err != nil {
 		return "", err
 	}
-	resp, err := client.Get(context.Background(), resourceGroupId.Name, id.Names()[0], id.Names()[1], nil)
+	resp, err := client.Get(ctx, resourceGroupId.Name, id.Names()[0], id.Names()[1], nil)
 	if err != nil {
-		return "", fmt.Errorf("retrieving %q: %v", id, err)
+		return "", fmt.Errorf("retrieving %q: %w", id, err)
 	}
 	props := resp.LabVirtualMachine.Properties
 	if props == nil {
@@ -45,6 +45,6 @@ func (devTestVirtualMachinesResolver) Resolve(b *client.ClientBuilder, id armid.
 	case "Windows":
 		return "azurerm_dev_test_windows_virtual_machine", nil
 	default:
-		return "", fmt.Errorf("unknown os type: %s", *osType)
+		return "", unknownKindError("unknown os type: %s", *osType)
 	}
 }
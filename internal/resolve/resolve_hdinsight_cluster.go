@@ -21,15 +21,15 @@ func (hdInsightClustersResolver) ResourceTypes() []string {
 	}
 }
 
-func (hdInsightClustersResolver) Resolve(b *client.ClientBuilder, id armid.ResourceId) (string, error) {
+func (hdInsightClustersResolver) Resolve(ctx context.Context, b *client.ClientBuilder, id armid.ResourceId) (string, error) {
 	resourceGroupId := id.RootScope().(*armid.ResourceGroup)
 	client, err := b.NewHDInsightClustersClient(resourceGroupId.SubscriptionId)
 	if err != nil {
 		return "", err
 	}
-	resp, err := client.Get(context.Background(), resourceGroupId.Name, id.Names()[0], nil)
+	resp, err := client.Get(ctx, resourceGroupId.Name, id.Names()[0], nil)
 	if err != nil {
-		return "", fmt.Errorf("retrieving %q: %v", id, err)
+		return "", fmt.Errorf("retrieving %q: %w", id, err)
 	}
 	props := resp.Cluster.Properties
 	if props == nil {
@@ -56,6 +56,6 @@ func (hdInsightClustersResolver) Resolve(b *client.ClientBuilder, id armid.Resou
 	case "INTERACTIVEHIVE":
 		return "azurerm_hdinsight_interactive_query_cluster", nil
 	default:
-		return "", fmt.Errorf("unknown cluster kind: %s", *kind)
+		return "", unknownKindError("unknown cluster kind: %s", *kind)
 	}
 }
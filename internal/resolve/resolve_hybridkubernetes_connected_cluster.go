@@ -14,15 +14,15 @@ func (hybridkubernetesConnectedClusterResolver) ResourceTypes() []string {
 	return []string{"azurerm_arc_kubernetes_cluster", "azurerm_arc_kubernetes_provisioned_cluster"}
 }
 
-func (hybridkubernetesConnectedClusterResolver) Resolve(b *client.ClientBuilder, id armid.ResourceId) (string, error) {
+func (hybridkubernetesConnectedClusterResolver) Resolve(ctx context.Context, b *client.ClientBuilder, id armid.ResourceId) (string, error) {
 	resourceGroupId := id.RootScope().(*armid.ResourceGroup)
 	client, err := b.NewHybridKubernetesConnectedClient(resourceGroupId.SubscriptionId)
 	if err != nil {
 		return "", err
 	}
-	resp, err := client.Get(context.Background(), resourceGroupId.Name, id.Names()[0], nil)
+	resp, err := client.Get(ctx, resourceGroupId.Name, id.Names()[0], nil)
 	if err != nil {
-		return "", fmt.Errorf("retrieving %q: %v", id, err)
+		return "", fmt.Errorf("retrieving %q: %w", id, err)
 	}
 
 	// TODO: The Azure/azure-sdk-for-go uses the API version: 2021-10-01, which has no "Kind" defined.
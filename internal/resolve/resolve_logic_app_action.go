@@ -16,15 +16,15 @@ func (logicAppAction) ResourceTypes() []string {
 	return []string{"azurerm_logic_app_action_custom", "azurerm_logic_app_action_http"}
 }
 
-func (logicAppAction) Resolve(b *client.ClientBuilder, id armid.ResourceId) (string, error) {
+func (logicAppAction) Resolve(ctx context.Context, b *client.ClientBuilder, id armid.ResourceId) (string, error) {
 	resourceGroupId := id.RootScope().(*armid.ResourceGroup)
 	client, err := b.NewLogicWorkflowsClient(resourceGroupId.SubscriptionId)
 	if err != nil {
 		return "", err
 	}
-	resp, err := client.Get(context.Background(), resourceGroupId.Name, id.Names()[0], nil)
+	resp, err := client.Get(ctx, resourceGroupId.Name, id.Names()[0], nil)
 	if err != nil {
-		return "", fmt.Errorf("retrieving %q: %v", id, err)
+		return "", fmt.Errorf("retrieving %q: %w", id, err)
 	}
 	props := resp.Workflow.Properties
 	if props == nil {
@@ -39,11 +39,11 @@ func (logicAppAction) Resolve(b *client.ClientBuilder, id armid.ResourceId) (str
 
 	rb, err := json.Marshal(props.Definition)
 	if err != nil {
-		return "", fmt.Errorf("marshaling definition: %v", err)
+		return "", fmt.Errorf("marshaling definition: %w", err)
 	}
 	var def Def
 	if err := json.Unmarshal(rb, &def); err != nil {
-		return "", fmt.Errorf("unmarshaling definition: %v", err)
+		return "", fmt.Errorf("unmarshaling definition: %w", err)
 	}
 
 	if len(def.Actions) == 0 {
@@ -20,15 +20,15 @@ func (machineLearningComputesResolver) ResourceTypes() []string {
 	}
 }
 
-func (machineLearningComputesResolver) Resolve(b *client.ClientBuilder, id armid.ResourceId) (string, error) {
+func (machineLearningComputesResolver) Resolve(ctx context.Context, b *client.ClientBuilder, id armid.ResourceId) (string, error) {
 	resourceGroupId := id.RootScope().(*armid.ResourceGroup)
 	client, err := b.NewMachineLearningComputeClient(resourceGroupId.SubscriptionId)
 	if err != nil {
 		return "", err
 	}
-	resp, err := client.Get(context.Background(), resourceGroupId.Name, id.Names()[0], id.Names()[1], nil)
+	resp, err := client.Get(ctx, resourceGroupId.Name, id.Names()[0], id.Names()[1], nil)
 	if err != nil {
-		return "", fmt.Errorf("retrieving %q: %v", id, err)
+		return "", fmt.Errorf("retrieving %q: %w", id, err)
 	}
 	props := resp.ComputeResource.Properties
 	if props == nil {
@@ -44,6 +44,6 @@ func (machineLearningComputesResolver) Resolve(b *client.ClientBuilder, id armid
 	case *armmachinelearning.AKS:
 		return "azurerm_machine_learning_inference_cluster", nil
 	default:
-		return "", fmt.Errorf("unknown compute resource type %T", props)
+		return "", unknownKindError("unknown compute resource type %T", props)
 	}
 }
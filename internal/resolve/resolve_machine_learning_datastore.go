@@ -19,15 +19,15 @@ func (machineLearningDataStoresResolver) ResourceTypes() []string {
 	}
 }
 
-func (machineLearningDataStoresResolver) Resolve(b *client.ClientBuilder, id armid.ResourceId) (string, error) {
+func (machineLearningDataStoresResolver) Resolve(ctx context.Context, b *client.ClientBuilder, id armid.ResourceId) (string, error) {
 	resourceGroupId := id.RootScope().(*armid.ResourceGroup)
 	client, err := b.NewMachineLearningDataStoreClient(resourceGroupId.SubscriptionId)
 	if err != nil {
 		return "", err
 	}
-	resp, err := client.Get(context.Background(), resourceGroupId.Name, id.Names()[0], id.Names()[1], nil)
+	resp, err := client.Get(ctx, resourceGroupId.Name, id.Names()[0], id.Names()[1], nil)
 	if err != nil {
-		return "", fmt.Errorf("retrieving %q: %v", id, err)
+		return "", fmt.Errorf("retrieving %q: %w", id, err)
 	}
 	props := resp.Datastore.Properties
 	if props == nil {
@@ -41,6 +41,6 @@ func (machineLearningDataStoresResolver) Resolve(b *client.ClientBuilder, id arm
 	case *armmachinelearning.AzureDataLakeGen2Datastore:
 		return "azurerm_machine_learning_datastore_datalake_gen2", nil
 	default:
-		return "", fmt.Errorf("unknown data store resource type %T", props)
+		return "", unknownKindError("unknown data store resource type %T", props)
 	}
 }
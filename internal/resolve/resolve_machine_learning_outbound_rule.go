@@ -19,15 +19,15 @@ func (machineLearningOutboundRulesResolver) ResourceTypes() []string {
 	}
 }
 
-func (machineLearningOutboundRulesResolver) Resolve(b *client.ClientBuilder, id armid.ResourceId) (string, error) {
+func (machineLearningOutboundRulesResolver) Resolve(ctx context.Context, b *client.ClientBuilder, id armid.ResourceId) (string, error) {
 	resourceGroupId := id.RootScope().(*armid.ResourceGroup)
 	client, err := b.NewMachineLearningOutboundRulesClient(resourceGroupId.SubscriptionId)
 	if err != nil {
 		return "", err
 	}
-	resp, err := client.Get(context.Background(), resourceGroupId.Name, id.Names()[0], id.Names()[1], nil)
+	resp, err := client.Get(ctx, resourceGroupId.Name, id.Names()[0], id.Names()[1], nil)
 	if err != nil {
-		return "", fmt.Errorf("retrieving %q: %v", id, err)
+		return "", fmt.Errorf("retrieving %q: %w", id, err)
 	}
 	props := resp.OutboundRuleBasicResource.Properties
 	if props == nil {
@@ -41,6 +41,6 @@ func (machineLearningOutboundRulesResolver) Resolve(b *client.ClientBuilder, id
 	case *armmachinelearning.ServiceTagOutboundRule:
 		return "azurerm_machine_learning_workspace_network_outbound_rule_service_tag", nil
 	default:
-		return "", fmt.Errorf("unknown outbound rule resource type %T", props)
+		return "", unknownKindError("unknown outbound rule resource type %T", props)
 	}
 }
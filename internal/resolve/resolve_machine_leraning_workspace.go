@@ -19,15 +19,15 @@ func (machineLearningWorkspaceResolver) ResourceTypes() []string {
 	}
 }
 
-func (machineLearningWorkspaceResolver) Resolve(b *client.ClientBuilder, id armid.ResourceId) (string, error) {
+func (machineLearningWorkspaceResolver) Resolve(ctx context.Context, b *client.ClientBuilder, id armid.ResourceId) (string, error) {
 	resourceGroupId := id.RootScope().(*armid.ResourceGroup)
 	client, err := b.NewMachineLearningWorkspaceClient(resourceGroupId.SubscriptionId)
 	if err != nil {
 		return "", err
 	}
-	resp, err := client.Get(context.Background(), resourceGroupId.Name, id.Names()[0], nil)
+	resp, err := client.Get(ctx, resourceGroupId.Name, id.Names()[0], nil)
 	if err != nil {
-		return "", fmt.Errorf("retrieving %q: %v", id, err)
+		return "", fmt.Errorf("retrieving %q: %w", id, err)
 	}
 	kind := resp.Kind
 	if kind == nil {
@@ -41,5 +41,5 @@ func (machineLearningWorkspaceResolver) Resolve(b *client.ClientBuilder, id armi
 	case "HUB":
 		return "azurerm_ai_foundry", nil
 	}
-	return "", fmt.Errorf("unknown kind: %s", *kind)
+	return "", unknownKindError("unknown kind: %s", *kind)
 }
@@ -17,15 +17,15 @@ func (netappBucketResolver) ResourceTypes() []string {
 	}
 }
 
-func (netappBucketResolver) Resolve(b *client.ClientBuilder, id armid.ResourceId) (string, error) {
+func (netappBucketResolver) Resolve(ctx context.Context, b *client.ClientBuilder, id armid.ResourceId) (string, error) {
 	resourceGroupId := id.RootScope().(*armid.ResourceGroup)
 	client, err := b.NewNetAppVolumeBucketClient(resourceGroupId.SubscriptionId)
 	if err != nil {
 		return "", err
 	}
-	resp, err := client.Get(context.Background(), resourceGroupId.Name, id.Names()[0], id.Names()[1], id.Names()[2], id.Names()[3], nil)
+	resp, err := client.Get(ctx, resourceGroupId.Name, id.Names()[0], id.Names()[1], id.Names()[2], id.Names()[3], nil)
 	if err != nil {
-		return "", fmt.Errorf("retrieving %q: %v", id, err)
+		return "", fmt.Errorf("retrieving %q: %w", id, err)
 	}
 	props := resp.Bucket.Properties
 	if props == nil {
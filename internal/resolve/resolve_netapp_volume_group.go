@@ -18,15 +18,15 @@ func (netappVolumeGroupResolver) ResourceTypes() []string {
 	}
 }
 
-func (netappVolumeGroupResolver) Resolve(b *client.ClientBuilder, id armid.ResourceId) (string, error) {
+func (netappVolumeGroupResolver) Resolve(ctx context.Context, b *client.ClientBuilder, id armid.ResourceId) (string, error) {
 	resourceGroupId := id.RootScope().(*armid.ResourceGroup)
 	client, err := b.NewNetAppVolumeGroupClient(resourceGroupId.SubscriptionId)
 	if err != nil {
 		return "", err
 	}
-	resp, err := client.Get(context.Background(), resourceGroupId.Name, id.Names()[0], id.Names()[1], nil)
+	resp, err := client.Get(ctx, resourceGroupId.Name, id.Names()[0], id.Names()[1], nil)
 	if err != nil {
-		return "", fmt.Errorf("retrieving %q: %v", id, err)
+		return "", fmt.Errorf("retrieving %q: %w", id, err)
 	}
 	props := resp.VolumeGroupDetails.Properties
 	if props == nil {
@@ -47,5 +47,5 @@ func (netappVolumeGroupResolver) Resolve(b *client.ClientBuilder, id armid.Resou
 	case "SAP-HANA":
 		return "azurerm_netapp_volume_group_sap_hana", nil
 	}
-	return "", fmt.Errorf("unknown application type: %s", *appType)
+	return "", unknownKindError("unknown application type: %s", *appType)
 }
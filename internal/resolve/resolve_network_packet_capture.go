@@ -15,15 +15,15 @@ func (networkPacketCaptureResolver) ResourceTypes() []string {
 	return []string{"azurerm_virtual_machine_scale_set_packet_capture", "azurerm_virtual_machine_packet_capture"}
 }
 
-func (networkPacketCaptureResolver) Resolve(b *client.ClientBuilder, id armid.ResourceId) (string, error) {
+func (networkPacketCaptureResolver) Resolve(ctx context.Context, b *client.ClientBuilder, id armid.ResourceId) (string, error) {
 	resourceGroupId := id.RootScope().(*armid.ResourceGroup)
 	client, err := b.NewNetworkPacketCapturesClient(resourceGroupId.SubscriptionId)
 	if err != nil {
 		return "", err
 	}
-	resp, err := client.Get(context.Background(), resourceGroupId.Name, id.Names()[0], id.Names()[1], nil)
+	resp, err := client.Get(ctx, resourceGroupId.Name, id.Names()[0], id.Names()[1], nil)
 	if err != nil {
-		return "", fmt.Errorf("retrieving %q: %v", id, err)
+		return "", fmt.Errorf("retrieving %q: %w", id, err)
 	}
 	props := resp.PacketCaptureResult.Properties
 	if props == nil {
@@ -36,11 +36,11 @@ func (networkPacketCaptureResolver) Resolve(b *client.ClientBuilder, id armid.Re
 
 	tid, err := armid.ParseResourceId(*targetId)
 	if err != nil {
-		return "", fmt.Errorf("parsing target id %q: %v", *targetId, err)
+		return "", fmt.Errorf("parsing target id %q: %w", *targetId, err)
 	}
 
 	if len(tid.Types()) != 1 {
-		return "", fmt.Errorf("un-supported resource types for this target id: %v", tid.Types())
+		return "", unknownKindError("un-supported resource types for this target id: %v", tid.Types())
 	}
 
 	switch rt := strings.ToUpper(tid.Types()[0]); rt {
@@ -49,6 +49,6 @@ func (networkPacketCaptureResolver) Resolve(b *client.ClientBuilder, id armid.Re
 	case "VIRTUALMACHINES":
 		return "azurerm_virtual_machine_packet_capture", nil
 	default:
-		return "", fmt.Errorf("unknown resource type: %s", rt)
+		return "", unknownKindError("unknown resource type: %s", rt)
 	}
 }
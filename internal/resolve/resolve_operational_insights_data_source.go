@@ -15,15 +15,15 @@ func (operationalInsightsDataSourcesResolver) ResourceTypes() []string {
 	return []string{"azurerm_log_analytics_datasource_windows_performance_counter", "azurerm_log_analytics_datasource_windows_event"}
 }
 
-func (operationalInsightsDataSourcesResolver) Resolve(b *client.ClientBuilder, id armid.ResourceId) (string, error) {
+func (operationalInsightsDataSourcesResolver) Resolve(ctx context.Context, b *client.ClientBuilder, id armid.ResourceId) (string, error) {
 	resourceGroupId := id.RootScope().(*armid.ResourceGroup)
 	client, err := b.NewOperationalInsightsDataSourcesClient(resourceGroupId.SubscriptionId)
 	if err != nil {
 		return "", err
 	}
-	resp, err := client.Get(context.Background(), resourceGroupId.Name, id.Names()[0], id.Names()[1], nil)
+	resp, err := client.Get(ctx, resourceGroupId.Name, id.Names()[0], id.Names()[1], nil)
 	if err != nil {
-		return "", fmt.Errorf("retrieving %q: %v", id, err)
+		return "", fmt.Errorf("retrieving %q: %w", id, err)
 	}
 	kind := resp.Kind
 	if kind == nil {
@@ -35,6 +35,6 @@ func (operationalInsightsDataSourcesResolver) Resolve(b *client.ClientBuilder, i
 	case armoperationalinsights.DataSourceKindWindowsEvent:
 		return "azurerm_log_analytics_datasource_windows_event", nil
 	default:
-		return "", fmt.Errorf("unknown data source kind: %s", *kind)
+		return "", unknownKindError("unknown data source kind: %s", *kind)
 	}
 }
@@ -17,15 +17,15 @@ func (operationalInsightsTableResolver) ResourceTypes() []string {
 	}
 }
 
-func (operationalInsightsTableResolver) Resolve(b *client.ClientBuilder, id armid.ResourceId) (string, error) {
+func (operationalInsightsTableResolver) Resolve(ctx context.Context, b *client.ClientBuilder, id armid.ResourceId) (string, error) {
 	resourceGroupId := id.RootScope().(*armid.ResourceGroup)
 	client, err := b.NewOperationalInsightsTablesClient(resourceGroupId.SubscriptionId)
 	if err != nil {
 		return "", err
 	}
-	resp, err := client.Get(context.Background(), resourceGroupId.Name, id.Names()[0], id.Names()[1], nil)
+	resp, err := client.Get(ctx, resourceGroupId.Name, id.Names()[0], id.Names()[1], nil)
 	if err != nil {
-		return "", fmt.Errorf("retrieving %q: %v", id, err)
+		return "", fmt.Errorf("retrieving %q: %w", id, err)
 	}
 	props := resp.Properties
 	if props == nil {
@@ -48,6 +48,6 @@ func (operationalInsightsTableResolver) Resolve(b *client.ClientBuilder, id armi
 	case armoperationalinsights.TableTypeEnumMicrosoft:
 		return "azurerm_log_analytics_workspace_table_microsoft", nil
 	default:
-		return "", fmt.Errorf("uknown table type: %v", *tableType)
+		return "", unknownKindError("unknown table type: %v", *tableType)
 	}
 }
@@ -15,15 +15,15 @@ func (recoveryServicesBackupProtectedItemsResolver) ResourceTypes() []string {
 	return []string{"azurerm_backup_protected_vm", "azurerm_backup_protected_file_share"}
 }
 
-func (recoveryServicesBackupProtectedItemsResolver) Resolve(b *client.ClientBuilder, id armid.ResourceId) (string, error) {
+func (recoveryServicesBackupProtectedItemsResolver) Resolve(ctx context.Context, b *client.ClientBuilder, id armid.ResourceId) (string, error) {
 	resourceGroupId := id.RootScope().(*armid.ResourceGroup)
 	client, err := b.NewRecoveryservicesBackupProtectedItemsClient(resourceGroupId.SubscriptionId)
 	if err != nil {
 		return "", err
 	}
-	resp, err := client.Get(context.Background(), id.Names()[0], resourceGroupId.Name, id.Names()[1], id.Names()[2], id.Names()[3], nil)
+	resp, err := client.Get(ctx, id.Names()[0], resourceGroupId.Name, id.Names()[1], id.Names()[2], id.Names()[3], nil)
 	if err != nil {
-		return "", fmt.Errorf("retrieving %q: %v", id, err)
+		return "", fmt.Errorf("retrieving %q: %w", id, err)
 	}
 	props := resp.ProtectedItemResource.Properties
 	if props == nil {
@@ -35,6 +35,6 @@ func (recoveryServicesBackupProtectedItemsResolver) Resolve(b *client.ClientBuil
 	case *armrecoveryservicesbackup.AzureFileshareProtectedItem:
 		return "azurerm_backup_protected_file_share", nil
 	default:
-		return "", fmt.Errorf("unknown protected item type: %T", props)
+		return "", unknownKindError("unknown protected item type: %T", props)
 	}
 }
@@ -19,15 +19,15 @@ func (recoveryServicesBackupProtectionPoliciesResolver) ResourceTypes() []string
 	}
 }
 
-func (recoveryServicesBackupProtectionPoliciesResolver) Resolve(b *client.ClientBuilder, id armid.ResourceId) (string, error) {
+func (recoveryServicesBackupProtectionPoliciesResolver) Resolve(ctx context.Context, b *client.ClientBuilder, id armid.ResourceId) (string, error) {
 	resourceGroupId := id.RootScope().(*armid.ResourceGroup)
 	client, err := b.NewRecoveryServicesBackupProtectionPoliciesClient(resourceGroupId.SubscriptionId)
 	if err != nil {
 		return "", err
 	}
-	resp, err := client.Get(context.Background(), id.Names()[0], resourceGroupId.Name, id.Names()[1], nil)
+	resp, err := client.Get(ctx, id.Names()[0], resourceGroupId.Name, id.Names()[1], nil)
 	if err != nil {
-		return "", fmt.Errorf("retrieving %q: %v", id, err)
+		return "", fmt.Errorf("retrieving %q: %w", id, err)
 	}
 	props := resp.ProtectionPolicyResource.Properties
 	if props == nil {
@@ -41,6 +41,6 @@ func (recoveryServicesBackupProtectionPoliciesResolver) Resolve(b *client.Client
 	case *armrecoveryservicesbackup.AzureVMWorkloadProtectionPolicy:
 		return "azurerm_backup_policy_vm_workload", nil
 	default:
-		return "", fmt.Errorf("unknown policy type: %T", props)
+		return "", unknownKindError("unknown policy type: %T", props)
 	}
 }
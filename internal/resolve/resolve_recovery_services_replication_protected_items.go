@@ -14,15 +14,15 @@ func (recoveryServicesReplicationProtectedItemsResolver) ResourceTypes() []strin
 	return []string{"azurerm_site_recovery_replicated_vm", "azurerm_site_recovery_vmware_replicated_vm"}
 }
 
-func (recoveryServicesReplicationProtectedItemsResolver) Resolve(b *client.ClientBuilder, id armid.ResourceId) (string, error) {
+func (recoveryServicesReplicationProtectedItemsResolver) Resolve(ctx context.Context, b *client.ClientBuilder, id armid.ResourceId) (string, error) {
 	resourceGroupId := id.RootScope().(*armid.ResourceGroup)
 	client, err := b.NewSiteRecoveryReplicationProtectedItemsClient(resourceGroupId.SubscriptionId)
 	if err != nil {
 		return "", err
 	}
-	resp, err := client.Get(context.Background(), resourceGroupId.Name, id.Names()[0], id.Names()[1], id.Names()[2], id.Names()[3], nil)
+	resp, err := client.Get(ctx, resourceGroupId.Name, id.Names()[0], id.Names()[1], id.Names()[2], id.Names()[3], nil)
 	if err != nil {
-		return "", fmt.Errorf("retrieving %q: %v", id, err)
+		return "", fmt.Errorf("retrieving %q: %w", id, err)
 	}
 	props := resp.Properties
 	if props == nil {
@@ -47,6 +47,6 @@ func (recoveryServicesReplicationProtectedItemsResolver) Resolve(b *client.Clien
 	case "InMageRcm":
 		return "azurerm_site_recovery_vmware_replicated_vm", nil
 	default:
-		return "", fmt.Errorf("unknown replication protected items type: %s", *typ)
+		return "", unknownKindError("unknown replication protected items type: %s", *typ)
 	}
 }
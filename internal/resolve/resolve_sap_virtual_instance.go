@@ -19,15 +19,15 @@ func (sapVirtualInstancesResolver) ResourceTypes() []string {
 	}
 }
 
-func (sapVirtualInstancesResolver) Resolve(b *client.ClientBuilder, id armid.ResourceId) (string, error) {
+func (sapVirtualInstancesResolver) Resolve(ctx context.Context, b *client.ClientBuilder, id armid.ResourceId) (string, error) {
 	resourceGroupId := id.RootScope().(*armid.ResourceGroup)
 	client, err := b.NewWorkloadSAPVirtualInstanceClient(resourceGroupId.SubscriptionId)
 	if err != nil {
 		return "", err
 	}
-	resp, err := client.Get(context.Background(), resourceGroupId.Name, id.Names()[0], nil)
+	resp, err := client.Get(ctx, resourceGroupId.Name, id.Names()[0], nil)
 	if err != nil {
-		return "", fmt.Errorf("retrieving %q: %v", id, err)
+		return "", fmt.Errorf("retrieving %q: %w", id, err)
 	}
 	props := resp.SAPVirtualInstance.Properties
 	if props == nil {
@@ -22,15 +22,15 @@ func (securityInsightsAlertRulesResolver) ResourceTypes() []string {
 	}
 }
 
-func (securityInsightsAlertRulesResolver) Resolve(b *client.ClientBuilder, id armid.ResourceId) (string, error) {
+func (securityInsightsAlertRulesResolver) Resolve(ctx context.Context, b *client.ClientBuilder, id armid.ResourceId) (string, error) {
 	resourceGroupId := id.RootScope().(*armid.ResourceGroup)
 	client, err := b.NewSecurityInsightsAlertRulesClient(resourceGroupId.SubscriptionId)
 	if err != nil {
 		return "", err
 	}
-	resp, err := client.Get(context.Background(), resourceGroupId.Name, id.ParentScope().Names()[0], id.Names()[0], nil)
+	resp, err := client.Get(ctx, resourceGroupId.Name, id.ParentScope().Names()[0], id.Names()[0], nil)
 	if err != nil {
-		return "", fmt.Errorf("retrieving %q: %v", id, err)
+		return "", fmt.Errorf("retrieving %q: %w", id, err)
 	}
 	model := resp.AlertRuleClassification
 	if model == nil {
@@ -51,6 +51,6 @@ func (securityInsightsAlertRulesResolver) Resolve(b *client.ClientBuilder, id ar
 	case *armsecurityinsights.ThreatIntelligenceAlertRule:
 		return "azurerm_sentinel_alert_rule_threat_intelligence", nil
 	default:
-		return "", fmt.Errorf("unknown alert rule type: %T", model)
+		return "", unknownKindError("unknown alert rule type: %T", model)
 	}
 }
@@ -34,15 +34,15 @@ func (securityInsightsDataConnectorsResolver) ResourceTypes() []string {
 	}
 }
 
-func (securityInsightsDataConnectorsResolver) Resolve(b *client.ClientBuilder, id armid.ResourceId) (string, error) {
+func (securityInsightsDataConnectorsResolver) Resolve(ctx context.Context, b *client.ClientBuilder, id armid.ResourceId) (string, error) {
 	resourceGroupId := id.RootScope().(*armid.ResourceGroup)
 	client, err := b.NewSecurityInsightsDataConnectorsClient(resourceGroupId.SubscriptionId)
 	if err != nil {
 		return "", err
 	}
-	resp, err := client.Get(context.Background(), resourceGroupId.Name, id.ParentScope().Names()[0], id.Names()[0], nil)
+	resp, err := client.Get(ctx, resourceGroupId.Name, id.ParentScope().Names()[0], id.Names()[0], nil)
 	if err != nil {
-		return "", fmt.Errorf("retrieving %q: %v", id, err)
+		return "", fmt.Errorf("retrieving %q: %w", id, err)
 	}
 	model := resp.DataConnectorClassification
 	if model == nil {
@@ -87,6 +87,6 @@ func (securityInsightsDataConnectorsResolver) Resolve(b *client.ClientBuilder, i
 	case *armsecurityinsights.MSTIDataConnector:
 		return "azurerm_sentinel_data_connector_microsoft_threat_intelligence", nil
 	default:
-		return "", fmt.Errorf("unknown data connector type: %T", model)
+		return "", unknownKindError("unknown data connector type: %T", model)
 	}
 }
@@ -18,15 +18,15 @@ func (securityInsightsSecurityMLAnalyticsSettingsResolver) ResourceTypes() []str
 	}
 }
 
-func (securityInsightsSecurityMLAnalyticsSettingsResolver) Resolve(b *client.ClientBuilder, id armid.ResourceId) (string, error) {
+func (securityInsightsSecurityMLAnalyticsSettingsResolver) Resolve(ctx context.Context, b *client.ClientBuilder, id armid.ResourceId) (string, error) {
 	resourceGroupId := id.RootScope().(*armid.ResourceGroup)
 	client, err := b.NewSecurityInsightsSecurityMLAnalyticsSettingsClient(resourceGroupId.SubscriptionId)
 	if err != nil {
 		return "", err
 	}
-	resp, err := client.Get(context.Background(), resourceGroupId.Name, id.ParentScope().Names()[0], id.Names()[0], nil)
+	resp, err := client.Get(ctx, resourceGroupId.Name, id.ParentScope().Names()[0], id.Names()[0], nil)
 	if err != nil {
-		return "", fmt.Errorf("retrieving %q: %v", id, err)
+		return "", fmt.Errorf("retrieving %q: %w", id, err)
 	}
 	model := resp.SecurityMLAnalyticsSettingClassification
 	if model == nil {
@@ -38,6 +38,6 @@ func (securityInsightsSecurityMLAnalyticsSettingsResolver) Resolve(b *client.Cli
 		// TODO: figure out how to resolve azurerm_sentinel_alert_rule_anomaly_{built_in|duplicate}
 		return "azurerm_sentinel_alert_rule_anomaly_built_in", nil
 	default:
-		return "", fmt.Errorf("unknown security ML analytics setting type: %T", model)
+		return "", unknownKindError("unknown security ML analytics setting type: %T", model)
 	}
 }
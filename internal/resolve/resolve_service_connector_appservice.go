@@ -1,7 +1,7 @@
 package resolve
 
 import (
-	"fmt"
+	"context"
 
 	"github.com/magodo/armid"
 	"github.com/magodo/aztft/internal/client"
@@ -16,8 +16,8 @@ func (serviceConnectorAppServiceResolver) ResourceTypes() []string {
 	}
 }
 
-func (serviceConnectorAppServiceResolver) Resolve(b *client.ClientBuilder, id armid.ResourceId) (string, error) {
-	rt, err := appServiceSitesResolver{}.Resolve(b, id.ParentScope())
+func (serviceConnectorAppServiceResolver) Resolve(ctx context.Context, b *client.ClientBuilder, id armid.ResourceId) (string, error) {
+	rt, err := appServiceSitesResolver{}.Resolve(ctx, b, id.ParentScope())
 	if err != nil {
 		return "", err
 	}
@@ -31,5 +31,5 @@ func (serviceConnectorAppServiceResolver) Resolve(b *client.ClientBuilder, id ar
 		"azurerm_windows_web_app":
 		return "azurerm_app_service_connection", nil
 	}
-	return "", fmt.Errorf("unknown app service site resource type: %s", rt)
+	return "", unknownKindError("unknown app service site resource type: %s", rt)
 }
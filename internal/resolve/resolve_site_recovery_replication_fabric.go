@@ -15,15 +15,15 @@ func (siteRecoveryReplicationFabricsResolver) ResourceTypes() []string {
 	return []string{"azurerm_site_recovery_services_vault_hyperv_site", "azurerm_site_recovery_fabric"}
 }
 
-func (siteRecoveryReplicationFabricsResolver) Resolve(b *client.ClientBuilder, id armid.ResourceId) (string, error) {
+func (siteRecoveryReplicationFabricsResolver) Resolve(ctx context.Context, b *client.ClientBuilder, id armid.ResourceId) (string, error) {
 	resourceGroupId := id.RootScope().(*armid.ResourceGroup)
 	client, err := b.NewSiteRecoveryReplicationFabricsClient(resourceGroupId.SubscriptionId)
 	if err != nil {
 		return "", err
 	}
-	resp, err := client.Get(context.Background(), resourceGroupId.Name, id.Names()[0], id.Names()[1], nil)
+	resp, err := client.Get(ctx, resourceGroupId.Name, id.Names()[0], id.Names()[1], nil)
 	if err != nil {
-		return "", fmt.Errorf("retrieving %q: %v", id, err)
+		return "", fmt.Errorf("retrieving %q: %w", id, err)
 	}
 	prop := resp.Fabric.Properties
 	if prop == nil {
@@ -35,6 +35,6 @@ func (siteRecoveryReplicationFabricsResolver) Resolve(b *client.ClientBuilder, i
 	case *armrecoveryservicessiterecovery.HyperVSiteDetails:
 		return "azurerm_site_recovery_services_vault_hyperv_site", nil
 	default:
-		return "", fmt.Errorf("unknown site recovery replication fabric detail type: %T", prop.CustomDetails)
+		return "", unknownKindError("unknown site recovery replication fabric detail type: %T", prop.CustomDetails)
 	}
 }
@@ -15,15 +15,15 @@ func (siteRecoveryReplicationNetworkMappingResolver) ResourceTypes() []string {
 	return []string{"azurerm_site_recovery_hyperv_network_mapping", "azurerm_site_recovery_network_mapping"}
 }
 
-func (siteRecoveryReplicationNetworkMappingResolver) Resolve(b *client.ClientBuilder, id armid.ResourceId) (string, error) {
+func (siteRecoveryReplicationNetworkMappingResolver) Resolve(ctx context.Context, b *client.ClientBuilder, id armid.ResourceId) (string, error) {
 	resourceGroupId := id.RootScope().(*armid.ResourceGroup)
 	client, err := b.NewSiteRecoveryReplicationNetworkMappingsClient(resourceGroupId.SubscriptionId)
 	if err != nil {
 		return "", err
 	}
-	resp, err := client.Get(context.Background(), resourceGroupId.Name, id.Names()[0], id.Names()[1], id.Names()[2], id.Names()[3], nil)
+	resp, err := client.Get(ctx, resourceGroupId.Name, id.Names()[0], id.Names()[1], id.Names()[2], id.Names()[3], nil)
 	if err != nil {
-		return "", fmt.Errorf("retrieving %q: %v", id, err)
+		return "", fmt.Errorf("retrieving %q: %w", id, err)
 	}
 	prop := resp.NetworkMapping.Properties
 	if prop == nil {
@@ -35,6 +35,6 @@ func (siteRecoveryReplicationNetworkMappingResolver) Resolve(b *client.ClientBui
 	case *armrecoveryservicessiterecovery.VmmToAzureNetworkMappingSettings:
 		return "azurerm_site_recovery_hyperv_network_mapping", nil
 	default:
-		return "", fmt.Errorf("unsupported site recovery replication network mapping detail type: %T", prop.FabricSpecificSettings)
+		return "", unknownKindError("unsupported site recovery replication network mapping detail type: %T", prop.FabricSpecificSettings)
 	}
 }
@@ -19,15 +19,15 @@ func (siteRecoveryReplicationPoliciesResolver) ResourceTypes() []string {
 	}
 }
 
-func (siteRecoveryReplicationPoliciesResolver) Resolve(b *client.ClientBuilder, id armid.ResourceId) (string, error) {
+func (siteRecoveryReplicationPoliciesResolver) Resolve(ctx context.Context, b *client.ClientBuilder, id armid.ResourceId) (string, error) {
 	resourceGroupId := id.RootScope().(*armid.ResourceGroup)
 	client, err := b.NewSiteRecoveryReplicationPoliciesClient(resourceGroupId.SubscriptionId)
 	if err != nil {
 		return "", err
 	}
-	resp, err := client.Get(context.Background(), resourceGroupId.Name, id.Names()[0], id.Names()[1], nil)
+	resp, err := client.Get(ctx, resourceGroupId.Name, id.Names()[0], id.Names()[1], nil)
 	if err != nil {
-		return "", fmt.Errorf("retrieving %q: %v", id, err)
+		return "", fmt.Errorf("retrieving %q: %w", id, err)
 	}
 	prop := resp.Policy.Properties
 	if prop == nil {
@@ -41,6 +41,6 @@ func (siteRecoveryReplicationPoliciesResolver) Resolve(b *client.ClientBuilder,
 	case *armrecoveryservicessiterecovery.VmwareCbtPolicyDetails:
 		return "azurerm_site_recovery_vmware_replication_policy", nil
 	default:
-		return "", fmt.Errorf("unknown site recovery replication policy detail type: %T", prop.ProviderSpecificDetails)
+		return "", unknownKindError("unknown site recovery replication policy detail type: %T", prop.ProviderSpecificDetails)
 	}
 }
@@ -21,15 +21,15 @@ func (siteRecoveryReplicationProtectionContainerMappingResolver) ResourceTypes()
 	}
 }
 
-func (siteRecoveryReplicationProtectionContainerMappingResolver) Resolve(b *client.ClientBuilder, id armid.ResourceId) (string, error) {
+func (siteRecoveryReplicationProtectionContainerMappingResolver) Resolve(ctx context.Context, b *client.ClientBuilder, id armid.ResourceId) (string, error) {
 	resourceGroupId := id.RootScope().(*armid.ResourceGroup)
 	client, err := b.NewSiteRecoveryReplicationProtectionContainerMappingsClient(resourceGroupId.SubscriptionId)
 	if err != nil {
 		return "", err
 	}
-	resp, err := client.Get(context.Background(), resourceGroupId.Name, id.Names()[0], id.Names()[1], id.Names()[2], id.Names()[3], nil)
+	resp, err := client.Get(ctx, resourceGroupId.Name, id.Names()[0], id.Names()[1], id.Names()[2], id.Names()[3], nil)
 	if err != nil {
-		return "", fmt.Errorf("retrieving %q: %v", id, err)
+		return "", fmt.Errorf("retrieving %q: %w", id, err)
 	}
 	prop := resp.ProtectionContainerMapping.Properties
 	if prop == nil {
@@ -41,6 +41,6 @@ func (siteRecoveryReplicationProtectionContainerMappingResolver) Resolve(b *clie
 	case *armrecoveryservicessiterecovery.A2AProtectionContainerMappingDetails:
 		return "azurerm_site_recovery_protection_container_mapping", nil
 	default:
-		return "", fmt.Errorf("unsupported site recovery replication container mapping detail type: %T", prop.ProviderSpecificDetails)
+		return "", unknownKindError("unsupported site recovery replication container mapping detail type: %T", prop.ProviderSpecificDetails)
 	}
 }
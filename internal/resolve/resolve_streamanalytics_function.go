@@ -15,15 +15,15 @@ func (streamAnalyticsFunctionsResolver) ResourceTypes() []string {
 	return []string{"azurerm_stream_analytics_function_javascript_uda", "azurerm_stream_analytics_function_javascript_udf"}
 }
 
-func (streamAnalyticsFunctionsResolver) Resolve(b *client.ClientBuilder, id armid.ResourceId) (string, error) {
+func (streamAnalyticsFunctionsResolver) Resolve(ctx context.Context, b *client.ClientBuilder, id armid.ResourceId) (string, error) {
 	resourceGroupId := id.RootScope().(*armid.ResourceGroup)
 	client, err := b.NewStreamAnalyticsFunctionsClient(resourceGroupId.SubscriptionId)
 	if err != nil {
 		return "", err
 	}
-	resp, err := client.Get(context.Background(), resourceGroupId.Name, id.Names()[0], id.Names()[1], nil)
+	resp, err := client.Get(ctx, resourceGroupId.Name, id.Names()[0], id.Names()[1], nil)
 	if err != nil {
-		return "", fmt.Errorf("retrieving %q: %v", id, err)
+		return "", fmt.Errorf("retrieving %q: %w", id, err)
 	}
 	props := resp.Function.Properties
 	if props == nil {
@@ -35,6 +35,6 @@ func (streamAnalyticsFunctionsResolver) Resolve(b *client.ClientBuilder, id armi
 	case *armstreamanalytics.FunctionProperties:
 		return "azurerm_stream_analytics_function_javascript_udf", nil
 	default:
-		return "", fmt.Errorf("unknown input property type: %T", props)
+		return "", unknownKindError("unknown input property type: %T", props)
 	}
 }
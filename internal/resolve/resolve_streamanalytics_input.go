@@ -23,15 +23,15 @@ func (streamAnalyticsInputsResolver) ResourceTypes() []string {
 	}
 }
 
-func (streamAnalyticsInputsResolver) Resolve(b *client.ClientBuilder, id armid.ResourceId) (string, error) {
+func (streamAnalyticsInputsResolver) Resolve(ctx context.Context, b *client.ClientBuilder, id armid.ResourceId) (string, error) {
 	resourceGroupId := id.RootScope().(*armid.ResourceGroup)
 	client, err := b.NewStreamAnalyticsInputsClient(resourceGroupId.SubscriptionId)
 	if err != nil {
 		return "", err
 	}
-	resp, err := client.Get(context.Background(), resourceGroupId.Name, id.Names()[0], id.Names()[1], nil)
+	resp, err := client.Get(ctx, resourceGroupId.Name, id.Names()[0], id.Names()[1], nil)
 	if err != nil {
-		return "", fmt.Errorf("retrieving %q: %v", id, err)
+		return "", fmt.Errorf("retrieving %q: %w", id, err)
 	}
 	props := resp.Input.Properties
 	if props == nil {
@@ -54,14 +54,14 @@ func (streamAnalyticsInputsResolver) Resolve(b *client.ClientBuilder, id armid.R
 			case "MICROSOFT.EVENTHUB/EVENTHUB":
 				return "azurerm_stream_analytics_stream_input_eventhub_v2", nil
 			default:
-				return "", fmt.Errorf("unknown properties.datasource.type: %s", *ds.Type)
+				return "", unknownKindError("unknown properties.datasource.type: %s", *ds.Type)
 			}
 		case *armstreamanalytics.BlobStreamInputDataSource:
 			return "azurerm_stream_analytics_stream_input_blob", nil
 		case *armstreamanalytics.IoTHubStreamInputDataSource:
 			return "azurerm_stream_analytics_stream_input_iothub", nil
 		default:
-			return "", fmt.Errorf("unknown input property data source type: %T", ds)
+			return "", unknownKindError("unknown input property data source type: %T", ds)
 		}
 	case *armstreamanalytics.ReferenceInputProperties:
 		ds := props.Datasource
@@ -74,10 +74,10 @@ func (streamAnalyticsInputsResolver) Resolve(b *client.ClientBuilder, id armid.R
 		case *armstreamanalytics.BlobReferenceInputDataSource:
 			return "azurerm_stream_analytics_reference_input_blob", nil
 		default:
-			return "", fmt.Errorf("unknown input property data source type: %T", ds)
+			return "", unknownKindError("unknown input property data source type: %T", ds)
 		}
 
 	default:
-		return "", fmt.Errorf("unknown input property type: %T", props)
+		return "", unknownKindError("unknown input property type: %T", props)
 	}
 }
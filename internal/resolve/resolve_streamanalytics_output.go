@@ -26,15 +26,15 @@ func (streamAnalyticsOutputsResolver) ResourceTypes() []string {
 	}
 }
 
-func (streamAnalyticsOutputsResolver) Resolve(b *client.ClientBuilder, id armid.ResourceId) (string, error) {
+func (streamAnalyticsOutputsResolver) Resolve(ctx context.Context, b *client.ClientBuilder, id armid.ResourceId) (string, error) {
 	resourceGroupId := id.RootScope().(*armid.ResourceGroup)
 	client, err := b.NewStreamAnalyticsOutputsClient(resourceGroupId.SubscriptionId)
 	if err != nil {
 		return "", err
 	}
-	resp, err := client.Get(context.Background(), resourceGroupId.Name, id.Names()[0], id.Names()[1], nil)
+	resp, err := client.Get(ctx, resourceGroupId.Name, id.Names()[0], id.Names()[1], nil)
 	if err != nil {
-		return "", fmt.Errorf("retrieving %q: %v", id, err)
+		return "", fmt.Errorf("retrieving %q: %w", id, err)
 	}
 	props := resp.Output.Properties
 	if props == nil {
@@ -66,6 +66,6 @@ func (streamAnalyticsOutputsResolver) Resolve(b *client.ClientBuilder, id armid.
 	case *armstreamanalytics.AzureFunctionOutputDataSource:
 		return "azurerm_stream_analytics_output_function", nil
 	default:
-		return "", fmt.Errorf("unknown output data source type: %T", ds)
+		return "", unknownKindError("unknown output data source type: %T", ds)
 	}
 }
@@ -0,0 +1,328 @@
+package resolve
+
+import (
+	"context"
+	"testing"
+
+	"github.com/magodo/armid"
+	"github.com/magodo/aztft/internal/client"
+	"github.com/magodo/aztft/internal/errs"
+	"github.com/magodo/aztft/internal/resmap"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResolve(t *testing.T) {
+	fixture, err := client.LoadFixture("testdata/resolve.json")
+	require.NoError(t, err)
+
+	cases := []struct {
+		name   string
+		id     string
+		expect string
+		err    bool
+		errIs  error
+	}{
+		{
+			name:   "linux virtual machine",
+			id:     "/subscriptions/sub1/resourceGroups/rg1/providers/Microsoft.Compute/virtualMachines/linux",
+			expect: "azurerm_linux_virtual_machine",
+		},
+		{
+			name:   "windows virtual machine",
+			id:     "/subscriptions/sub1/resourceGroups/rg1/providers/Microsoft.Compute/virtualMachines/windows",
+			expect: "azurerm_windows_virtual_machine",
+		},
+		{
+			name:   "legacy virtual machine",
+			id:     "/subscriptions/sub1/resourceGroups/rg1/providers/Microsoft.Compute/virtualMachines/legacy",
+			expect: "azurerm_virtual_machine",
+		},
+		{
+			name: "virtual machine not found",
+			id:   "/subscriptions/sub1/resourceGroups/rg1/providers/Microsoft.Compute/virtualMachines/missing",
+			err:  true,
+		},
+		{
+			name:   "logic app standard",
+			id:     "/subscriptions/sub1/resourceGroups/rg1/providers/Microsoft.Web/sites/logicapp",
+			expect: "azurerm_logic_app_standard",
+		},
+		{
+			name:   "linux web app",
+			id:     "/subscriptions/sub1/resourceGroups/rg1/providers/Microsoft.Web/sites/webapp",
+			expect: "azurerm_linux_web_app",
+		},
+		{
+			name:   "flex consumption function app",
+			id:     "/subscriptions/sub1/resourceGroups/rg1/providers/Microsoft.Web/sites/flex",
+			expect: "azurerm_function_app_flex_consumption",
+		},
+		{
+			name:   "data factory web linked service",
+			id:     "/subscriptions/sub1/resourceGroups/rg1/providers/Microsoft.DataFactory/factories/adf/linkedservices/web",
+			expect: "azurerm_data_factory_linked_service_web",
+		},
+		{
+			name:   "web pubsub socketio",
+			id:     "/subscriptions/sub1/resourceGroups/rg1/providers/Microsoft.SignalRService/webPubSub/socketio",
+			expect: "azurerm_web_pubsub_socketio",
+		},
+		{
+			// The replaced resolver returned "azurerm_palo_alto_next_generation_firewall_vhub_panorama", which isn't a TF resource type.
+			name:   "palo alto firewall (virtual hub panorama)",
+			id:     "/subscriptions/sub1/resourceGroups/rg1/providers/PaloAltoNetworks.Cloudngfw/firewalls/panorama",
+			expect: "azurerm_palo_alto_next_generation_firewall_virtual_hub_panorama",
+		},
+		{
+			name:   "palo alto firewall (virtual network panorama)",
+			id:     "/subscriptions/sub1/resourceGroups/rg1/providers/PaloAltoNetworks.Cloudngfw/firewalls/vnetpanorama",
+			expect: "azurerm_palo_alto_next_generation_firewall_virtual_network_panorama",
+		},
+		{
+			name:   "palo alto firewall (local rulestack)",
+			id:     "/subscriptions/sub1/resourceGroups/rg1/providers/PaloAltoNetworks.Cloudngfw/firewalls/local",
+			expect: "azurerm_palo_alto_next_generation_firewall_virtual_network_local_rulestack",
+		},
+		{
+			name:   "web pubsub (default rule)",
+			id:     "/subscriptions/sub1/resourceGroups/rg1/providers/Microsoft.SignalRService/webPubSub/default",
+			expect: "azurerm_web_pubsub",
+		},
+		{
+			name:   "alert processing rule (array index)",
+			id:     "/subscriptions/sub1/resourceGroups/rg1/providers/Microsoft.AlertsManagement/actionRules/rule1",
+			expect: "azurerm_monitor_alert_processing_rule_suppression",
+		},
+		{
+			name: "alert processing rule (multiple actions)",
+			id:   "/subscriptions/sub1/resourceGroups/rg1/providers/Microsoft.AlertsManagement/actionRules/rule2",
+			err:  true,
+		},
+		{
+			name: "kubernetes cluster (no rule matches)",
+			id:   "/subscriptions/sub1/resourceGroups/rg1/providers/Microsoft.ContainerService/managedClusters/unknown",
+			err:  true,
+		},
+		{
+			name: "no resolver",
+			id:   "/subscriptions/sub1/resourceGroups/rg1/providers/Microsoft.Network/virtualNetworks/vnet1",
+			err:  true,
+		},
+		{
+			name:  "virtual machine data disk (unknown create option)",
+			id:    "/subscriptions/sub1/resourceGroups/rg1/providers/Microsoft.Compute/virtualMachines/vm1/dataDisks/disk1",
+			err:   true,
+			errIs: errs.ErrUnknownKind,
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			id, err := armid.ParseResourceId(tt.id)
+			require.NoError(t, err)
+			b := client.NewReplayClientBuilder(fixture)
+			rt, err := Resolve(context.Background(), id, b.Cred, b.ClientOpt)
+			if tt.err {
+				require.Error(t, err)
+				if tt.errIs != nil {
+					require.ErrorIs(t, err, tt.errIs)
+				}
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.expect, rt)
+		})
+	}
+}
+
+func TestLoadRules(t *testing.T) {
+	cases := []struct {
+		name  string
+		input string
+		err   bool
+	}{
+		{
+			name:  "valid",
+			input: `{"/MICROSOFT.FOO/FOOS": {"/SUBSCRIPTIONS/RESOURCEGROUPS": {"api_version": "2020-01-01", "rules": [{"resource_type": "azurerm_foo", "when": [{"path": "$.properties.items[0].kind", "in": ["a", "b"]}]}, {"resource_type": "azurerm_bar"}]}}}`,
+		},
+		{
+			name:  "no api version",
+			input: `{"/MICROSOFT.FOO/FOOS": {"/SUBSCRIPTIONS/RESOURCEGROUPS": {"rules": [{"resource_type": "azurerm_foo"}]}}}`,
+			err:   true,
+		},
+		{
+			name:  "multiple tests",
+			input: `{"/MICROSOFT.FOO/FOOS": {"/SUBSCRIPTIONS/RESOURCEGROUPS": {"api_version": "2020-01-01", "rules": [{"resource_type": "azurerm_foo", "when": [{"path": "$.kind", "equals": "a", "exists": true}]}]}}}`,
+			err:   true,
+		},
+		{
+			name:  "invalid path",
+			input: `{"/MICROSOFT.FOO/FOOS": {"/SUBSCRIPTIONS/RESOURCEGROUPS": {"api_version": "2020-01-01", "rules": [{"resource_type": "azurerm_foo", "when": [{"path": "$.items[a]", "exists": true}]}]}}}`,
+			err:   true,
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := loadRules([]byte(tt.input))
+			if tt.err {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestRulesResourceTypes(t *testing.T) {
+	m, err := resmap.Embedded()
+	require.NoError(t, err)
+	rules, err := loadRules(rulesContent)
+	require.NoError(t, err)
+	for k1, rm := range rules {
+		for k2, rs := range rm {
+			for _, rt := range rs.ResourceTypes() {
+				require.Contains(t, m.TF2ARMIdMap, rt, "rules of %s in scope of %s", k1, k2)
+			}
+		}
+	}
+}
+
+func TestResolvePrefetched(t *testing.T) {
+	fixture, err := client.LoadFixture("testdata/resolve.json")
+	require.NoError(t, err)
+	b := client.NewReplayClientBuilder(fixture)
+
+	var ids []armid.ResourceId
+	for _, idStr := range []string{
+		"/subscriptions/sub1/resourceGroups/rg1/providers/Microsoft.Compute/virtualMachines/graphvm",
+		"/subscriptions/sub1/resourceGroups/rg1/providers/Microsoft.Compute/virtualMachineScaleSets/graphvmss",
+		"/subscriptions/sub1/resourceGroups/rg1/providers/Microsoft.SignalRService/webPubSub/graphsocketio",
+		"/subscriptions/sub1/resourceGroups/rg1/providers/Microsoft.Compute/virtualMachines/legacy",
+		"/subscriptions/sub1/resourceGroups/rg1/providers/Microsoft.Compute/virtualMachines/linux",
+		"/subscriptions/sub1/resourceGroups/rg1/providers/Microsoft.DataFactory/factories/adf/linkedservices/web",
+		"/subscriptions/sub1/resourceGroups/rg1/providers/Microsoft.Network/virtualNetworks/vnet1",
+	} {
+		id, err := armid.ParseResourceId(idStr)
+		require.NoError(t, err)
+		ids = append(ids, id)
+	}
+
+	prefetched, err := Prefetch(context.Background(), ids, b.Cred, b.ClientOpt)
+	require.NoError(t, err)
+	require.Len(t, prefetched, 4)
+
+	expects := []string{
+		// Resolved from the prefetched bodies, which have no GET interaction recorded.
+		"azurerm_windows_virtual_machine",
+		"azurerm_orchestrated_virtual_machine_scale_set",
+		"azurerm_web_pubsub_socketio",
+		// The prefetched body can't be resolved, fall back to GET.
+		"azurerm_virtual_machine",
+		// Not prefetched, fall back to GET.
+		"azurerm_linux_virtual_machine",
+		"azurerm_data_factory_linked_service_web",
+	}
+	for i, expect := range expects {
+		rt, _, err := ResolvePrefetched(context.Background(), ids[i], prefetched, b.Cred, b.ClientOpt)
+		require.NoError(t, err, ids[i].String())
+		require.Equal(t, expect, rt, ids[i].String())
+	}
+	_, _, err = ResolvePrefetched(context.Background(), ids[6], prefetched, b.Cred, b.ClientOpt)
+	require.Error(t, err)
+
+	require.Equal(t,
+		`resources | where id in~ ('/subscriptions/sub1/resourceGroups/rg1/providers/Microsoft.Compute/virtualMachines/vm1', '/subscriptions/sub1/resourceGroups/rg1/providers/Microsoft.Foo/foos/it\'s') | project id, kind, properties`,
+		resourceGraphQuery([]string{
+			"/subscriptions/sub1/resourceGroups/rg1/providers/Microsoft.Compute/virtualMachines/vm1",
+			"/subscriptions/sub1/resourceGroups/rg1/providers/Microsoft.Foo/foos/it's",
+		}, []string{"kind", "properties"}),
+	)
+}
+
+func TestResolveCandidates(t *testing.T) {
+	fixture, err := client.LoadFixture("testdata/resolve.json")
+	require.NoError(t, err)
+
+	cases := []struct {
+		name   string
+		id     string
+		expect []Candidate
+		err    bool
+	}{
+		{
+			name: "no rule matches",
+			id:   "/subscriptions/sub1/resourceGroups/rg1/providers/Microsoft.ContainerService/managedClusters/unknown",
+			expect: []Candidate{
+				{
+					ResourceType: "azurerm_kubernetes_automatic_cluster",
+					Evidence:     []string{"sku.name=Unknown"},
+					Reason:       `not met: $.sku.name equals "Automatic"`,
+				},
+				{
+					ResourceType: "azurerm_kubernetes_cluster",
+					Evidence:     []string{"sku.name=Unknown"},
+					Reason:       `not met: $.sku.name equals "Base"`,
+				},
+			},
+			err: true,
+		},
+		{
+			name: "flex consumption function app",
+			id:   "/subscriptions/sub1/resourceGroups/rg1/providers/Microsoft.Web/sites/flex",
+			expect: []Candidate{
+				{
+					ResourceType: "azurerm_logic_app_standard",
+					Evidence:     []string{"kind=functionapp,linux", "properties.sku=FlexConsumption"},
+					Reason:       `requires the kind to have both "functionapp" and "workflowapp"`,
+				},
+				{
+					ResourceType: "azurerm_function_app_flex_consumption",
+					Evidence:     []string{"kind=functionapp,linux", "properties.sku=FlexConsumption"},
+					Matched:      true,
+					Reason:       `requires the kind to have "functionapp" and "linux", and the "FlexConsumption" SKU`,
+				},
+				{
+					ResourceType: "azurerm_linux_function_app",
+					Evidence:     []string{"kind=functionapp,linux", "properties.sku=FlexConsumption"},
+					Reason:       `requires the kind to have "functionapp" and "linux", but not the "FlexConsumption" SKU`,
+				},
+				{
+					ResourceType: "azurerm_windows_function_app",
+					Evidence:     []string{"kind=functionapp,linux", "properties.sku=FlexConsumption"},
+					Reason:       `requires the kind to have "functionapp", but not "linux"`,
+				},
+				{
+					ResourceType: "azurerm_linux_web_app",
+					Evidence:     []string{"kind=functionapp,linux", "properties.sku=FlexConsumption"},
+					Reason:       `requires the kind to have "app" and "linux"`,
+				},
+				{
+					ResourceType: "azurerm_windows_web_app",
+					Evidence:     []string{"kind=functionapp,linux", "properties.sku=FlexConsumption"},
+					Reason:       `requires the kind to have "app", but not "linux"`,
+				},
+			},
+		},
+		{
+			name: "virtual machine not found",
+			id:   "/subscriptions/sub1/resourceGroups/rg1/providers/Microsoft.Compute/virtualMachines/missing",
+			err:  true,
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			id, err := armid.ParseResourceId(tt.id)
+			require.NoError(t, err)
+			b := client.NewReplayClientBuilder(fixture)
+			_, candidates, err := ResolvePrefetched(context.Background(), id, nil, b.Cred, b.ClientOpt)
+			if tt.err {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+			require.Equal(t, tt.expect, candidates)
+		})
+	}
+}
@@ -2,9 +2,8 @@ package resolve
 
 import (
 	"context"
-	"fmt"
+	"strings"
 
-	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/compute/armcompute/v5"
 	"github.com/magodo/armid"
 	"github.com/magodo/aztft/internal/client"
 )
@@ -15,52 +14,49 @@ func (virtualMachinesResolver) ResourceTypes() []string {
 	return []string{"azurerm_linux_virtual_machine", "azurerm_windows_virtual_machine", "azurerm_virtual_machine"}
 }
 
-func (virtualMachinesResolver) Resolve(b *client.ClientBuilder, id armid.ResourceId) (string, error) {
-	resourceGroupId := id.RootScope().(*armid.ResourceGroup)
-	client, err := b.NewVirtualMachinesClient(resourceGroupId.SubscriptionId)
-	if err != nil {
-		return "", err
-	}
-	resp, err := client.Get(context.Background(), resourceGroupId.Name, id.Names()[0], nil)
-	if err != nil {
-		return "", fmt.Errorf("retrieving %q: %v", id, err)
-	}
-	props := resp.VirtualMachine.Properties
-	if props == nil {
-		return "", fmt.Errorf("unexpected nil property in response")
-	}
-
-	if props.OSProfile == nil {
-		// Per: https://github.com/hashicorp/terraform-provider-azurerm/blob/c8d1a23b143360eaf5ee371840cc4d5ee286eddc/internal/services/compute/virtual_machine_import.go#L45-L48
-		return "azurerm_virtual_machine", nil
-	}
-
-	storageProfile := props.StorageProfile
-	if storageProfile == nil {
-		return "", fmt.Errorf("unexpected nil storage profile in response")
-	}
-
-	osDisk := storageProfile.OSDisk
-	if osDisk == nil {
-		return "", fmt.Errorf("unexpected nil OS Disk in storage profile")
-	}
+func (r virtualMachinesResolver) Resolve(ctx context.Context, b *client.ClientBuilder, id armid.ResourceId) (string, error) {
+	return resolveById(ctx, b, id, r)
+}
 
-	if osDisk.Vhd != nil {
-		// Per: https://github.com/hashicorp/terraform-provider-azurerm/blob/c8d1a23b143360eaf5ee371840cc4d5ee286eddc/internal/services/compute/virtual_machine_import.go#L36-L38
-		return "azurerm_virtual_machine", nil
-	}
+func (virtualMachinesResolver) Get(ctx context.Context, b *client.ClientBuilder, id armid.ResourceId) (interface{}, error) {
+	return getBody(ctx, b, id, "2024-03-01")
+}
 
-	osType := osDisk.OSType
-	if osType == nil {
-		return "", fmt.Errorf("unexpected nil OS Type in OS Disk")
-	}
+func (virtualMachinesResolver) BodyKeys() []string {
+	return []string{"properties"}
+}
 
-	switch *osType {
-	case armcompute.OperatingSystemTypesLinux:
-		return "azurerm_linux_virtual_machine", nil
-	case armcompute.OperatingSystemTypesWindows:
-		return "azurerm_windows_virtual_machine", nil
-	default:
-		return "", fmt.Errorf("Unknown OS Type: %s", *osType)
+func (virtualMachinesResolver) Explain(body interface{}) []Candidate {
+	evidence := []string{
+		bodyEvidence(body, "properties", "osProfile"),
+		bodyEvidence(body, "properties", "storageProfile", "osDisk", "vhd"),
+		bodyEvidence(body, "properties", "storageProfile", "osDisk", "osType"),
+	}
+	_, hasOSProfile := lookupKeys(body, "properties", "osProfile")
+	_, hasVhd := lookupKeys(body, "properties", "storageProfile", "osDisk", "vhd")
+	v, _ := lookupKeys(body, "properties", "storageProfile", "osDisk", "osType")
+	osType, _ := v.(string)
+
+	// Per: https://github.com/hashicorp/terraform-provider-azurerm/blob/c8d1a23b143360eaf5ee371840cc4d5ee286eddc/internal/services/compute/virtual_machine_import.go#L36-L48
+	legacy := !hasOSProfile || hasVhd
+	return []Candidate{
+		{
+			ResourceType: "azurerm_linux_virtual_machine",
+			Evidence:     evidence,
+			Matched:      !legacy && strings.EqualFold(osType, "Linux"),
+			Reason:       "requires the osProfile, a managed OS disk and the Linux OS type",
+		},
+		{
+			ResourceType: "azurerm_windows_virtual_machine",
+			Evidence:     evidence,
+			Matched:      !legacy && strings.EqualFold(osType, "Windows"),
+			Reason:       "requires the osProfile, a managed OS disk and the Windows OS type",
+		},
+		{
+			ResourceType: "azurerm_virtual_machine",
+			Evidence:     evidence,
+			Matched:      legacy,
+			Reason:       "requires no osProfile, or an unmanaged (VHD) OS disk",
+		},
 	}
 }
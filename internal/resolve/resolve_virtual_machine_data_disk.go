@@ -18,15 +18,15 @@ func (virutalMachineDataDiskResolver) ResourceTypes() []string {
 	}
 }
 
-func (virutalMachineDataDiskResolver) Resolve(b *client.ClientBuilder, id armid.ResourceId) (string, error) {
+func (virutalMachineDataDiskResolver) Resolve(ctx context.Context, b *client.ClientBuilder, id armid.ResourceId) (string, error) {
 	resourceGroupId := id.RootScope().(*armid.ResourceGroup)
 	client, err := b.NewVirtualMachinesClient(resourceGroupId.SubscriptionId)
 	if err != nil {
 		return "", err
 	}
-	resp, err := client.Get(context.Background(), resourceGroupId.Name, id.Names()[0], nil)
+	resp, err := client.Get(ctx, resourceGroupId.Name, id.Names()[0], nil)
 	if err != nil {
-		return "", fmt.Errorf("retrieving %q: %v", id, err)
+		return "", fmt.Errorf("retrieving %q: %w", id, err)
 	}
 
 	props := resp.VirtualMachine.Properties
@@ -59,7 +59,7 @@ func (virutalMachineDataDiskResolver) Resolve(b *client.ClientBuilder, id armid.
 		case armcompute.DiskCreateOptionTypesCopy:
 			return "azurerm_virtual_machine_implicit_data_disk_from_source", nil
 		default:
-			return "", fmt.Errorf("unexpected storageProfile.dataDisks.*.createOption: %v", *createOpt)
+			return "", unknownKindError("unknown storageProfile.dataDisks.*.createOption: %v", *createOpt)
 		}
 	}
 	return "", fmt.Errorf("data disk named %q not found", diskName)
@@ -2,9 +2,8 @@ package resolve
 
 import (
 	"context"
-	"fmt"
+	"strings"
 
-	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/compute/armcompute/v5"
 	"github.com/magodo/armid"
 	"github.com/magodo/aztft/internal/client"
 )
@@ -15,40 +14,48 @@ func (virtualMachineScaleSetsResolver) ResourceTypes() []string {
 	return []string{"azurerm_orchestrated_virtual_machine_scale_set", "azurerm_linux_virtual_machine_scale_set", "azurerm_windows_virtual_machine_scale_set"}
 }
 
-func (virtualMachineScaleSetsResolver) Resolve(b *client.ClientBuilder, id armid.ResourceId) (string, error) {
-	resourceGroupId := id.RootScope().(*armid.ResourceGroup)
-	client, err := b.NewVirtualMachineScaleSetsClient(resourceGroupId.SubscriptionId)
-	if err != nil {
-		return "", err
-	}
-	resp, err := client.Get(context.Background(), resourceGroupId.Name, id.Names()[0], nil)
-	if err != nil {
-		return "", fmt.Errorf("retrieving %q: %v", id, err)
-	}
-	props := resp.VirtualMachineScaleSet.Properties
-	if props == nil {
-		return "", fmt.Errorf("unexpected nil property in response")
-	}
+func (r virtualMachineScaleSetsResolver) Resolve(ctx context.Context, b *client.ClientBuilder, id armid.ResourceId) (string, error) {
+	return resolveById(ctx, b, id, r)
+}
 
-	// If the VMSS is created with orchestration mode "Uniform" (i.e. either linux/windows vmss), the orchestrationMode is not returned in the GET response body.
-	if orchMode := props.OrchestrationMode; orchMode != nil && *orchMode == armcompute.OrchestrationModeFlexible {
-		return "azurerm_orchestrated_virtual_machine_scale_set", nil
-	}
+func (virtualMachineScaleSetsResolver) Get(ctx context.Context, b *client.ClientBuilder, id armid.ResourceId) (interface{}, error) {
+	return getBody(ctx, b, id, "2024-03-01")
+}
 
-	profile := props.VirtualMachineProfile
-	if profile == nil {
-		return "", fmt.Errorf("unexpected nil virtualMachineProfile in response")
-	}
-	osProfile := profile.OSProfile
-	if osProfile == nil {
-		return "", fmt.Errorf("unexpected nil virtualMachineProfile.osProfile in response")
+func (virtualMachineScaleSetsResolver) BodyKeys() []string {
+	return []string{"properties"}
+}
+
+func (virtualMachineScaleSetsResolver) Explain(body interface{}) []Candidate {
+	evidence := []string{
+		bodyEvidence(body, "properties", "orchestrationMode"),
+		bodyEvidence(body, "properties", "virtualMachineProfile", "osProfile", "linuxConfiguration"),
+		bodyEvidence(body, "properties", "virtualMachineProfile", "osProfile", "windowsConfiguration"),
 	}
-	switch {
-	case osProfile.LinuxConfiguration != nil:
-		return "azurerm_linux_virtual_machine_scale_set", nil
-	case osProfile.WindowsConfiguration != nil:
-		return "azurerm_windows_virtual_machine_scale_set", nil
-	default:
-		return "", fmt.Errorf("both windowsConfiguration and linuxConfiguration in OS profile is null")
+	// If the VMSS is created with orchestration mode "Uniform" (i.e. either linux/windows vmss), the orchestrationMode is not returned in the GET response body.
+	v, _ := lookupKeys(body, "properties", "orchestrationMode")
+	orchMode, _ := v.(string)
+	flexible := strings.EqualFold(orchMode, "Flexible")
+	_, linux := lookupKeys(body, "properties", "virtualMachineProfile", "osProfile", "linuxConfiguration")
+	_, windows := lookupKeys(body, "properties", "virtualMachineProfile", "osProfile", "windowsConfiguration")
+	return []Candidate{
+		{
+			ResourceType: "azurerm_orchestrated_virtual_machine_scale_set",
+			Evidence:     evidence,
+			Matched:      flexible,
+			Reason:       `requires the "Flexible" orchestration mode`,
+		},
+		{
+			ResourceType: "azurerm_linux_virtual_machine_scale_set",
+			Evidence:     evidence,
+			Matched:      !flexible && linux,
+			Reason:       `requires the "Uniform" orchestration mode and the linuxConfiguration in the OS profile`,
+		},
+		{
+			ResourceType: "azurerm_windows_virtual_machine_scale_set",
+			Evidence:     evidence,
+			Matched:      !flexible && !linux && windows,
+			Reason:       `requires the "Uniform" orchestration mode and the windowsConfiguration in the OS profile`,
+		},
 	}
 }
@@ -14,15 +14,15 @@ func (virtualHubsResolver) ResourceTypes() []string {
 	return []string{"azurerm_route_server", "azurerm_virtual_hub"}
 }
 
-func (virtualHubsResolver) Resolve(b *client.ClientBuilder, id armid.ResourceId) (string, error) {
+func (virtualHubsResolver) Resolve(ctx context.Context, b *client.ClientBuilder, id armid.ResourceId) (string, error) {
 	resourceGroupId := id.RootScope().(*armid.ResourceGroup)
 	client, err := b.NewNetworkVirtualHubsClient(resourceGroupId.SubscriptionId)
 	if err != nil {
 		return "", err
 	}
-	resp, err := client.Get(context.Background(), resourceGroupId.Name, id.Names()[0], nil)
+	resp, err := client.Get(ctx, resourceGroupId.Name, id.Names()[0], nil)
 	if err != nil {
-		return "", fmt.Errorf("retrieving %q: %v", id, err)
+		return "", fmt.Errorf("retrieving %q: %w", id, err)
 	}
 	props := resp.VirtualHub.Properties
 	if props == nil {
@@ -1,7 +1,7 @@
 package resolve
 
 import (
-	"fmt"
+	"context"
 
 	"github.com/magodo/armid"
 	"github.com/magodo/aztft/internal/client"
@@ -13,9 +13,9 @@ func (virtualHubBgpConnectionsResolver) ResourceTypes() []string {
 	return []string{"azurerm_route_server_bgp_connection", "azurerm_virtual_hub_bgp_connection"}
 }
 
-func (virtualHubBgpConnectionsResolver) Resolve(b *client.ClientBuilder, id armid.ResourceId) (string, error) {
+func (virtualHubBgpConnectionsResolver) Resolve(ctx context.Context, b *client.ClientBuilder, id armid.ResourceId) (string, error) {
 	// The two connections are actually the same, disambiguate them via their parent resource
-	t, err := virtualHubsResolver{}.Resolve(b, id)
+	t, err := virtualHubsResolver{}.Resolve(ctx, b, id)
 	if err != nil {
 		return "", err
 	}
@@ -25,5 +25,5 @@ func (virtualHubBgpConnectionsResolver) Resolve(b *client.ClientBuilder, id armi
 	case "azurerm_virtual_hub":
 		return "azurerm_virtual_hub_bgp_connection", nil
 	}
-	return "", fmt.Errorf("unknown parent resource type: %s", t)
+	return "", unknownKindError("unknown parent resource type: %s", t)
 }
@@ -0,0 +1,359 @@
+package resolve
+
+import (
+	"context"
+	_ "embed"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/magodo/armid"
+	"github.com/magodo/aztft/internal/client"
+)
+
+//go:embed rules.json
+var rulesContent []byte
+
+func init() {
+	rules, err := loadRules(rulesContent)
+	if err != nil {
+		panic(fmt.Sprintf("loading the embedded resolver rules: %v", err))
+	}
+	for k1, rm := range rules {
+		for k2, rs := range rm {
+			if _, ok := Resolvers[k1][k2]; ok {
+				panic(fmt.Sprintf("the embedded resolver rules for %s in scope of %s conflict with the resolver", k1, k2))
+			}
+			if _, ok := Resolvers[k1]; !ok {
+				Resolvers[k1] = map[string]resolver{}
+			}
+			Resolvers[k1][k2] = rs
+		}
+	}
+}
+
+// ruleSet resolves the TF resource type of an Azure resource by GET it with the pinned API version, then matching the response against the rules.
+type ruleSet struct {
+	APIVersion string `json:"api_version"`
+
+	// Rules are matched in order, where the first matched one wins.
+	Rules []rule `json:"rules"`
+
+	// ExtraResourceTypes are the TF resource types that map to the same Azure resource type, but can't be told by the rules (yet).
+	ExtraResourceTypes []string `json:"extra_resource_types,omitempty"`
+}
+
+// rule maps the Azure resource to the TF resource type if all of its conditions are met. A rule without any condition always matches.
+type rule struct {
+	ResourceType string      `json:"resource_type"`
+	When         []condition `json:"when,omitempty"`
+}
+
+// condition tests the value at the Path of the GET response, by exactly one of Equals, In and Exists.
+// The string values are compared case insensitively, while the other scalar values are compared in their JSON form.
+type condition struct {
+	// Path is a JSONPath-style path, e.g. "$.properties.actions[0].actionType".
+	Path string `json:"path"`
+
+	Equals *string  `json:"equals,omitempty"`
+	In     []string `json:"in,omitempty"`
+
+	// Exists tests whether the value at the Path exists and is not null.
+	Exists *bool `json:"exists,omitempty"`
+
+	segs []pathSegment
+}
+
+type pathSegment struct {
+	key   string
+	index int
+	isIdx bool
+}
+
+// loadRules loads the resolver rules from the JSON, which maps from the routing scope key to the parent scope key to the rule set,
+// in the same form as the keys of the Resolvers.
+func loadRules(b []byte) (map[string]map[string]ruleSet, error) {
+	var rules map[string]map[string]ruleSet
+	if err := json.Unmarshal(b, &rules); err != nil {
+		return nil, err
+	}
+	for k1, rm := range rules {
+		for k2, rs := range rm {
+			if err := rs.init(); err != nil {
+				return nil, fmt.Errorf("rules of %s in scope of %s: %w", k1, k2, err)
+			}
+			rm[k2] = rs
+		}
+	}
+	return rules, nil
+}
+
+func (rs *ruleSet) init() error {
+	if rs.APIVersion == "" {
+		return fmt.Errorf("no api_version")
+	}
+	if len(rs.Rules) == 0 {
+		return fmt.Errorf("no rule")
+	}
+	for i, r := range rs.Rules {
+		if r.ResourceType == "" {
+			return fmt.Errorf("rule %d: no resource_type", i)
+		}
+		for j := range r.When {
+			cond := &r.When[j]
+			var n int
+			if cond.Equals != nil {
+				n++
+			}
+			if cond.In != nil {
+				n++
+			}
+			if cond.Exists != nil {
+				n++
+			}
+			if n != 1 {
+				return fmt.Errorf("rule %d condition %d: expect exactly one of equals, in and exists, got %d", i, j, n)
+			}
+			segs, err := parsePath(cond.Path)
+			if err != nil {
+				return fmt.Errorf("rule %d condition %d: parsing path %q: %w", i, j, cond.Path, err)
+			}
+			cond.segs = segs
+		}
+	}
+	return nil
+}
+
+func (rs ruleSet) ResourceTypes() []string {
+	var rts []string
+	seen := map[string]bool{}
+	for _, r := range rs.Rules {
+		if seen[r.ResourceType] {
+			continue
+		}
+		seen[r.ResourceType] = true
+		rts = append(rts, r.ResourceType)
+	}
+	return append(rts, rs.ExtraResourceTypes...)
+}
+
+func (rs ruleSet) Resolve(ctx context.Context, b *client.ClientBuilder, id armid.ResourceId) (string, error) {
+	return resolveById(ctx, b, id, rs)
+}
+
+func (rs ruleSet) Get(ctx context.Context, b *client.ClientBuilder, id armid.ResourceId) (interface{}, error) {
+	return getBody(ctx, b, id, rs.APIVersion)
+}
+
+// BodyKeys returns the top level properties referred to by the conditions, where a condition on the whole body is denoted by "$".
+func (rs ruleSet) BodyKeys() []string {
+	var keys []string
+	seen := map[string]bool{}
+	for _, r := range rs.Rules {
+		for _, cond := range r.When {
+			key := "$"
+			if len(cond.segs) != 0 && !cond.segs[0].isIdx {
+				key = cond.segs[0].key
+			}
+			if seen[key] {
+				continue
+			}
+			seen[key] = true
+			keys = append(keys, key)
+		}
+	}
+	return keys
+}
+
+// Explain matches the body against the rules in order, where the first matched rule wins.
+// The rules of the same resource type are explained as a single candidate, followed by the candidates of the extra resource types.
+func (rs ruleSet) Explain(body interface{}) []Candidate {
+	var (
+		candidates []Candidate
+		idx        = map[string]int{}
+		winner     string
+	)
+	for _, r := range rs.Rules {
+		var (
+			evidence []string
+			unmet    []string
+		)
+		for _, cond := range r.When {
+			evidence = append(evidence, cond.evidence(body))
+			if !cond.match(body) {
+				unmet = append(unmet, cond.String())
+			}
+		}
+		var (
+			matched bool
+			reason  string
+		)
+		switch {
+		case len(unmet) != 0:
+			reason = "not met: " + strings.Join(unmet, ", ")
+		case winner != "":
+			reason = fmt.Sprintf("the rule is met, but an earlier rule of %s is matched first", winner)
+		case len(r.When) == 0:
+			matched, reason, winner = true, "the default rule", r.ResourceType
+		default:
+			matched, reason, winner = true, "all the conditions are met", r.ResourceType
+		}
+
+		i, ok := idx[r.ResourceType]
+		if !ok {
+			idx[r.ResourceType] = len(candidates)
+			candidates = append(candidates, Candidate{
+				ResourceType: r.ResourceType,
+				Evidence:     evidence,
+				Matched:      matched,
+				Reason:       reason,
+			})
+			continue
+		}
+		c := &candidates[i]
+		for _, e := range evidence {
+			if !containsString(c.Evidence, e) {
+				c.Evidence = append(c.Evidence, e)
+			}
+		}
+		if matched {
+			c.Matched, c.Reason = true, reason
+		} else if !c.Matched {
+			c.Reason += "; " + reason
+		}
+	}
+	for _, rt := range rs.ExtraResourceTypes {
+		candidates = append(candidates, Candidate{
+			ResourceType: rt,
+			Reason:       "not covered by the resolver rules",
+		})
+	}
+	return candidates
+}
+
+func (cond condition) String() string {
+	switch {
+	case cond.Equals != nil:
+		return fmt.Sprintf("%s equals %q", cond.Path, *cond.Equals)
+	case cond.In != nil:
+		return fmt.Sprintf("%s in %q", cond.Path, cond.In)
+	case *cond.Exists:
+		return cond.Path + " exists"
+	default:
+		return cond.Path + " not exists"
+	}
+}
+
+// evidence returns the value at the Path of the body, in the same form as bodyEvidence.
+func (cond condition) evidence(body interface{}) string {
+	v, ok := lookupPath(body, cond.segs)
+	return strings.TrimPrefix(strings.TrimPrefix(cond.Path, "$"), ".") + "=" + evidenceValue(v, ok)
+}
+
+func containsString(l []string, s string) bool {
+	for _, e := range l {
+		if e == s {
+			return true
+		}
+	}
+	return false
+}
+
+func (cond condition) match(resp interface{}) bool {
+	v, ok := lookupPath(resp, cond.segs)
+	if cond.Exists != nil {
+		return *cond.Exists == (ok && v != nil)
+	}
+	if !ok || v == nil {
+		return false
+	}
+	var s string
+	switch v := v.(type) {
+	case string:
+		s = v
+	case bool, float64:
+		b, _ := json.Marshal(v)
+		s = string(b)
+	default:
+		return false
+	}
+	if cond.Equals != nil {
+		return strings.EqualFold(s, *cond.Equals)
+	}
+	for _, e := range cond.In {
+		if strings.EqualFold(s, e) {
+			return true
+		}
+	}
+	return false
+}
+
+// parsePath parses the JSONPath-style path, which only supports the member (i.e. ".key") and the array index (i.e. "[0]") accessors, e.g. "$.properties.actions[0].actionType".
+func parsePath(path string) ([]pathSegment, error) {
+	if !strings.HasPrefix(path, "$") {
+		return nil, fmt.Errorf(`not starting with "$"`)
+	}
+	var segs []pathSegment
+	p := path[1:]
+	for p != "" {
+		switch p[0] {
+		case '.':
+			p = p[1:]
+			end := strings.IndexAny(p, ".[")
+			if end == -1 {
+				end = len(p)
+			}
+			if end == 0 {
+				return nil, fmt.Errorf("empty member name")
+			}
+			segs = append(segs, pathSegment{key: p[:end]})
+			p = p[end:]
+		case '[':
+			end := strings.IndexByte(p, ']')
+			if end == -1 {
+				return nil, fmt.Errorf(`missing "]"`)
+			}
+			idx, err := strconv.Atoi(p[1:end])
+			if err != nil || idx < 0 {
+				return nil, fmt.Errorf("invalid array index %q", p[1:end])
+			}
+			segs = append(segs, pathSegment{index: idx, isIdx: true})
+			p = p[end+1:]
+		default:
+			return nil, fmt.Errorf("unexpected %q", p[0])
+		}
+	}
+	return segs, nil
+}
+
+func lookupPath(v interface{}, segs []pathSegment) (interface{}, bool) {
+	for _, seg := range segs {
+		if seg.isIdx {
+			l, ok := v.([]interface{})
+			if !ok || seg.index >= len(l) {
+				return nil, false
+			}
+			v = l[seg.index]
+			continue
+		}
+		m, ok := v.(map[string]interface{})
+		if !ok {
+			return nil, false
+		}
+		if v, ok = m[seg.key]; !ok {
+			return nil, false
+		}
+	}
+	return v, true
+}
+
+// lookupKeys is similar to lookupPath, but only follows the member keys. A null value is regarded as not existing.
+func lookupKeys(v interface{}, keys ...string) (interface{}, bool) {
+	segs := make([]pathSegment, 0, len(keys))
+	for _, k := range keys {
+		segs = append(segs, pathSegment{key: k})
+	}
+	v, ok := lookupPath(v, segs)
+	return v, ok && v != nil
+}
@@ -8,15 +8,15 @@ import (
 	"github.com/magodo/aztft/internal/client"
 )
 
-func buildActiveDirectoryDomainService(b *client.ClientBuilder, id armid.ResourceId, spec string) (string, error) {
+func buildActiveDirectoryDomainService(ctx context.Context, b *client.ClientBuilder, id armid.ResourceId, spec string) (string, error) {
 	resourceGroupId := id.RootScope().(*armid.ResourceGroup)
 	client, err := b.NewDomainServiceClient(resourceGroupId.SubscriptionId)
 	if err != nil {
 		return "", err
 	}
-	resp, err := client.Get(context.Background(), resourceGroupId.Name, id.Names()[0], nil)
+	resp, err := client.Get(ctx, resourceGroupId.Name, id.Names()[0], nil)
 	if err != nil {
-		return "", fmt.Errorf("retrieving %q: %v", id, err)
+		return "", fmt.Errorf("retrieving %q: %w", id, err)
 	}
 	props := resp.DomainService.Properties
 	if props == nil {
@@ -37,7 +37,7 @@ func buildActiveDirectoryDomainService(b *client.ClientBuilder, id armid.Resourc
 	rid.AttrTypes = append(rid.AttrTypes, "initialReplicaSetId")
 	rid.AttrNames = append(rid.AttrNames, *initReplicaSetId)
 	if err := id.Normalize(spec); err != nil {
-		return "", fmt.Errorf("normalizing id %q with import spec %q: %v", id.String(), spec, err)
+		return "", fmt.Errorf("normalizing id %q with import spec %q: %w", id.String(), spec, err)
 	}
 	return id.String(), nil
 }
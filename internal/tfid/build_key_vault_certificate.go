@@ -10,16 +10,16 @@ import (
 	"github.com/magodo/aztft/internal/client"
 )
 
-func buildKeyVaultCertificate(b *client.ClientBuilder, id armid.ResourceId, spec string) (string, error) {
+func buildKeyVaultCertificate(ctx context.Context, b *client.ClientBuilder, id armid.ResourceId, spec string) (string, error) {
 	resourceGroupId := id.RootScope().(*armid.ResourceGroup)
 	// We use the key client here as the certificate is a data plane only resource, which is a combination of both a key and secret, with the same name.
 	client, err := b.NewKeyVaultKeysClient(resourceGroupId.SubscriptionId)
 	if err != nil {
 		return "", err
 	}
-	resp, err := client.Get(context.Background(), resourceGroupId.Name, id.Names()[0], id.Names()[1], nil)
+	resp, err := client.Get(ctx, resourceGroupId.Name, id.Names()[0], id.Names()[1], nil)
 	if err != nil {
-		return "", fmt.Errorf("retrieving %q: %v", id, err)
+		return "", fmt.Errorf("retrieving %q: %w", id, err)
 	}
 	props := resp.Key.Properties
 	if props == nil {
@@ -31,7 +31,7 @@ func buildKeyVaultCertificate(b *client.ClientBuilder, id armid.ResourceId, spec
 	}
 	keyUrl, err := url.Parse(*uri)
 	if err != nil {
-		return "", fmt.Errorf("failed to parse uri %s: %v", *uri, err)
+		return "", fmt.Errorf("failed to parse uri %s: %w", *uri, err)
 	}
 	segs := strings.Split(keyUrl.Path, "/")
 	segs[1] = "certificates"
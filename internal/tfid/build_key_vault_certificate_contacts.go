@@ -9,15 +9,15 @@ import (
 	"github.com/magodo/aztft/internal/client"
 )
 
-func buildKeyVaultCertificateContacts(b *client.ClientBuilder, id armid.ResourceId, spec string) (string, error) {
+func buildKeyVaultCertificateContacts(ctx context.Context, b *client.ClientBuilder, id armid.ResourceId, spec string) (string, error) {
 	resourceGroupId := id.RootScope().(*armid.ResourceGroup)
 	client, err := b.NewKeyVaultVaultsClient(resourceGroupId.SubscriptionId)
 	if err != nil {
 		return "", err
 	}
-	resp, err := client.Get(context.Background(), resourceGroupId.Name, id.Names()[0], nil)
+	resp, err := client.Get(ctx, resourceGroupId.Name, id.Names()[0], nil)
 	if err != nil {
-		return "", fmt.Errorf("retrieving %q: %v", id, err)
+		return "", fmt.Errorf("retrieving %q: %w", id, err)
 	}
 	props := resp.Vault.Properties
 	if props == nil {
@@ -29,7 +29,7 @@ func buildKeyVaultCertificateContacts(b *client.ClientBuilder, id armid.Resource
 	}
 	uri, err := url.Parse(*puri)
 	if err != nil {
-		return "", fmt.Errorf("parsing uri %s: %v", *puri, err)
+		return "", fmt.Errorf("parsing uri %s: %w", *puri, err)
 	}
 	uri.Path = "/certificates/contacts"
 	return uri.String(), nil
@@ -1,6 +1,7 @@
 package tfid
 
 import (
+	"context"
 	"fmt"
 	"net/url"
 
@@ -8,14 +9,14 @@ import (
 	"github.com/magodo/aztft/internal/client"
 )
 
-func buildKeyVaultStorageAccountSasTokenDefinition(b *client.ClientBuilder, id armid.ResourceId, spec string) (string, error) {
-	storageId, err := buildKeyVaultStorageAccount(b, id.Parent(), spec)
+func buildKeyVaultStorageAccountSasTokenDefinition(ctx context.Context, b *client.ClientBuilder, id armid.ResourceId, spec string) (string, error) {
+	storageId, err := buildKeyVaultStorageAccount(ctx, b, id.Parent(), spec)
 	if err != nil {
 		return "", err
 	}
 	uri, err := url.Parse(storageId)
 	if err != nil {
-		return "", fmt.Errorf("parsing uri %s: %v", storageId, err)
+		return "", fmt.Errorf("parsing uri %s: %w", storageId, err)
 	}
 	uri = uri.JoinPath("sas", id.Names()[2])
 	return uri.String(), nil
@@ -9,15 +9,15 @@ import (
 	"github.com/magodo/aztft/internal/client"
 )
 
-func buildStorageContainerEndpoint(b *client.ClientBuilder, id armid.ResourceId, _ string) (string, error) {
+func buildStorageContainerEndpoint(ctx context.Context, b *client.ClientBuilder, id armid.ResourceId, _ string) (string, error) {
 	resourceGroupId := id.RootScope().(*armid.ResourceGroup)
 	client, err := b.NewStorageAccountsClient(resourceGroupId.SubscriptionId)
 	if err != nil {
 		return "", err
 	}
-	resp, err := client.GetProperties(context.Background(), resourceGroupId.Name, id.Names()[0], nil)
+	resp, err := client.GetProperties(ctx, resourceGroupId.Name, id.Names()[0], nil)
 	if err != nil {
-		return "", fmt.Errorf("retrieving %q: %v", id, err)
+		return "", fmt.Errorf("retrieving %q: %w", id, err)
 	}
 	props := resp.Account.Properties
 	if props == nil {
@@ -33,20 +33,20 @@ func buildStorageContainerEndpoint(b *client.ClientBuilder, id armid.ResourceId,
 	}
 	uri, err := url.Parse(*blobEndpoint)
 	if err != nil {
-		return "", fmt.Errorf("failed to parse url %s: %v", *blobEndpoint, err)
+		return "", fmt.Errorf("failed to parse url %s: %w", *blobEndpoint, err)
 	}
 	uri = uri.JoinPath(id.Names()[2])
 	return uri.String(), nil
 }
 
-func buildStorageBlob(b *client.ClientBuilder, id armid.ResourceId, spec string) (string, error) {
-	containerUrl, err := buildStorageContainerEndpoint(b, id.Parent(), spec)
+func buildStorageBlob(ctx context.Context, b *client.ClientBuilder, id armid.ResourceId, spec string) (string, error) {
+	containerUrl, err := buildStorageContainerEndpoint(ctx, b, id.Parent(), spec)
 	if err != nil {
 		return "", err
 	}
 	uri, err := url.Parse(containerUrl)
 	if err != nil {
-		return "", fmt.Errorf("parsing uri %s: %v", containerUrl, err)
+		return "", fmt.Errorf("parsing uri %s: %w", containerUrl, err)
 	}
 	uri = uri.JoinPath(id.Names()[3])
 	return uri.String(), nil
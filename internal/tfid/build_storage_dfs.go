@@ -9,15 +9,15 @@ import (
 	"github.com/magodo/aztft/internal/client"
 )
 
-func buildStorageDfs(b *client.ClientBuilder, id armid.ResourceId, spec string) (string, error) {
+func buildStorageDfs(ctx context.Context, b *client.ClientBuilder, id armid.ResourceId, spec string) (string, error) {
 	resourceGroupId := id.RootScope().(*armid.ResourceGroup)
 	client, err := b.NewStorageAccountsClient(resourceGroupId.SubscriptionId)
 	if err != nil {
 		return "", err
 	}
-	resp, err := client.GetProperties(context.Background(), resourceGroupId.Name, id.Names()[0], nil)
+	resp, err := client.GetProperties(ctx, resourceGroupId.Name, id.Names()[0], nil)
 	if err != nil {
-		return "", fmt.Errorf("retrieving %q: %v", id, err)
+		return "", fmt.Errorf("retrieving %q: %w", id, err)
 	}
 	props := resp.Account.Properties
 	if props == nil {
@@ -33,7 +33,7 @@ func buildStorageDfs(b *client.ClientBuilder, id armid.ResourceId, spec string)
 	}
 	uri, err := url.Parse(*dfsEndpoint)
 	if err != nil {
-		return "", fmt.Errorf("failed to parse url %s: %v", *dfsEndpoint, err)
+		return "", fmt.Errorf("failed to parse url %s: %w", *dfsEndpoint, err)
 	}
 	uri = uri.JoinPath(id.Names()[1])
 	return uri.String(), nil
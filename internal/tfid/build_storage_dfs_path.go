@@ -1,6 +1,7 @@
 package tfid
 
 import (
+	"context"
 	"fmt"
 	"net/url"
 	"strings"
@@ -9,14 +10,14 @@ import (
 	"github.com/magodo/aztft/internal/client"
 )
 
-func buildStorageDfsPath(b *client.ClientBuilder, id armid.ResourceId, spec string) (string, error) {
-	dfsId, err := buildStorageDfs(b, id.Parent(), spec)
+func buildStorageDfsPath(ctx context.Context, b *client.ClientBuilder, id armid.ResourceId, spec string) (string, error) {
+	dfsId, err := buildStorageDfs(ctx, b, id.Parent(), spec)
 	if err != nil {
 		return "", err
 	}
 	uri, err := url.Parse(dfsId)
 	if err != nil {
-		return "", fmt.Errorf("parsing uri %s: %v", dfsId, err)
+		return "", fmt.Errorf("parsing uri %s: %w", dfsId, err)
 	}
 	path := id.Names()[2]
 	path = strings.ReplaceAll(path, ":", "/")
@@ -9,15 +9,15 @@ import (
 	"github.com/magodo/aztft/internal/client"
 )
 
-func buildStorageQueue(b *client.ClientBuilder, id armid.ResourceId, spec string) (string, error) {
+func buildStorageQueue(ctx context.Context, b *client.ClientBuilder, id armid.ResourceId, spec string) (string, error) {
 	resourceGroupId := id.RootScope().(*armid.ResourceGroup)
 	client, err := b.NewStorageAccountsClient(resourceGroupId.SubscriptionId)
 	if err != nil {
 		return "", err
 	}
-	resp, err := client.GetProperties(context.Background(), resourceGroupId.Name, id.Names()[0], nil)
+	resp, err := client.GetProperties(ctx, resourceGroupId.Name, id.Names()[0], nil)
 	if err != nil {
-		return "", fmt.Errorf("retrieving %q: %v", id, err)
+		return "", fmt.Errorf("retrieving %q: %w", id, err)
 	}
 	props := resp.Account.Properties
 	if props == nil {
@@ -33,7 +33,7 @@ func buildStorageQueue(b *client.ClientBuilder, id armid.ResourceId, spec string
 	}
 	uri, err := url.Parse(*queueEndpoint)
 	if err != nil {
-		return "", fmt.Errorf("failed to parse url %s: %v", *queueEndpoint, err)
+		return "", fmt.Errorf("failed to parse url %s: %w", *queueEndpoint, err)
 	}
 	uri = uri.JoinPath(id.Names()[2])
 	return uri.String(), nil
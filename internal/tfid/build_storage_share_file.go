@@ -1,6 +1,7 @@
 package tfid
 
 import (
+	"context"
 	"fmt"
 	"net/url"
 	"strings"
@@ -9,14 +10,14 @@ import (
 	"github.com/magodo/aztft/internal/client"
 )
 
-func buildStorageShareFile(b *client.ClientBuilder, id armid.ResourceId, spec string) (string, error) {
-	shareId, err := buildStorageShare(b, id.Parent(), spec)
+func buildStorageShareFile(ctx context.Context, b *client.ClientBuilder, id armid.ResourceId, spec string) (string, error) {
+	shareId, err := buildStorageShare(ctx, b, id.Parent(), spec)
 	if err != nil {
 		return "", err
 	}
 	uri, err := url.Parse(shareId)
 	if err != nil {
-		return "", fmt.Errorf("parsing uri %s: %v", shareId, err)
+		return "", fmt.Errorf("parsing uri %s: %w", shareId, err)
 	}
 	path := id.Names()[3]
 	path = strings.ReplaceAll(path, ":", "/")
@@ -0,0 +1,221 @@
+package tfid
+
+import (
+	"encoding/base64"
+	"fmt"
+	"strings"
+
+	"github.com/magodo/armid"
+	"github.com/magodo/aztft/internal/errs"
+	"github.com/magodo/aztft/internal/resmap"
+)
+
+type propertyLikeSpec struct {
+	mainRt   string
+	propRt   string
+	attrType string
+}
+
+// propertyLikeSpecs records how the property-like resources' TF id (i.e. "<main TF id>|<property TF id>") are built from their pesudo Azure resource ids.
+// The property-like resources whose main TF id is not the direct parent of the pesudo resource id are not invertible, hence not listed here.
+var propertyLikeSpecs = map[string]propertyLikeSpec{
+	"azurerm_nat_gateway_public_ip_association":                                      {"azurerm_nat_gateway", "azurerm_public_ip", "publicIPAddresses"},
+	"azurerm_nat_gateway_public_ip_prefix_association":                               {"azurerm_nat_gateway", "azurerm_public_ip_prefix", "publicIPPrefixes"},
+	"azurerm_network_interface_application_gateway_backend_address_pool_association": {"fake_azurerm_network_interface_ipconfig", "fake_azurerm_application_gateway_backend_address_pool", "applicationGatewayBackendAddressPools"},
+	"azurerm_network_interface_backend_address_pool_association":                     {"fake_azurerm_network_interface_ipconfig", "azurerm_lb_backend_address_pool", "loadBalancerBackendAddressPools"},
+	"azurerm_network_interface_nat_rule_association":                                 {"fake_azurerm_network_interface_ipconfig", "azurerm_lb_nat_rule", "loadBalancerInboundNatRules"},
+	"azurerm_network_interface_security_group_association":                           {"azurerm_network_interface", "azurerm_network_security_group", "networkSecurityGroups"},
+	"azurerm_virtual_desktop_workspace_application_group_association":                {"azurerm_virtual_desktop_workspace", "azurerm_virtual_desktop_application_group", "applicationGroups"},
+}
+
+// PesudoNames returns the well-known trailing names of the Azure resource id, which are dropped in the TF resource id by the projection of the id transform.
+// It returns nil if any of the dropped names isn't a constant name.
+func PesudoNames(m *resmap.Mapping, rt string) []string {
+	item, ok := m.TF2ARMIdMap[rt]
+	if !ok || item.ManagementPlane == nil {
+		return nil
+	}
+	mm := item.ManagementPlane
+	n := mm.ProjectedTypes()
+	if n == 0 || n > len(mm.Types) {
+		return nil
+	}
+	var names []string
+	for _, typ := range mm.Types[len(mm.Types)-n:] {
+		name, ok := mm.IdTransform.ConstantName(typ)
+		if !ok {
+			return nil
+		}
+		names = append(names, name)
+	}
+	return names
+}
+
+// StaticParse is the reverse of StaticBuild, which parses the TF resource id of the specified TF resource type back to its Azure resource id.
+// Of the TF resource ids built by DynamicBuild, only those embedding the Azure resource id (e.g. azurerm_api_management_api) are parsed.
+// The data plane URLs (e.g. of the storage blobs and the key vault secrets) result in an ErrNeedsAPI, as they don't have the subscription
+// and resource group of the storage account or key vault.
+func StaticParse(m *resmap.Mapping, tfId string, rt string) (armid.ResourceId, error) {
+	item, ok := m.TF2ARMIdMap[rt]
+	if !ok {
+		return nil, errs.Mark(errs.ErrNoMatch, "unknown resource type %q", rt)
+	}
+	mm := item.ManagementPlane
+	if mm == nil {
+		return nil, fmt.Errorf("%q has no management plane mapping", rt)
+	}
+
+	switch rt {
+	case "azurerm_monitor_diagnostic_setting":
+		segs, err := splitPipe(tfId, 2)
+		if err != nil {
+			return nil, err
+		}
+		return newScopedId(segs[0], mm, segs[1])
+
+	case "azurerm_synapse_role_assignment":
+		segs, err := splitPipe(tfId, 2)
+		if err != nil {
+			return nil, err
+		}
+		return appendNames(segs[0], mm, segs[1])
+
+	case "azurerm_network_manager_deployment":
+		segs, err := splitPipe(tfId, 3)
+		if err != nil {
+			return nil, err
+		}
+		return appendNames(strings.TrimSuffix(segs[0], "/commit"), mm, segs[1], segs[2])
+
+	case "azurerm_postgresql_flexible_server_virtual_endpoint",
+		"azurerm_role_management_policy",
+		"azurerm_role_definition":
+		segs, err := splitPipe(tfId, 2)
+		if err != nil {
+			return nil, err
+		}
+		return parseAs(segs[0], mm)
+
+	case "azurerm_api_management_api":
+		id, _, _ := strings.Cut(tfId, ";")
+		return parseAs(id, mm)
+
+	case "azurerm_active_directory_domain_service":
+		id, err := armid.ParseResourceId(tfId)
+		if err != nil {
+			return nil, errs.Mark(errs.ErrInvalidId, "parsing %q: %w", tfId, err)
+		}
+		if id.Parent() == nil {
+			return nil, fmt.Errorf("%q has no parent resource", tfId)
+		}
+		return parseAs(id.Parent().String(), mm)
+	}
+
+	if spec, ok := propertyLikeSpecs[rt]; ok {
+		segs, err := splitPipe(tfId, 2)
+		if err != nil {
+			return nil, err
+		}
+		mainTFId, propTFId := segs[0], segs[1]
+		mainId, err := StaticParse(m, mainTFId, spec.mainRt)
+		if err != nil {
+			return nil, fmt.Errorf("parsing %q as %q: %w", mainTFId, spec.mainRt, err)
+		}
+		propId, err := StaticParse(m, propTFId, spec.propRt)
+		if err != nil {
+			return nil, fmt.Errorf("parsing %q as %q: %w", propTFId, spec.propRt, err)
+		}
+		id := mainId.(*armid.ScopedResourceId)
+		id.AttrTypes = append(id.AttrTypes, spec.attrType)
+		id.AttrNames = append(id.AttrNames, base64.StdEncoding.EncodeToString([]byte(propId.String())))
+		return id, nil
+	}
+
+	if names := PesudoNames(m, rt); names != nil {
+		if mm.IdTransform.Projection == resmap.ProjectionParentScope {
+			return newScopedId(tfId, mm, names...)
+		}
+		return appendNames(tfId, mm, names...)
+	}
+	if mm.ProjectedTypes() != 0 {
+		return nil, fmt.Errorf("the TF id of %q only refers to part of its Azure resource id, which can't be parsed back", rt)
+	}
+
+	if NeedsAPI(rt) {
+		return nil, errs.Mark(errs.ErrNeedsAPI, "the TF id of %q is a data plane URL, which can't be parsed back to its Azure resource id without calling Azure API", rt)
+	}
+	if len(mm.ImportSpecs) == 0 && (len(mm.ParentScopes) != 1 || mm.ParentScopes[0] != resmap.ScopeAny) {
+		return nil, fmt.Errorf("the TF id of %q is synthetic and can't be parsed back to its Azure resource id", rt)
+	}
+	if len(mm.ImportSpecs) != 0 && len(mm.ParentScopes) != 0 {
+		// The import spec of the resource whose TF id is its parent id is shorter than its ARM scope string.
+		if len(strings.Split(mm.ImportSpecs[0], "/")) < len(strings.Split(mm.ParentScopes[0], "/"))+1+len(mm.Types) {
+			return nil, fmt.Errorf("the TF id of %q only refers to part of its Azure resource id, which can't be parsed back", rt)
+		}
+	}
+	return parseAs(tfId, mm)
+}
+
+// parseAs parses the id literal, and ensures it has the same resource type as the mapping item.
+// The route scope is replaced by the mapping item, in case the TF id uses a different literal (e.g. "endpoints" for the "endpointsEventhub").
+func parseAs(idStr string, mm *resmap.MapManagementPlane) (armid.ResourceId, error) {
+	id, err := armid.ParseResourceId(idStr)
+	if err != nil {
+		return nil, errs.Mark(errs.ErrInvalidId, "parsing %q: %w", idStr, err)
+	}
+	rid, ok := id.(*armid.ScopedResourceId)
+	if !ok {
+		if !strings.EqualFold(id.Provider(), mm.Provider) || !strings.EqualFold(strings.Join(id.Types(), "/"), strings.Join(mm.Types, "/")) {
+			return nil, errs.Mark(errs.ErrNoMatch, "%q is not a %s/%s", idStr, mm.Provider, strings.Join(mm.Types, "/"))
+		}
+		return id, nil
+	}
+	if !strings.EqualFold(rid.AttrProvider, mm.Provider) || len(rid.AttrTypes) != len(mm.Types) {
+		return nil, errs.Mark(errs.ErrNoMatch, "%q is not a %s/%s", idStr, mm.Provider, strings.Join(mm.Types, "/"))
+	}
+	rid.AttrProvider = mm.Provider
+	rid.AttrTypes = append([]string{}, mm.Types...)
+	return rid, nil
+}
+
+// appendNames parses the parent id literal, and appends the remaining types from the mapping item together with the names.
+func appendNames(parentIdStr string, mm *resmap.MapManagementPlane, names ...string) (armid.ResourceId, error) {
+	id, err := armid.ParseResourceId(parentIdStr)
+	if err != nil {
+		return nil, errs.Mark(errs.ErrInvalidId, "parsing %q: %w", parentIdStr, err)
+	}
+	rid, ok := id.(*armid.ScopedResourceId)
+	if !ok || !strings.EqualFold(rid.AttrProvider, mm.Provider) || len(rid.AttrTypes)+len(names) != len(mm.Types) ||
+		!strings.EqualFold(strings.Join(rid.AttrTypes, "/"), strings.Join(mm.Types[:len(rid.AttrTypes)], "/")) {
+		return nil, errs.Mark(errs.ErrNoMatch, "%q is not a parent of %s/%s", parentIdStr, mm.Provider, strings.Join(mm.Types, "/"))
+	}
+	rid.AttrProvider = mm.Provider
+	rid.AttrTypes = append([]string{}, mm.Types...)
+	rid.AttrNames = append(rid.AttrNames, names...)
+	return rid, nil
+}
+
+// newScopedId parses the parent scope id literal, and builds the scoped id based on the mapping item and the names.
+func newScopedId(parentScopeIdStr string, mm *resmap.MapManagementPlane, names ...string) (armid.ResourceId, error) {
+	pid, err := armid.ParseResourceId(parentScopeIdStr)
+	if err != nil {
+		return nil, errs.Mark(errs.ErrInvalidId, "parsing %q: %w", parentScopeIdStr, err)
+	}
+	if len(names) != len(mm.Types) {
+		return nil, errs.Mark(errs.ErrNoMatch, "expect %d names for %s/%s, got %d", len(mm.Types), mm.Provider, strings.Join(mm.Types, "/"), len(names))
+	}
+	return &armid.ScopedResourceId{
+		AttrParentScope: pid,
+		AttrProvider:    mm.Provider,
+		AttrTypes:       append([]string{}, mm.Types...),
+		AttrNames:       names,
+	}, nil
+}
+
+func splitPipe(tfId string, n int) ([]string, error) {
+	segs := strings.SplitN(tfId, "|", n)
+	if len(segs) != n {
+		return nil, fmt.Errorf("expect %d segments separated by %q in %q, got %d", n, "|", tfId, len(segs))
+	}
+	return segs, nil
+}
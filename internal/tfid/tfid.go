@@ -1,18 +1,21 @@
 package tfid
 
 import (
+	"context"
 	"encoding/base64"
 	"fmt"
+	"sort"
 	"strings"
 
 	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
 	"github.com/Azure/azure-sdk-for-go/sdk/azcore/arm"
 	"github.com/magodo/armid"
 	"github.com/magodo/aztft/internal/client"
+	"github.com/magodo/aztft/internal/errs"
 	"github.com/magodo/aztft/internal/resmap"
 )
 
-type builderFunc func(*client.ClientBuilder, armid.ResourceId, string) (string, error)
+type builderFunc func(context.Context, *client.ClientBuilder, armid.ResourceId, string) (string, error)
 
 var dynamicBuilders = map[string]builderFunc{
 	"azurerm_active_directory_domain_service":                        buildActiveDirectoryDomainService,
@@ -36,22 +39,127 @@ var dynamicBuilders = map[string]builderFunc{
 	"azurerm_automation_job_schedule":                                buildAutomationJobSchedule,
 }
 
+// dataPlaneOnlyTypes are the TF resource types that are data plane only, whose Azure resource id is a pesudo resource id.
+var dataPlaneOnlyTypes = map[string]bool{
+	"azurerm_key_vault_certificate":                                  true,
+	"azurerm_key_vault_certificate_contacts":                         true,
+	"azurerm_key_vault_certificate_issuer":                           true,
+	"azurerm_key_vault_managed_storage_account":                      true,
+	"azurerm_key_vault_managed_storage_account_sas_token_definition": true,
+	"azurerm_storage_blob":                                           true,
+	"azurerm_storage_data_lake_gen2_filesystem":                      true,
+	"azurerm_storage_data_lake_gen2_path":                            true,
+	"azurerm_storage_share_directory":                                true,
+	"azurerm_storage_share_file":                                     true,
+	"azurerm_storage_table_entity":                                   true,
+	"azurerm_synapse_linked_service":                                 true,
+	"azurerm_synapse_role_assignment":                                true,
+	"azurerm_storage_account_queue_properties":                       true,
+	"azurerm_storage_account_static_website":                         true,
+}
+
+// pesudoIdNotes are the notes about how to compose the pesudo resource id of the data plane only resources.
+var pesudoIdNotes = map[string]string{
+	"azurerm_storage_data_lake_gen2_path": "For path that is more than one level, use `:` as separator. E.g. `paths1` can be `dir1:dir2`",
+	"azurerm_storage_share_directory":     "For path that is more than one level, use `:` as separator. E.g. `directories1` can be `dir1:dir2`",
+	"azurerm_storage_share_file":          "For path that is more than one level, use `:` as separator. E.g. `files1` can be `dir1:file1`",
+}
+
+// PesudoIdNote returns the note about how to compose the pesudo resource id of the TF resource type, if any.
+func PesudoIdNote(rt string) string {
+	return pesudoIdNotes[rt]
+}
+
+// IsDataPlaneOnly tells whether the TF resource type is data plane only, whose Azure resource id is a pesudo resource id.
+func IsDataPlaneOnly(rt string) bool {
+	return dataPlaneOnlyTypes[rt]
+}
+
 func NeedsAPI(rt string) bool {
 	_, ok := dynamicBuilders[rt]
 	return ok
 }
 
-func DynamicBuild(id armid.ResourceId, rt string, cred azcore.TokenCredential, clientOpt arm.ClientOptions) (string, error) {
+// DynamicBuildTypes returns the TF resource types that are built by DynamicBuild, sorted.
+func DynamicBuildTypes() []string {
+	var out []string
+	for rt := range dynamicBuilders {
+		out = append(out, rt)
+	}
+	sort.Strings(out)
+	return out
+}
+
+// propertyLikeResource describes how StaticBuild builds the TF resource id of a property-like resource, which combines the TF resource id
+// of the main resource and the TF resource id of the referenced resource, whose id is base64 encoded as the last name of the pesudo resource id.
+type propertyLikeResource struct {
+	mainRt string
+	refRt  string
+
+	// mainIdDepth is the number of levels from the pesudo resource id up to the main resource id.
+	mainIdDepth int
+}
+
+var propertyLikeResources = map[string]propertyLikeResource{
+	"azurerm_nat_gateway_public_ip_association":                                      {mainRt: "azurerm_nat_gateway", refRt: "azurerm_public_ip", mainIdDepth: 1},
+	"azurerm_nat_gateway_public_ip_prefix_association":                               {mainRt: "azurerm_nat_gateway", refRt: "azurerm_public_ip_prefix", mainIdDepth: 1},
+	"azurerm_network_interface_application_gateway_backend_address_pool_association": {mainRt: "fake_azurerm_network_interface_ipconfig", refRt: "fake_azurerm_application_gateway_backend_address_pool", mainIdDepth: 1},
+	"azurerm_network_interface_application_security_group_association":               {mainRt: "azurerm_network_interface", refRt: "azurerm_application_security_group", mainIdDepth: 2},
+	"azurerm_network_interface_backend_address_pool_association":                     {mainRt: "fake_azurerm_network_interface_ipconfig", refRt: "azurerm_lb_backend_address_pool", mainIdDepth: 1},
+	"azurerm_network_interface_nat_rule_association":                                 {mainRt: "fake_azurerm_network_interface_ipconfig", refRt: "azurerm_lb_nat_rule", mainIdDepth: 1},
+	"azurerm_network_interface_security_group_association":                           {mainRt: "azurerm_network_interface", refRt: "azurerm_network_security_group", mainIdDepth: 1},
+	"azurerm_virtual_desktop_workspace_application_group_association":                {mainRt: "azurerm_virtual_desktop_workspace", refRt: "azurerm_virtual_desktop_application_group", mainIdDepth: 1},
+}
+
+// PropertyLikeTypes returns the TF resource types of the property-like resources, whose TF resource id is combined from the main resource and the referenced resource by StaticBuild, sorted.
+func PropertyLikeTypes() []string {
+	var out []string
+	for rt := range propertyLikeResources {
+		out = append(out, rt)
+	}
+	sort.Strings(out)
+	return out
+}
+
+// Tracer receives the steps of building the TF resource id, see StaticBuildWithTrace. Either of its functions can be nil.
+type Tracer struct {
+	// ImportSpec is called with the import spec chosen for the TF resource type, and why it is chosen.
+	ImportSpec func(rt, importSpec, reason string)
+
+	// Transform is called for each transformation applied to the id, in form of fmt.Printf arguments.
+	Transform func(rt, format string, a ...interface{})
+}
+
+func (t *Tracer) importSpec(rt, importSpec, reason string) {
+	if t == nil || t.ImportSpec == nil {
+		return
+	}
+	t.ImportSpec(rt, importSpec, reason)
+}
+
+func (t *Tracer) transform(rt, format string, a ...interface{}) {
+	if t == nil || t.Transform == nil {
+		return
+	}
+	t.Transform(rt, format, a...)
+}
+
+func DynamicBuild(ctx context.Context, m *resmap.Mapping, id armid.ResourceId, rt string, cred azcore.TokenCredential, clientOpt arm.ClientOptions) (string, error) {
+	return DynamicBuildWithTrace(ctx, m, id, rt, cred, clientOpt, nil)
+}
+
+// DynamicBuildWithTrace is similar to DynamicBuild, except it reports the import spec and the builder to the tracer.
+func DynamicBuildWithTrace(ctx context.Context, m *resmap.Mapping, id armid.ResourceId, rt string, cred azcore.TokenCredential, clientOpt arm.ClientOptions, t *Tracer) (string, error) {
 	id = id.Clone()
 
-	importSpec, err := GetImportSpec(id, rt)
+	importSpec, err := getImportSpec(m, id, rt, t)
 	if err != nil {
-		return "", fmt.Errorf("getting import spec for %s as %s: %v", id, rt, err)
+		return "", fmt.Errorf("getting import spec for %s as %s: %w", id, rt, err)
 	}
 
 	builder, ok := dynamicBuilders[rt]
 	if !ok {
-		return "", fmt.Errorf("unknown resource type: %q", rt)
+		return "", errs.Mark(errs.ErrNoMatch, "unknown resource type: %q", rt)
 	}
 
 	b := &client.ClientBuilder{
@@ -59,19 +167,26 @@ func DynamicBuild(id armid.ResourceId, rt string, cred azcore.TokenCredential, c
 		ClientOpt: clientOpt,
 	}
 
-	return builder(b, id, importSpec)
+	t.transform(rt, "built %s by calling the Azure API", id)
+	return builder(ctx, b, id, importSpec)
+}
+
+func StaticBuild(m *resmap.Mapping, id armid.ResourceId, rt string) (string, error) {
+	return StaticBuildWithTrace(m, id, rt, nil)
 }
 
-func StaticBuild(id armid.ResourceId, rt string) (string, error) {
+// StaticBuildWithTrace is similar to StaticBuild, except it reports the import spec and each transformation applied to the id to the tracer.
+func StaticBuildWithTrace(m *resmap.Mapping, id armid.ResourceId, rt string, t *Tracer) (string, error) {
 	id = id.Clone()
 
-	importSpec, err := GetImportSpec(id, rt)
+	importSpec, err := getImportSpec(m, id, rt, t)
 	if err != nil {
-		return "", fmt.Errorf("getting import spec for %s as %s: %v", id, rt, err)
+		return "", fmt.Errorf("getting import spec for %s as %s: %w", id, rt, err)
 	}
 
 	rid, ok := id.(*armid.ScopedResourceId)
 	if !ok {
+		t.transform(rt, "kept the root scope id %s as is", id)
 		return id.String(), nil
 	}
 
@@ -82,135 +197,117 @@ func StaticBuild(id armid.ResourceId, rt string) (string, error) {
 		return l[len(l)-1]
 	}
 
+	// Porperty-like resources
+	if plr, ok := propertyLikeResources[rt]; ok {
+		mainId := id.Parent()
+		for i := 1; i < plr.mainIdDepth; i++ {
+			mainId = mainId.Parent()
+		}
+		t.transform(rt, "combined the %s id of %s and the %s id decoded from the last name, separated by %q", plr.mainRt, mainId, plr.refRt, "|")
+		return buildIdForPropertyLikeResource(m, mainId, lastItem(id.Names()), plr.mainRt, plr.refRt, "|", t)
+	}
+
 	switch rt {
 	case "azurerm_monitor_diagnostic_setting":
 		// input: <target id>/providers/Microsoft.Insights/diagnosticSettings/setting1
 		// tfid : <target id>|setting1
 		id = id.ParentScope()
+		t.transform(rt, "combined the parent scope id %s and the name %q", id, rid.Names()[0])
 		return id.String() + "|" + rid.Names()[0], nil
 
 	case "azurerm_synapse_role_assignment":
 		pid := id.Parent()
 		if err := pid.Normalize(importSpec); err != nil {
-			return "", fmt.Errorf("normalizing id %q for %q with import spec %q: %v", pid.String(), rt, importSpec, err)
+			return "", fmt.Errorf("normalizing id %q for %q with import spec %q: %w", pid.String(), rt, importSpec, err)
 		}
+		t.transform(rt, "combined the normalized parent id %s and the name %q", pid, id.Names()[1])
 		return pid.String() + "|" + id.Names()[1], nil
 
 	case "azurerm_network_manager_deployment":
 		managerId := id.Parent().Parent()
 		if err := managerId.Normalize(importSpec); err != nil {
-			return "", fmt.Errorf("normalizing id %q for %q with import spec %q: %v", managerId.String(), rt, importSpec, err)
+			return "", fmt.Errorf("normalizing id %q for %q with import spec %q: %w", managerId.String(), rt, importSpec, err)
 		}
+		t.transform(rt, "combined the normalized network manager id %s with \"/commit\", and the names %q and %q", managerId, id.Names()[1], id.Names()[2])
 		return managerId.String() + "/commit|" + id.Names()[1] + "|" + id.Names()[2], nil
 	case "azurerm_postgresql_flexible_server_virtual_endpoint":
 		if err := id.Normalize(importSpec); err != nil {
-			return "", fmt.Errorf("normalizing id %q for %q with import spec %q: %v", id.String(), rt, importSpec, err)
+			return "", fmt.Errorf("normalizing id %q for %q with import spec %q: %w", id.String(), rt, importSpec, err)
 		}
 		// It is fine to simply combine the same id twice, instead of getting the review replica id.
 		// That is because the Azure resource id is provided by the user and we can guarantee it is not failovered.
+		t.transform(rt, "combined the normalized id %s twice", id)
 		return fmt.Sprintf("%[1]s|%[1]s", id.String()), nil
 
-	// Porperty-like resources
-	case "azurerm_nat_gateway_public_ip_association":
-		return buildIdForPropertyLikeResource(id.Parent(), lastItem(id.Names()), "azurerm_nat_gateway", "azurerm_public_ip", "|")
-	case "azurerm_nat_gateway_public_ip_prefix_association":
-		return buildIdForPropertyLikeResource(id.Parent(), lastItem(id.Names()), "azurerm_nat_gateway", "azurerm_public_ip_prefix", "|")
-	case "azurerm_network_interface_application_gateway_backend_address_pool_association":
-		return buildIdForPropertyLikeResource(id.Parent(), lastItem(id.Names()), "fake_azurerm_network_interface_ipconfig", "fake_azurerm_application_gateway_backend_address_pool", "|")
-	case "azurerm_network_interface_application_security_group_association":
-		return buildIdForPropertyLikeResource(id.Parent().Parent(), lastItem(id.Names()), "azurerm_network_interface", "azurerm_application_security_group", "|")
-	case "azurerm_network_interface_backend_address_pool_association":
-		return buildIdForPropertyLikeResource(id.Parent(), lastItem(id.Names()), "fake_azurerm_network_interface_ipconfig", "azurerm_lb_backend_address_pool", "|")
-	case "azurerm_network_interface_nat_rule_association":
-		return buildIdForPropertyLikeResource(id.Parent(), lastItem(id.Names()), "fake_azurerm_network_interface_ipconfig", "azurerm_lb_nat_rule", "|")
-	case "azurerm_network_interface_security_group_association":
-		return buildIdForPropertyLikeResource(id.Parent(), lastItem(id.Names()), "azurerm_network_interface", "azurerm_network_security_group", "|")
-	case "azurerm_virtual_desktop_workspace_application_group_association":
-		return buildIdForPropertyLikeResource(id.Parent(), lastItem(id.Names()), "azurerm_virtual_desktop_workspace", "azurerm_virtual_desktop_application_group", "|")
 	case "azurerm_role_management_policy":
 		parentScopeId := id.ParentScope()
+		t.transform(rt, "combined the id and the parent scope id %s", parentScopeId)
 		return id.String() + "|" + parentScopeId.String(), nil
 	case "azurerm_role_definition":
+		t.transform(rt, "combined the id and the parent scope id %s", id.ParentScope())
 		return id.String() + "|" + id.ParentScope().String(), nil
+	}
 
-	///
-	// Non-early return branches below
-	case "azurerm_iothub_endpoint_cosmosdb_account",
-		"azurerm_iothub_endpoint_eventhub",
-		"azurerm_iothub_endpoint_servicebus_queue",
-		"azurerm_iothub_endpoint_servicebus_topic",
-		"azurerm_iothub_endpoint_storage_container":
-		rid.AttrTypes[len(rid.AttrTypes)-1] = "endpoints"
-	case "azurerm_app_service_slot_virtual_network_swift_connection":
-		rid.AttrTypes[2] = "config"
-	case "azurerm_app_service_virtual_network_swift_connection":
-		rid.AttrTypes[1] = "config"
-	case "azurerm_synapse_workspace_sql_aad_admin":
-		rid.AttrTypes[1] = "sqlAdministrators"
-	case "azurerm_role_assignment":
-		// This resource has scope any, causing it has no format string. Manually format the last segment.
-		id := id.(*armid.ScopedResourceId)
-		id.AttrTypes[len(id.AttrTypes)-1] = "roleAssignments"
-
-	// Parent ID
-	case "azurerm_postgresql_active_directory_administrator",
-		"azurerm_iotcentral_application_network_rule_set",
-		"azurerm_api_management_api_operation_policy",
-		"azurerm_api_management_api_policy",
-		"azurerm_api_management_policy",
-		"azurerm_api_management_product_policy",
-		"azurerm_netapp_account_encryption",
-		"azurerm_storage_blob_inventory_policy",
-		"azurerm_storage_account_queue_properties",
-		"azurerm_storage_account_table_properties",
-		"azurerm_storage_account_static_website",
-		"azurerm_container_app_environment_custom_domain",
-		"azurerm_subnet_nat_gateway_association",
-		"azurerm_subnet_network_security_group_association",
-		"azurerm_subnet_route_table_association",
-		"azurerm_mssql_job_schedule",
-		"azurerm_stream_analytics_job_storage_account",
-		"azurerm_api_management_workspace_policy",
-		"azurerm_managed_redis":
-		id = id.Parent()
-
-	// Grand parent ID
-	case "azurerm_managed_redis_geo_replication":
-		id = id.Parent().Parent()
-
-	// Parent Scope
-	case "azurerm_eventgrid_partner_configuration",
-		"azurerm_kubernetes_cluster_deployment_safeguard":
-		id = id.ParentScope()
+	if mm := m.TF2ARMIdMap[rt].ManagementPlane; mm != nil && mm.IdTransform != nil {
+		from := id.String()
+		id = transformId(rid, mm.IdTransform)
+		t.transform(rt, "applied the id transform of the mapping: %s -> %s", from, id)
 	}
 
 	if importSpec != "" {
+		from := id.String()
 		if err := id.Normalize(importSpec); err != nil {
-			return "", fmt.Errorf("normalizing id %q for %q with import spec %q: %v", id.String(), rt, importSpec, err)
+			return "", fmt.Errorf("normalizing id %q for %q with import spec %q: %w", id.String(), rt, importSpec, err)
 		}
+		t.transform(rt, "normalized the id with the import spec: %s -> %s", from, id)
+	} else {
+		t.transform(rt, "kept the id %s as is, as there is no import spec", id)
 	}
 	return id.String(), nil
 }
 
-func GetImportSpec(id armid.ResourceId, rt string) (string, error) {
-	resmap.Init()
-	m := resmap.TF2ARMIdMap
-	_ = m
-	item, ok := resmap.TF2ARMIdMap[rt]
+// transformId applies the id transform of the mapping item to the Azure resource id, whose result is to be normalized as the TF resource id.
+func transformId(id *armid.ScopedResourceId, t *resmap.IdTransform) armid.ResourceId {
+	for i, typ := range id.AttrTypes {
+		if name, ok := t.ConstantName(typ); ok {
+			id.AttrNames[i] = name
+		}
+		id.AttrTypes[i] = t.RenameType(typ)
+	}
+	switch t.Projection {
+	case resmap.ProjectionParent:
+		return id.Parent()
+	case resmap.ProjectionGrandParent:
+		return id.Parent().Parent()
+	case resmap.ProjectionParentScope:
+		return id.ParentScope()
+	}
+	return id
+}
+
+func GetImportSpec(m *resmap.Mapping, id armid.ResourceId, rt string) (string, error) {
+	return getImportSpec(m, id, rt, nil)
+}
+
+func getImportSpec(m *resmap.Mapping, id armid.ResourceId, rt string, t *Tracer) (string, error) {
+	item, ok := m.TF2ARMIdMap[rt]
 	if !ok {
-		return "", fmt.Errorf("unknown resource type %q", rt)
+		return "", errs.Mark(errs.ErrNoMatch, "unknown resource type %q", rt)
 	}
 
 	if id.ParentScope() == nil {
 		// For root scope resource id, the import spec is guaranteed to be only one.
+		t.importSpec(rt, item.ManagementPlane.ImportSpecs[0], "the only import spec of the root scope id")
 		return item.ManagementPlane.ImportSpecs[0], nil
 	}
 
 	switch len(item.ManagementPlane.ImportSpecs) {
 	case 0:
 		// The ID is dynamically built (e.g. for property-like or some of the data plane only resources)
+		t.importSpec(rt, "", "no import spec, the id is dynamically built")
 		return "", nil
 	case 1:
+		t.importSpec(rt, item.ManagementPlane.ImportSpecs[0], "the only import spec")
 		return item.ManagementPlane.ImportSpecs[0], nil
 	default:
 		// Needs to be matched with the scope. Or there might be zero import spec, as for the hypothetic resource ids.
@@ -222,28 +319,29 @@ func GetImportSpec(id armid.ResourceId, rt string) (string, error) {
 			}
 		}
 		if i == -1 {
-			return "", fmt.Errorf("id %q doesn't correspond to resource type %q", id, rt)
+			return "", errs.Mark(errs.ErrNoMatch, "id %q doesn't correspond to resource type %q", id, rt)
 		}
+		t.importSpec(rt, item.ManagementPlane.ImportSpecs[i], fmt.Sprintf("matched by the parent scope %q among %d import specs", item.ManagementPlane.ParentScopes[i], len(item.ManagementPlane.ImportSpecs)))
 		return item.ManagementPlane.ImportSpecs[i], nil
 	}
 }
 
-func buildIdForPropertyLikeResource(mainId armid.ResourceId, secondaryIdEnc string, mainRt, propRt, sep string) (string, error) {
-	mainTFId, err := StaticBuild(mainId, mainRt)
+func buildIdForPropertyLikeResource(m *resmap.Mapping, mainId armid.ResourceId, secondaryIdEnc string, mainRt, propRt, sep string, t *Tracer) (string, error) {
+	mainTFId, err := StaticBuildWithTrace(m, mainId, mainRt, t)
 	if err != nil {
-		return "", fmt.Errorf("building resource id for %q: %v", mainId, err)
+		return "", fmt.Errorf("building resource id for %q: %w", mainId, err)
 	}
 	b, err := base64.StdEncoding.DecodeString(secondaryIdEnc)
 	if err != nil {
-		return "", fmt.Errorf("base64 decoding resource id %q: %v", secondaryIdEnc, err)
+		return "", fmt.Errorf("base64 decoding resource id %q: %w", secondaryIdEnc, err)
 	}
 	secondaryId, err := armid.ParseResourceId(string(b))
 	if err != nil {
-		return "", fmt.Errorf("parsing resource id %q: %v", string(b), err)
+		return "", fmt.Errorf("parsing resource id %q: %w", string(b), err)
 	}
-	secondaryTFId, err := StaticBuild(secondaryId, propRt)
+	secondaryTFId, err := StaticBuildWithTrace(m, secondaryId, propRt, t)
 	if err != nil {
-		return "", fmt.Errorf("building resource id for %q: %v", secondaryId, err)
+		return "", fmt.Errorf("building resource id for %q: %w", secondaryId, err)
 	}
 	return mainTFId + sep + secondaryTFId, nil
 }
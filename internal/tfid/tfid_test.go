@@ -0,0 +1,79 @@
+package tfid
+
+import (
+	"context"
+	"testing"
+
+	"github.com/magodo/armid"
+	"github.com/magodo/aztft/internal/client"
+	"github.com/magodo/aztft/internal/resmap"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDynamicBuild(t *testing.T) {
+	fixture, err := client.LoadFixture("testdata/tfid.json")
+	require.NoError(t, err)
+
+	cases := []struct {
+		name   string
+		id     string
+		rt     string
+		expect string
+		err    bool
+	}{
+		{
+			name:   "key vault secret",
+			id:     "/subscriptions/sub1/resourceGroups/rg1/providers/Microsoft.KeyVault/vaults/vault1/secrets/secret1",
+			rt:     "azurerm_key_vault_secret",
+			expect: "https://vault1.vault.azure.net/secrets/secret1/v1",
+		},
+		{
+			name:   "key vault certificate contacts",
+			id:     "/subscriptions/sub1/resourceGroups/rg1/providers/Microsoft.KeyVault/vaults/vault1/certificates/default/contacts/default",
+			rt:     "azurerm_key_vault_certificate_contacts",
+			expect: "https://vault1.vault.azure.net/certificates/contacts",
+		},
+		{
+			name:   "storage queue",
+			id:     "/subscriptions/sub1/resourceGroups/rg1/providers/Microsoft.Storage/storageAccounts/sa1/queueServices/default/queues/queue1",
+			rt:     "azurerm_storage_queue",
+			expect: "https://sa1.queue.core.windows.net/queue1",
+		},
+		{
+			name:   "api management api",
+			id:     "/subscriptions/sub1/resourceGroups/rg1/providers/Microsoft.ApiManagement/service/apim1/apis/api1",
+			rt:     "azurerm_api_management_api",
+			expect: "/subscriptions/sub1/resourceGroups/rg1/providers/Microsoft.ApiManagement/service/apim1/apis/api1;rev=2",
+		},
+		{
+			name: "resource not recorded",
+			id:   "/subscriptions/sub1/resourceGroups/rg1/providers/Microsoft.KeyVault/vaults/vault1/secrets/secret2",
+			rt:   "azurerm_key_vault_secret",
+			err:  true,
+		},
+		{
+			name: "no builder",
+			id:   "/subscriptions/sub1/resourceGroups/rg1/providers/Microsoft.Network/virtualNetworks/vnet1",
+			rt:   "azurerm_virtual_network",
+			err:  true,
+		},
+	}
+
+	m, err := resmap.Embedded()
+	require.NoError(t, err)
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			id, err := armid.ParseResourceId(tt.id)
+			require.NoError(t, err)
+			b := client.NewReplayClientBuilder(fixture)
+			actual, err := DynamicBuild(context.Background(), m, id, tt.rt, b.Cred, b.ClientOpt)
+			if tt.err {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.expect, actual)
+		})
+	}
+}
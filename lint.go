@@ -0,0 +1,34 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/magodo/aztft/aztft"
+)
+
+// lintMapping lints the mapping file, or the mapping in use if the path is empty, and prints the findings as a JSON array.
+// It returns an error if there is any finding.
+func lintMapping(mapping *aztft.Mapping, path string) error {
+	if path != "" {
+		b, err := os.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		mapping, err = aztft.LoadMapping(b)
+		if err != nil {
+			return err
+		}
+	}
+	findings := mapping.Lint()
+	b, err := json.MarshalIndent(findings, "", "  ")
+	if err != nil {
+		return err
+	}
+	fmt.Println(string(b))
+	if len(findings) != 0 {
+		return fmt.Errorf("found %d problem(s) in the mapping", len(findings))
+	}
+	return nil
+}
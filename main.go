@@ -1,9 +1,10 @@
 package main
 
 import (
+	"context"
 	"fmt"
-	"log"
 	"os"
+	"slices"
 	"strings"
 
 	"github.com/Azure/azure-sdk-for-go/sdk/azcore/arm"
@@ -11,22 +12,53 @@ import (
 	"github.com/Azure/azure-sdk-for-go/sdk/azcore/policy"
 	"github.com/Azure/azure-sdk-for-go/sdk/azidentity"
 	"github.com/magodo/aztft/aztft"
+	"github.com/magodo/aztft/internal/client"
 	"github.com/urfave/cli/v2"
 )
 
 func main() {
+	if err := newApp().Run(os.Args); err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(1)
+	}
+}
+
+// newApp builds the CLI app.
+func newApp() *cli.App {
 	var (
 		flagEnvironment    string
 		flagSubscriptionId string
 		flagAPI            bool
 		flagImport         bool
+		flagFormat         string
+		flagOutput         string
+		flagInput          string
+		flagOffline        string
+		flagRecord         string
+		flagResourceGraph  bool
+
+		flagProviderVersion  string
+		flagProviderSnapshot string
+		flagMapping          string
+		flagAzapi            bool
+		flagAzapiAPIVersions cli.StringSlice
+
+		flagScanFormat string
+
+		flagCatalogFormat string
+		flagCatalogKind   string
+
+		flagDiffFormat string
+
+		mapping *aztft.Mapping
+		qopts   []aztft.QueryOption
 	)
 
-	app := &cli.App{
+	return &cli.App{
 		Name:      "aztft",
 		Version:   getVersion(),
 		Usage:     "Find Azure resource's Terraform AzureRM provider resource type or/and id, together with any property-like resources, by its Azure resource ID",
-		UsageText: "aztft [option] <ID>",
+		UsageText: "aztft [option] <ID>\n   aztft [option] --input <FILE|->",
 		Flags: []cli.Flag{
 			&cli.StringFlag{
 				Name:        "env",
@@ -39,8 +71,7 @@ func main() {
 				Name:        "subscription-id",
 				EnvVars:     []string{"AZTFT_SUBSCRIPTION_ID", "ARM_SUBSCRIPTION_ID"},
 				Aliases:     []string{"s"},
-				Required:    true,
-				Usage:       "The subscription id",
+				Usage:       `The subscription id, required by querying the IDs and the "scan" command`,
 				Destination: &flagSubscriptionId,
 			},
 			&cli.BoolFlag{
@@ -57,102 +88,454 @@ func main() {
 				Destination: &flagImport,
 				Value:       false,
 			},
+			&cli.StringFlag{
+				Name:        "format",
+				EnvVars:     []string{"AZTFT_FORMAT"},
+				Usage:       fmt.Sprintf(`The format of the TF import instruction, used together with "--import". Can be one of %q.`, importFormats),
+				Destination: &flagFormat,
+				Value:       importFormatCmd,
+			},
+			&cli.StringFlag{
+				Name:        "output",
+				EnvVars:     []string{"AZTFT_OUTPUT"},
+				Aliases:     []string{"o"},
+				Usage:       fmt.Sprintf(`The output mode. Can be one of %q. The "json" output reports the TF resource type and id, together with whether it is an exact match, for each matched item.`, outputs),
+				Destination: &flagOutput,
+				Value:       outputText,
+			},
+			&cli.StringFlag{
+				Name:        "input",
+				EnvVars:     []string{"AZTFT_INPUT"},
+				Aliases:     []string{"i"},
+				Usage:       `Read the IDs from the file (or the stdin if "-" is specified), instead of the command line argument. The input is either newline-delimited IDs, or the JSON output of "az resource list". The results are printed in the input order, where each line of the "text" output is prefixed with the ID.`,
+				Destination: &flagInput,
+			},
+			&cli.StringFlag{
+				Name:        "offline",
+				EnvVars:     []string{"AZTFT_OFFLINE"},
+				Usage:       `Disambiguate matching results as "--api" does, but offline, by the ARM resource JSON from this path instead of calling Azure API. The path is either a JSON file, or a directory of JSON files, where each file is the output of "az resource show" (either for one or more resources).`,
+				Destination: &flagOffline,
+			},
+			&cli.StringFlag{
+				Name:        "record",
+				EnvVars:     []string{"AZTFT_RECORD"},
+				Usage:       `Record the Azure API interactions to this fixture file, used together with "--api". The fixture can be replayed in tests without network access.`,
+				Destination: &flagRecord,
+			},
+			&cli.BoolFlag{
+				Name:        "resource-graph",
+				EnvVars:     []string{"AZTFT_RESOURCE_GRAPH"},
+				Usage:       `Resolve the ambiguous resources from bulk Azure Resource Graph queries, instead of one Azure API call per resource, used together with "--api" or the "scan" command. The resources not indexed by Azure Resource Graph are still resolved by their own API calls.`,
+				Destination: &flagResourceGraph,
+			},
+			&cli.StringFlag{
+				Name:        "provider-version",
+				EnvVars:     []string{"AZTFT_PROVIDER_VERSION"},
+				Usage:       fmt.Sprintf(`Only return the TF resource types that exist in this azurerm provider version. The embedded mapping corresponds to %q, other versions require the "--provider-snapshot".`, aztft.LatestProviderVersion),
+				Destination: &flagProviderVersion,
+			},
+			&cli.StringFlag{
+				Name:        "provider-snapshot",
+				EnvVars:     []string{"AZTFT_PROVIDER_SNAPSHOT"},
+				Usage:       `The provider snapshot(s) used together with "--provider-version". The path is either a JSON file, or a directory of JSON files, where each file is generated by "tool/aztft-import -provider-version".`,
+				Destination: &flagProviderSnapshot,
+			},
+			&cli.StringFlag{
+				Name:        "mapping",
+				EnvVars:     []string{"AZTFT_MAPPING_OVERLAY"},
+				Usage:       `The mapping overlay JSON file, which has the same schema as the embedded mapping, and is merged over it. It can add TF resource types, override their import specs, or mark them as removed.`,
+				Destination: &flagMapping,
+			},
+			&cli.BoolFlag{
+				Name:        "azapi",
+				EnvVars:     []string{"AZTFT_AZAPI"},
+				Usage:       `Fallback to the "azapi_resource" for the resources not covered by the azurerm provider. The API version is discovered by the Azure API (requires "--api"), unless specified by "--azapi-api-version".`,
+				Destination: &flagAzapi,
+			},
+			&cli.StringSliceFlag{
+				Name:        "azapi-api-version",
+				EnvVars:     []string{"AZTFT_AZAPI_API_VERSION"},
+				Usage:       `The API version of the ARM resource type used by "--azapi", in form of "<provider>/<types>@<api-version>" (e.g. "Microsoft.Foo/foos@2023-01-01"). Can be specified multiple times.`,
+				Destination: &flagAzapiAPIVersions,
+			},
 		},
-		Action: func(ctx *cli.Context) error {
-			if ctx.NArg() == 0 {
-				return fmt.Errorf("No ID specified")
+		Before: func(ctx *cli.Context) error {
+			var err error
+			mapping, err = buildMapping(flagMapping)
+			if err != nil {
+				return err
 			}
-			if ctx.NArg() > 1 {
-				return fmt.Errorf("More than one IDs specified")
+			qopts, err = buildQueryOptions(mapping, flagProviderVersion, flagProviderSnapshot)
+			if err != nil {
+				return err
 			}
-
-			var opt *aztft.APIOption
-			if flagAPI {
-				cloudCfg := cloud.AzurePublic
-				switch strings.ToLower(flagEnvironment) {
-				case "public":
-					cloudCfg = cloud.AzurePublic
-				case "usgovernment":
-					cloudCfg = cloud.AzureGovernment
-				case "china":
-					cloudCfg = cloud.AzureChina
-				default:
-					return fmt.Errorf("unknown environment specified: %q", flagEnvironment)
-				}
-
-				if v, ok := os.LookupEnv("ARM_TENANT_ID"); ok {
-					os.Setenv("AZURE_TENANT_ID", v)
-				}
-				if v, ok := os.LookupEnv("ARM_CLIENT_ID"); ok {
-					os.Setenv("AZURE_CLIENT_ID", v)
+			azapiOpt, err := buildAzapiOption(flagAzapi, flagAzapiAPIVersions.Value())
+			if err != nil {
+				return err
+			}
+			if azapiOpt != nil {
+				qopts = append(qopts, azapiOpt)
+			}
+			return nil
+		},
+		Action: func(ctx *cli.Context) error {
+			if err := requireSubscriptionId(flagSubscriptionId); err != nil {
+				return err
+			}
+			var ids []string
+			if flagInput != "" {
+				if ctx.NArg() != 0 {
+					return fmt.Errorf(`IDs can't be specified together with "--input"`)
 				}
-				if v, ok := os.LookupEnv("ARM_CLIENT_SECRET"); ok {
-					os.Setenv("AZURE_CLIENT_SECRET", v)
+				var err error
+				ids, err = readIds(flagInput)
+				if err != nil {
+					return err
 				}
-				if v, ok := os.LookupEnv("ARM_CLIENT_CERTIFICATE_PATH"); ok {
-					os.Setenv("AZURE_CLIENT_CERTIFICATE_PATH", v)
+			} else {
+				if ctx.NArg() == 0 {
+					return fmt.Errorf("No ID specified")
 				}
-
-				clientOpt := arm.ClientOptions{
-					ClientOptions: policy.ClientOptions{
-						Cloud: cloudCfg,
-						Telemetry: policy.TelemetryOptions{
-							ApplicationID: "aztft",
-							Disabled:      false,
-						},
-						Logging: policy.LogOptions{
-							IncludeBody: true,
-						},
-					},
+				if ctx.NArg() > 1 {
+					return fmt.Errorf(`More than one IDs specified, use "--input" instead`)
 				}
+				ids = []string{ctx.Args().First()}
+			}
+			if !slices.Contains(importFormats, flagFormat) {
+				return fmt.Errorf("unknown format %q, must be one of %q", flagFormat, importFormats)
+			}
+			if ctx.IsSet("format") && !flagImport {
+				return fmt.Errorf(`"--format" can only be used together with "--import"`)
+			}
+			if !slices.Contains(outputs, flagOutput) {
+				return fmt.Errorf("unknown output %q, must be one of %q", flagOutput, outputs)
+			}
+			if flagOutput == outputJSON && flagImport {
+				return fmt.Errorf(`"--output=json" can't be used together with "--import"`)
+			}
 
-				cred, err := azidentity.NewDefaultAzureCredential(&azidentity.DefaultAzureCredentialOptions{
-					ClientOptions: clientOpt.ClientOptions,
-					TenantID:      os.Getenv("ARM_TENANT_ID"),
-				})
-				if err != nil {
-					return fmt.Errorf("failed to obtain a credential: %v", err)
-				}
+			opt, err := buildQueryAPIOption(flagOffline, flagAPI, flagEnvironment)
+			if err != nil {
+				return err
+			}
 
-				opt = &aztft.APIOption{
-					Cred:         cred,
-					ClientOption: clientOpt,
-				}
+			if flagResourceGraph && !flagAPI {
+				return fmt.Errorf(`"--resource-graph" can only be used together with "--api"`)
 			}
 
-			id := ctx.Args().First()
-			var output []string
-			if flagImport {
-				types, ids, _, err := aztft.QueryTypeAndId(id, opt)
-				if err != nil {
-					log.Fatal(err)
-				}
-				for i := 0; i < len(types); i++ {
-					output = append(output, fmt.Sprintf("terraform import %s.example %s", types[i].TFType, ids[i]))
-				}
-			} else {
-				rts, _, err := aztft.QueryType(id, opt)
-				if err != nil {
-					log.Fatal(err)
-				}
-				for _, t := range rts {
-					output = append(output, t.TFType)
+			var recorder *client.RecordTransport
+			if flagRecord != "" {
+				if !flagAPI {
+					return fmt.Errorf(`"--record" can only be used together with "--api"`)
 				}
+				recorder = client.NewRecordTransport(opt.ClientOption.Transport)
+				opt.ClientOption.Transport = recorder
 			}
-			if len(output) == 0 {
-				fmt.Println("No match")
-				return nil
+			if flagResourceGraph {
+				opt = prefetchResourceGraph(ctx.Context, ids, opt)
 			}
-			for _, line := range output {
-				fmt.Println(line)
+			runErr := run(ctx.Context, ids, opt, qopts, flagInput != "", flagImport, flagFormat, flagOutput)
+			if recorder != nil {
+				if err := recorder.Fixture().Save(flagRecord); err != nil {
+					return fmt.Errorf("saving the recorded fixture: %v", err)
+				}
 			}
+			return runErr
+		},
+		Commands: []*cli.Command{
+			{
+				Name:      "scan",
+				Usage:     "List the resources under a resource group, subscription or management group, and print the TF import plan for all of them, together with any property-like resources",
+				UsageText: "aztft [option] scan [command option] <SCOPE>\n\nThe SCOPE is either a resource group name (in the subscription specified by \"--subscription-id\"), or the ID of a resource group, subscription or management group.",
+				Flags: []cli.Flag{
+					&cli.StringFlag{
+						Name:        "format",
+						EnvVars:     []string{"AZTFT_FORMAT"},
+						Usage:       fmt.Sprintf(`The format of the TF import instruction. Can be one of %q.`, importFormats),
+						Destination: &flagScanFormat,
+						Value:       importFormatCmd,
+					},
+				},
+				Action: func(ctx *cli.Context) error {
+					if err := requireSubscriptionId(flagSubscriptionId); err != nil {
+						return err
+					}
+					if ctx.NArg() != 1 {
+						return fmt.Errorf("Exactly one scope shall be specified")
+					}
+					if !slices.Contains(importFormats, flagScanFormat) {
+						return fmt.Errorf("unknown format %q, must be one of %q", flagScanFormat, importFormats)
+					}
+					scope := ctx.Args().First()
+					if !strings.HasPrefix(scope, "/") {
+						scope = "/subscriptions/" + flagSubscriptionId + "/resourceGroups/" + scope
+					}
 
-			return nil
+					opt, err := buildAPIOption(flagEnvironment)
+					if err != nil {
+						return err
+					}
+					ids, err := aztft.ListResources(ctx.Context, scope, opt)
+					if err != nil {
+						return err
+					}
+					if flagResourceGraph {
+						opt = prefetchResourceGraph(ctx.Context, ids, opt)
+					}
+					return run(ctx.Context, ids, opt, qopts, true, true, flagScanFormat, outputText)
+				},
+			},
+			{
+				Name:      "explain",
+				Usage:     "Explain how the TF resource types and IDs of a resource are derived, step by step",
+				UsageText: "aztft [option] explain <ID>\n\nThe mapping lookup, the resolver (with \"--api\" or \"--offline\"), the import spec and the transformations applied to the ID are printed in order, followed by the results.",
+				Action: func(ctx *cli.Context) error {
+					if ctx.NArg() != 1 {
+						return fmt.Errorf("Exactly one ID shall be specified")
+					}
+					opt, err := buildQueryAPIOption(flagOffline, flagAPI, flagEnvironment)
+					if err != nil {
+						return err
+					}
+					return explain(ctx.Context, os.Stdout, ctx.Args().First(), opt, qopts)
+				},
+			},
+			{
+				Name:      "catalog",
+				Usage:     "List the supported TF resource types",
+				UsageText: "aztft [option] catalog [command option]",
+				Flags: []cli.Flag{
+					&cli.StringFlag{
+						Name:        "format",
+						Usage:       fmt.Sprintf(`The output format. Can be one of %q.`, catalogFormats),
+						Destination: &flagCatalogFormat,
+						Value:       catalogFormatJSON,
+					},
+					&cli.StringFlag{
+						Name:        "kind",
+						Usage:       fmt.Sprintf(`The kind of TF resource types to list. Can be one of %q. The "markdown" format of the non-"all" kinds prints their pesudo resource IDs.`, catalogKinds),
+						Destination: &flagCatalogKind,
+						Value:       catalogKindAll,
+					},
+				},
+				Action: func(ctx *cli.Context) error {
+					if !slices.Contains(catalogFormats, flagCatalogFormat) {
+						return fmt.Errorf("unknown format %q, must be one of %q", flagCatalogFormat, catalogFormats)
+					}
+					if !slices.Contains(catalogKinds, flagCatalogKind) {
+						return fmt.Errorf("unknown kind %q, must be one of %q", flagCatalogKind, catalogKinds)
+					}
+					out, err := formatCatalog(filterCatalog(mapping.Catalog(), flagCatalogKind), flagCatalogKind, flagCatalogFormat)
+					if err != nil {
+						return err
+					}
+					fmt.Println(out)
+					return nil
+				},
+			},
+			{
+				Name:  "mapping",
+				Usage: "Maintain the mapping between the TF resource types and the ARM resource types",
+				Subcommands: []*cli.Command{
+					{
+						Name:      "lint",
+						Usage:     "Check the consistency of the mapping, and print the problems found as JSON. It exits with non-zero code if there is any problem",
+						UsageText: "aztft [option] mapping lint [MAPPING]\n\nThe MAPPING is a mapping JSON file that has the same schema as the embedded mapping. If not specified, the mapping in use (i.e. the embedded mapping merged with \"--mapping\", if any) is checked.",
+						Action: func(ctx *cli.Context) error {
+							if ctx.NArg() > 1 {
+								return fmt.Errorf("At most one mapping file shall be specified")
+							}
+							return lintMapping(mapping, ctx.Args().First())
+						},
+					},
+					{
+						Name:      "diff",
+						Usage:     "Compare two mapping files, and print the added, removed and changed TF resource types, and the ARM resource types that become ambiguous",
+						UsageText: "aztft [option] mapping diff [command option] <OLD MAPPING> <NEW MAPPING>",
+						Flags: []cli.Flag{
+							&cli.StringFlag{
+								Name:        "format",
+								Usage:       fmt.Sprintf(`The output format. Can be one of %q.`, diffFormats),
+								Destination: &flagDiffFormat,
+								Value:       diffFormatText,
+							},
+						},
+						Action: func(ctx *cli.Context) error {
+							if ctx.NArg() != 2 {
+								return fmt.Errorf("Exactly two mapping files shall be specified")
+							}
+							return diffMappings(ctx.Args().Get(0), ctx.Args().Get(1), flagDiffFormat)
+						},
+					},
+				},
+			},
 		},
 	}
+}
 
-	if err := app.Run(os.Args); err != nil {
-		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
-		os.Exit(1)
+// requireSubscriptionId returns an error if the subscription id is not specified, which is only required by the query and scan actions.
+func requireSubscriptionId(subscriptionId string) error {
+	if subscriptionId == "" {
+		return fmt.Errorf(`Required flag "subscription-id" not set`)
+	}
+	return nil
+}
+
+// prefetchResourceGraph returns the API option that resolves the ids from bulk Azure Resource Graph queries.
+// If the queries fail, the error is reported as a warning and the original API option is returned, which resolves the ids one by one.
+func prefetchResourceGraph(ctx context.Context, ids []string, opt *aztft.APIOption) *aztft.APIOption {
+	popt, err := aztft.PrefetchResourceGraph(ctx, ids, opt)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Warning: prefetching from Azure Resource Graph: %v\n", err)
+		return opt
+	}
+	return popt
+}
+
+// buildAPIOption builds the API option for the environment, whose credential is shared by all the Azure API calls.
+func buildAPIOption(env string) (*aztft.APIOption, error) {
+	cloudCfg := cloud.AzurePublic
+	switch strings.ToLower(env) {
+	case "public":
+		cloudCfg = cloud.AzurePublic
+	case "usgovernment":
+		cloudCfg = cloud.AzureGovernment
+	case "china":
+		cloudCfg = cloud.AzureChina
+	default:
+		return nil, fmt.Errorf("unknown environment specified: %q", env)
+	}
+
+	if v, ok := os.LookupEnv("ARM_TENANT_ID"); ok {
+		os.Setenv("AZURE_TENANT_ID", v)
+	}
+	if v, ok := os.LookupEnv("ARM_CLIENT_ID"); ok {
+		os.Setenv("AZURE_CLIENT_ID", v)
+	}
+	if v, ok := os.LookupEnv("ARM_CLIENT_SECRET"); ok {
+		os.Setenv("AZURE_CLIENT_SECRET", v)
+	}
+	if v, ok := os.LookupEnv("ARM_CLIENT_CERTIFICATE_PATH"); ok {
+		os.Setenv("AZURE_CLIENT_CERTIFICATE_PATH", v)
+	}
+
+	clientOpt := arm.ClientOptions{
+		ClientOptions: policy.ClientOptions{
+			Cloud: cloudCfg,
+			Telemetry: policy.TelemetryOptions{
+				ApplicationID: "aztft",
+				Disabled:      false,
+			},
+			Logging: policy.LogOptions{
+				IncludeBody: true,
+			},
+		},
+	}
+
+	cred, err := azidentity.NewDefaultAzureCredential(&azidentity.DefaultAzureCredentialOptions{
+		ClientOptions: clientOpt.ClientOptions,
+		TenantID:      os.Getenv("ARM_TENANT_ID"),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to obtain a credential: %v", err)
+	}
+
+	return &aztft.APIOption{
+		Cred:         cred,
+		ClientOption: clientOpt,
+	}, nil
+}
+
+// buildMapping builds the mapping in use, which is the embedded mapping merged with the overlay, if any.
+func buildMapping(overlayPath string) (*aztft.Mapping, error) {
+	mapping, err := aztft.DefaultMapping()
+	if err != nil {
+		return nil, err
+	}
+	if overlayPath == "" {
+		return mapping, nil
+	}
+	b, err := os.ReadFile(overlayPath)
+	if err != nil {
+		return nil, err
+	}
+	mapping, err = mapping.Overlay(b)
+	if err != nil {
+		return nil, fmt.Errorf("applying mapping overlay %s: %v", overlayPath, err)
+	}
+	return mapping, nil
+}
+
+func buildQueryOptions(mapping *aztft.Mapping, providerVersion, providerSnapshot string) ([]aztft.QueryOption, error) {
+	qopts := []aztft.QueryOption{aztft.WithMapping(mapping)}
+	if providerVersion == "" {
+		if providerSnapshot != "" {
+			return nil, fmt.Errorf(`"--provider-snapshot" can only be used together with "--provider-version"`)
+		}
+		return qopts, nil
+	}
+	var snapshots []aztft.ProviderSnapshot
+	if providerSnapshot != "" {
+		var err error
+		snapshots, err = aztft.LoadProviderSnapshots(providerSnapshot)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return append(qopts, aztft.WithProviderVersion(providerVersion, snapshots...)), nil
+}
+
+func buildAzapiOption(enabled bool, apiVersions []string) (aztft.QueryOption, error) {
+	if !enabled {
+		if len(apiVersions) != 0 {
+			return nil, fmt.Errorf(`"--azapi-api-version" can only be used together with "--azapi"`)
+		}
+		return nil, nil
+	}
+	m := map[string]string{}
+	for _, v := range apiVersions {
+		rt, apiVersion, ok := strings.Cut(v, "@")
+		if !ok || rt == "" || apiVersion == "" {
+			return nil, fmt.Errorf(`malformed azapi API version %q, must be in form of "<provider>/<types>@<api-version>"`, v)
+		}
+		m[rt] = apiVersion
+	}
+	return aztft.WithAzapiFallback(m), nil
+}
+
+// buildQueryAPIOption builds the API option of the queries by the "--offline" and "--api" flags, which is nil if neither is specified.
+func buildQueryAPIOption(offline string, api bool, env string) (*aztft.APIOption, error) {
+	switch {
+	case offline != "":
+		if api {
+			return nil, fmt.Errorf(`"--offline" can't be used together with "--api"`)
+		}
+		return buildOfflineAPIOption(offline)
+	case api:
+		return buildAPIOption(env)
+	}
+	return nil, nil
+}
+
+// buildOfflineAPIOption builds the API option that serves the Azure API calls by the ARM resource JSON from the file or directory.
+func buildOfflineAPIOption(path string) (*aztft.APIOption, error) {
+	fi, err := os.Stat(path)
+	if err != nil {
+		return nil, err
+	}
+	var provider aztft.ResourceBodyProvider
+	if fi.IsDir() {
+		provider, err = aztft.NewResourceBodyProviderFromDir(path)
+	} else {
+		var b []byte
+		b, err = os.ReadFile(path)
+		if err != nil {
+			return nil, err
+		}
+		provider, err = aztft.NewResourceBodyProvider(b)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("loading resource bodies from %s: %v", path, err)
 	}
+	return &aztft.APIOption{BodyProvider: provider}, nil
 }
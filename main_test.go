@@ -0,0 +1,128 @@
+package main
+
+import (
+	"encoding/json"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// runApp runs the CLI app with the arguments (excluding the program name), and returns its stdout.
+func runApp(t *testing.T, args ...string) (string, error) {
+	t.Helper()
+	r, w, err := os.Pipe()
+	require.NoError(t, err)
+	stdout := os.Stdout
+	os.Stdout = w
+	defer func() { os.Stdout = stdout }()
+
+	out := make(chan string)
+	go func() {
+		b, _ := io.ReadAll(r)
+		out <- string(b)
+	}()
+	runErr := newApp().Run(append([]string{"aztft"}, args...))
+	w.Close()
+	return <-out, runErr
+}
+
+func TestCatalogCommand(t *testing.T) {
+	t.Setenv("AZTFT_SUBSCRIPTION_ID", "")
+	t.Setenv("ARM_SUBSCRIPTION_ID", "")
+
+	// No subscription id is needed.
+	out, err := runApp(t, "catalog", "--kind", "data-plane-only")
+	require.NoError(t, err)
+	var entries []map[string]interface{}
+	require.NoError(t, json.Unmarshal([]byte(out), &entries))
+	require.NotEmpty(t, entries)
+
+	// The query still requires the subscription id.
+	_, err = runApp(t, "/subscriptions/sub1/resourceGroups/rg1")
+	require.ErrorContains(t, err, `Required flag "subscription-id" not set`)
+}
+
+func TestQueryCommand(t *testing.T) {
+	out, err := runApp(t, "-s", "sub1", "/subscriptions/sub1/resourceGroups/rg1")
+	require.NoError(t, err)
+	require.Equal(t, "azurerm_resource_group\n", out)
+
+	// The query error of a single id is returned, instead of exiting.
+	out, err = runApp(t, "-s", "sub1", "/subscriptions/sub1/resourceGroups/rg1/foos")
+	require.ErrorContains(t, err, "invalid resource id")
+	require.Empty(t, out)
+
+	// The format only applies to the import instruction.
+	_, err = runApp(t, "-s", "sub1", "--format", "import-block", "/subscriptions/sub1/resourceGroups/rg1")
+	require.ErrorContains(t, err, `"--format" can only be used together with "--import"`)
+	out, err = runApp(t, "-s", "sub1", "--import", "--format", "import-block", "/subscriptions/sub1/resourceGroups/rg1")
+	require.NoError(t, err)
+	require.Contains(t, out, "import {")
+}
+
+const (
+	fooMapping = `{
+  "azurerm_foo": {"management_plane": {"scopes": ["/subscriptions/resourceGroups"], "provider": "Microsoft.Foo", "types": ["foos"], "import_specs": ["/subscriptions/resourceGroups/Microsoft.Foo/foos"]}}
+}`
+	fooV2Mapping = `{
+  "azurerm_foo": {"management_plane": {"scopes": ["/subscriptions/resourceGroups"], "provider": "Microsoft.Foo", "types": ["foos"], "import_specs": ["/subscriptions/resourceGroups/Microsoft.Foo/foos"]}},
+  "azurerm_foo_v2": {"management_plane": {"scopes": ["/subscriptions/resourceGroups"], "provider": "Microsoft.Foo", "types": ["foos"], "import_specs": ["/subscriptions/resourceGroups/Microsoft.Foo/foos"]}}
+}`
+)
+
+// writeMapping writes the mapping to a file in a temporary directory, and returns its path.
+func writeMapping(t *testing.T, name, content string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), name)
+	require.NoError(t, os.WriteFile(path, []byte(content), 0644))
+	return path
+}
+
+func TestMappingLintCommand(t *testing.T) {
+	t.Setenv("AZTFT_SUBSCRIPTION_ID", "")
+	t.Setenv("ARM_SUBSCRIPTION_ID", "")
+
+	// The embedded mapping has no problem.
+	out, err := runApp(t, "mapping", "lint")
+	require.NoError(t, err)
+	require.Equal(t, "[]\n", out)
+
+	out, err = runApp(t, "mapping", "lint", writeMapping(t, "map.json", fooV2Mapping))
+	require.ErrorContains(t, err, "problem(s) in the mapping")
+	var findings []map[string]interface{}
+	require.NoError(t, json.Unmarshal([]byte(out), &findings))
+	require.Contains(t, out, "multiple matches found for /MICROSOFT.FOO/FOOS in scope of /SUBSCRIPTIONS/RESOURCEGROUPS: [azurerm_foo azurerm_foo_v2]")
+}
+
+func TestMappingDiffCommand(t *testing.T) {
+	t.Setenv("AZTFT_SUBSCRIPTION_ID", "")
+	t.Setenv("ARM_SUBSCRIPTION_ID", "")
+
+	oldPath := writeMapping(t, "old.json", fooMapping)
+	newPath := writeMapping(t, "new.json", fooV2Mapping)
+
+	out, err := runApp(t, "mapping", "diff", oldPath, newPath)
+	require.NoError(t, err)
+	require.Equal(t, `Added:
+  + azurerm_foo_v2
+
+New ambiguities:
+  ! /MICROSOFT.FOO/FOOS in scope of /SUBSCRIPTIONS/RESOURCEGROUPS (needs resolver): azurerm_foo, azurerm_foo_v2
+`, out)
+
+	out, err = runApp(t, "mapping", "diff", "--format", "json", oldPath, newPath)
+	require.NoError(t, err)
+	var diff struct {
+		Added []string `json:"added"`
+	}
+	require.NoError(t, json.Unmarshal([]byte(out), &diff))
+	require.Equal(t, []string{"azurerm_foo_v2"}, diff.Added)
+
+	// No difference
+	out, err = runApp(t, "mapping", "diff", oldPath, oldPath)
+	require.NoError(t, err)
+	require.Empty(t, out)
+}
@@ -0,0 +1,138 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/magodo/aztft/aztft"
+)
+
+const (
+	outputText = "text"
+	outputJSON = "json"
+)
+
+var outputs = []string{outputText, outputJSON}
+
+// queryResult is the structured query result of a single matched TF resource.
+type queryResult struct {
+	AzureId string `json:"azure_id"`
+	TFType  string `json:"tf_type,omitempty"`
+	TFId    string `json:"tf_id,omitempty"`
+
+	// AzapiType is the "type" of the azapi_resource, only set when the TFType is "azapi_resource".
+	AzapiType string `json:"azapi_type,omitempty"`
+
+	// Exact indicates whether the query result is an exact match, i.e. not one of the multiple ambiguous matches.
+	Exact bool `json:"exact"`
+
+	// API indicates whether the Azure API is actually called to query the Azure resource id, i.e. to resolve the ambiguous resource,
+	// to populate the property-like resources of its main resource, or to build its TF resource IDs (see aztft.BatchResult.API).
+	// It is always false without "--api", including with "--offline".
+	API bool `json:"api"`
+
+	Error string `json:"error,omitempty"`
+
+	// Candidate explains the TF resource type, only set when the resource is ambiguous, i.e. the Azure API can't tell which candidate it is.
+	Candidate *aztft.Candidate `json:"candidate,omitempty"`
+}
+
+// queryResults returns a result for each matched TF resource of the query result of an Azure resource id.
+// Different from aztft.QueryTypeAndId, failing to build the TF id for one TF resource is recorded in that result, instead of failing the whole query.
+func queryResults(r aztft.BatchResult) []queryResult {
+	results := []queryResult{}
+	var amb *aztft.Ambiguity
+	if errors.As(r.Err, &amb) {
+		tfids := map[string]string{}
+		for i, t := range r.Types {
+			tfids[t.TFType] = r.TFIds[i]
+		}
+		for _, c := range amb.Candidates {
+			results = append(results, queryResult{
+				AzureId:   r.Id,
+				TFType:    c.TFType,
+				TFId:      tfids[c.TFType],
+				API:       r.API,
+				Error:     amb.Err.Error(),
+				Candidate: &c,
+			})
+		}
+		return results
+	}
+	if len(r.Types) == 0 && r.Err != nil {
+		return append(results, queryResult{
+			AzureId: r.Id,
+			API:     r.API,
+			Error:   r.Err.Error(),
+		})
+	}
+	for i, t := range r.Types {
+		result := queryResult{
+			AzureId:   t.AzureId.String(),
+			TFType:    t.TFType,
+			TFId:      r.TFIds[i],
+			AzapiType: t.AzapiType,
+			Exact:     r.Exact,
+			API:       r.API,
+		}
+		if err := r.TFIdErrs[i]; err != nil {
+			result.Error = err.Error()
+		}
+		results = append(results, result)
+	}
+	return results
+}
+
+// ambiguityLines returns a line for each candidate of the ambiguous resource in the text output, which tells whether it is ruled in or out, and why.
+func ambiguityLines(amb *aztft.Ambiguity) []string {
+	var lines []string
+	for _, c := range amb.Candidates {
+		verdict := "ruled out"
+		if c.Matched {
+			verdict = "ruled in"
+		}
+		line := fmt.Sprintf("%s (ambiguous, %s: %s", c.TFType, verdict, c.Reason)
+		if len(c.Evidence) != 0 {
+			line += "; evidence: " + strings.Join(c.Evidence, ", ")
+		}
+		lines = append(lines, line+")")
+	}
+	return lines
+}
+
+// jsonArrayWriter writes the elements of a JSON array one by one, so that the output can be streamed.
+// The output is the same as json.MarshalIndent the whole array, with two spaces as the indent.
+type jsonArrayWriter struct {
+	w io.Writer
+	n int
+}
+
+func newJSONArrayWriter(w io.Writer) *jsonArrayWriter {
+	return &jsonArrayWriter{w: w}
+}
+
+func (w *jsonArrayWriter) Write(v interface{}) error {
+	b, err := json.MarshalIndent(v, "  ", "  ")
+	if err != nil {
+		return err
+	}
+	sep := "[\n  "
+	if w.n != 0 {
+		sep = ",\n  "
+	}
+	w.n++
+	_, err = fmt.Fprint(w.w, sep, string(b))
+	return err
+}
+
+func (w *jsonArrayWriter) Close() error {
+	if w.n == 0 {
+		_, err := fmt.Fprintln(w.w, "[]")
+		return err
+	}
+	_, err := fmt.Fprintln(w.w, "\n]")
+	return err
+}
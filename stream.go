@@ -0,0 +1,153 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/magodo/aztft/aztft"
+)
+
+// run queries the resource ids by aztft.QueryStream, which sends the identical Azure GET requests only once, and prints the results to the stdout, in the input order.
+// When multi is false, there is only one resource id and its query error is returned. Otherwise, the query error of a resource id is reported
+// to the stderr and the remaining resource ids are continued, and the text output is prefixed with the resource id.
+func run(ctx context.Context, ids []string, opt *aztft.APIOption, qopts []aztft.QueryOption, multi, importMode bool, format, output string) error {
+	var (
+		emit        func(aztft.BatchResult) error
+		closeOutput = func() error { return nil }
+		failures    int
+		chunks      int
+	)
+	bopt := &aztft.BatchOption{
+		APIOption:    opt,
+		QueryOptions: qopts,
+	}
+
+	// reportErr returns the query error if there is only one resource id, which stops the query. Otherwise, the error is reported and nil is returned.
+	reportErr := func(id string, err error) error {
+		if !multi {
+			return err
+		}
+		fmt.Fprintf(os.Stderr, "Error: querying %s: %v\n", id, err)
+		failures++
+		return nil
+	}
+
+	// printChunk prints the output of a resource id, the import blocks of different resource ids are separated by an empty line.
+	printChunk := func(s string) {
+		if format == importFormatImportBlock && importMode && chunks != 0 {
+			fmt.Println()
+		}
+		chunks++
+		fmt.Println(s)
+	}
+
+	noMatch := func(id string) {
+		switch {
+		case !multi:
+			fmt.Println("No match")
+		case importMode:
+			printChunk("# No match: " + id)
+		default:
+			fmt.Printf("%s\tNo match\n", id)
+		}
+	}
+
+	switch {
+	case output == outputJSON:
+		w := newJSONArrayWriter(os.Stdout)
+		emit = func(r aztft.BatchResult) error {
+			for _, result := range queryResults(r) {
+				if err := w.Write(result); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+		closeOutput = w.Close
+	case importMode:
+		namer := newImportItemNamer()
+		if format == importFormatJSON {
+			w := newJSONArrayWriter(os.Stdout)
+			emit = func(r aztft.BatchResult) error {
+				if r.Err != nil {
+					return reportErr(r.Id, r.Err)
+				}
+				for _, item := range buildImportItems(r.Types, r.TFIds, namer) {
+					if err := w.Write(item); err != nil {
+						return err
+					}
+				}
+				return nil
+			}
+			closeOutput = w.Close
+			break
+		}
+		emit = func(r aztft.BatchResult) error {
+			if r.Err != nil {
+				return reportErr(r.Id, r.Err)
+			}
+			items := buildImportItems(r.Types, r.TFIds, namer)
+			if len(items) == 0 {
+				noMatch(r.Id)
+				return nil
+			}
+			s, err := formatImportItems(items, format)
+			if err != nil {
+				return err
+			}
+			printChunk(s)
+			return nil
+		}
+	default:
+		bopt.TypesOnly = true
+		emit = func(r aztft.BatchResult) error {
+			id := r.Id
+			if r.Err != nil {
+				var amb *aztft.Ambiguity
+				if errors.As(r.Err, &amb) {
+					for _, line := range ambiguityLines(amb) {
+						if multi {
+							fmt.Printf("%s\t%s\n", id, line)
+						} else {
+							fmt.Println(line)
+						}
+					}
+				}
+				return reportErr(id, r.Err)
+			}
+			if len(r.Types) == 0 {
+				noMatch(id)
+				return nil
+			}
+			for _, t := range r.Types {
+				if multi {
+					fmt.Printf("%s\t%s\n", id, typeString(t))
+				} else {
+					fmt.Println(typeString(t))
+				}
+			}
+			return nil
+		}
+	}
+
+	if err := aztft.QueryStream(ctx, ids, bopt, emit); err != nil {
+		return err
+	}
+	if err := closeOutput(); err != nil {
+		return err
+	}
+	if failures != 0 {
+		return fmt.Errorf("failed to query %d out of %d IDs", failures, len(ids))
+	}
+	return nil
+}
+
+// typeString returns the TF resource type in the text output, where the azapi_resource is followed by its "type".
+func typeString(t aztft.Type) string {
+	if t.AzapiType != "" {
+		return fmt.Sprintf("%s (%s)", t.TFType, t.AzapiType)
+	}
+	return t.TFType
+}
@@ -1,14 +1,16 @@
 package main
 
-/// This program generate the mapping from the Azure document.
+/// This program generate the mapping from the Azure document, or additionally from the provider source.
 
 import (
 	"bufio"
 	"encoding/json"
+	"flag"
 	"fmt"
 	"log"
 	"os"
 	"path"
+	"sort"
 	"strconv"
 	"strings"
 
@@ -470,15 +472,24 @@ var HardcodedTypes = map[string]*HardCodedTypeInfo{
 	},
 }
 
-const usage = `aztft-generate-static <provider root dir>`
+const usage = `aztft-generate-static [-provider-version <version constraints>] [-source] <provider root dir>
+
+When "-provider-version" is specified (e.g. ">= 3.0.0, < 4.0.0"), it generates the provider snapshot of the resource types that exist in the provider, instead of the mapping.
+
+When "-source" is specified, the import specs are derived from the provider source (the resource id definitions and the importers of the resources),
+which take precedence over the ones in the docs. Where the docs and the source disagree are reported. The provider's dependencies must be vendored (i.e. "go mod vendor").`
 
 func main() {
-	if len(os.Args) != 2 {
+	flagProviderVersion := flag.String("provider-version", "", "The version constraints of the provider, which generates the provider snapshot")
+	flagSource := flag.Bool("source", false, "Derive the import specs from the provider source")
+	flag.Usage = func() { fmt.Println(usage) }
+	flag.Parse()
+	if flag.NArg() != 1 {
 		fmt.Println(usage)
 		os.Exit(1)
 	}
 
-	rootDir := os.Args[1]
+	rootDir := flag.Arg(0)
 	rDir := path.Join(rootDir, "website", "docs", "r")
 	dir, err := os.Open(rDir)
 	if err != nil {
@@ -492,6 +503,9 @@ func main() {
 
 	m := map[string]armid.ResourceId{}
 
+	// The resource types that have import specs, regardless of whether they can be parsed.
+	seen := map[string]bool{}
+
 	for _, entry := range entries {
 		p := path.Join(rDir, entry)
 		f, err := os.Open(p)
@@ -507,6 +521,12 @@ func main() {
 			}
 			line = line[strings.Index(line, "terraform import"):]
 			rtype, id, err := parse(line)
+			if rtype != "" {
+				seen[rtype] = true
+			}
+			if *flagProviderVersion != "" {
+				continue
+			}
 			if err != nil {
 				if HardcodedTypes[rtype] == nil {
 					log.Printf("%s new parse error: %v\n", rtype, err)
@@ -545,6 +565,38 @@ func main() {
 		}
 	}
 
+	var codeIds map[string]*sourceId
+	if *flagSource {
+		codeIds, err = scanSource(rootDir)
+		if err != nil {
+			log.Fatalf("scanning the provider source: %v", err)
+		}
+		// The registered resource types are more accurate than the ones that have docs.
+		seen = map[string]bool{}
+		for rtype := range codeIds {
+			seen[rtype] = true
+		}
+	}
+
+	if *flagProviderVersion != "" {
+		snapshot := resmap.Snapshot{ProviderVersion: *flagProviderVersion, ResourceTypes: []string{}}
+		if err := snapshot.Init(); err != nil {
+			log.Fatal(err)
+		}
+		for rtype := range seen {
+			snapshot.ResourceTypes = append(snapshot.ResourceTypes, rtype)
+		}
+		sort.Strings(snapshot.ResourceTypes)
+		// Not escaping the version constraints, e.g. ">=".
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetEscapeHTML(false)
+		enc.SetIndent("", "  ")
+		if err := enc.Encode(snapshot); err != nil {
+			log.Fatal(err)
+		}
+		return
+	}
+
 	// Ensure all the caught errors are really caught
 	for rtype, err := range HardcodedTypes {
 		if !err.caught {
@@ -553,6 +605,13 @@ func main() {
 	}
 
 	mapItems := resmap.TF2ARMIdMapType{}
+	if *flagSource {
+		var reports []string
+		m, mapItems, reports = mergeSourceIds(m, codeIds)
+		for _, r := range reports {
+			log.Println(r)
+		}
+	}
 	for rtype, id := range m {
 		var scopes []string
 		if _, ok := id.(armid.RootScope); !ok {
@@ -0,0 +1,620 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"io/fs"
+	"log"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/magodo/armid"
+	"github.com/magodo/aztft/internal/resmap"
+)
+
+// This file derives the import specs from the provider source tree, by statically analyzing:
+// - The resource id definitions, which are either the go-azure-sdk style "Segments()" methods (under the vendor directory),
+//   or the provider's own "ID()" methods that format the id with a format string (under the "parse" packages).
+// - The registrations of the resources, where:
+//   - The untyped resources are registered as `"azurerm_foo": resourceFoo()`, whose "Importer" calls the id parse/validate function.
+//   - The typed resources have a "ResourceType()" method returning the resource type, and an "IDValidationFunc()" method returning the id validate function.
+
+// sourceId is a resource id definition found in the provider source.
+type sourceId struct {
+	// pos is the position of the id definition, for reporting.
+	pos string
+
+	// id is an example resource id of the definition. For the scopeAny id, the parent scope is a placeholder.
+	id armid.ResourceId
+
+	// scopeAny indicates the id is under any scope.
+	scopeAny bool
+}
+
+// spec returns the import spec of the id definition, or the resource type under any scope for the scopeAny id.
+func (id *sourceId) spec() string {
+	if id.scopeAny {
+		return resmap.ScopeAny + "/" + typeString(id.id)
+	}
+	return id.id.ScopeString()
+}
+
+// sourceFile is a parsed source file, together with the package info.
+type sourceFile struct {
+	pkgPath string
+	file    *ast.File
+
+	// imports maps the import name to the import path.
+	imports map[string]string
+}
+
+type sourceIndex struct {
+	fset  *token.FileSet
+	root  string
+	files []*sourceFile
+
+	// pkgNames maps the package path to the package name.
+	pkgNames map[string]string
+
+	// typeIds maps the "<pkg path>.<type name>" to the id definition.
+	typeIds map[string]*sourceId
+
+	// funcIds maps the "<pkg path>.<func name>" to the id definition that the function parses or validates.
+	funcIds map[string]*sourceId
+}
+
+// scanSource scans the provider source tree, and returns the id definition of each registered TF resource type,
+// which is nil if the id definition can't be derived.
+func scanSource(rootDir string) (map[string]*sourceId, error) {
+	b, err := os.ReadFile(filepath.Join(rootDir, "go.mod"))
+	if err != nil {
+		return nil, err
+	}
+	var modulePath string
+	for _, line := range strings.Split(string(b), "\n") {
+		if fields := strings.Fields(line); len(fields) == 2 && fields[0] == "module" {
+			modulePath = strings.Trim(fields[1], `"`)
+			break
+		}
+	}
+	if modulePath == "" {
+		return nil, fmt.Errorf("no module path found in go.mod of %s", rootDir)
+	}
+
+	idx := &sourceIndex{
+		fset:     token.NewFileSet(),
+		root:     rootDir,
+		pkgNames: map[string]string{},
+		typeIds:  map[string]*sourceId{},
+		funcIds:  map[string]*sourceId{},
+	}
+
+	if err := idx.load(filepath.Join(rootDir, "internal"), modulePath+"/internal", nil); err != nil {
+		return nil, err
+	}
+	// Only the files that define the resource ids are needed from the vendored SDKs, which are huge.
+	for _, dir := range []string{"github.com/hashicorp/go-azure-sdk", "github.com/hashicorp/go-azure-helpers"} {
+		vendorDir := filepath.Join(rootDir, "vendor", filepath.FromSlash(dir))
+		if _, err := os.Stat(vendorDir); err != nil {
+			log.Printf("skip loading the resource ids from %s: %v", vendorDir, err)
+			continue
+		}
+		if err := idx.load(vendorDir, dir, []byte("resourceids.")); err != nil {
+			return nil, err
+		}
+	}
+
+	idx.indexIds()
+	return idx.registrations(), nil
+}
+
+// load parses the non-test Go files under the directory, whose package path is prefixed by the pkgPath.
+// If the keyword is specified, only the files that contain it are parsed.
+func (idx *sourceIndex) load(dir, pkgPath string, keyword []byte) error {
+	return filepath.WalkDir(dir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() || !strings.HasSuffix(path, ".go") || strings.HasSuffix(path, "_test.go") {
+			return nil
+		}
+		b, err := os.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		if keyword != nil && !bytes.Contains(b, keyword) {
+			return nil
+		}
+		f, err := parser.ParseFile(idx.fset, path, b, parser.SkipObjectResolution)
+		if err != nil {
+			return fmt.Errorf("parsing %s: %v", path, err)
+		}
+		rel, err := filepath.Rel(dir, filepath.Dir(path))
+		if err != nil {
+			return err
+		}
+		sf := &sourceFile{
+			pkgPath: pkgPath,
+			file:    f,
+			imports: map[string]string{},
+		}
+		if rel != "." {
+			sf.pkgPath += "/" + filepath.ToSlash(rel)
+		}
+		idx.pkgNames[sf.pkgPath] = f.Name.Name
+		idx.files = append(idx.files, sf)
+		return nil
+	})
+}
+
+func (idx *sourceIndex) position(pos token.Pos) string {
+	p := idx.fset.Position(pos)
+	if rel, err := filepath.Rel(idx.root, p.Filename); err == nil {
+		p.Filename = rel
+	}
+	return p.String()
+}
+
+// resolveImports resolves the import names of each file, which requires all the package names being loaded.
+func (idx *sourceIndex) resolveImports() {
+	for _, sf := range idx.files {
+		for _, imp := range sf.file.Imports {
+			path, err := strconv.Unquote(imp.Path.Value)
+			if err != nil {
+				continue
+			}
+			var name string
+			switch {
+			case imp.Name != nil:
+				name = imp.Name.Name
+			case idx.pkgNames[path] != "":
+				name = idx.pkgNames[path]
+			default:
+				name = path[strings.LastIndex(path, "/")+1:]
+			}
+			sf.imports[name] = path
+		}
+	}
+}
+
+// indexIds indexes the id definitions by their types, and then by the functions that parse or validate them.
+func (idx *sourceIndex) indexIds() {
+	idx.resolveImports()
+
+	for _, sf := range idx.files {
+		for _, decl := range sf.file.Decls {
+			fd, ok := decl.(*ast.FuncDecl)
+			if !ok || fd.Recv == nil || fd.Body == nil {
+				continue
+			}
+			typeName := receiverTypeName(fd)
+			var id *sourceId
+			switch fd.Name.Name {
+			case "Segments":
+				id = idx.idFromSegments(fd)
+			case "ID":
+				id = idx.idFromFormatString(fd)
+			}
+			if id != nil {
+				idx.typeIds[sf.pkgPath+"."+typeName] = id
+			}
+		}
+	}
+
+	// The parse functions return the id type.
+	for _, sf := range idx.files {
+		for _, decl := range sf.file.Decls {
+			fd, ok := decl.(*ast.FuncDecl)
+			if !ok || fd.Recv != nil || fd.Type.Results == nil {
+				continue
+			}
+			for _, field := range fd.Type.Results.List {
+				expr := field.Type
+				if star, ok := expr.(*ast.StarExpr); ok {
+					expr = star.X
+				}
+				if ident, ok := expr.(*ast.Ident); ok {
+					if id, ok := idx.typeIds[sf.pkgPath+"."+ident.Name]; ok {
+						idx.funcIds[sf.pkgPath+"."+fd.Name.Name] = id
+						break
+					}
+				}
+			}
+		}
+	}
+
+	// The validate functions call the parse functions. Resolve twice for the validate functions that call other validate functions.
+	for i := 0; i < 2; i++ {
+		for _, sf := range idx.files {
+			for _, decl := range sf.file.Decls {
+				fd, ok := decl.(*ast.FuncDecl)
+				if !ok || fd.Recv != nil || fd.Body == nil || !isValidateFunc(fd) {
+					continue
+				}
+				key := sf.pkgPath + "." + fd.Name.Name
+				if _, ok := idx.funcIds[key]; ok {
+					continue
+				}
+				if id := idx.firstCalledId(sf, fd.Body); id != nil {
+					idx.funcIds[key] = id
+				}
+			}
+		}
+	}
+}
+
+// registrations returns the id definition of each registered TF resource type.
+func (idx *sourceIndex) registrations() map[string]*sourceId {
+	out := map[string]*sourceId{}
+
+	funcDecls := map[string]*ast.FuncDecl{}
+	funcFiles := map[string]*sourceFile{}
+	typedResourceTypes := map[string]string{}
+	typedIds := map[string]*sourceId{}
+	for _, sf := range idx.files {
+		for _, decl := range sf.file.Decls {
+			fd, ok := decl.(*ast.FuncDecl)
+			if !ok || fd.Body == nil {
+				continue
+			}
+			if fd.Recv == nil {
+				funcDecls[sf.pkgPath+"."+fd.Name.Name] = fd
+				funcFiles[sf.pkgPath+"."+fd.Name.Name] = sf
+				continue
+			}
+			key := sf.pkgPath + "." + receiverTypeName(fd)
+			switch fd.Name.Name {
+			case "ResourceType":
+				if rt := returnedString(fd); strings.HasPrefix(rt, "azurerm_") {
+					typedResourceTypes[key] = rt
+				}
+			case "IDValidationFunc":
+				if ret := returnedExpr(fd); ret != nil {
+					typedIds[key] = idx.firstCalledId(sf, ret)
+				}
+			}
+		}
+	}
+
+	// Typed resources
+	for key, rt := range typedResourceTypes {
+		out[rt] = typedIds[key]
+	}
+
+	// Untyped resources
+	for _, sf := range idx.files {
+		ast.Inspect(sf.file, func(n ast.Node) bool {
+			kv, ok := n.(*ast.KeyValueExpr)
+			if !ok {
+				return true
+			}
+			lit, ok := kv.Key.(*ast.BasicLit)
+			if !ok || lit.Kind != token.STRING {
+				return true
+			}
+			rt, err := strconv.Unquote(lit.Value)
+			if err != nil || !strings.HasPrefix(rt, "azurerm_") {
+				return true
+			}
+			call, ok := kv.Value.(*ast.CallExpr)
+			if !ok {
+				return true
+			}
+			key := idx.funcKey(sf, call.Fun)
+			fd, ok := funcDecls[key]
+			if !ok {
+				return true
+			}
+			if _, ok := out[rt]; !ok {
+				out[rt] = nil
+			}
+			if id := idx.importerId(funcFiles[key], fd); id != nil {
+				out[rt] = id
+			}
+			return false
+		})
+	}
+	return out
+}
+
+// importerId returns the id definition that is parsed or validated in the "Importer" of the resource.
+func (idx *sourceIndex) importerId(sf *sourceFile, fd *ast.FuncDecl) *sourceId {
+	var id *sourceId
+	ast.Inspect(fd.Body, func(n ast.Node) bool {
+		if id != nil {
+			return false
+		}
+		kv, ok := n.(*ast.KeyValueExpr)
+		if !ok {
+			return true
+		}
+		if key, ok := kv.Key.(*ast.Ident); !ok || key.Name != "Importer" {
+			return true
+		}
+		id = idx.firstCalledId(sf, kv.Value)
+		return false
+	})
+	return id
+}
+
+// firstCalledId returns the id definition of the first called (or referenced) function that parses or validates an id.
+func (idx *sourceIndex) firstCalledId(sf *sourceFile, node ast.Node) *sourceId {
+	var id *sourceId
+	ast.Inspect(node, func(n ast.Node) bool {
+		if id != nil {
+			return false
+		}
+		switch n := n.(type) {
+		case *ast.CallExpr:
+			id = idx.resolveFunc(sf, n.Fun)
+		case *ast.SelectorExpr:
+			// The validate function can be passed as an argument.
+			id = idx.resolveFunc(sf, n)
+		}
+		return true
+	})
+	return id
+}
+
+func (idx *sourceIndex) resolveFunc(sf *sourceFile, expr ast.Expr) *sourceId {
+	return idx.funcIds[idx.funcKey(sf, expr)]
+}
+
+// funcKey returns the "<pkg path>.<func name>" of the function expression, which is either an identifier or a package qualified identifier.
+func (idx *sourceIndex) funcKey(sf *sourceFile, expr ast.Expr) string {
+	switch expr := expr.(type) {
+	case *ast.Ident:
+		return sf.pkgPath + "." + expr.Name
+	case *ast.SelectorExpr:
+		x, ok := expr.X.(*ast.Ident)
+		if !ok {
+			return ""
+		}
+		pkgPath, ok := sf.imports[x.Name]
+		if !ok {
+			return ""
+		}
+		return pkgPath + "." + expr.Sel.Name
+	}
+	return ""
+}
+
+// idFromSegments builds the id definition from the go-azure-sdk style "Segments()" method, e.g.
+//
+//	func (id FooId) Segments() []resourceids.Segment {
+//		return []resourceids.Segment{
+//			resourceids.StaticSegment("staticSubscriptions", "subscriptions", "subscriptions"),
+//			resourceids.SubscriptionIdSegment("subscriptionId", "12345678-1234-9876-4563-123456789012"),
+//			...
+//		}
+//	}
+func (idx *sourceIndex) idFromSegments(fd *ast.FuncDecl) *sourceId {
+	ret := returnedExpr(fd)
+	cl, ok := ret.(*ast.CompositeLit)
+	if !ok {
+		return nil
+	}
+	var (
+		segs     []string
+		scopeAny bool
+	)
+	for i, elt := range cl.Elts {
+		call, ok := elt.(*ast.CallExpr)
+		if !ok {
+			return nil
+		}
+		sel, ok := call.Fun.(*ast.SelectorExpr)
+		if !ok {
+			return nil
+		}
+		args := stringArgs(call)
+		switch sel.Sel.Name {
+		case "StaticSegment", "ResourceProviderSegment":
+			if len(args) < 2 {
+				return nil
+			}
+			segs = append(segs, args[1])
+		case "ConstantSegment":
+			if len(args) < 3 {
+				return nil
+			}
+			segs = append(segs, args[2])
+		case "UserSpecifiedSegment", "SubscriptionIdSegment", "ResourceGroupSegment":
+			segs = append(segs, "name")
+		case "ScopeSegment":
+			if i != 0 {
+				return nil
+			}
+			scopeAny = true
+		default:
+			return nil
+		}
+	}
+	return idx.newSourceId(fd, segs, scopeAny)
+}
+
+// idFromFormatString builds the id definition from the provider's "ID()" method, e.g.
+//
+//	func (id FooId) ID() string {
+//		fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Foo/foos/%s"
+//		return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.Name)
+//	}
+func (idx *sourceIndex) idFromFormatString(fd *ast.FuncDecl) *sourceId {
+	var format string
+	ast.Inspect(fd.Body, func(n ast.Node) bool {
+		if format != "" {
+			return false
+		}
+		lit, ok := n.(*ast.BasicLit)
+		if !ok || lit.Kind != token.STRING {
+			return true
+		}
+		if s, err := strconv.Unquote(lit.Value); err == nil && strings.HasPrefix(s, "/") && strings.Contains(s, "%s") {
+			format = s
+		}
+		return true
+	})
+	if format == "" {
+		return nil
+	}
+	var segs []string
+	for _, seg := range strings.Split(strings.TrimPrefix(format, "/"), "/") {
+		if seg == "%s" {
+			seg = "name"
+		}
+		segs = append(segs, seg)
+	}
+	return idx.newSourceId(fd, segs, false)
+}
+
+func (idx *sourceIndex) newSourceId(fd *ast.FuncDecl, segs []string, scopeAny bool) *sourceId {
+	idStr := "/" + strings.Join(segs, "/")
+	if scopeAny {
+		idStr = "/subscriptions/name/resourceGroups/name" + idStr
+	}
+	id, err := armid.ParseResourceId(idStr)
+	if err != nil {
+		return nil
+	}
+	return &sourceId{
+		pos:      idx.position(fd.Pos()),
+		id:       id,
+		scopeAny: scopeAny,
+	}
+}
+
+// isValidateFunc tells whether the function has the signature of a schema validate function, i.e. returning ([]string, []error).
+func isValidateFunc(fd *ast.FuncDecl) bool {
+	results := fd.Type.Results
+	if results == nil {
+		return false
+	}
+	var types []ast.Expr
+	for _, field := range results.List {
+		n := len(field.Names)
+		if n == 0 {
+			n = 1
+		}
+		for i := 0; i < n; i++ {
+			types = append(types, field.Type)
+		}
+	}
+	if len(types) != 2 {
+		return false
+	}
+	arr, ok := types[1].(*ast.ArrayType)
+	if !ok {
+		return false
+	}
+	elt, ok := arr.Elt.(*ast.Ident)
+	return ok && elt.Name == "error"
+}
+
+func receiverTypeName(fd *ast.FuncDecl) string {
+	if len(fd.Recv.List) == 0 {
+		return ""
+	}
+	expr := fd.Recv.List[0].Type
+	if star, ok := expr.(*ast.StarExpr); ok {
+		expr = star.X
+	}
+	if ident, ok := expr.(*ast.Ident); ok {
+		return ident.Name
+	}
+	return ""
+}
+
+// returnedExpr returns the expression of the first return statement at the top level of the function.
+func returnedExpr(fd *ast.FuncDecl) ast.Expr {
+	for _, stmt := range fd.Body.List {
+		if ret, ok := stmt.(*ast.ReturnStmt); ok && len(ret.Results) == 1 {
+			return ret.Results[0]
+		}
+	}
+	return nil
+}
+
+func returnedString(fd *ast.FuncDecl) string {
+	lit, ok := returnedExpr(fd).(*ast.BasicLit)
+	if !ok || lit.Kind != token.STRING {
+		return ""
+	}
+	s, _ := strconv.Unquote(lit.Value)
+	return s
+}
+
+func stringArgs(call *ast.CallExpr) []string {
+	var out []string
+	for _, arg := range call.Args {
+		var s string
+		if lit, ok := arg.(*ast.BasicLit); ok && lit.Kind == token.STRING {
+			s, _ = strconv.Unquote(lit.Value)
+		}
+		out = append(out, s)
+	}
+	return out
+}
+
+// mergeSourceIds merges the import specs derived from the docs and from the source, where the source takes precedence.
+// It returns the mapping of the resource types that are not under any scope, together with the mapping items of the ones
+// that are under any scope, and the sorted reports of where the docs and the source disagree.
+func mergeSourceIds(docIds map[string]armid.ResourceId, codeIds map[string]*sourceId) (map[string]armid.ResourceId, resmap.TF2ARMIdMapType, []string) {
+	ids := map[string]armid.ResourceId{}
+	scopeAnyItems := resmap.TF2ARMIdMapType{}
+
+	var reports []string
+	report := func(format string, a ...interface{}) {
+		reports = append(reports, fmt.Sprintf(format, a...))
+	}
+
+	for rt, docId := range docIds {
+		codeId, ok := codeIds[rt]
+		switch {
+		case !ok:
+			report("%s: import spec %q found in docs, but the resource isn't registered in code", rt, docId.ScopeString())
+		case codeId == nil:
+			report("%s: import spec %q found in docs, but no resource id found in code", rt, docId.ScopeString())
+		case codeId.scopeAny:
+			if docRt, codeRt := typeString(docId), typeString(codeId.id); !strings.EqualFold(docRt, codeRt) {
+				report("%s: docs and code disagree on resource type: docs %q, code %q (%s)", rt, docRt, codeRt, codeId.pos)
+			}
+		case docId.ScopeString() != codeId.id.ScopeString():
+			report("%s: docs and code disagree on import spec: docs %q, code %q (%s)", rt, docId.ScopeString(), codeId.id.ScopeString(), codeId.pos)
+		}
+		ids[rt] = docId
+	}
+
+	for rt, codeId := range codeIds {
+		if codeId == nil {
+			continue
+		}
+		if _, ok := docIds[rt]; !ok {
+			report("%s: import spec %q found in code (%s), but not in docs", rt, codeId.spec(), codeId.pos)
+		}
+		if codeId.scopeAny {
+			delete(ids, rt)
+			scopeAnyItems[rt] = resmap.TF2ARMIdMapItem{
+				ManagementPlane: &resmap.MapManagementPlane{
+					ParentScopes: []string{resmap.ScopeAny},
+					Provider:     codeId.id.Provider(),
+					Types:        codeId.id.Types(),
+				},
+			}
+			continue
+		}
+		ids[rt] = codeId.id
+	}
+
+	sort.Strings(reports)
+	return ids, scopeAnyItems, reports
+}
+
+func typeString(id armid.ResourceId) string {
+	return strings.Join(append([]string{id.Provider()}, id.Types()...), "/")
+}
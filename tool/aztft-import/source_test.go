@@ -0,0 +1,130 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/magodo/armid"
+	"github.com/magodo/aztft/internal/resmap"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	fooPos       = "internal/services/foo/parse/foo.go:13:1"
+	barPos       = "vendor/github.com/hashicorp/go-azure-sdk/resource-manager/foo/2023-01-01/bars/id_bar.go:32:1"
+	extensionPos = "vendor/github.com/hashicorp/go-azure-sdk/resource-manager/foo/2023-01-01/extensions/id_scoped_extension.go:30:1"
+)
+
+func TestScanSource(t *testing.T) {
+	ids, err := scanSource("testdata/provider")
+	require.NoError(t, err)
+
+	cases := []struct {
+		name     string
+		rt       string
+		spec     string
+		scopeAny bool
+		pos      string
+	}{
+		{
+			name: "untyped resource whose importer parses the id (format string)",
+			rt:   "azurerm_foo",
+			spec: "/subscriptions/resourceGroups/Microsoft.Foo/foos",
+			pos:  fooPos,
+		},
+		{
+			name: "untyped resource whose importer references a validate function calling another one",
+			rt:   "azurerm_foo_validate",
+			spec: "/subscriptions/resourceGroups/Microsoft.Foo/foos",
+			pos:  fooPos,
+		},
+		{
+			name: "typed resource (segments)",
+			rt:   "azurerm_foo_bar",
+			spec: "/subscriptions/resourceGroups/Microsoft.Foo/foos/bars",
+			pos:  barPos,
+		},
+		{
+			name:     "typed resource under any scope (segments)",
+			rt:       "azurerm_foo_extension",
+			spec:     "any/Microsoft.Foo/extensions",
+			scopeAny: true,
+			pos:      extensionPos,
+		},
+		{
+			name: "untyped resource whose importer has no id",
+			rt:   "azurerm_foo_no_id",
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			id, ok := ids[tt.rt]
+			require.True(t, ok)
+			if tt.spec == "" {
+				require.Nil(t, id)
+				return
+			}
+			require.NotNil(t, id)
+			require.Equal(t, tt.spec, id.spec())
+			require.Equal(t, tt.scopeAny, id.scopeAny)
+			require.Equal(t, tt.pos, id.pos)
+		})
+	}
+	require.Len(t, ids, len(cases))
+}
+
+func TestMergeSourceIds(t *testing.T) {
+	codeIds, err := scanSource("testdata/provider")
+	require.NoError(t, err)
+
+	docIds := map[string]armid.ResourceId{}
+	for rt, idStr := range map[string]string{
+		// Agrees with the code
+		"azurerm_foo": "/subscriptions/sub1/resourceGroups/rg1/providers/Microsoft.Foo/foos/foo1",
+		// Disagrees with the code on the import spec
+		"azurerm_foo_bar": "/subscriptions/sub1/resourceGroups/rg1/providers/Microsoft.Foo/foos/foo1/bazs/baz1",
+		// Disagrees with the code on the resource type under any scope
+		"azurerm_foo_extension": "/subscriptions/sub1/providers/Microsoft.Foo/plugins/ext1",
+		// No id in code
+		"azurerm_foo_no_id": "/subscriptions/sub1/resourceGroups/rg1/providers/Microsoft.Foo/noIds/n1",
+		// Not registered in code
+		"azurerm_foo_gone": "/subscriptions/sub1/resourceGroups/rg1/providers/Microsoft.Foo/gones/g1",
+	} {
+		id, err := armid.ParseResourceId(idStr)
+		require.NoError(t, err)
+		docIds[rt] = id
+	}
+
+	ids, scopeAnyItems, reports := mergeSourceIds(docIds, codeIds)
+
+	require.Equal(t, []string{
+		`azurerm_foo_bar: docs and code disagree on import spec: docs "/subscriptions/resourceGroups/Microsoft.Foo/foos/bazs", code "/subscriptions/resourceGroups/Microsoft.Foo/foos/bars" (` + barPos + `)`,
+		`azurerm_foo_extension: docs and code disagree on resource type: docs "Microsoft.Foo/plugins", code "Microsoft.Foo/extensions" (` + extensionPos + `)`,
+		`azurerm_foo_gone: import spec "/subscriptions/resourceGroups/Microsoft.Foo/gones" found in docs, but the resource isn't registered in code`,
+		`azurerm_foo_no_id: import spec "/subscriptions/resourceGroups/Microsoft.Foo/noIds" found in docs, but no resource id found in code`,
+		`azurerm_foo_validate: import spec "/subscriptions/resourceGroups/Microsoft.Foo/foos" found in code (` + fooPos + `), but not in docs`,
+	}, reports)
+
+	// The code takes precedence, while the docs are used if there is no id in code.
+	specs := map[string]string{}
+	for rt, id := range ids {
+		specs[rt] = id.ScopeString()
+	}
+	require.Equal(t, map[string]string{
+		"azurerm_foo":          "/subscriptions/resourceGroups/Microsoft.Foo/foos",
+		"azurerm_foo_bar":      "/subscriptions/resourceGroups/Microsoft.Foo/foos/bars",
+		"azurerm_foo_gone":     "/subscriptions/resourceGroups/Microsoft.Foo/gones",
+		"azurerm_foo_no_id":    "/subscriptions/resourceGroups/Microsoft.Foo/noIds",
+		"azurerm_foo_validate": "/subscriptions/resourceGroups/Microsoft.Foo/foos",
+	}, specs)
+
+	require.Equal(t, resmap.TF2ARMIdMapType{
+		"azurerm_foo_extension": {
+			ManagementPlane: &resmap.MapManagementPlane{
+				ParentScopes: []string{resmap.ScopeAny},
+				Provider:     "Microsoft.Foo",
+				Types:        []string{"extensions"},
+			},
+		},
+	}, scopeAnyItems)
+}
@@ -0,0 +1,3 @@
+module github.com/hashicorp/terraform-provider-azurerm
+
+go 1.22
@@ -0,0 +1,16 @@
+package foo
+
+import (
+	"github.com/hashicorp/go-azure-sdk/resource-manager/foo/2023-01-01/bars"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type BarResource struct{}
+
+func (r BarResource) ResourceType() string {
+	return "azurerm_foo_bar"
+}
+
+func (r BarResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return bars.ValidateBarID
+}
@@ -0,0 +1,16 @@
+package foo
+
+import (
+	"github.com/hashicorp/go-azure-sdk/resource-manager/foo/2023-01-01/extensions"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type ExtensionResource struct{}
+
+func (r ExtensionResource) ResourceType() string {
+	return "azurerm_foo_extension"
+}
+
+func (r ExtensionResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return extensions.ValidateScopedExtensionID
+}
@@ -0,0 +1,31 @@
+package foo
+
+import (
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/foo/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/foo/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+// The importer parses the id.
+func resourceFoo() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := parse.FooID(id)
+			return err
+		}),
+	}
+}
+
+// The importer doesn't parse or validate any id.
+func resourceFooNoId() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Importer: pluginsdk.DefaultImporter(),
+	}
+}
+
+// The importer references the validate function, which calls another validate function.
+func resourceFooValidate() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Importer: pluginsdk.ImporterValidatingResourceId(validate.FooIDWrapped),
+	}
+}
@@ -0,0 +1,20 @@
+package parse
+
+import (
+	"fmt"
+)
+
+type FooId struct {
+	SubscriptionId string
+	ResourceGroup  string
+	Name           string
+}
+
+func (id FooId) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Foo/foos/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.Name)
+}
+
+func FooID(input string) (*FooId, error) {
+	return nil, fmt.Errorf("not implemented")
+}
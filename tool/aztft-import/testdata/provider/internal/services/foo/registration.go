@@ -0,0 +1,25 @@
+package foo
+
+import (
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type Registration struct{}
+
+// SupportedResources returns the untyped resources.
+func (r Registration) SupportedResources() map[string]*pluginsdk.Resource {
+	return map[string]*pluginsdk.Resource{
+		"azurerm_foo":          resourceFoo(),
+		"azurerm_foo_no_id":    resourceFooNoId(),
+		"azurerm_foo_validate": resourceFooValidate(),
+	}
+}
+
+// Resources returns the typed resources.
+func (r Registration) Resources() []sdk.Resource {
+	return []sdk.Resource{
+		BarResource{},
+		ExtensionResource{},
+	}
+}
@@ -0,0 +1,23 @@
+package validate
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/foo/parse"
+)
+
+func FooIDWrapped(input interface{}, key string) (warnings []string, errors []error) {
+	return FooID(input, key)
+}
+
+func FooID(input interface{}, key string) (warnings []string, errors []error) {
+	v, ok := input.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected %q to be a string", key))
+		return
+	}
+	if _, err := parse.FooID(v); err != nil {
+		errors = append(errors, err)
+	}
+	return
+}
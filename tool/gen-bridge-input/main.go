@@ -37,9 +37,12 @@ var (
 )
 
 func main() {
-	resmap.Init()
+	m, err := resmap.Embedded()
+	if err != nil {
+		log.Fatal(err)
+	}
 	var rts []string
-	for rt := range resmap.TF2ARMIdMap {
+	for rt := range m.TF2ARMIdMap {
 		rts = append(rts, rt)
 	}
 	sort.Sort(sort.StringSlice(rts))
@@ -48,7 +51,7 @@ func main() {
 	body := f.Body()
 
 	for _, rt := range rts {
-		entry := resmap.TF2ARMIdMap[rt]
+		entry := m.TF2ARMIdMap[rt]
 
 		// Resources need dynamically construct its resource ID are mostly data plane resources
 		if tfid.NeedsAPI(rt) {
@@ -104,7 +107,7 @@ func main() {
 			}
 
 			var err error
-			idstr, err = tfid.StaticBuild(id, rt)
+			idstr, err = tfid.StaticBuild(m, id, rt)
 			if err != nil {
 				log.Fatal(err)
 			}
@@ -0,0 +1,182 @@
+package main
+
+/// This program compiles the mapping JSON (i.e. "map.json") into the static Go tables of the resmap package, which saves the runtime cost of unmarshalling and indexing it.
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"go/format"
+	"log"
+	"os"
+	"sort"
+	"strconv"
+
+	"github.com/magodo/aztft/internal/resmap"
+)
+
+const usage = `gen-resmap-tables [-o output] <mapping file>`
+
+func main() {
+	flagOutput := flag.String("o", "", "The output file, defaults to stdout")
+	flag.Usage = func() { fmt.Println(usage) }
+	flag.Parse()
+	if flag.NArg() != 1 {
+		fmt.Println(usage)
+		os.Exit(1)
+	}
+
+	b, err := os.ReadFile(flag.Arg(0))
+	if err != nil {
+		log.Fatalf("reading %s: %v", flag.Arg(0), err)
+	}
+	m, err := resmap.LoadMapping(b)
+	if err != nil {
+		log.Fatalf("loading %s: %v", flag.Arg(0), err)
+	}
+
+	src, err := generate(m)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	if *flagOutput == "" {
+		fmt.Print(string(src))
+		return
+	}
+	if err := os.WriteFile(*flagOutput, src, 0644); err != nil {
+		log.Fatalf("writing %s: %v", *flagOutput, err)
+	}
+}
+
+func generate(m *resmap.Mapping) ([]byte, error) {
+	var buf bytes.Buffer
+	w := func(format string, a ...interface{}) {
+		fmt.Fprintf(&buf, format, a...)
+	}
+
+	w("// Code generated by gen-resmap-tables; DO NOT EDIT.\n\n")
+	w("package resmap\n\n")
+
+	w("var tf2ARMIdTable = []tf2ARMIdEntry{\n")
+	var rts []string
+	for rt := range m.TF2ARMIdMap {
+		rts = append(rts, rt)
+	}
+	sort.Strings(rts)
+	for _, rt := range rts {
+		w("{%s, %s},\n", strconv.Quote(rt), mapItemLiteral(m.TF2ARMIdMap[rt]))
+	}
+	w("}\n\n")
+
+	w("var armId2TFTable = %s\n\n", armTableLiteral(m.ARMId2TFMap))
+	w("var removedARMId2TFTable = %s\n\n", armTableLiteral(m.RemovedARMId2TFMap))
+	w("var latestResourceTypes = %s\n", stringsLiteral(m.LatestSnapshot().ResourceTypes))
+
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("formatting the generated code: %v", err)
+	}
+	return src, nil
+}
+
+func mapItemLiteral(item resmap.TF2ARMIdMapItem) string {
+	var buf bytes.Buffer
+	buf.WriteString("TF2ARMIdMapItem{")
+	if mm := item.ManagementPlane; mm != nil {
+		fmt.Fprintf(&buf, "ManagementPlane: &MapManagementPlane{")
+		if mm.ParentScopes != nil {
+			fmt.Fprintf(&buf, "ParentScopes: %s, ", stringsLiteral(mm.ParentScopes))
+		}
+		fmt.Fprintf(&buf, "Provider: %s, ", strconv.Quote(mm.Provider))
+		fmt.Fprintf(&buf, "Types: %s, ", stringsLiteral(mm.Types))
+		if mm.ImportSpecs != nil {
+			fmt.Fprintf(&buf, "ImportSpecs: %s, ", stringsLiteral(mm.ImportSpecs))
+		}
+		if t := mm.IdTransform; t != nil {
+			buf.WriteString("IdTransform: &IdTransform{")
+			if t.ConstantNames != nil {
+				fmt.Fprintf(&buf, "ConstantNames: %s, ", stringMapLiteral(t.ConstantNames))
+			}
+			if t.TypeRenames != nil {
+				fmt.Fprintf(&buf, "TypeRenames: %s, ", stringMapLiteral(t.TypeRenames))
+			}
+			if t.Projection != "" {
+				fmt.Fprintf(&buf, "Projection: %s, ", strconv.Quote(t.Projection))
+			}
+			buf.WriteString("}, ")
+		}
+		buf.WriteString("}, ")
+	}
+	if item.IsRemoved {
+		buf.WriteString("IsRemoved: true, ")
+	}
+	if item.RemoveReason != "" {
+		fmt.Fprintf(&buf, "RemoveReason: %s, ", strconv.Quote(item.RemoveReason))
+	}
+	if d := item.Deprecation; d != nil {
+		fmt.Fprintf(&buf, "Deprecation: &Deprecation{Reason: %s, Successors: %s}, ", strconv.Quote(d.Reason), stringsLiteral(d.Successors))
+	}
+	buf.WriteString("}")
+	return buf.String()
+}
+
+func armTableLiteral(m resmap.ARMId2TFMapType) string {
+	var buf bytes.Buffer
+	buf.WriteString("[]armId2TFEntry{\n")
+	var k1s []string
+	for k1 := range m {
+		k1s = append(k1s, k1)
+	}
+	sort.Strings(k1s)
+	for _, k1 := range k1s {
+		var k2s []string
+		for k2 := range m[k1] {
+			k2s = append(k2s, k2)
+		}
+		sort.Strings(k2s)
+		for _, k2 := range k2s {
+			fmt.Fprintf(&buf, "{%s, %s, []ARMId2TFMapItem{", strconv.Quote(k1), strconv.Quote(k2))
+			for _, item := range m[k1][k2] {
+				fmt.Fprintf(&buf, "{%s, %s}, ", strconv.Quote(item.ResourceType), strconv.Quote(item.ImportSpec))
+			}
+			buf.WriteString("}},\n")
+		}
+	}
+	buf.WriteString("}")
+	return buf.String()
+}
+
+func stringsLiteral(l []string) string {
+	if l == nil {
+		return "nil"
+	}
+	var buf bytes.Buffer
+	buf.WriteString("[]string{")
+	for i, s := range l {
+		if i != 0 {
+			buf.WriteString(", ")
+		}
+		buf.WriteString(strconv.Quote(s))
+	}
+	buf.WriteString("}")
+	return buf.String()
+}
+
+func stringMapLiteral(m map[string]string) string {
+	var buf bytes.Buffer
+	buf.WriteString("map[string]string{")
+	var keys []string
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for i, k := range keys {
+		if i != 0 {
+			buf.WriteString(", ")
+		}
+		fmt.Fprintf(&buf, "%s: %s", strconv.Quote(k), strconv.Quote(m[k]))
+	}
+	buf.WriteString("}")
+	return buf.String()
+}